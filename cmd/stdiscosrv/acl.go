@@ -0,0 +1,261 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+const aclReloadInterval = 10 * time.Second
+
+// The ACL file is JSON of the following form. Device sets are lists of
+// device IDs and group names; "*" matches any device, including an
+// anonymous one for lookups. Leaving out "announce" lets every device
+// announce, leaving out "lookup" lets everyone look up every device.
+//
+//	{
+//	    "groups": {
+//	        "fleet": ["DEVICE-ID-1", "DEVICE-ID-2"],
+//	        "ops": ["DEVICE-ID-3"]
+//	    },
+//	    "announce": ["fleet", "ops"],
+//	    "lookup": [
+//	        {"from": ["fleet", "ops"], "to": ["fleet"]},
+//	        {"from": ["ops"], "to": ["*"]}
+//	    ]
+//	}
+type aclFileContents struct {
+	Groups   map[string][]string `json:"groups"`
+	Announce []string            `json:"announce"`
+	Lookup   []struct {
+		From []string `json:"from"`
+		To   []string `json:"to"`
+	} `json:"lookup"`
+}
+
+// An accessController decides who may announce and look up what
+type accessController interface {
+	allowAnnounce(id protocol.DeviceID) bool
+	allowLookup(requester, target protocol.DeviceID) bool
+}
+
+// accessControl is a parsed ACL file. The zero value allows everything.
+type accessControl struct {
+	announce *deviceSet  // nil means everyone may announce
+	lookup   []lookupACL // nil means everyone may look up everyone
+}
+
+type lookupACL struct {
+	from, to deviceSet
+}
+
+type deviceSet struct {
+	any     bool
+	devices map[protocol.DeviceID]struct{}
+}
+
+func (s deviceSet) contains(id protocol.DeviceID) bool {
+	if s.any {
+		return true
+	}
+	_, ok := s.devices[id]
+	return ok
+}
+
+func parseACL(bs []byte) (*accessControl, error) {
+	var contents aclFileContents
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&contents); err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string][]protocol.DeviceID, len(contents.Groups))
+	for name, members := range contents.Groups {
+		if name == "*" {
+			return nil, fmt.Errorf("invalid group name %q", name)
+		}
+		for _, member := range members {
+			id, err := protocol.DeviceIDFromString(member)
+			if err != nil {
+				return nil, fmt.Errorf("group %q: %w", name, err)
+			}
+			groups[name] = append(groups[name], id)
+		}
+	}
+
+	parseSet := func(entries []string) (deviceSet, error) {
+		set := deviceSet{devices: make(map[protocol.DeviceID]struct{})}
+		for _, entry := range entries {
+			if entry == "*" {
+				set.any = true
+				continue
+			}
+			if members, ok := groups[entry]; ok {
+				for _, id := range members {
+					set.devices[id] = struct{}{}
+				}
+				continue
+			}
+			id, err := protocol.DeviceIDFromString(entry)
+			if err != nil {
+				return deviceSet{}, fmt.Errorf("%q is neither a group nor a device ID", entry)
+			}
+			set.devices[id] = struct{}{}
+		}
+		return set, nil
+	}
+
+	var acl accessControl
+	if contents.Announce != nil {
+		set, err := parseSet(contents.Announce)
+		if err != nil {
+			return nil, fmt.Errorf("announce: %w", err)
+		}
+		acl.announce = &set
+	}
+	if contents.Lookup != nil {
+		acl.lookup = make([]lookupACL, 0, len(contents.Lookup))
+		for i, rule := range contents.Lookup {
+			from, err := parseSet(rule.From)
+			if err != nil {
+				return nil, fmt.Errorf("lookup rule %d: from: %w", i+1, err)
+			}
+			to, err := parseSet(rule.To)
+			if err != nil {
+				return nil, fmt.Errorf("lookup rule %d: to: %w", i+1, err)
+			}
+			acl.lookup = append(acl.lookup, lookupACL{from: from, to: to})
+		}
+	}
+
+	return &acl, nil
+}
+
+// allowAnnounce returns whether the device may announce its addresses
+func (a *accessControl) allowAnnounce(id protocol.DeviceID) bool {
+	return a.announce == nil || a.announce.contains(id)
+}
+
+// allowLookup returns whether the requester may look up the target. An
+// anonymous requester, i.e. one that didn't present a certificate, is given
+// as the empty device ID and is only matched by "*".
+func (a *accessControl) allowLookup(requester, target protocol.DeviceID) bool {
+	if a.lookup == nil {
+		return true
+	}
+	for _, rule := range a.lookup {
+		if (rule.from.any || requester != protocol.EmptyDeviceID && rule.from.contains(requester)) && rule.to.contains(target) {
+			return true
+		}
+	}
+	return false
+}
+
+// aclFile is an accessControl loaded from a file and reloaded when the
+// file changes. A failed reload keeps the previous ACL in effect.
+type aclFile struct {
+	path string
+
+	mut     sync.RWMutex
+	acl     *accessControl
+	modTime time.Time
+	size    int64
+}
+
+func newACLFile(path string) (*aclFile, error) {
+	f := &aclFile{path: path}
+	if err := f.reload(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *aclFile) get() *accessControl {
+	f.mut.RLock()
+	defer f.mut.RUnlock()
+	return f.acl
+}
+
+func (f *aclFile) allowAnnounce(id protocol.DeviceID) bool {
+	return f.get().allowAnnounce(id)
+}
+
+func (f *aclFile) allowLookup(requester, target protocol.DeviceID) bool {
+	return f.get().allowLookup(requester, target)
+}
+
+// reload reads and parses the file if it has changed since the last
+// successful load.
+func (f *aclFile) reload() error {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return err
+	}
+
+	f.mut.RLock()
+	unchanged := info.ModTime().Equal(f.modTime) && info.Size() == f.size
+	f.mut.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	bs, err := os.ReadFile(f.path)
+	if err != nil {
+		return err
+	}
+	acl, err := parseACL(bs)
+	if err != nil {
+		return err
+	}
+
+	f.mut.Lock()
+	f.acl = acl
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+	f.mut.Unlock()
+
+	log.Println("Loaded ACL file", f.path)
+	return nil
+}
+
+func (f *aclFile) Serve(ctx context.Context) error {
+	t := time.NewTicker(aclReloadInterval)
+	defer t.Stop()
+
+	var lastErr string
+	for {
+		select {
+		case <-t.C:
+			if err := f.reload(); err != nil {
+				// Log each distinct error once, not every ten seconds.
+				if err.Error() != lastErr {
+					log.Println("Reloading ACL file:", err)
+					lastErr = err.Error()
+				}
+			} else {
+				lastErr = ""
+			}
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (f *aclFile) String() string {
+	return fmt.Sprintf("aclFile(%s)", f.path)
+}
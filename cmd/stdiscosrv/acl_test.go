@@ -0,0 +1,152 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+var (
+	aclDev1 = protocol.DeviceID{1}
+	aclDev2 = protocol.DeviceID{2}
+	aclDev3 = protocol.DeviceID{3}
+	aclDev4 = protocol.DeviceID{4}
+)
+
+func TestACL(t *testing.T) {
+	acl, err := parseACL([]byte(fmt.Sprintf(`{
+		"groups": {
+			"fleet": [%q, %q],
+			"ops": [%q]
+		},
+		"announce": ["fleet", %q],
+		"lookup": [
+			{"from": ["fleet"], "to": ["fleet"]},
+			{"from": ["ops"], "to": ["*"]}
+		]
+	}`, aclDev1, aclDev2, aclDev3, aclDev4)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	announce := map[protocol.DeviceID]bool{
+		aclDev1: true,
+		aclDev2: true,
+		aclDev3: false,
+		aclDev4: true,
+	}
+	for id, exp := range announce {
+		if res := acl.allowAnnounce(id); res != exp {
+			t.Errorf("announce by %s: %v != expected %v", id.Short(), res, exp)
+		}
+	}
+
+	lookups := []struct {
+		from, to protocol.DeviceID
+		exp      bool
+	}{
+		{aclDev1, aclDev2, true},
+		{aclDev2, aclDev1, true},
+		{aclDev1, aclDev3, false},
+		{aclDev3, aclDev1, true},
+		{aclDev3, aclDev4, true},
+		{aclDev4, aclDev1, false},
+		{protocol.EmptyDeviceID, aclDev1, false},
+	}
+	for _, tc := range lookups {
+		if res := acl.allowLookup(tc.from, tc.to); res != tc.exp {
+			t.Errorf("lookup of %s by %s: %v != expected %v", tc.to.Short(), tc.from.Short(), res, tc.exp)
+		}
+	}
+}
+
+func TestACLDefaults(t *testing.T) {
+	// Everything is allowed unless restricted.
+
+	acl, err := parseACL([]byte(`{"lookup": [{"from": ["*"], "to": ["*"]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !acl.allowAnnounce(aclDev1) {
+		t.Error("announce should be allowed without announce list")
+	}
+	if !acl.allowLookup(protocol.EmptyDeviceID, aclDev1) {
+		t.Error("anonymous lookup should be allowed by wildcard")
+	}
+
+	acl, err = parseACL([]byte(`{"announce": [], "lookup": []}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acl.allowAnnounce(aclDev1) || acl.allowLookup(aclDev1, aclDev1) {
+		t.Error("empty lists should deny everything")
+	}
+}
+
+func TestACLInvalid(t *testing.T) {
+	cases := []string{
+		`{"announce": ["nonexistent"]}`,
+		`{"groups": {"g": ["invalid"]}}`,
+		`{"groups": {"*": []}}`,
+		`{"lookups": []}`,
+		`[]`,
+	}
+	for _, tc := range cases {
+		if _, err := parseACL([]byte(tc)); err == nil {
+			t.Errorf("%s: expected error", tc)
+		}
+	}
+}
+
+func TestACLFileReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "acl.json")
+	write := func(s string, mtime time.Time) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(s), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t0 := time.Now().Add(-time.Hour)
+	write(fmt.Sprintf(`{"announce": [%q]}`, aclDev1), t0)
+	f, err := newACLFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.allowAnnounce(aclDev1) || f.allowAnnounce(aclDev2) {
+		t.Fatal("unexpected initial ACL")
+	}
+
+	// A changed file is picked up.
+
+	write(fmt.Sprintf(`{"announce": [%q]}`, aclDev2), t0.Add(time.Minute))
+	if err := f.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if f.allowAnnounce(aclDev1) || !f.allowAnnounce(aclDev2) {
+		t.Fatal("changed ACL not loaded")
+	}
+
+	// An invalid file keeps the previous ACL.
+
+	write(`{"announce": [`, t0.Add(2*time.Minute))
+	if err := f.reload(); err == nil {
+		t.Fatal("expected error for invalid file")
+	}
+	if !f.allowAnnounce(aclDev2) {
+		t.Fatal("previous ACL should remain after failed reload")
+	}
+}
@@ -44,10 +44,12 @@ type apiSrv struct {
 	db       database
 	listener net.Listener
 	repl     replicator // optional
+	acl      accessController
 	useHTTP  bool
 
-	mapsMut sync.Mutex
-	misses  map[string]int32
+	mapsMut      sync.Mutex
+	misses       map[string]int32
+	deniedMisses map[string]int32
 }
 
 type requestID int64
@@ -60,14 +62,20 @@ type contextKey int
 
 const idKey contextKey = iota
 
-func newAPISrv(addr string, cert tls.Certificate, db database, repl replicator, useHTTP bool) *apiSrv {
+func newAPISrv(addr string, cert tls.Certificate, db database, repl replicator, acl accessController, useHTTP bool) *apiSrv {
+	if acl == nil {
+		acl = &accessControl{}
+	}
 	return &apiSrv{
 		addr:    addr,
 		cert:    cert,
 		db:      db,
 		repl:    repl,
+		acl:     acl,
 		useHTTP: useHTTP,
 		misses:  make(map[string]int32),
+
+		deniedMisses: make(map[string]int32),
 	}
 }
 
@@ -181,6 +189,32 @@ func (s *apiSrv) handleGET(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	// Lookups may be made anonymously; the requester is then the empty
+	// device ID.
+	var requester protocol.DeviceID
+	if rawCert, err := certificateBytes(req); err == nil {
+		requester = protocol.NewDeviceID(rawCert)
+	}
+	if !s.acl.allowLookup(requester, deviceID) {
+		if debug {
+			log.Println(reqID, "lookup of", deviceID, "by", requester, "denied")
+		}
+		// Answer as if the device was unknown, so as to not reveal which
+		// devices use this server. The misses are counted apart from
+		// those of allowed lookups, which are written to the database
+		// and set the retry interval for everyone.
+		lookupRequestsTotal.WithLabelValues("denied").Inc()
+		s.mapsMut.Lock()
+		s.deniedMisses[deviceID.String()]++
+		misses := s.deniedMisses[deviceID.String()]
+		s.mapsMut.Unlock()
+		afterS := notFoundRetryAfterSeconds(int(misses))
+		retryAfterHistogram.Observe(float64(afterS))
+		w.Header().Set("Retry-After", strconv.Itoa(afterS))
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return
+	}
+
 	key := deviceID.String()
 	rec, err := s.db.get(key)
 	if err != nil {
@@ -252,6 +286,17 @@ func (s *apiSrv) handlePOST(remoteAddr *net.TCPAddr, w http.ResponseWriter, req
 		return
 	}
 
+	deviceID := protocol.NewDeviceID(rawCert)
+	if !s.acl.allowAnnounce(deviceID) {
+		if debug {
+			log.Println(reqID, "announcement by", deviceID, "denied")
+		}
+		announceRequestsTotal.WithLabelValues("denied").Inc()
+		w.Header().Set("Retry-After", errorRetryAfterString())
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
 	var ann announcement
 	if err := json.NewDecoder(req.Body).Decode(&ann); err != nil {
 		if debug {
@@ -263,8 +308,6 @@ func (s *apiSrv) handlePOST(remoteAddr *net.TCPAddr, w http.ResponseWriter, req
 		return
 	}
 
-	deviceID := protocol.NewDeviceID(rawCert)
-
 	addresses := fixupAddresses(remoteAddr, ann.Addresses)
 	if len(addresses) == 0 {
 		announceRequestsTotal.WithLabelValues("bad_request").Inc()
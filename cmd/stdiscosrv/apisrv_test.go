@@ -7,9 +7,15 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/syncthing/syncthing/lib/protocol"
 )
 
 func TestFixupAddresses(t *testing.T) {
@@ -94,3 +100,43 @@ func addr(host string, port int) *net.TCPAddr {
 		Port: port,
 	}
 }
+
+func TestDeniedLookupMisses(t *testing.T) {
+	db, err := newMemoryLevelDBStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	acl, err := parseACL([]byte(fmt.Sprintf(`{"lookup": [{"from": ["*"], "to": [%q]}]}`, aclDev2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newAPISrv("", tls.Certificate{}, db, nil, acl, true)
+
+	lookup := func(id protocol.DeviceID) {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/?device="+id.String(), nil)
+		req = req.WithContext(context.WithValue(req.Context(), idKey, requestID(1)))
+		w := httptest.NewRecorder()
+		srv.handleGET(w, req)
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("lookup of %s: %d", id.Short(), w.Code)
+		}
+	}
+
+	// Denied lookups don't count as misses of the device itself, which
+	// would be stored and slow down the lookups of those allowed.
+	for i := 0; i < notFoundMissesWriteInterval; i++ {
+		lookup(aclDev1)
+	}
+	if misses := srv.misses[aclDev1.String()]; misses != 0 {
+		t.Errorf("denied lookups counted as %d misses", misses)
+	}
+	if rec, err := db.get(aclDev1.String()); err != nil || rec.Misses != 0 {
+		t.Errorf("denied lookups stored as %d misses (%v)", rec.Misses, err)
+	}
+
+	lookup(aclDev2)
+	if misses := srv.misses[aclDev2.String()]; misses != 1 {
+		t.Errorf("allowed lookup counted as %d misses", misses)
+	}
+}
@@ -37,6 +37,10 @@ type database interface {
 	put(key string, rec DatabaseRecord) error
 	merge(key string, addrs []DatabaseAddress, seen int64) error
 	get(key string) (DatabaseRecord, error)
+
+	// iterate calls fn for each record with a key starting with prefix,
+	// in key order, until fn returns false. Addresses are not expired.
+	iterate(prefix string, fn func(key string, rec DatabaseRecord) bool) error
 }
 
 type levelDBStore struct {
@@ -170,6 +174,21 @@ func (s *levelDBStore) get(key string) (DatabaseRecord, error) {
 	return rec, nil
 }
 
+func (s *levelDBStore) iterate(prefix string, fn func(key string, rec DatabaseRecord) bool) error {
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(prefix)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		var rec DatabaseRecord
+		if err := rec.Unmarshal(iter.Value()); err != nil {
+			continue
+		}
+		if !fn(string(iter.Key()), rec) {
+			break
+		}
+	}
+	return iter.Error()
+}
+
 func (s *levelDBStore) Serve(ctx context.Context) error {
 	t := time.NewTimer(0)
 	defer t.Stop()
@@ -215,12 +234,7 @@ func (s *levelDBStore) statisticsServe(trigger <-chan struct{}, done chan<- stru
 	defer close(done)
 
 	for range trigger {
-		t0 := time.Now()
-		nowNanos := t0.UnixNano()
-		cutoff24h := t0.Add(-24 * time.Hour).UnixNano()
-		cutoff1w := t0.Add(-7 * 24 * time.Hour).UnixNano()
-		cutoff2Mon := t0.Add(-60 * 24 * time.Hour).UnixNano()
-		current, currentIPv4, currentIPv6, last24h, last1w, inactive, errors := 0, 0, 0, 0, 0, 0, 0
+		stats := newDatabaseStatistics(time.Now())
 
 		iter := s.db.NewIterator(&util.Range{}, nil)
 		for iter.Next() {
@@ -228,49 +242,11 @@ func (s *levelDBStore) statisticsServe(trigger <-chan struct{}, done chan<- stru
 			// failure if there's something wrong with it.
 			var rec DatabaseRecord
 			if err := rec.Unmarshal(iter.Value()); err != nil {
-				errors++
+				stats.errors++
 				continue
 			}
 
-			// If there are addresses that have not expired it's a current
-			// record, otherwise account it based on when it was last seen
-			// (last 24 hours or last week) or finally as inactice.
-			addrs := expire(rec.Addresses, nowNanos)
-			switch {
-			case len(addrs) > 0:
-				current++
-				seenIPv4, seenIPv6 := false, false
-				for _, addr := range addrs {
-					uri, err := url.Parse(addr.Address)
-					if err != nil {
-						continue
-					}
-					host, _, err := net.SplitHostPort(uri.Host)
-					if err != nil {
-						continue
-					}
-					if ip := net.ParseIP(host); ip != nil && ip.To4() != nil {
-						seenIPv4 = true
-					} else if ip != nil {
-						seenIPv6 = true
-					}
-					if seenIPv4 && seenIPv6 {
-						break
-					}
-				}
-				if seenIPv4 {
-					currentIPv4++
-				}
-				if seenIPv6 {
-					currentIPv6++
-				}
-			case rec.Seen > cutoff24h:
-				last24h++
-			case rec.Seen > cutoff1w:
-				last1w++
-			case rec.Seen > cutoff2Mon:
-				inactive++
-			case rec.Missed < cutoff2Mon:
+			if stats.add(rec) {
 				// It hasn't been seen lately and we haven't recorded
 				// someone asking for this device in a long time either;
 				// delete the record.
@@ -279,27 +255,101 @@ func (s *levelDBStore) statisticsServe(trigger <-chan struct{}, done chan<- stru
 				} else {
 					databaseOperations.WithLabelValues(dbOpDelete, dbResSuccess).Inc()
 				}
-			default:
-				inactive++
 			}
 		}
 
 		iter.Release()
 
-		databaseKeys.WithLabelValues("current").Set(float64(current))
-		databaseKeys.WithLabelValues("currentIPv4").Set(float64(currentIPv4))
-		databaseKeys.WithLabelValues("currentIPv6").Set(float64(currentIPv6))
-		databaseKeys.WithLabelValues("last24h").Set(float64(last24h))
-		databaseKeys.WithLabelValues("last1w").Set(float64(last1w))
-		databaseKeys.WithLabelValues("inactive").Set(float64(inactive))
-		databaseKeys.WithLabelValues("error").Set(float64(errors))
-		databaseStatisticsSeconds.Set(time.Since(t0).Seconds())
+		stats.publish()
 
 		// Signal that we are done and can be scheduled again.
 		done <- struct{}{}
 	}
 }
 
+// databaseStatistics counts the records of one pass over the database, for
+// the database_keys metric.
+type databaseStatistics struct {
+	t0         time.Time
+	nowNanos   int64
+	cutoff24h  int64
+	cutoff1w   int64
+	cutoff2Mon int64
+
+	current, currentIPv4, currentIPv6, last24h, last1w, inactive, errors int
+}
+
+func newDatabaseStatistics(t0 time.Time) *databaseStatistics {
+	return &databaseStatistics{
+		t0:         t0,
+		nowNanos:   t0.UnixNano(),
+		cutoff24h:  t0.Add(-24 * time.Hour).UnixNano(),
+		cutoff1w:   t0.Add(-7 * 24 * time.Hour).UnixNano(),
+		cutoff2Mon: t0.Add(-60 * 24 * time.Hour).UnixNano(),
+	}
+}
+
+// add accounts for the given record. It returns true if the record is
+// stale and should be deleted from the database.
+func (s *databaseStatistics) add(rec DatabaseRecord) bool {
+	// If there are addresses that have not expired it's a current
+	// record, otherwise account it based on when it was last seen
+	// (last 24 hours or last week) or finally as inactice.
+	addrs := expire(rec.Addresses, s.nowNanos)
+	switch {
+	case len(addrs) > 0:
+		s.current++
+		seenIPv4, seenIPv6 := false, false
+		for _, addr := range addrs {
+			uri, err := url.Parse(addr.Address)
+			if err != nil {
+				continue
+			}
+			host, _, err := net.SplitHostPort(uri.Host)
+			if err != nil {
+				continue
+			}
+			if ip := net.ParseIP(host); ip != nil && ip.To4() != nil {
+				seenIPv4 = true
+			} else if ip != nil {
+				seenIPv6 = true
+			}
+			if seenIPv4 && seenIPv6 {
+				break
+			}
+		}
+		if seenIPv4 {
+			s.currentIPv4++
+		}
+		if seenIPv6 {
+			s.currentIPv6++
+		}
+	case rec.Seen > s.cutoff24h:
+		s.last24h++
+	case rec.Seen > s.cutoff1w:
+		s.last1w++
+	case rec.Seen > s.cutoff2Mon:
+		s.inactive++
+	case rec.Missed < s.cutoff2Mon:
+		return true
+	default:
+		s.inactive++
+	}
+	return false
+}
+
+// publish sets the metrics from the accumulated counts
+func (s *databaseStatistics) publish() {
+	databaseKeys.WithLabelValues("current").Set(float64(s.current))
+	databaseKeys.WithLabelValues("currentIPv4").Set(float64(s.currentIPv4))
+	databaseKeys.WithLabelValues("currentIPv6").Set(float64(s.currentIPv6))
+	databaseKeys.WithLabelValues("last24h").Set(float64(s.last24h))
+	databaseKeys.WithLabelValues("last1w").Set(float64(s.last1w))
+	databaseKeys.WithLabelValues("inactive").Set(float64(s.inactive))
+	databaseKeys.WithLabelValues("error").Set(float64(s.errors))
+	databaseStatisticsSeconds.Set(time.Since(s.t0).Seconds())
+}
+
 // merge returns the merged result of the two database records a and b. The
 // result is the union of the two address sets, with the newer expiry time
 // chosen for any duplicates.
@@ -67,6 +67,7 @@ type ReplicationRecord struct {
 	Key       string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Addresses []DatabaseAddress `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses"`
 	Seen      int64             `protobuf:"varint,3,opt,name=seen,proto3" json:"seen,omitempty"`
+	Bulk      bool              `protobuf:"varint,4,opt,name=bulk,proto3" json:"bulk,omitempty"`
 }
 
 func (m *ReplicationRecord) Reset()         { *m = ReplicationRecord{} }
@@ -102,6 +103,84 @@ func (m *ReplicationRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReplicationRecord proto.InternalMessageInfo
 
+// Exchanged at the start of anti-entropy replication connections. The
+// sender lists the digests of its key ranges, the receiver answers with
+// the ranges (without digests) it wants to receive.
+type ReplicationDigest struct {
+	Ranges []ReplicationRangeDigest `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges"`
+}
+
+func (m *ReplicationDigest) Reset()         { *m = ReplicationDigest{} }
+func (m *ReplicationDigest) String() string { return proto.CompactTextString(m) }
+func (*ReplicationDigest) ProtoMessage()    {}
+func (*ReplicationDigest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b90fe3356ea5df07, []int{2}
+}
+func (m *ReplicationDigest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationDigest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationDigest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationDigest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationDigest.Merge(m, src)
+}
+func (m *ReplicationDigest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationDigest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationDigest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationDigest proto.InternalMessageInfo
+
+type ReplicationRangeDigest struct {
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Digest []byte `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (m *ReplicationRangeDigest) Reset()         { *m = ReplicationRangeDigest{} }
+func (m *ReplicationRangeDigest) String() string { return proto.CompactTextString(m) }
+func (*ReplicationRangeDigest) ProtoMessage()    {}
+func (*ReplicationRangeDigest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b90fe3356ea5df07, []int{3}
+}
+func (m *ReplicationRangeDigest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationRangeDigest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationRangeDigest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationRangeDigest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationRangeDigest.Merge(m, src)
+}
+func (m *ReplicationRangeDigest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationRangeDigest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationRangeDigest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationRangeDigest proto.InternalMessageInfo
+
 type DatabaseAddress struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Expires int64  `protobuf:"varint,2,opt,name=expires,proto3" json:"expires,omitempty"`
@@ -111,7 +190,7 @@ func (m *DatabaseAddress) Reset()         { *m = DatabaseAddress{} }
 func (m *DatabaseAddress) String() string { return proto.CompactTextString(m) }
 func (*DatabaseAddress) ProtoMessage()    {}
 func (*DatabaseAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b90fe3356ea5df07, []int{2}
+	return fileDescriptor_b90fe3356ea5df07, []int{4}
 }
 func (m *DatabaseAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -143,30 +222,37 @@ var xxx_messageInfo_DatabaseAddress proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*DatabaseRecord)(nil), "main.DatabaseRecord")
 	proto.RegisterType((*ReplicationRecord)(nil), "main.ReplicationRecord")
+	proto.RegisterType((*ReplicationDigest)(nil), "main.ReplicationDigest")
+	proto.RegisterType((*ReplicationRangeDigest)(nil), "main.ReplicationRangeDigest")
 	proto.RegisterType((*DatabaseAddress)(nil), "main.DatabaseAddress")
 }
 
 func init() { proto.RegisterFile("database.proto", fileDescriptor_b90fe3356ea5df07) }
 
 var fileDescriptor_b90fe3356ea5df07 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0x41, 0x4a, 0xc4, 0x30,
-	0x18, 0x85, 0x9b, 0x49, 0x1d, 0x99, 0x08, 0xa3, 0x06, 0x94, 0x20, 0x12, 0x4b, 0xdd, 0x74, 0xd5,
-	0x01, 0x5d, 0xb9, 0x74, 0xd0, 0x0b, 0xe4, 0x06, 0xe9, 0xe4, 0x77, 0x08, 0x3a, 0x4d, 0x49, 0x2a,
-	0xe8, 0x29, 0xf4, 0x58, 0x5d, 0xce, 0xd2, 0x95, 0x68, 0x7b, 0x11, 0x69, 0x26, 0x55, 0x14, 0x37,
-	0xb3, 0x7b, 0xdf, 0xff, 0xbf, 0x97, 0xbc, 0x84, 0x4c, 0x95, 0xac, 0x65, 0x21, 0x1d, 0xe4, 0x95,
-	0x35, 0xb5, 0xa1, 0xf1, 0x4a, 0xea, 0xf2, 0xe4, 0xdc, 0x42, 0x65, 0xdc, 0xcc, 0x8f, 0x8a, 0xc7,
-	0xbb, 0xd9, 0xd2, 0x2c, 0x8d, 0x07, 0xaf, 0x36, 0xd6, 0xf4, 0x05, 0x91, 0xe9, 0x4d, 0x48, 0x0b,
-	0x58, 0x18, 0xab, 0xe8, 0x15, 0x99, 0x48, 0xa5, 0x2c, 0x38, 0x07, 0x8e, 0xa1, 0x04, 0x67, 0x7b,
-	0x17, 0x47, 0x79, 0x7f, 0x62, 0x3e, 0x18, 0xaf, 0x37, 0xeb, 0x79, 0xdc, 0xbc, 0x9f, 0x45, 0xe2,
-	0xc7, 0x4d, 0x8f, 0xc9, 0x78, 0xa5, 0x7d, 0x6e, 0x94, 0xa0, 0x6c, 0x47, 0x04, 0xa2, 0x94, 0xc4,
-	0x0e, 0xa0, 0x64, 0x38, 0x41, 0x19, 0x16, 0x5e, 0x7f, 0x7b, 0x15, 0x8b, 0xfd, 0x34, 0x50, 0x5a,
-	0x93, 0x43, 0x01, 0xd5, 0x83, 0x5e, 0xc8, 0x5a, 0x9b, 0x32, 0x74, 0x3a, 0x20, 0xf8, 0x1e, 0x9e,
-	0x19, 0x4a, 0x50, 0x36, 0x11, 0xbd, 0xfc, 0xdd, 0x72, 0xb4, 0x55, 0xcb, 0x7f, 0xda, 0xa4, 0xb7,
-	0x64, 0xff, 0x4f, 0x8e, 0x32, 0xb2, 0x1b, 0x32, 0xe1, 0xde, 0x01, 0xfb, 0x0d, 0x3c, 0x55, 0xda,
-	0x86, 0x77, 0x62, 0x31, 0xe0, 0xfc, 0xb4, 0xf9, 0xe4, 0x51, 0xd3, 0x72, 0xb4, 0x6e, 0x39, 0xfa,
-	0x68, 0x39, 0x7a, 0xed, 0x78, 0xb4, 0xee, 0x78, 0xf4, 0xd6, 0xf1, 0xa8, 0x18, 0xfb, 0x3f, 0xbf,
-	0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xa2, 0xf6, 0x1e, 0xb0, 0x01, 0x00, 0x00,
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x4e, 0xf2, 0x40,
+	0x10, 0xee, 0xd2, 0xfe, 0xf0, 0xb3, 0x1a, 0xd4, 0x4d, 0x24, 0x8d, 0x21, 0x6b, 0x53, 0x2f, 0x3d,
+	0x41, 0xa2, 0x27, 0xbd, 0x49, 0x30, 0xf1, 0x66, 0xb2, 0x6f, 0xb0, 0xa5, 0x4b, 0xb3, 0x01, 0xba,
+	0xcd, 0x6e, 0x49, 0xf0, 0x0d, 0xbc, 0xe9, 0x63, 0x71, 0xe4, 0xe8, 0xc9, 0x28, 0xbc, 0x88, 0xe9,
+	0x76, 0x00, 0x31, 0x5c, 0xbc, 0x7d, 0xdf, 0xcc, 0x37, 0x33, 0xdf, 0xcc, 0x2e, 0x6e, 0x25, 0xbc,
+	0xe0, 0x31, 0x37, 0xa2, 0x9b, 0x6b, 0x55, 0x28, 0xe2, 0x4d, 0xb9, 0xcc, 0x2e, 0xae, 0xb4, 0xc8,
+	0x95, 0xe9, 0xd9, 0x50, 0x3c, 0x1b, 0xf5, 0x52, 0x95, 0x2a, 0x4b, 0x2c, 0xaa, 0xa4, 0xe1, 0x2b,
+	0xc2, 0xad, 0x01, 0x54, 0x33, 0x31, 0x54, 0x3a, 0x21, 0xb7, 0xb8, 0xc9, 0x93, 0x44, 0x0b, 0x63,
+	0x84, 0xf1, 0x51, 0xe0, 0x46, 0x47, 0xd7, 0xe7, 0xdd, 0xb2, 0x63, 0x77, 0x23, 0xbc, 0xaf, 0xd2,
+	0x7d, 0x6f, 0xf1, 0x71, 0xe9, 0xb0, 0x9d, 0x9a, 0xb4, 0x71, 0x7d, 0x2a, 0x6d, 0x5d, 0x2d, 0x40,
+	0xd1, 0x3f, 0x06, 0x8c, 0x10, 0xec, 0x19, 0x21, 0x32, 0xdf, 0x0d, 0x50, 0xe4, 0x32, 0x8b, 0xb7,
+	0xda, 0xc4, 0xf7, 0x6c, 0x14, 0x58, 0xf8, 0x82, 0xf0, 0x19, 0x13, 0xf9, 0x44, 0x0e, 0x79, 0x21,
+	0x55, 0x06, 0xa6, 0x4e, 0xb1, 0x3b, 0x16, 0xcf, 0x3e, 0x0a, 0x50, 0xd4, 0x64, 0x25, 0xdc, 0xb7,
+	0x59, 0xfb, 0x93, 0xcd, 0x43, 0x76, 0x08, 0xf6, 0xe2, 0xd9, 0x64, 0x6c, 0xcd, 0xfc, 0x67, 0x16,
+	0x87, 0x4f, 0x7b, 0x4e, 0x06, 0x32, 0x15, 0xa6, 0x20, 0x77, 0xb8, 0xae, 0x79, 0x96, 0x6e, 0x6f,
+	0xd3, 0xa9, 0x86, 0xfe, 0xb4, 0x5c, 0xa6, 0x2b, 0x35, 0xcc, 0x86, 0x8a, 0xf0, 0x11, 0xb7, 0x0f,
+	0xeb, 0xca, 0x6b, 0xe4, 0x5a, 0x8c, 0xe4, 0x1c, 0x56, 0x04, 0x56, 0xc6, 0x13, 0xab, 0xb0, 0x17,
+	0x3d, 0x66, 0xc0, 0xc2, 0x07, 0x7c, 0xf2, 0x6b, 0x4d, 0xe2, 0xe3, 0x06, 0xac, 0x08, 0x3d, 0x1a,
+	0x7c, 0x97, 0x11, 0xf3, 0x5c, 0x6a, 0x78, 0x17, 0x97, 0x6d, 0x68, 0xbf, 0xb3, 0xf8, 0xa2, 0xce,
+	0x62, 0x45, 0xd1, 0x72, 0x45, 0xd1, 0xe7, 0x8a, 0xa2, 0xb7, 0x35, 0x75, 0x96, 0x6b, 0xea, 0xbc,
+	0xaf, 0xa9, 0x13, 0xd7, 0xed, 0x1f, 0xb9, 0xf9, 0x1e, 0x00, 0xd0, 0xbf, 0x44, 0xdd, 0x60, 0x02,
+	0x00, 0x00,
 }
 
 func (m *DatabaseRecord) Marshal() (dAtA []byte, err error) {
@@ -241,6 +327,16 @@ func (m *ReplicationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Bulk {
+		i--
+		if m.Bulk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Seen != 0 {
 		i = encodeVarintDatabase(dAtA, i, uint64(m.Seen))
 		i--
@@ -270,6 +366,80 @@ func (m *ReplicationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReplicationDigest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationDigest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationDigest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ranges) > 0 {
+		for iNdEx := len(m.Ranges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ranges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDatabase(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationRangeDigest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationRangeDigest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationRangeDigest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintDatabase(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintDatabase(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DatabaseAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -359,6 +529,41 @@ func (m *ReplicationRecord) Size() (n int) {
 	if m.Seen != 0 {
 		n += 1 + sovDatabase(uint64(m.Seen))
 	}
+	if m.Bulk {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReplicationDigest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ranges) > 0 {
+		for _, e := range m.Ranges {
+			l = e.Size()
+			n += 1 + l + sovDatabase(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReplicationRangeDigest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovDatabase(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovDatabase(uint64(l))
+	}
 	return n
 }
 
@@ -639,6 +844,226 @@ func (m *ReplicationRecord) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bulk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatabase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bulk = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDatabase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationDigest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDatabase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationDigest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationDigest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatabase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ranges = append(m.Ranges, ReplicationRangeDigest{})
+			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDatabase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationRangeDigest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDatabase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationRangeDigest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationRangeDigest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatabase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatabase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDatabase(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"testing"
 	"time"
 )
@@ -22,6 +23,129 @@ func TestDatabaseGetSet(t *testing.T) {
 	go db.Serve(ctx)
 	defer cancel()
 
+	testDatabaseGetSet(t, db, func(c clock) { db.clock = c })
+}
+
+func TestSQLDatabaseGetSet(t *testing.T) {
+	db, err := newSQLStore("sqlite://" + filepath.Join(t.TempDir(), "discovery.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go db.Serve(ctx)
+	defer cancel()
+
+	testDatabaseGetSet(t, db, func(c clock) { db.clock = c })
+}
+
+func TestSQLDatabaseShared(t *testing.T) {
+	dbURL := "sqlite://" + filepath.Join(t.TempDir(), "discovery.sqlite")
+	db1, err := newSQLStore(dbURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db1.db.Close()
+	db2, err := newSQLStore(dbURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db2.db.Close()
+
+	// Announcements to either instance are merged in the shared database
+
+	expires := time.Now().Add(time.Minute).UnixNano()
+	if err := db1.merge("abcd", []DatabaseAddress{{Address: "tcp://1.2.3.4:5", Expires: expires}}, time.Now().UnixNano()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db2.merge("abcd", []DatabaseAddress{{Address: "tcp://6.7.8.9:0", Expires: expires}}, time.Now().UnixNano()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, db := range []*sqlStore{db1, db2} {
+		rec, err := db.get("abcd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rec.Addresses) != 2 {
+			t.Log(rec.Addresses)
+			t.Error("should have two addresses")
+		}
+	}
+}
+
+func TestSQLDatabaseExpiry(t *testing.T) {
+	db, err := newSQLStore("sqlite://" + filepath.Join(t.TempDir(), "discovery.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.db.Close()
+
+	// A record that has been neither seen nor asked for in a long time is
+	// removed by the statistics pass, while a recently missed one is kept.
+
+	old := time.Now().Add(-90 * 24 * time.Hour).UnixNano()
+	if err := db.put("stale", DatabaseRecord{Seen: old, Missed: old}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.put("missed", DatabaseRecord{Seen: old, Missed: time.Now().UnixNano(), Misses: 10}); err != nil {
+		t.Fatal(err)
+	}
+
+	db.statistics(context.Background())
+
+	var count int
+	if err := db.db.QueryRow(`SELECT COUNT(*) FROM discovery_records`).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatal("expected one remaining record, not", count)
+	}
+	rec, err := db.get("missed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Misses != 10 {
+		t.Error("incorrect misses", rec.Misses)
+	}
+}
+
+func TestSQLDatabaseExpiryRefreshed(t *testing.T) {
+	db, err := newSQLStore("sqlite://" + filepath.Join(t.TempDir(), "discovery.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.db.Close()
+
+	// A record refreshed by another instance after being judged stale is
+	// kept.
+
+	old := time.Now().Add(-90 * 24 * time.Hour).UnixNano()
+	stale := DatabaseRecord{Seen: old, Missed: old}
+	if err := db.put("refreshed", stale); err != nil {
+		t.Fatal(err)
+	}
+	bs, err := stale.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.put("refreshed", DatabaseRecord{Seen: time.Now().UnixNano()}); err != nil {
+		t.Fatal(err)
+	}
+
+	db.deleteStale(context.Background(), "refreshed", bs)
+
+	rec, err := db.get("refreshed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Seen == old || rec.Seen == 0 {
+		t.Error("refreshed record was deleted")
+	}
+}
+
+func testDatabaseGetSet(t *testing.T, db database, setClock func(clock)) {
+	t.Helper()
+
 	// Check missing record
 
 	rec, err := db.get("abcd")
@@ -39,7 +163,7 @@ func TestDatabaseGetSet(t *testing.T) {
 
 	now := time.Now()
 	tc := &testClock{now}
-	db.clock = tc
+	setClock(tc)
 
 	// Put a record
 
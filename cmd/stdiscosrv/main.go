@@ -69,6 +69,7 @@ var debug = false
 func main() {
 	var listen string
 	var dir string
+	var dbURL string
 	var metricsListen string
 	var replicationListen string
 	var replicationPeers string
@@ -76,15 +77,18 @@ func main() {
 	var keyFile string
 	var replCertFile string
 	var replKeyFile string
+	var aclPath string
 	var useHTTP bool
 	var largeDB bool
 
 	log.SetOutput(os.Stdout)
 	log.SetFlags(0)
 
+	flag.StringVar(&aclPath, "acl", "", "Access control list file for announcements and lookups")
 	flag.StringVar(&certFile, "cert", "./cert.pem", "Certificate file")
 	flag.StringVar(&keyFile, "key", "./key.pem", "Key file")
 	flag.StringVar(&dir, "db-dir", "./discovery.db", "Database directory")
+	flag.StringVar(&dbURL, "db-url", "", "Shared SQL database (postgres://... or sqlite://...), instead of -db-dir")
 	flag.BoolVar(&debug, "debug", false, "Print debug output")
 	flag.BoolVar(&useHTTP, "http", false, "Listen on HTTP (behind an HTTPS proxy)")
 	flag.StringVar(&listen, "listen", ":8443", "Listen address")
@@ -179,7 +183,18 @@ func main() {
 	})
 
 	// Start the database.
-	db, err := newLevelDBStore(dir)
+	var db interface {
+		database
+		suture.Service
+	}
+	if dbURL != "" {
+		db, err = newSQLStore(dbURL)
+		if len(replicationDestinations) > 0 {
+			log.Println("Note: replication is not required between instances sharing a database")
+		}
+	} else {
+		db, err = newLevelDBStore(dir)
+	}
 	if err != nil {
 		log.Fatalln("Open database:", err)
 	}
@@ -188,7 +203,7 @@ func main() {
 	// Start any replication senders.
 	var repl replicationMultiplexer
 	for _, dst := range replicationDestinations {
-		rs := newReplicationSender(dst, replCert, allowedReplicationPeers)
+		rs := newReplicationSender(dst, replCert, allowedReplicationPeers, db)
 		main.Add(rs)
 		repl = append(repl, rs)
 	}
@@ -199,8 +214,20 @@ func main() {
 		main.Add(rl)
 	}
 
+	// Load the access control lists, if any. The file is reloaded when
+	// it changes.
+	var acl accessController
+	if aclPath != "" {
+		aclf, err := newACLFile(aclPath)
+		if err != nil {
+			log.Fatalln("Loading ACL file:", err)
+		}
+		main.Add(aclf)
+		acl = aclf
+	}
+
 	// Start the main API server.
-	qs := newAPISrv(listen, cert, db, repl, useHTTP)
+	qs := newAPISrv(listen, cert, db, repl, acl, useHTTP)
 	main.Add(qs)
 
 	// If we have a metrics port configured, start a metrics handler.
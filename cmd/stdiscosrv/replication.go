@@ -7,10 +7,13 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/binary"
 	"fmt"
+	"hash"
 	io "io"
 	"log"
 	"net"
@@ -23,6 +26,20 @@ const (
 	replicationReadTimeout       = time.Minute
 	replicationWriteTimeout      = 30 * time.Second
 	replicationHeartbeatInterval = time.Second * 30
+
+	// Connections negotiating this protocol via ALPN start with an
+	// anti-entropy exchange, where the receiver learns which parts of
+	// the sender's database differ from its own and has those sent in
+	// bulk. Connections without it are plain streams of replication
+	// records, as from older versions.
+	replicationProtoAntiEntropy = "stdiscosrv-replication-ae"
+
+	// Keys are device IDs in base32. The digests cover the key ranges of
+	// each two character prefix, i.e., 1024 ranges.
+	deviceIDAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+
+	// Size of the channel between the bulk sync reader and the sender
+	replicationBulkBufferSize = 1000
 )
 
 type replicator interface {
@@ -35,14 +52,16 @@ type replicationSender struct {
 	dst        string
 	cert       tls.Certificate // our certificate
 	allowedIDs []protocol.DeviceID
+	db         database
 	outbox     chan ReplicationRecord
 }
 
-func newReplicationSender(dst string, cert tls.Certificate, allowedIDs []protocol.DeviceID) *replicationSender {
+func newReplicationSender(dst string, cert tls.Certificate, allowedIDs []protocol.DeviceID, db database) *replicationSender {
 	return &replicationSender{
 		dst:        dst,
 		cert:       cert,
 		allowedIDs: allowedIDs,
+		db:         db,
 		outbox:     make(chan ReplicationRecord, replicationOutboxSize),
 	}
 }
@@ -58,6 +77,7 @@ func (s *replicationSender) Serve(ctx context.Context) error {
 		Certificates:       []tls.Certificate{s.cert},
 		MinVersion:         tls.VersionTLS12,
 		InsecureSkipVerify: true,
+		NextProtos:         []string{replicationProtoAntiEntropy},
 	}
 
 	// Dial the TLS connection.
@@ -90,64 +110,169 @@ func (s *replicationSender) Serve(ctx context.Context) error {
 		return err
 	}
 
+	// If the other side supports it, find out what it's missing and start
+	// sending that in the background.
+	var bulk <-chan ReplicationRecord
+	if conn.ConnectionState().NegotiatedProtocol == replicationProtoAntiEntropy {
+		prefixes, err := s.exchangeDigests(conn)
+		if err != nil {
+			log.Println("Replication digest exchange:", err)
+			return err
+		}
+		if len(prefixes) > 0 {
+			log.Printf("Replication: sending %d key ranges to %s", len(prefixes), remoteID)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			bulk = s.readRanges(ctx, prefixes)
+		}
+	}
+
 	heartBeatTicker := time.NewTicker(replicationHeartbeatInterval)
 	defer heartBeatTicker.Stop()
 
 	// Send records.
 	buf := make([]byte, 1024)
 	for {
+		var rec ReplicationRecord
 		select {
 		case <-heartBeatTicker.C:
-			if len(s.outbox) > 0 {
+			if len(s.outbox) > 0 || bulk != nil {
 				// No need to send heartbeats if there are events/prevrious
 				// heartbeats to send, they will keep the connection alive.
 				continue
 			}
 			// Empty replication message is the heartbeat:
 			s.outbox <- ReplicationRecord{}
+			continue
 
-		case rec := <-s.outbox:
-			// Buffer must hold record plus four bytes for size
-			size := rec.Size()
-			if len(buf) < size+4 {
-				buf = make([]byte, size+4)
-			}
+		case rec = <-s.outbox:
 
-			// Record comes after the four bytes size
-			n, err := rec.MarshalTo(buf[4:])
-			if err != nil {
-				// odd to get an error here, but we haven't sent anything
-				// yet so it's not fatal
-				replicationSendsTotal.WithLabelValues("error").Inc()
-				log.Println("Replication marshal:", err)
+		case bulkRec, ok := <-bulk:
+			if !ok {
+				log.Println("Replication: bulk sync to", remoteID, "complete")
+				bulk = nil
 				continue
 			}
-			binary.BigEndian.PutUint32(buf, uint32(n))
-
-			// Send
-			conn.SetWriteDeadline(time.Now().Add(replicationWriteTimeout))
-			if _, err := conn.Write(buf[:4+n]); err != nil {
-				replicationSendsTotal.WithLabelValues("error").Inc()
-				log.Println("Replication write:", err)
-				// Yes, we are losing the replication event here.
-				return err
-			}
-			replicationSendsTotal.WithLabelValues("success").Inc()
+			rec = bulkRec
 
 		case <-ctx.Done():
 			return nil
 		}
+
+		// Buffer must hold record plus four bytes for size
+		size := rec.Size()
+		if len(buf) < size+4 {
+			buf = make([]byte, size+4)
+		}
+
+		// Record comes after the four bytes size
+		n, err := rec.MarshalTo(buf[4:])
+		if err != nil {
+			// odd to get an error here, but we haven't sent anything
+			// yet so it's not fatal
+			replicationSendsTotal.WithLabelValues("error").Inc()
+			log.Println("Replication marshal:", err)
+			continue
+		}
+		binary.BigEndian.PutUint32(buf, uint32(n))
+
+		// Send
+		conn.SetWriteDeadline(time.Now().Add(replicationWriteTimeout))
+		if _, err := conn.Write(buf[:4+n]); err != nil {
+			replicationSendsTotal.WithLabelValues("error").Inc()
+			log.Println("Replication write:", err)
+			// Yes, we are losing the replication event here.
+			return err
+		}
+		replicationSendsTotal.WithLabelValues("success").Inc()
+		if rec.Bulk {
+			replicationBulkRecordsTotal.WithLabelValues("sent").Inc()
+		}
+	}
+}
+
+// exchangeDigests sends the digests of our key ranges and returns the
+// prefixes of the ranges the other side wants.
+func (s *replicationSender) exchangeDigests(conn net.Conn) ([]string, error) {
+	digests, err := databaseDigests(s.db, time.Now().UnixNano())
+	if err != nil {
+		return nil, err
+	}
+
+	var msg ReplicationDigest
+	for _, prefix := range keyPrefixes() {
+		if digest, ok := digests[prefix]; ok {
+			msg.Ranges = append(msg.Ranges, ReplicationRangeDigest{Prefix: prefix, Digest: digest})
+		}
+	}
+	bs, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	conn.SetWriteDeadline(time.Now().Add(replicationWriteTimeout))
+	if err := writeReplicationMessage(conn, bs); err != nil {
+		return nil, err
 	}
+
+	// The other side needs to compute its own digests before answering.
+	conn.SetReadDeadline(time.Now().Add(replicationReadTimeout))
+	bs, err = readReplicationMessage(conn)
+	if err != nil {
+		return nil, err
+	}
+	var resp ReplicationDigest
+	if err := resp.Unmarshal(bs); err != nil {
+		return nil, err
+	}
+
+	prefixes := make([]string, len(resp.Ranges))
+	for i, r := range resp.Ranges {
+		prefixes[i] = r.Prefix
+	}
+	return prefixes, nil
+}
+
+// readRanges returns a channel that receives all current records in the
+// ranges given by prefixes, and is then closed.
+func (s *replicationSender) readRanges(ctx context.Context, prefixes []string) <-chan ReplicationRecord {
+	res := make(chan ReplicationRecord, replicationBulkBufferSize)
+	go func() {
+		defer close(res)
+		for _, prefix := range prefixes {
+			now := time.Now().UnixNano()
+			err := s.db.iterate(prefix, func(key string, rec DatabaseRecord) bool {
+				addrs := expire(rec.Addresses, now)
+				if len(addrs) == 0 {
+					return true
+				}
+				select {
+				case res <- ReplicationRecord{Key: key, Addresses: addrs, Seen: rec.Seen, Bulk: true}:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+			if err != nil {
+				log.Println("Replication bulk read:", err)
+				return
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+	return res
 }
 
 func (s *replicationSender) String() string {
 	return fmt.Sprintf("replicationSender(%q)", s.dst)
 }
 
-func (s *replicationSender) send(key string, ps []DatabaseAddress, _ int64) {
+func (s *replicationSender) send(key string, ps []DatabaseAddress, seen int64) {
 	item := ReplicationRecord{
 		Key:       key,
 		Addresses: ps,
+		Seen:      seen,
 	}
 
 	// The send should never block. The inbox is suitably buffered for at
@@ -193,6 +318,7 @@ func (l *replicationListener) Serve(ctx context.Context) error {
 		ClientAuth:         tls.RequestClientCert,
 		MinVersion:         tls.VersionTLS12,
 		InsecureSkipVerify: true,
+		NextProtos:         []string{replicationProtoAntiEntropy},
 	}
 
 	lst, err := tls.Listen("tcp", l.addr, tlsCfg)
@@ -233,7 +359,7 @@ func (l *replicationListener) Serve(ctx context.Context) error {
 			continue
 		}
 
-		go l.handle(ctx, conn)
+		go l.handle(ctx, conn.(*tls.Conn))
 	}
 }
 
@@ -241,12 +367,20 @@ func (l *replicationListener) String() string {
 	return fmt.Sprintf("replicationListener(%q)", l.addr)
 }
 
-func (l *replicationListener) handle(ctx context.Context, conn net.Conn) {
+func (l *replicationListener) handle(ctx context.Context, conn *tls.Conn) {
 	defer func() {
 		conn.SetWriteDeadline(time.Now().Add(time.Second))
 		conn.Close()
 	}()
 
+	if conn.ConnectionState().NegotiatedProtocol == replicationProtoAntiEntropy {
+		if err := l.answerDigests(conn); err != nil {
+			log.Println("Replication digest exchange:", err)
+			replicationRecvsTotal.WithLabelValues("error").Inc()
+			return
+		}
+	}
+
 	buf := make([]byte, 1024)
 
 	for {
@@ -293,7 +427,118 @@ func (l *replicationListener) handle(ctx context.Context, conn net.Conn) {
 		// Store
 		l.db.merge(rec.Key, rec.Addresses, rec.Seen)
 		replicationRecvsTotal.WithLabelValues("success").Inc()
+		if rec.Bulk {
+			replicationBulkRecordsTotal.WithLabelValues("received").Inc()
+		} else if rec.Seen > 0 {
+			replicationLagSeconds.Observe(time.Since(time.Unix(0, rec.Seen)).Seconds())
+		}
+	}
+}
+
+// answerDigests reads the other side's range digests and responds with
+// the prefixes of the ranges where ours differ.
+func (l *replicationListener) answerDigests(conn net.Conn) error {
+	conn.SetReadDeadline(time.Now().Add(replicationReadTimeout))
+	bs, err := readReplicationMessage(conn)
+	if err != nil {
+		return err
+	}
+	var msg ReplicationDigest
+	if err := msg.Unmarshal(bs); err != nil {
+		return err
+	}
+
+	digests, err := databaseDigests(l.db, time.Now().UnixNano())
+	if err != nil {
+		return err
+	}
+
+	var resp ReplicationDigest
+	for _, r := range msg.Ranges {
+		if !bytes.Equal(r.Digest, digests[r.Prefix]) {
+			resp.Ranges = append(resp.Ranges, ReplicationRangeDigest{Prefix: r.Prefix})
+		}
+	}
+	replicationBulkRangesTotal.Add(float64(len(resp.Ranges)))
+
+	bs, err = resp.Marshal()
+	if err != nil {
+		return err
+	}
+	conn.SetWriteDeadline(time.Now().Add(replicationWriteTimeout))
+	return writeReplicationMessage(conn, bs)
+}
+
+// databaseDigests returns a digest for each non-empty key range in the
+// database, keyed by prefix. Only records with current addresses are
+// considered. Two replicas that have seen the same announcements have the
+// same digests, as merging keeps the latest seen time.
+func databaseDigests(db database, now int64) (map[string][]byte, error) {
+	digests := make(map[string][]byte)
+
+	var cur string
+	var h hash.Hash
+	flush := func() {
+		if h != nil {
+			digests[cur] = h.Sum(nil)[:8]
+			h = nil
+		}
+	}
+
+	var seen [8]byte
+	for _, c := range deviceIDAlphabet {
+		err := db.iterate(string(c), func(key string, rec DatabaseRecord) bool {
+			if len(key) < 2 || len(expire(rec.Addresses, now)) == 0 {
+				return true
+			}
+			if h == nil || key[:2] != cur {
+				flush()
+				cur = key[:2]
+				h = sha256.New()
+			}
+			h.Write([]byte(key))
+			binary.BigEndian.PutUint64(seen[:], uint64(rec.Seen))
+			h.Write(seen[:])
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		flush()
+	}
+
+	return digests, nil
+}
+
+// keyPrefixes returns all the two character key range prefixes, in order.
+func keyPrefixes() []string {
+	prefixes := make([]string, 0, len(deviceIDAlphabet)*len(deviceIDAlphabet))
+	for _, a := range deviceIDAlphabet {
+		for _, b := range deviceIDAlphabet {
+			prefixes = append(prefixes, string([]rune{a, b}))
+		}
+	}
+	return prefixes
+}
+
+func writeReplicationMessage(w io.Writer, bs []byte) error {
+	buf := make([]byte, 4+len(bs))
+	binary.BigEndian.PutUint32(buf, uint32(len(bs)))
+	copy(buf[4:], bs)
+	_, err := w.Write(buf)
+	return err
+}
+
+func readReplicationMessage(r io.Reader) ([]byte, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, err
+	}
+	bs := make([]byte, binary.BigEndian.Uint32(size[:]))
+	if _, err := io.ReadFull(r, bs); err != nil {
+		return nil, err
 	}
+	return bs, nil
 }
 
 func deviceID(conn *tls.Conn) (protocol.DeviceID, error) {
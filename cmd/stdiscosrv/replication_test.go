@@ -0,0 +1,164 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+func TestDatabaseDigests(t *testing.T) {
+	db1, cancel1 := newTestLevelDBStore(t)
+	defer cancel1()
+	db2, cancel2 := newTestLevelDBStore(t)
+	defer cancel2()
+
+	now := time.Now()
+	for i := 0; i < 100; i++ {
+		key := protocol.NewDeviceID([]byte(fmt.Sprint(i))).String()
+		addrs := []DatabaseAddress{{Address: "tcp://1.2.3.4:5", Expires: now.Add(time.Hour).UnixNano()}}
+		if err := db1.merge(key, addrs, now.UnixNano()); err != nil {
+			t.Fatal(err)
+		}
+		if err := db2.merge(key, addrs, now.UnixNano()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	d1, err := databaseDigests(db1, now.UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	d2, err := databaseDigests(db2, now.UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d1) == 0 || fmt.Sprint(d1) != fmt.Sprint(d2) {
+		t.Fatal("digests should be equal and non-empty")
+	}
+
+	// A newer announcement changes the digest of one range only
+
+	key := protocol.NewDeviceID([]byte("42")).String()
+	addrs := []DatabaseAddress{{Address: "tcp://1.2.3.4:5", Expires: now.Add(time.Hour).UnixNano()}}
+	if err := db2.merge(key, addrs, now.Add(time.Second).UnixNano()); err != nil {
+		t.Fatal(err)
+	}
+	d2, err = databaseDigests(db2, now.UnixNano())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for prefix := range d1 {
+		equal := string(d1[prefix]) == string(d2[prefix])
+		if equal == (prefix == key[:2]) {
+			t.Errorf("unexpected digest equality %v for range %s", equal, prefix)
+		}
+	}
+}
+
+func TestReplicationAntiEntropy(t *testing.T) {
+	src, cancelSrc := newTestLevelDBStore(t)
+	defer cancelSrc()
+	dst, cancelDst := newTestLevelDBStore(t)
+	defer cancelDst()
+
+	// The source has a number of records the destination has never heard
+	// of, plus one expired record that should not be sent.
+
+	now := time.Now()
+	for i := 0; i < 50; i++ {
+		key := protocol.NewDeviceID([]byte(fmt.Sprint(i))).String()
+		addrs := []DatabaseAddress{{Address: "tcp://1.2.3.4:5", Expires: now.Add(time.Hour).UnixNano()}}
+		if err := src.merge(key, addrs, now.UnixNano()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expiredKey := protocol.NewDeviceID([]byte("expired")).String()
+	if err := src.put(expiredKey, DatabaseRecord{Addresses: []DatabaseAddress{{Address: "tcp://1.2.3.4:5", Expires: now.Add(-time.Hour).UnixNano()}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	srcCert, err := tlsutil.NewCertificateInMemory("src", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dstCert, err := tlsutil.NewCertificateInMemory("dst", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srcID := protocol.NewDeviceID(srcCert.Certificate[0])
+	dstID := protocol.NewDeviceID(dstCert.Certificate[0])
+
+	addr := freeLocalAddr(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rl := newReplicationListener(addr, dstCert, []protocol.DeviceID{srcID}, dst)
+	go rl.Serve(ctx)
+	rs := newReplicationSender(addr, srcCert, []protocol.DeviceID{dstID}, src)
+	go rs.Serve(ctx)
+
+	// Wait for the records to arrive
+
+	t0 := time.Now()
+	for {
+		count := 0
+		dst.iterate("", func(string, DatabaseRecord) bool {
+			count++
+			return true
+		})
+		if count == 50 {
+			break
+		}
+		if time.Since(t0) > 10*time.Second {
+			t.Fatal("records not replicated, have", count)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	rec, err := dst.get(protocol.NewDeviceID([]byte("7")).String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Addresses) != 1 || rec.Seen != now.UnixNano() {
+		t.Error("unexpected replicated record", rec)
+	}
+	rec, err = dst.get(expiredKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Seen != 0 {
+		t.Error("expired record should not be replicated")
+	}
+}
+
+func newTestLevelDBStore(t *testing.T) (*levelDBStore, context.CancelFunc) {
+	t.Helper()
+	db, err := newMemoryLevelDBStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go db.Serve(ctx)
+	return db, cancel
+}
+
+func freeLocalAddr(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
@@ -59,6 +59,28 @@ var (
 			Name:      "replication_recvs_total",
 			Help:      "Number of replication receives.",
 		}, []string{"result"})
+	replicationLagSeconds = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "syncthing",
+			Subsystem: "discovery",
+			Name:      "replication_lag_seconds",
+			Help:      "Time from announcement to the replicated record being received.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10), // 1ms ... 262s
+		})
+	replicationBulkRangesTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "syncthing",
+			Subsystem: "discovery",
+			Name:      "replication_bulk_ranges_total",
+			Help:      "Number of key ranges requested in anti-entropy syncs.",
+		})
+	replicationBulkRecordsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "syncthing",
+			Subsystem: "discovery",
+			Name:      "replication_bulk_records_total",
+			Help:      "Number of records sent or received in anti-entropy syncs.",
+		}, []string{"direction"})
 
 	databaseKeys = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -115,6 +137,7 @@ func init() {
 	prometheus.MustRegister(apiRequestsTotal, apiRequestsSeconds,
 		lookupRequestsTotal, announceRequestsTotal,
 		replicationSendsTotal, replicationRecvsTotal,
+		replicationLagSeconds, replicationBulkRangesTotal, replicationBulkRecordsTotal,
 		databaseKeys, databaseStatisticsSeconds,
 		databaseOperations, databaseOperationSeconds,
 		retryAfterHistogram)
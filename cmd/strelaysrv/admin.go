@@ -0,0 +1,230 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	syncthingprotocol "github.com/syncthing/syncthing/lib/protocol"
+)
+
+// adminToken enables the admin endpoints on the status service, when set.
+// Requests must carry it as "Authorization: Bearer <token>".
+var adminToken string
+
+// bans is the set of devices refused by the relay, as banned through the
+// admin endpoint. Bans are kept in memory only.
+var bans = &banList{banned: make(map[syncthingprotocol.DeviceID]time.Time)}
+
+type banList struct {
+	mut    sync.Mutex
+	banned map[syncthingprotocol.DeviceID]time.Time
+}
+
+func (b *banList) isBanned(id syncthingprotocol.DeviceID) bool {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	_, ok := b.banned[id]
+	return ok
+}
+
+func (b *banList) add(id syncthingprotocol.DeviceID) {
+	b.mut.Lock()
+	if _, ok := b.banned[id]; !ok {
+		b.banned[id] = time.Now()
+	}
+	b.mut.Unlock()
+}
+
+func (b *banList) remove(id syncthingprotocol.DeviceID) bool {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	_, ok := b.banned[id]
+	delete(b.banned, id)
+	return ok
+}
+
+func (b *banList) list() map[string]time.Time {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	res := make(map[string]time.Time, len(b.banned))
+	for id, t := range b.banned {
+		res[id.String()] = t
+	}
+	return res
+}
+
+// kickMessage is put in the outbox of a joined device to make its protocol
+// connection handler disconnect it.
+type kickMessage struct{}
+
+// banDevice bans the device, ending its sessions and disconnecting it
+func banDevice(id syncthingprotocol.DeviceID) {
+	bans.add(id)
+
+	sessionMut.RLock()
+	for _, ses := range activeSessions {
+		if ses.HasParticipant(id) {
+			sessionsTerminatedTotal.WithLabelValues("ban").Inc()
+		}
+	}
+	sessionMut.RUnlock()
+	dropSessions(id)
+
+	outboxesMut.RLock()
+	outbox, ok := outboxes[id]
+	outboxesMut.RUnlock()
+	if ok {
+		select {
+		case outbox <- kickMessage{}:
+		case <-time.After(time.Second):
+		}
+	}
+
+	log.Println("Banned", id)
+}
+
+// sessionInfo is the view of a session given by the admin endpoint
+type sessionInfo struct {
+	ID              int64     `json:"id"`
+	ServerID        string    `json:"serverID"`
+	ClientID        string    `json:"clientID"`
+	Started         time.Time `json:"started"`
+	DurationS       float64   `json:"durationSeconds"`
+	BytesFromServer int64     `json:"bytesFromServer"`
+	BytesFromClient int64     `json:"bytesFromClient"`
+}
+
+func listSessions() []sessionInfo {
+	sessionMut.RLock()
+	res := make([]sessionInfo, 0, len(activeSessions))
+	for _, ses := range activeSessions {
+		res = append(res, sessionInfo{
+			ID:              ses.id,
+			ServerID:        ses.serverid.String(),
+			ClientID:        ses.clientid.String(),
+			Started:         ses.started,
+			DurationS:       time.Since(ses.started).Seconds(),
+			BytesFromServer: ses.bytesFromServer.Load(),
+			BytesFromClient: ses.bytesFromClient.Load(),
+		})
+	}
+	sessionMut.RUnlock()
+
+	sort.Slice(res, func(a, b int) bool {
+		return res[a].ID < res[b].ID
+	})
+	return res
+}
+
+// terminateSession ends the active session with the given ID, returning
+// whether there was one.
+func terminateSession(id int64) bool {
+	sessionMut.RLock()
+	defer sessionMut.RUnlock()
+	for _, ses := range activeSessions {
+		if ses.id == id {
+			if debug {
+				log.Println("Terminating session", ses)
+			}
+			ses.CloseConns()
+			sessionsTerminatedTotal.WithLabelValues("admin").Inc()
+			return true
+		}
+	}
+	return false
+}
+
+func registerAdminHandlers(mux *http.ServeMux) {
+	mux.HandleFunc("/admin/sessions", withAdminAuth(handleAdminSessions))
+	mux.HandleFunc("/admin/bans", withAdminAuth(handleAdminBans))
+}
+
+func withAdminAuth(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		h(w, r)
+	}
+}
+
+// handleAdminSessions lists the active sessions (GET), or terminates the
+// one given by the "id" parameter (DELETE).
+func handleAdminSessions(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, listSessions())
+
+	case http.MethodDelete:
+		id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+		if err != nil {
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+		if !terminateSession(id) {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleAdminBans lists the banned devices (GET), or bans (POST) or unbans
+// (DELETE) the one given by the "device" parameter.
+func handleAdminBans(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		writeJSON(w, bans.list())
+		return
+	}
+
+	id, err := syncthingprotocol.DeviceIDFromString(r.URL.Query().Get("device"))
+	if err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		banDevice(id)
+		w.WriteHeader(http.StatusNoContent)
+
+	case http.MethodDelete:
+		if !bans.remove(id) {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		log.Println("Unbanned", id)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	bs, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(bs)
+}
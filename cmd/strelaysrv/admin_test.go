@@ -0,0 +1,148 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newAdminServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	adminToken = "s3cret"
+	t.Cleanup(func() { adminToken = "" })
+	mux := http.NewServeMux()
+	registerAdminHandlers(mux)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func adminRequest(t *testing.T, srv *httptest.Server, method, path, token string, v interface{}) int {
+	t.Helper()
+	req, err := http.NewRequest(method, srv.URL+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if v != nil && resp.StatusCode == http.StatusOK {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	} else {
+		_, _ = io.Copy(io.Discard, resp.Body)
+	}
+	return resp.StatusCode
+}
+
+func TestAdminAuth(t *testing.T) {
+	srv := newAdminServer(t)
+	for _, path := range []string{"/admin/sessions", "/admin/bans"} {
+		for _, token := range []string{"", "wrong", "s3cret2"} {
+			if code := adminRequest(t, srv, http.MethodGet, path, token, nil); code != http.StatusUnauthorized {
+				t.Errorf("%s with token %q: got %d", path, token, code)
+			}
+		}
+		if code := adminRequest(t, srv, http.MethodGet, path, "s3cret", nil); code != http.StatusOK {
+			t.Errorf("%s: got %d", path, code)
+		}
+	}
+}
+
+func TestAdminSessions(t *testing.T) {
+	srv := newAdminServer(t)
+
+	c1, c2 := net.Pipe()
+	defer c2.Close()
+	ses := &session{id: 4711, serverid: device1, clientid: device2, conns: []net.Conn{c1}, started: time.Now()}
+	ses.bytesFromServer.Store(100)
+	sessionMut.Lock()
+	activeSessions = append(activeSessions, ses)
+	sessionMut.Unlock()
+	defer func() {
+		sessionMut.Lock()
+		activeSessions = activeSessions[:0]
+		sessionMut.Unlock()
+	}()
+
+	var sessions []sessionInfo
+	if code := adminRequest(t, srv, http.MethodGet, "/admin/sessions", "s3cret", &sessions); code != http.StatusOK {
+		t.Fatalf("got %d", code)
+	}
+	if len(sessions) != 1 || sessions[0].ID != 4711 || sessions[0].ServerID != device1.String() || sessions[0].BytesFromServer != 100 {
+		t.Errorf("unexpected sessions %+v", sessions)
+	}
+
+	for query, exp := range map[string]int{
+		"":                          http.StatusBadRequest,
+		"?id=foo":                   http.StatusBadRequest,
+		"?id=1":                     http.StatusNotFound,
+		"?id=" + strconv.Itoa(4711): http.StatusNoContent,
+	} {
+		if code := adminRequest(t, srv, http.MethodDelete, "/admin/sessions"+query, "s3cret", nil); code != exp {
+			t.Errorf("DELETE %q: got %d, expected %d", query, code, exp)
+		}
+	}
+	// The connections of the terminated session are closed.
+	if _, err := c2.Write([]byte("x")); err == nil {
+		t.Error("expected the session to be closed")
+	}
+
+	if code := adminRequest(t, srv, http.MethodPost, "/admin/sessions", "s3cret", nil); code != http.StatusMethodNotAllowed {
+		t.Errorf("POST: got %d", code)
+	}
+}
+
+func TestAdminBans(t *testing.T) {
+	srv := newAdminServer(t)
+	defer bans.remove(device3)
+
+	path := "/admin/bans?device=" + device3.String()
+	if code := adminRequest(t, srv, http.MethodPost, path, "s3cret", nil); code != http.StatusNoContent {
+		t.Fatalf("POST: got %d", code)
+	}
+	if !bans.isBanned(device3) {
+		t.Error("expected the device to be banned")
+	}
+
+	var banned map[string]time.Time
+	if code := adminRequest(t, srv, http.MethodGet, "/admin/bans", "s3cret", &banned); code != http.StatusOK {
+		t.Fatalf("GET: got %d", code)
+	}
+	if _, ok := banned[device3.String()]; !ok || len(banned) != 1 {
+		t.Errorf("unexpected bans %v", banned)
+	}
+
+	if code := adminRequest(t, srv, http.MethodDelete, path, "s3cret", nil); code != http.StatusNoContent {
+		t.Errorf("DELETE: got %d", code)
+	}
+	if bans.isBanned(device3) {
+		t.Error("expected the device to be unbanned")
+	}
+	if code := adminRequest(t, srv, http.MethodDelete, path, "s3cret", nil); code != http.StatusNotFound {
+		t.Errorf("second DELETE: got %d", code)
+	}
+	if code := adminRequest(t, srv, http.MethodPost, "/admin/bans?device=invalid", "s3cret", nil); code != http.StatusBadRequest {
+		t.Errorf("invalid device: got %d", code)
+	}
+	if code := adminRequest(t, srv, http.MethodPut, path, "s3cret", nil); code != http.StatusMethodNotAllowed {
+		t.Errorf("PUT: got %d", code)
+	}
+}
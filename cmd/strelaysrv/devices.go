@@ -0,0 +1,355 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	syncthingprotocol "github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/relay/protocol"
+)
+
+const devicesReloadInterval = 10 * time.Second
+
+var (
+	errNotAllowed      = errors.New("device not allowed")
+	errQuotaExceeded   = errors.New("quota exceeded")
+	errTooManySessions = errors.New("too many sessions")
+)
+
+// devices is the allowlist of devices that may use the relay, or nil if
+// everyone may.
+var devices *deviceRegistry
+
+// deviceLimits are the limits for one allowlisted device. Zero means
+// unlimited.
+type deviceLimits struct {
+	dailyBytes   int64
+	monthlyBytes int64
+	maxSessions  int
+}
+
+// deviceUsage is the accounting for one device. Byte counts are for the
+// current UTC day and month, and include traffic in both directions of
+// every session the device takes part in. It is updated without locking
+// the registry, as that happens for every read in the proxy loop.
+type deviceUsage struct {
+	// The byte limits, updated when the allowlist is reloaded. Zero means
+	// unlimited.
+	dailyLimit   atomic.Int64
+	monthlyLimit atomic.Int64
+
+	day        periodCounter
+	month      periodCounter
+	totalBytes atomic.Int64
+
+	// Only incremented with the registry locked, to check the limit.
+	sessions atomic.Int32
+}
+
+func (u *deviceUsage) setLimits(limits deviceLimits) {
+	u.dailyLimit.Store(limits.dailyBytes)
+	u.monthlyLimit.Store(limits.monthlyBytes)
+}
+
+// overQuota returns whether the usage is past a limit, or at it unless
+// strictly is set.
+func (u *deviceUsage) overQuota(now time.Time, strictly bool) bool {
+	over := func(used, limit int64) bool {
+		return limit > 0 && (used > limit || !strictly && used == limit)
+	}
+	return over(u.day.get(dayPeriod(now)), u.dailyLimit.Load()) || over(u.month.get(monthPeriod(now)), u.monthlyLimit.Load())
+}
+
+// dayPeriod and monthPeriod number the UTC days and months
+func dayPeriod(t time.Time) int64 {
+	return t.Unix() / (24 * 60 * 60)
+}
+
+func monthPeriod(t time.Time) int64 {
+	t = t.UTC()
+	return int64(t.Year())*12 + int64(t.Month())
+}
+
+// periodCounter counts bytes in a period, starting over at zero when a new
+// one begins. Bytes counted by other goroutines at the very moment the
+// period changes may be lost.
+type periodCounter struct {
+	period atomic.Int64
+	bytes  atomic.Int64
+}
+
+func (c *periodCounter) add(period, n int64) int64 {
+	if cur := c.period.Load(); cur != period && c.period.CompareAndSwap(cur, period) {
+		c.bytes.Store(0)
+	}
+	return c.bytes.Add(n)
+}
+
+func (c *periodCounter) get(period int64) int64 {
+	if c.period.Load() != period {
+		return 0
+	}
+	return c.bytes.Load()
+}
+
+// deviceRegistry holds the allowlist, loaded from a file that is reloaded
+// when it changes, and the usage of each device. Usage is kept in memory
+// only and survives reloads of the allowlist.
+type deviceRegistry struct {
+	path string
+
+	mut     sync.Mutex
+	limits  map[syncthingprotocol.DeviceID]deviceLimits
+	usage   map[syncthingprotocol.DeviceID]*deviceUsage
+	modTime time.Time
+	size    int64
+}
+
+func newDeviceRegistry(path string) (*deviceRegistry, error) {
+	r := &deviceRegistry{
+		path:  path,
+		usage: make(map[syncthingprotocol.DeviceID]*deviceUsage),
+	}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// The devices file has one device per line, optionally followed by limits:
+//
+//	# device ID                                              limits
+//	P56IOI7-MZJNU2Y-IQGDREY-DM2MGTI-MGL3BXN-PQ6W5BM-TBBZ4TJ-XZWICQ2 daily=10G monthly=100G sessions=4
+//	MFZWI3D-BONSGYC-YLTMRWG-C43ENR5-QXGZDMM-FZWI3DP-BONSGYY-LTMRWAD
+//
+// Sizes take the usual SI suffixes (k, M, G, T).
+func parseDevices(bs []byte) (map[syncthingprotocol.DeviceID]deviceLimits, error) {
+	res := make(map[syncthingprotocol.DeviceID]deviceLimits)
+	sc := bufio.NewScanner(bytes.NewReader(bs))
+	for line := 1; sc.Scan(); line++ {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		id, err := syncthingprotocol.DeviceIDFromString(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		var limits deviceLimits
+		for _, field := range fields[1:] {
+			key, val, ok := strings.Cut(field, "=")
+			if !ok {
+				return nil, fmt.Errorf("line %d: malformed limit %q", line, field)
+			}
+			switch key {
+			case "daily", "monthly":
+				size, err := config.ParseSize(val)
+				if err != nil || size.Percentage() {
+					return nil, fmt.Errorf("line %d: invalid size %q", line, val)
+				}
+				if key == "daily" {
+					limits.dailyBytes = int64(size.BaseValue())
+				} else {
+					limits.monthlyBytes = int64(size.BaseValue())
+				}
+			case "sessions":
+				limits.maxSessions, err = strconv.Atoi(val)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid session count %q", line, val)
+				}
+			default:
+				return nil, fmt.Errorf("line %d: unknown limit %q", line, key)
+			}
+		}
+		res[id] = limits
+	}
+	return res, sc.Err()
+}
+
+// reload reads and parses the file if it has changed since the last
+// successful load. Existing sessions are not affected.
+func (r *deviceRegistry) reload() error {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return err
+	}
+
+	r.mut.Lock()
+	unchanged := info.ModTime().Equal(r.modTime) && info.Size() == r.size
+	r.mut.Unlock()
+	if unchanged {
+		return nil
+	}
+
+	bs, err := os.ReadFile(r.path)
+	if err != nil {
+		return err
+	}
+	limits, err := parseDevices(bs)
+	if err != nil {
+		return err
+	}
+
+	r.mut.Lock()
+	r.limits = limits
+	for id, u := range r.usage {
+		u.setLimits(limits[id])
+	}
+	r.modTime = info.ModTime()
+	r.size = info.Size()
+	r.mut.Unlock()
+
+	log.Printf("Loaded %d allowed devices from %s", len(limits), r.path)
+	return nil
+}
+
+// reloadLoop reloads the file periodically, until the context is
+// cancelled.
+func (r *deviceRegistry) reloadLoop(ctx context.Context) {
+	ticker := time.NewTicker(devicesReloadInterval)
+	defer ticker.Stop()
+
+	var lastErr string
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		if err := r.reload(); err != nil {
+			if err.Error() != lastErr {
+				log.Println("Reloading devices:", err)
+				lastErr = err.Error()
+			}
+		} else {
+			lastErr = ""
+		}
+	}
+}
+
+// allowed returns whether the device may join the relay
+func (r *deviceRegistry) allowed(id syncthingprotocol.DeviceID) bool {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	_, ok := r.limits[id]
+	return ok
+}
+
+// usageLocked returns the usage for the device, creating it if necessary.
+func (r *deviceRegistry) usageLocked(id syncthingprotocol.DeviceID) *deviceUsage {
+	u, ok := r.usage[id]
+	if !ok {
+		u = &deviceUsage{}
+		u.setLimits(r.limits[id])
+		r.usage[id] = u
+	}
+	return u
+}
+
+// startSession checks that a session between the two devices is allowed
+// and within their limits, and if so counts it. The returned usage is to
+// be passed to addBytes and endSession.
+func (r *deviceRegistry) startSession(ids ...syncthingprotocol.DeviceID) ([]*deviceUsage, error) {
+	now := time.Now()
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	usage := make([]*deviceUsage, len(ids))
+	for i, id := range ids {
+		limits, ok := r.limits[id]
+		if !ok {
+			return nil, fmt.Errorf("%s: %w", id, errNotAllowed)
+		}
+		u := r.usageLocked(id)
+		if u.overQuota(now, false) {
+			return nil, fmt.Errorf("%s: %w", id, errQuotaExceeded)
+		}
+		if limits.maxSessions > 0 && int(u.sessions.Load()) >= limits.maxSessions {
+			return nil, fmt.Errorf("%s: %w", id, errTooManySessions)
+		}
+		usage[i] = u
+	}
+
+	for _, u := range usage {
+		u.sessions.Add(1)
+	}
+	return usage, nil
+}
+
+// refusalResponse returns the response to send for a session refused by
+// startSession.
+func refusalResponse(err error) protocol.Response {
+	if errors.Is(err, errNotAllowed) {
+		return protocol.ResponseNotAllowed
+	}
+	return protocol.ResponseQuotaExceeded
+}
+
+// endSession stops counting a session started by startSession
+func endSession(usage []*deviceUsage) {
+	for _, u := range usage {
+		u.sessions.Add(-1)
+	}
+}
+
+// addBytes accounts traffic to the devices of a session and returns
+// errQuotaExceeded if any of them is now past its quota. Devices no longer
+// on the allowlist are accounted but not limited, so that reloading
+// doesn't drop existing sessions.
+func addBytes(usage []*deviceUsage, n int64) error {
+	now := time.Now()
+	day, month := dayPeriod(now), monthPeriod(now)
+
+	var err error
+	for _, u := range usage {
+		u.day.add(day, n)
+		u.month.add(month, n)
+		u.totalBytes.Add(n)
+		if u.overQuota(now, true) {
+			err = errQuotaExceeded
+		}
+	}
+	return err
+}
+
+// status returns the limits and usage per device, for the status service
+func (r *deviceRegistry) status() map[string]interface{} {
+	now := time.Now()
+	day, month := dayPeriod(now), monthPeriod(now)
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	res := make(map[string]interface{}, len(r.limits))
+	for id, limits := range r.limits {
+		u := r.usageLocked(id)
+		res[id.String()] = map[string]interface{}{
+			"bytesToday":     u.day.get(day),
+			"bytesThisMonth": u.month.get(month),
+			"bytesTotal":     u.totalBytes.Load(),
+			"sessions":       u.sessions.Load(),
+			"limitDaily":     limits.dailyBytes,
+			"limitMonthly":   limits.monthlyBytes,
+			"limitSessions":  limits.maxSessions,
+		}
+	}
+	return res
+}
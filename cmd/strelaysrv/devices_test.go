@@ -0,0 +1,212 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	syncthingprotocol "github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/relay/protocol"
+)
+
+var (
+	device1 = syncthingprotocol.DeviceID{1}
+	device2 = syncthingprotocol.DeviceID{2}
+	device3 = syncthingprotocol.DeviceID{3}
+)
+
+func writeDevices(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestRegistry(t *testing.T, contents string) (*deviceRegistry, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "devices")
+	writeDevices(t, path, contents)
+	r, err := newDeviceRegistry(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r, path
+}
+
+func TestParseDevices(t *testing.T) {
+	devices, err := parseDevices([]byte(`
+# comment
+` + device1.String() + ` daily=10k monthly=1M sessions=4
+` + device2.String() + `
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devices) != 2 {
+		t.Fatalf("expected two devices, got %v", devices)
+	}
+	if l := devices[device1]; l.dailyBytes != 10000 || l.monthlyBytes != 1000000 || l.maxSessions != 4 {
+		t.Errorf("unexpected limits %+v", l)
+	}
+	if l := devices[device2]; l != (deviceLimits{}) {
+		t.Errorf("unexpected limits %+v", l)
+	}
+
+	for _, line := range []string{
+		"invalid",
+		device1.String() + " daily",
+		device1.String() + " daily=10%",
+		device1.String() + " sessions=many",
+		device1.String() + " weekly=10G",
+	} {
+		if _, err := parseDevices([]byte(line)); err == nil {
+			t.Errorf("expected an error for %q", line)
+		}
+	}
+}
+
+func TestDeviceSessions(t *testing.T) {
+	r, _ := newTestRegistry(t, device1.String()+" sessions=1\n"+device2.String()+"\n")
+
+	if !r.allowed(device1) || r.allowed(device3) {
+		t.Error("unexpected allowlist")
+	}
+
+	_, err := r.startSession(device1, device3)
+	if !errors.Is(err, errNotAllowed) {
+		t.Fatalf("expected not allowed, got %v", err)
+	}
+	if resp := refusalResponse(err); resp != protocol.ResponseNotAllowed {
+		t.Errorf("unexpected response %v", resp)
+	}
+	// The refused session is not counted for the allowed device.
+	usage, err := r.startSession(device1, device2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = r.startSession(device2, device1)
+	if !errors.Is(err, errTooManySessions) {
+		t.Fatalf("expected too many sessions, got %v", err)
+	}
+	if resp := refusalResponse(err); resp != protocol.ResponseQuotaExceeded {
+		t.Errorf("unexpected response %v", resp)
+	}
+
+	endSession(usage)
+	if _, err := r.startSession(device2, device1); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDeviceQuota(t *testing.T) {
+	r, path := newTestRegistry(t, device1.String()+" daily=1k\n"+device2.String()+" monthly=10k\n")
+
+	usage, err := r.startSession(device1, device2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := addBytes(usage, 1000); err != nil {
+		t.Errorf("reaching the quota should be allowed, got %v", err)
+	}
+	if err := addBytes(usage, 1); !errors.Is(err, errQuotaExceeded) {
+		t.Errorf("expected quota exceeded, got %v", err)
+	}
+	endSession(usage)
+
+	// Device1 is at its daily quota, and can't start new sessions.
+	_, err = r.startSession(device1, device2)
+	if !errors.Is(err, errQuotaExceeded) {
+		t.Fatalf("expected quota exceeded, got %v", err)
+	}
+	if resp := refusalResponse(err); resp != protocol.ResponseQuotaExceeded {
+		t.Errorf("unexpected response %v", resp)
+	}
+
+	status := r.status()[device2.String()].(map[string]interface{})
+	if status["bytesThisMonth"] != int64(1001) || status["limitMonthly"] != int64(10000) || status["sessions"] != int32(0) {
+		t.Errorf("unexpected status %v", status)
+	}
+
+	// Reloading raises the quota and keeps the usage. (The contents of
+	// the file change size each time, so that the change is seen within
+	// the resolution of the modification time.)
+	writeDevices(t, path, device1.String()+" daily=2k\n"+device2.String()+"\n")
+	if err := r.reload(); err != nil {
+		t.Fatal(err)
+	}
+	usage, err = r.startSession(device1, device2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := addBytes(usage, 999); err != nil {
+		t.Error(err)
+	}
+	if err := addBytes(usage, 1); !errors.Is(err, errQuotaExceeded) {
+		t.Errorf("expected quota exceeded, got %v", err)
+	}
+
+	// Devices removed from the allowlist are no longer limited in their
+	// existing sessions.
+	writeDevices(t, path, device2.String()+"\n")
+	if err := r.reload(); err != nil {
+		t.Fatal(err)
+	}
+	if err := addBytes(usage, 1000); err != nil {
+		t.Error(err)
+	}
+	if r.allowed(device1) {
+		t.Error("device1 should no longer be allowed")
+	}
+}
+
+func TestPeriodCounter(t *testing.T) {
+	var c periodCounter
+	c.add(1, 10)
+	c.add(1, 5)
+	if n := c.get(1); n != 15 {
+		t.Errorf("got %d, expected 15", n)
+	}
+	if n := c.get(2); n != 0 {
+		t.Errorf("got %d for a new period, expected 0", n)
+	}
+	if n := c.add(2, 3); n != 3 {
+		t.Errorf("got %d after a new period began, expected 3", n)
+	}
+
+	day := time.Date(2023, 1, 31, 23, 59, 59, 0, time.UTC)
+	if dayPeriod(day) == dayPeriod(day.Add(time.Second)) {
+		t.Error("expected a new day")
+	}
+	if monthPeriod(day) == monthPeriod(day.Add(time.Second)) {
+		t.Error("expected a new month")
+	}
+	if monthPeriod(day) != monthPeriod(day.Add(-30*24*time.Hour)) {
+		t.Error("expected the same month")
+	}
+}
+
+func TestReloadLoopStops(t *testing.T) {
+	r, _ := newTestRegistry(t, device1.String()+"\n")
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.reloadLoop(ctx)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("reload loop didn't stop")
+	}
+}
@@ -3,6 +3,7 @@
 package main
 
 import (
+	"bytes"
 	"crypto/tls"
 	"encoding/hex"
 	"log"
@@ -134,6 +135,24 @@ func protocolConnectionHandler(tcpConn net.Conn, config *tls.Config, token strin
 					continue
 				}
 
+				if bans.isBanned(id) {
+					if debug {
+						log.Println("Refusing join request from banned", id)
+					}
+					protocol.WriteMessage(conn, protocol.ResponseNotAllowed)
+					conn.Close()
+					continue
+				}
+
+				if devices != nil && !devices.allowed(id) {
+					if debug {
+						log.Println("Refusing join request from", id, "as it is not allowlisted")
+					}
+					protocol.WriteMessage(conn, protocol.ResponseNotAllowed)
+					conn.Close()
+					continue
+				}
+
 				if overLimit.Load() {
 					protocol.WriteMessage(conn, protocol.RelayFull{})
 					if debug {
@@ -184,8 +203,29 @@ func protocolConnectionHandler(tcpConn net.Conn, config *tls.Config, token strin
 					conn.Close()
 					continue
 				}
+				if bans.isBanned(id) || bans.isBanned(requestedPeer) {
+					if debug {
+						log.Println("Refusing session from", id, "to", requestedPeer, "involving a banned device")
+					}
+					protocol.WriteMessage(conn, protocol.ResponseNotAllowed)
+					conn.Close()
+					continue
+				}
+				var usage []*deviceUsage
+				if devices != nil {
+					usage, err = devices.startSession(requestedPeer, id)
+					if err != nil {
+						if debug {
+							log.Printf("Refusing session from %s to %s: %v", id, requestedPeer, err)
+						}
+						protocol.WriteMessage(conn, refusalResponse(err))
+						conn.Close()
+						continue
+					}
+				}
 				// requestedPeer is the server, id is the client
 				ses := newSession(requestedPeer, id, sessionLimiter, globalLimiter)
+				ses.usage = usage
 
 				go ses.Serve()
 
@@ -292,6 +332,14 @@ func protocolConnectionHandler(tcpConn net.Conn, config *tls.Config, token strin
 			conn.Close()
 
 		case msg := <-outbox:
+			if _, ok := msg.(kickMessage); ok {
+				if debug {
+					log.Println("Disconnecting banned", id)
+				}
+				protocol.WriteMessage(conn, protocol.ResponseNotAllowed)
+				conn.Close()
+				continue
+			}
 			if debug {
 				log.Printf("Sending message %T to %s", msg, id)
 			}
@@ -332,7 +380,7 @@ func sessionConnectionHandler(conn net.Conn) {
 			return
 		}
 
-		if !ses.AddConnection(conn) {
+		if !ses.AddConnection(conn, bytes.Equal(msg.Key, ses.serverkey)) {
 			if debug {
 				log.Println("Failed to add", conn.RemoteAddr(), "to session", ses)
 			}
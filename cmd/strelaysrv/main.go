@@ -28,6 +28,7 @@ import (
 
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/nat"
+	_ "github.com/syncthing/syncthing/lib/pcp"
 	_ "github.com/syncthing/syncthing/lib/pmp"
 	_ "github.com/syncthing/syncthing/lib/upnp"
 
@@ -56,7 +57,9 @@ var (
 	networkBufferSize int
 
 	statusAddr       string
+	metricsAddr      string
 	token            string
+	allowlist        string
 	poolAddrs        string
 	pools            []string
 	providedBy       string
@@ -90,7 +93,10 @@ func main() {
 	flag.IntVar(&globalLimitBps, "global-rate", globalLimitBps, "Global rate limit, in bytes/s")
 	flag.BoolVar(&debug, "debug", debug, "Enable debug output")
 	flag.StringVar(&statusAddr, "status-srv", ":22070", "Listen address for status service (blank to disable)")
+	flag.StringVar(&metricsAddr, "metrics-listen", "", "Listen address for the Prometheus metrics endpoint (blank to disable)")
+	flag.StringVar(&adminToken, "admin-token", "", "Bearer token enabling the session and ban admin endpoints on the status service (optional). The status service is plain HTTP, so keep it on a trusted network.")
 	flag.StringVar(&token, "token", "", "Token to restrict access to the relay (optional). Disables joining any pools.")
+	flag.StringVar(&allowlist, "allowlist", "", "File listing the device IDs allowed to use the relay, with optional quotas. Reloaded on change. Disables joining any pools.")
 	flag.StringVar(&poolAddrs, "pools", defaultPoolAddrs, "Comma separated list of relay pool addresses to join")
 	flag.StringVar(&providedBy, "provided-by", "", "An optional description about who provides the relay")
 	flag.StringVar(&extAddress, "ext-address", "", "An optional address to advertise as being available on.\n\tAllows listening on an unprivileged port with port forwarding from e.g. 443, and be connected to on port 443.")
@@ -220,6 +226,16 @@ func main() {
 		}
 	}
 
+	if allowlist != "" {
+		devices, err = newDeviceRegistry(allowlist)
+		if err != nil {
+			log.Fatalln("Failed to load allowlist:", err)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		go devices.reloadLoop(ctx)
+		defer cancel()
+	}
+
 	if sessionLimitBps > 0 {
 		sessionLimiter = rate.NewLimiter(rate.Limit(sessionLimitBps), 2*sessionLimitBps)
 	}
@@ -230,6 +246,9 @@ func main() {
 	if statusAddr != "" {
 		go statusService(statusAddr)
 	}
+	if metricsAddr != "" {
+		go metricsService(metricsAddr)
+	}
 
 	uri, err := url.Parse(fmt.Sprintf("relay://%s/", mapping.Address()))
 	if err != nil {
@@ -258,7 +277,7 @@ func main() {
 
 	log.Println("URI:", uri.String())
 
-	if token != "" {
+	if token != "" || allowlist != "" {
 		poolAddrs = ""
 	}
 
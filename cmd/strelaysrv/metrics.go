@@ -0,0 +1,86 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	sessionBytesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "syncthing",
+			Subsystem: "relaysrv",
+			Name:      "session_bytes_total",
+			Help:      "Number of bytes relayed in sessions, by the side that sent them.",
+		}, []string{"from"})
+	sessionBytesFromServer = sessionBytesTotal.WithLabelValues("server")
+	sessionBytesFromClient = sessionBytesTotal.WithLabelValues("client")
+
+	sessionDurationSeconds = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "syncthing",
+			Subsystem: "relaysrv",
+			Name:      "session_duration_seconds",
+			Help:      "Duration of relayed sessions.",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 10), // 1s ... 72h
+		})
+	sessionsTerminatedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "syncthing",
+			Subsystem: "relaysrv",
+			Name:      "sessions_terminated_total",
+			Help:      "Number of sessions terminated by an administrator.",
+		}, []string{"reason"})
+
+	activeSessionsGauge = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: "syncthing",
+			Subsystem: "relaysrv",
+			Name:      "active_sessions",
+			Help:      "Number of currently active sessions.",
+		}, func() float64 {
+			sessionMut.RLock()
+			defer sessionMut.RUnlock()
+			return float64(len(activeSessions))
+		})
+	bannedDevicesGauge = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: "syncthing",
+			Subsystem: "relaysrv",
+			Name:      "banned_devices",
+			Help:      "Number of currently banned devices.",
+		}, func() float64 {
+			return float64(len(bans.list()))
+		})
+)
+
+func init() {
+	prometheus.MustRegister(sessionBytesTotal, sessionDurationSeconds,
+		sessionsTerminatedTotal, activeSessionsGauge, bannedDevicesGauge)
+}
+
+// metricsService serves the metrics on a listener of its own, as the
+// status service is public.
+func metricsService(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	srv := http.Server{
+		Addr:        addr,
+		Handler:     mux,
+		ReadTimeout: 15 * time.Second,
+	}
+	if err := srv.ListenAndServe(); err != nil {
+		log.Fatal(err)
+	}
+}
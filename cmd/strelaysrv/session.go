@@ -25,6 +25,7 @@ var (
 	pendingSessions = make(map[string]*session)
 	numProxies      atomic.Int64
 	bytesProxied    atomic.Int64
+	lastSessionID   atomic.Int64
 )
 
 func newSession(serverid, clientid syncthingprotocol.DeviceID, sessionRateLimit, globalRateLimit *rate.Limiter) *session {
@@ -41,12 +42,13 @@ func newSession(serverid, clientid syncthingprotocol.DeviceID, sessionRateLimit,
 	}
 
 	ses := &session{
+		id:        lastSessionID.Add(1),
 		serverkey: serverkey,
 		serverid:  serverid,
 		clientkey: clientkey,
 		clientid:  clientid,
 		rateLimit: makeRateLimitFunc(sessionRateLimit, globalRateLimit),
-		connsChan: make(chan net.Conn),
+		connsChan: make(chan sessionConn),
 		conns:     make([]net.Conn, 0, 2),
 	}
 
@@ -103,6 +105,8 @@ func hasSessions(id syncthingprotocol.DeviceID) bool {
 type session struct {
 	mut sync.Mutex
 
+	id int64
+
 	serverkey []byte
 	serverid  syncthingprotocol.DeviceID
 
@@ -111,17 +115,34 @@ type session struct {
 
 	rateLimit func(bytes int)
 
-	connsChan chan net.Conn
+	// The usage of the allowlisted devices of the session, if any
+	usage []*deviceUsage
+
+	connsChan chan sessionConn
 	conns     []net.Conn
+
+	// Set when the session starts, and not changed after.
+	serverConn net.Conn
+	started    time.Time
+
+	bytesFromServer atomic.Int64
+	bytesFromClient atomic.Int64
 }
 
-func (s *session) AddConnection(conn net.Conn) bool {
+// sessionConn is a connection joining a session, from either the server or
+// the client side.
+type sessionConn struct {
+	net.Conn
+	server bool
+}
+
+func (s *session) AddConnection(conn net.Conn, server bool) bool {
 	if debug {
 		log.Println("New connection for", s, "from", conn.RemoteAddr())
 	}
 
 	select {
-	case s.connsChan <- conn:
+	case s.connsChan <- sessionConn{conn, server}:
 		return true
 	default:
 	}
@@ -139,8 +160,11 @@ func (s *session) Serve() {
 		select {
 		case conn := <-s.connsChan:
 			s.mut.Lock()
-			s.conns = append(s.conns, conn)
+			s.conns = append(s.conns, conn.Conn)
 			s.mut.Unlock()
+			if conn.server {
+				s.serverConn = conn.Conn
+			}
 			// We're the only ones mutating s.conns, hence we are free to read it.
 			if len(s.conns) < 2 {
 				continue
@@ -168,11 +192,14 @@ func (s *session) Serve() {
 			}()
 
 			sessionMut.Lock()
+			s.started = time.Now()
 			activeSessions = append(activeSessions, s)
 			sessionMut.Unlock()
 
 			wg.Wait()
 
+			sessionDurationSeconds.Observe(time.Since(s.started).Seconds())
+
 			if debug {
 				log.Println("Session", s, "ended, outcomes:", err0, "and", err1)
 			}
@@ -205,6 +232,8 @@ done:
 	}
 	sessionMut.Unlock()
 
+	endSession(s.usage)
+
 	// If we are here because of case 2 or 3, we are potentially closing some or
 	// all connections a second time.
 	s.CloseConns()
@@ -254,6 +283,11 @@ func (s *session) proxy(c1, c2 net.Conn) error {
 	numProxies.Add(1)
 	defer numProxies.Add(-1)
 
+	counter, direction := &s.bytesFromClient, sessionBytesFromClient
+	if c1 == s.serverConn {
+		counter, direction = &s.bytesFromServer, sessionBytesFromServer
+	}
+
 	buf := make([]byte, networkBufferSize)
 	for {
 		c1.SetReadDeadline(time.Now().Add(networkTimeout))
@@ -263,6 +297,16 @@ func (s *session) proxy(c1, c2 net.Conn) error {
 		}
 
 		bytesProxied.Add(int64(n))
+		counter.Add(int64(n))
+		direction.Add(float64(n))
+
+		if s.usage != nil {
+			if err := addBytes(s.usage, int64(n)); err != nil {
+				// Ends the other direction as well.
+				s.CloseConns()
+				return err
+			}
+		}
 
 		if debug {
 			log.Printf("%d bytes from %s to %s", n, c1.RemoteAddr(), c2.RemoteAddr())
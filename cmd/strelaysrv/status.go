@@ -21,6 +21,9 @@ func statusService(addr string) {
 
 	handler := http.NewServeMux()
 	handler.HandleFunc("/status", getStatus)
+	if adminToken != "" {
+		registerAdminHandlers(handler)
+	}
 	if pprofEnabled {
 		handler.HandleFunc("/debug/pprof/", pprof.Index)
 	}
@@ -75,6 +78,10 @@ func getStatus(w http.ResponseWriter, _ *http.Request) {
 		"global-rate":      globalLimitBps,
 		"pools":            pools,
 		"provided-by":      providedBy,
+		"allowlist":        devices != nil,
+	}
+	if devices != nil {
+		status["devices"] = devices.status()
 	}
 
 	bs, err := json.MarshalIndent(status, "", "    ")
@@ -57,7 +57,7 @@ func main() {
 
 	if join {
 		log.Println("Creating client")
-		relay, err := client.NewClient(uri, []tls.Certificate{cert}, 10*time.Second)
+		relay, err := client.NewClient(uri, []tls.Certificate{cert}, 10*time.Second, 1)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -80,7 +80,7 @@ func main() {
 		}()
 
 		for {
-			conn, err := client.JoinSession(ctx, <-recv)
+			conn, err := client.JoinSession(ctx, <-recv, "")
 			if err != nil {
 				log.Fatalln("Failed to join", err)
 			}
@@ -94,13 +94,13 @@ func main() {
 			log.Fatal(err)
 		}
 
-		invite, err := client.GetInvitationFromRelay(ctx, uri, id, []tls.Certificate{cert}, 10*time.Second)
+		invite, err := client.GetInvitationFromRelay(ctx, uri, id, []tls.Certificate{cert}, 10*time.Second, "")
 		if err != nil {
 			log.Fatal(err)
 		}
 
 		log.Println("Received invitation", invite)
-		conn, err := client.JoinSession(ctx, invite)
+		conn, err := client.JoinSession(ctx, invite, "")
 		if err != nil {
 			log.Fatalln("Failed to join", err)
 		}
@@ -0,0 +1,90 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/syncthing/syncthing/lib/api/apiclient"
+	"github.com/syncthing/syncthing/lib/config"
+)
+
+var apiKeysCommand = cli.Command{
+	Name:     "apikeys",
+	HideHelp: true,
+	Usage:    "Named API key command group",
+	Subcommands: []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "Show the named API keys",
+			Action: expects(0, clientAction(listAPIKeys)),
+		},
+		{
+			Name:      "add",
+			Usage:     "Add a named API key and show it",
+			ArgsUsage: "NAME",
+			Flags: []cli.Flag{
+				cli.StringFlag{Name: "role", Value: "viewer", Usage: "Give the key the `ROLE` viewer (read-only), operator or admin"},
+				cli.StringSliceFlag{Name: "endpoint", Usage: "Restrict the key to the endpoint `GROUP` (cluster, config, db, debug, events, folder, metrics, stats, svc, system); may be repeated"},
+				cli.StringSliceFlag{Name: "folder", Usage: "Restrict the key to the folder `FOLDER-ID`; may be repeated"},
+				cli.StringFlag{Name: "expires", Usage: "Expire the key at `TIME`, in RFC 3339 format or as a duration from now (e.g. \"720h\")"},
+				cli.StringFlag{Name: "key", Usage: "Use `KEY` instead of a generated key"},
+			},
+			Action: expects(1, clientAction(addAPIKey)),
+		},
+		{
+			Name:      "revoke",
+			Usage:     "Revoke a named API key",
+			ArgsUsage: "NAME",
+			Action:    expects(1, clientAction(revokeAPIKey)),
+		},
+	},
+}
+
+func listAPIKeys(_ *cli.Context, client *apiclient.Client) error {
+	keys, err := client.APIKeys(context.Background())
+	if err != nil {
+		return err
+	}
+	return prettyPrintJSON(keys)
+}
+
+func addAPIKey(c *cli.Context, client *apiclient.Client) error {
+	key := config.APIKeyConfiguration{
+		Name:      c.Args()[0],
+		Key:       c.String("key"),
+		Endpoints: c.StringSlice("endpoint"),
+		Folders:   c.StringSlice("folder"),
+	}
+	if err := key.Role.UnmarshalText([]byte(c.String("role"))); err != nil {
+		return err
+	}
+	if key.Role.String() != c.String("role") {
+		return fmt.Errorf("unknown role %q", c.String("role"))
+	}
+	if v := c.String("expires"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			key.Expires = time.Now().Add(d).Truncate(time.Second)
+		} else if key.Expires, err = time.Parse(time.RFC3339, v); err != nil {
+			return fmt.Errorf("expiry: %w", err)
+		}
+	}
+
+	added, err := client.AddAPIKey(context.Background(), key)
+	if err != nil {
+		return err
+	}
+	return prettyPrintJSON(added)
+}
+
+func revokeAPIKey(c *cli.Context, client *apiclient.Client) error {
+	return client.RevokeAPIKey(context.Background(), c.Args()[0])
+}
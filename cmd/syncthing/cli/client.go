@@ -7,40 +7,25 @@
 package cli
 
 import (
-	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net"
 	"net/http"
-	"strings"
 
+	"github.com/syncthing/syncthing/lib/api/apiclient"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/locations"
 	"github.com/syncthing/syncthing/lib/protocol"
 )
 
-type APIClient interface {
-	Get(url string) (*http.Response, error)
-	Post(url, body string) (*http.Response, error)
-	PutJSON(url string, o interface{}) (*http.Response, error)
-}
-
-type apiClient struct {
-	http.Client
-	cfg    config.GUIConfiguration
-	apikey string
-}
-
 type apiClientFactory struct {
 	cfg config.GUIConfiguration
 }
 
-func (f *apiClientFactory) getClient() (APIClient, error) {
+func (f *apiClientFactory) getClient() (*apiclient.Client, error) {
 	// Now if the API key and address is not provided (we are not connecting to a remote instance),
 	// try to rip it out of the config.
 	if f.cfg.RawAddress == "" && f.cfg.APIKey == "" {
@@ -53,7 +38,7 @@ func (f *apiClientFactory) getClient() (APIClient, error) {
 		return nil, errors.New("Both --gui-address and --gui-apikey should be specified")
 	}
 
-	httpClient := http.Client{
+	httpClient := &http.Client{
 		Transport: &http.Transport{
 			TLSClientConfig: &tls.Config{
 				InsecureSkipVerify: true,
@@ -63,11 +48,11 @@ func (f *apiClientFactory) getClient() (APIClient, error) {
 			},
 		},
 	}
-	return &apiClient{
-		Client: httpClient,
-		cfg:    f.cfg,
-		apikey: f.cfg.APIKey,
-	}, nil
+	endpoint := f.cfg.URL()
+	if f.cfg.Network() == "unix" {
+		endpoint = "http://unix/"
+	}
+	return apiclient.New(endpoint, f.cfg.APIKey, httpClient), nil
 }
 
 func loadGUIConfig() (config.GUIConfiguration, error) {
@@ -100,73 +85,3 @@ func loadGUIConfig() (config.GUIConfiguration, error) {
 
 	return guiCfg, nil
 }
-
-func (c *apiClient) Endpoint() string {
-	if c.cfg.Network() == "unix" {
-		return "http://unix/"
-	}
-	url := c.cfg.URL()
-	if !strings.HasSuffix(url, "/") {
-		url += "/"
-	}
-	return url
-}
-
-func (c *apiClient) Do(req *http.Request) (*http.Response, error) {
-	req.Header.Set("X-API-Key", c.apikey)
-	resp, err := c.Client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	return resp, checkResponse(resp)
-}
-
-func (c *apiClient) Request(url, method string, r io.Reader) (*http.Response, error) {
-	request, err := http.NewRequest(method, c.Endpoint()+"rest/"+url, r)
-	if err != nil {
-		return nil, err
-	}
-	return c.Do(request)
-}
-
-func (c *apiClient) RequestString(url, method, data string) (*http.Response, error) {
-	return c.Request(url, method, bytes.NewBufferString(data))
-}
-
-func (c *apiClient) RequestJSON(url, method string, o interface{}) (*http.Response, error) {
-	data, err := json.Marshal(o)
-	if err != nil {
-		return nil, err
-	}
-	return c.Request(url, method, bytes.NewBuffer(data))
-}
-
-func (c *apiClient) Get(url string) (*http.Response, error) {
-	return c.RequestString(url, "GET", "")
-}
-
-func (c *apiClient) Post(url, body string) (*http.Response, error) {
-	return c.RequestString(url, "POST", body)
-}
-
-func (c *apiClient) PutJSON(url string, o interface{}) (*http.Response, error) {
-	return c.RequestJSON(url, "PUT", o)
-}
-
-var errNotFound = errors.New("invalid endpoint or API call")
-
-func checkResponse(response *http.Response) error {
-	if response.StatusCode == http.StatusNotFound {
-		return errNotFound
-	} else if response.StatusCode == http.StatusUnauthorized {
-		return errors.New("invalid API key")
-	} else if response.StatusCode != http.StatusOK {
-		data, err := responseToBArray(response)
-		if err != nil {
-			return err
-		}
-		body := strings.TrimSpace(string(data))
-		return fmt.Errorf("unexpected HTTP status returned: %s\n%s", response.Status, body)
-	}
-	return nil
-}
@@ -7,19 +7,19 @@
 package cli
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
 	"reflect"
 
 	"github.com/AudriusButkevicius/recli"
+	"github.com/syncthing/syncthing/lib/api/apiclient"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/urfave/cli"
 )
 
 type configHandler struct {
 	original, cfg config.Configuration
-	client        APIClient
+	client        *apiclient.Client
 	err           error
 }
 
@@ -27,7 +27,7 @@ func getConfigCommand(f *apiClientFactory) (cli.Command, error) {
 	h := new(configHandler)
 	h.client, h.err = f.getClient()
 	if h.err == nil {
-		h.cfg, h.err = getConfig(h.client)
+		h.cfg, h.err = h.client.Config(context.Background())
 	}
 	h.original = h.cfg.Copy()
 
@@ -45,7 +45,7 @@ func getConfigCommand(f *apiClientFactory) (cli.Command, error) {
 		Name:        "config",
 		HideHelp:    true,
 		Usage:       "Configuration modification command group",
-		Subcommands: commands,
+		Subcommands: append(commands, configApplyCommand),
 		Before:      h.configBefore,
 		After:       h.configAfter,
 	}, nil
@@ -68,20 +68,5 @@ func (h *configHandler) configAfter(_ *cli.Context) error {
 	if reflect.DeepEqual(h.cfg, h.original) {
 		return nil
 	}
-	body, err := json.MarshalIndent(h.cfg, "", "  ")
-	if err != nil {
-		return err
-	}
-	resp, err := h.client.Post("system/config", string(body))
-	if err != nil {
-		return err
-	}
-	if resp.StatusCode != 200 {
-		body, err := responseToBArray(resp)
-		if err != nil {
-			return err
-		}
-		return errors.New(string(body))
-	}
-	return nil
+	return h.client.SetConfig(context.Background(), h.cfg)
 }
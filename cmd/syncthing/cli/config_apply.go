@@ -0,0 +1,410 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+
+	"github.com/syncthing/syncthing/lib/api/apiclient"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// The desired state applied by "config apply" is a YAML file with the same
+// field names as the REST API. Only the listed objects and fields are
+// changed, through the same endpoints as used by the GUI:
+//
+//	options:
+//	  globalAnnounceEnabled: false
+//	devices:
+//	  - deviceID: ${LAPTOP_ID}
+//	    name: laptop
+//	folders:
+//	  - id: photos
+//	    path: ~/Photos
+//	    devices:
+//	      - deviceID: ${LAPTOP_ID}
+//
+// Environment variables are substituted as $NAME or ${NAME} in values,
+// with $$ for a literal dollar sign. Keys and comments are left as they are.
+
+var configApplyCommand = cli.Command{
+	Name:  "apply",
+	Usage: "Apply a desired state of folders, devices and settings",
+	Flags: []cli.Flag{
+		cli.StringFlag{Name: "file, f", Usage: "Read the desired state from the YAML `FILE`, or standard input for \"-\""},
+		cli.BoolFlag{Name: "plan", Usage: "Only show the changes that would be made"},
+		cli.BoolFlag{Name: "prune", Usage: "Remove the folders and devices not listed, if the file lists any"},
+	},
+	Action: expects(0, configApply),
+}
+
+type desiredState struct {
+	Options map[string]interface{}   `yaml:"options"`
+	GUI     map[string]interface{}   `yaml:"gui"`
+	LDAP    map[string]interface{}   `yaml:"ldap"`
+	Devices []map[string]interface{} `yaml:"devices"`
+	Folders []map[string]interface{} `yaml:"folders"`
+}
+
+// An applyRequest makes the changes to the object with the given path, as
+// in config.Change.
+type applyRequest struct {
+	path string
+	do   func(context.Context, *apiclient.Client) error
+}
+
+func configApply(c *cli.Context) error {
+	if c.String("file") == "" {
+		return errors.New("missing desired state file (--file)")
+	}
+	state, err := readDesiredState(c.String("file"))
+	if err != nil {
+		return err
+	}
+
+	client, err := getClientFactory(c).getClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	current, err := client.Config(ctx)
+	if err != nil {
+		return err
+	}
+	status, err := client.SystemStatus(ctx)
+	if err != nil {
+		return err
+	}
+	myID := status.MyID
+
+	desired, requests, err := planConfig(current, state, myID, c.Bool("prune"))
+	if err != nil {
+		return err
+	}
+	changes, err := diffPrepared(current, desired, myID)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		fmt.Println("No changes.")
+		return nil
+	}
+	for _, change := range changes {
+		fmt.Println(formatChange(change))
+	}
+	if c.Bool("plan") {
+		return nil
+	}
+
+	for _, req := range requests {
+		if !hasChanges(changes, req.path) {
+			continue
+		}
+		if err := req.do(ctx, client); err != nil {
+			return fmt.Errorf("%s: %w", req.path, err)
+		}
+	}
+	fmt.Printf("Applied %d changes.\n", len(changes))
+	return nil
+}
+
+func readDesiredState(path string) (desiredState, error) {
+	var bs []byte
+	var err error
+	if path == "-" {
+		bs, err = io.ReadAll(os.Stdin)
+	} else {
+		bs, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return desiredState{}, err
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(bs, &root); err != nil {
+		return desiredState{}, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if err := expandEnv(&root); err != nil {
+		return desiredState{}, err
+	}
+
+	var state desiredState
+	if root.Kind == 0 {
+		// Empty file
+		return state, nil
+	}
+	// Decoding the node doesn't refuse unknown fields, so encode it again.
+	bs, err = yaml.Marshal(&root)
+	if err != nil {
+		return desiredState{}, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(bs))
+	dec.KnownFields(true)
+	if err := dec.Decode(&state); err != nil && !errors.Is(err, io.EOF) {
+		return desiredState{}, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return state, nil
+}
+
+// expandEnv substitutes environment variables in the scalar values of the
+// parsed document, which leaves out mapping keys and comments.
+func expandEnv(root *yaml.Node) error {
+	var missing []string
+	var walk func(n *yaml.Node)
+	walk = func(n *yaml.Node) {
+		for i, child := range n.Content {
+			if n.Kind == yaml.MappingNode && i%2 == 0 {
+				// A key
+				continue
+			}
+			walk(child)
+		}
+		if n.Kind != yaml.ScalarNode || !strings.Contains(n.Value, "$") {
+			return
+		}
+		n.Value = os.Expand(n.Value, func(name string) string {
+			if name == "$" {
+				return "$"
+			}
+			value, ok := os.LookupEnv(name)
+			if !ok && !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+			return value
+		})
+		if n.Style&(yaml.TaggedStyle|yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+			// Resolve the type of the substituted value, as if it had
+			// been written out in the file.
+			n.Tag = ""
+		}
+	}
+	walk(root)
+	if len(missing) > 0 {
+		return fmt.Errorf("undefined environment variables: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// planConfig returns the configuration with the desired state applied, and
+// the requests to make for it, in the order they are to be made.
+func planConfig(current config.Configuration, state desiredState, myID protocol.DeviceID, prune bool) (config.Configuration, []applyRequest, error) {
+	desired := current.Copy()
+	var requests []applyRequest
+
+	listedDevices := make(map[protocol.DeviceID]bool)
+	for _, fields := range state.Devices {
+		fields := fields
+		idStr, _ := fields["deviceID"].(string)
+		id, err := protocol.DeviceIDFromString(idStr)
+		if err != nil {
+			return config.Configuration{}, nil, fmt.Errorf("device %q: %w", idStr, err)
+		}
+		listedDevices[id] = true
+		path := fmt.Sprintf("devices[%s]", id)
+		device, _, ok := desired.Device(id)
+		if ok {
+			requests = append(requests, applyRequest{path, func(ctx context.Context, client *apiclient.Client) error {
+				return client.PatchDevice(ctx, id, fields)
+			}})
+		} else {
+			device = desired.Defaults.Device.Copy()
+			requests = append(requests, applyRequest{path, func(ctx context.Context, client *apiclient.Client) error {
+				return client.AddDevice(ctx, fields)
+			}})
+		}
+		if err := mergeFields(path, fields, &device); err != nil {
+			return config.Configuration{}, nil, err
+		}
+		desired.SetDevice(device)
+	}
+
+	listedFolders := make(map[string]bool)
+	for _, fields := range state.Folders {
+		fields := fields
+		id, _ := fields["id"].(string)
+		if id == "" {
+			return config.Configuration{}, nil, errors.New("folder without id")
+		}
+		listedFolders[id] = true
+		path := fmt.Sprintf("folders[%s]", id)
+		folder, _, ok := desired.Folder(id)
+		if ok {
+			requests = append(requests, applyRequest{path, func(ctx context.Context, client *apiclient.Client) error {
+				return client.PatchFolder(ctx, id, fields)
+			}})
+		} else {
+			folder = desired.Defaults.Folder.Copy()
+			requests = append(requests, applyRequest{path, func(ctx context.Context, client *apiclient.Client) error {
+				return client.AddFolder(ctx, fields)
+			}})
+		}
+		if err := mergeFields(path, fields, &folder); err != nil {
+			return config.Configuration{}, nil, err
+		}
+		desired.SetFolder(folder)
+	}
+
+	if prune && state.Folders != nil {
+		folders := desired.Folders[:0]
+		for _, folder := range desired.Folders {
+			if listedFolders[folder.ID] {
+				folders = append(folders, folder)
+				continue
+			}
+			id := folder.ID
+			requests = append(requests, applyRequest{fmt.Sprintf("folders[%s]", id), func(ctx context.Context, client *apiclient.Client) error {
+				return client.RemoveFolder(ctx, id)
+			}})
+		}
+		desired.Folders = folders
+	}
+	if prune && state.Devices != nil {
+		devices := desired.Devices[:0]
+		for _, device := range desired.Devices {
+			if listedDevices[device.DeviceID] || device.DeviceID == myID {
+				devices = append(devices, device)
+				continue
+			}
+			id := device.DeviceID
+			requests = append(requests, applyRequest{fmt.Sprintf("devices[%s]", id), func(ctx context.Context, client *apiclient.Client) error {
+				return client.RemoveDevice(ctx, id)
+			}})
+		}
+		desired.Devices = devices
+	}
+
+	if state.Options != nil {
+		if err := mergeFields("options", state.Options, &desired.Options); err != nil {
+			return config.Configuration{}, nil, err
+		}
+		requests = append(requests, applyRequest{"options", func(ctx context.Context, client *apiclient.Client) error {
+			return client.PatchOptions(ctx, state.Options)
+		}})
+	}
+	if state.LDAP != nil {
+		if err := mergeFields("ldap", state.LDAP, &desired.LDAP); err != nil {
+			return config.Configuration{}, nil, err
+		}
+		requests = append(requests, applyRequest{"ldap", func(ctx context.Context, client *apiclient.Client) error {
+			return client.PatchLDAP(ctx, state.LDAP)
+		}})
+	}
+	// Last, as changing it may restart the GUI and REST API.
+	if state.GUI != nil {
+		fields := state.GUI
+		if password, ok := fields["password"].(string); ok {
+			if bcrypt.CompareHashAndPassword([]byte(current.GUI.Password), []byte(password)) == nil {
+				// The password is set already, as a hash that differs
+				// every time.
+				fields = make(map[string]interface{}, len(state.GUI))
+				for key, value := range state.GUI {
+					if key != "password" {
+						fields[key] = value
+					}
+				}
+			}
+		}
+		if err := mergeFields("gui", fields, &desired.GUI); err != nil {
+			return config.Configuration{}, nil, err
+		}
+		if desired.GUI.Password != current.GUI.Password {
+			if err := desired.GUI.SetPassword(desired.GUI.Password); err != nil {
+				return config.Configuration{}, nil, err
+			}
+		}
+		requests = append(requests, applyRequest{"gui", func(ctx context.Context, client *apiclient.Client) error {
+			return client.PatchGUI(ctx, fields)
+		}})
+	}
+
+	return desired, requests, nil
+}
+
+// mergeFields sets the given fields of the object, like the REST API does
+// for a PATCH request, refusing unknown ones.
+func mergeFields(path string, fields map[string]interface{}, to interface{}) error {
+	bs, err := json.Marshal(fields)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(to); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// diffPrepared returns the changes between the configurations as they are
+// once prepared, with defaults filled in, the way Syncthing will store them.
+func diffPrepared(from, to config.Configuration, myID protocol.DeviceID) ([]config.Change, error) {
+	prepare := func(cfg config.Configuration) (config.Configuration, error) {
+		bs, err := json.Marshal(cfg)
+		if err != nil {
+			return config.Configuration{}, err
+		}
+		return config.ReadJSON(bytes.NewReader(bs), myID)
+	}
+	from, err := prepare(from)
+	if err != nil {
+		return nil, err
+	}
+	to, err = prepare(to)
+	if err != nil {
+		return nil, err
+	}
+	changes := config.Diff(from, to)
+	sort.SliceStable(changes, func(a, b int) bool {
+		return changeOrder(changes[a].Path) < changeOrder(changes[b].Path)
+	})
+	return changes, nil
+}
+
+// changeOrder orders the changes the way the requests are made.
+func changeOrder(path string) int {
+	for i, prefix := range []string{"devices", "folders", "options", "ldap", "gui"} {
+		if strings.HasPrefix(path, prefix) {
+			return i
+		}
+	}
+	return 5
+}
+
+func hasChanges(changes []config.Change, path string) bool {
+	for _, change := range changes {
+		if change.Path == path || strings.HasPrefix(change.Path, path+".") || strings.HasPrefix(change.Path, path+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+func formatChange(change config.Change) string {
+	switch {
+	case change.From == nil:
+		return "+ " + change.Path
+	case change.To == nil:
+		return "- " + change.Path
+	}
+	from, _ := json.Marshal(change.From)
+	to, _ := json.Marshal(change.To)
+	return fmt.Sprintf("~ %s: %s -> %s", change.Path, from, to)
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+var (
+	applyDevice1, _ = protocol.DeviceIDFromString("AIR6LPZ7K4PTTUXQSMUUCPQ5YWOEDFIIQJUG7772YQXXR5YD6AWQ")
+	applyDevice2, _ = protocol.DeviceIDFromString("GYRZZQBIRNPV4T7TC52WEQYJ3TFDQW6MWDFLMU4SSSU6EMFBK2VA")
+)
+
+func TestPlanConfig(t *testing.T) {
+	myID := protocol.LocalDeviceID
+
+	current := config.New(myID)
+	current.SetDevice(config.DeviceConfiguration{DeviceID: applyDevice2, Name: "old"})
+	current.SetFolder(config.FolderConfiguration{ID: "old", Path: "/old", Devices: []config.FolderDeviceConfiguration{{DeviceID: applyDevice2}}})
+	current = prepareForTest(t, current, myID)
+
+	cases := []struct {
+		name    string
+		state   string
+		prune   bool
+		changes []string
+		err     bool
+	}{
+		{
+			name:  "nothing",
+			state: ``,
+		},
+		{
+			name:    "add device and folder",
+			state:   "devices:\n  - deviceID: " + applyDevice1.String() + "\n    name: laptop\nfolders:\n  - id: photos\n    path: /photos\n    devices:\n      - deviceID: " + applyDevice1.String() + "\n",
+			changes: []string{"devices[" + applyDevice1.String() + "]", "folders[photos]"},
+		},
+		{
+			name:    "change device",
+			state:   "devices:\n  - deviceID: " + applyDevice2.String() + "\n    name: renamed\n",
+			changes: []string{"devices[" + applyDevice2.String() + "].name"},
+		},
+		{
+			name:    "change options",
+			state:   "options:\n  globalAnnounceEnabled: false\n  maxSendKbps: 100\n",
+			changes: []string{"options.globalAnnounceEnabled", "options.maxSendKbps"},
+		},
+		{
+			name:    "set password",
+			state:   "gui:\n  user: admin\n  password: secret\n",
+			changes: []string{"options.unackedNotificationIDs", "gui.password", "gui.user"},
+		},
+		{
+			name:    "prune keeps local device",
+			state:   "devices:\n  - deviceID: " + applyDevice1.String() + "\n",
+			prune:   true,
+			changes: []string{"devices[" + applyDevice1.String() + "]", "devices[" + applyDevice2.String() + "]", "folders[old].devices[" + applyDevice2.String() + "]"},
+		},
+		{
+			name:    "prune folders",
+			state:   "folders:\n  - id: photos\n    path: /photos\n",
+			prune:   true,
+			changes: []string{"folders[old]", "folders[photos]"},
+		},
+		{
+			name:  "listing without prune",
+			state: "devices:\n  - deviceID: " + applyDevice2.String() + "\nfolders: []\n",
+		},
+		{
+			name:  "unknown field",
+			state: "options:\n  noSuchOption: true\n",
+			err:   true,
+		},
+		{
+			name:  "invalid device ID",
+			state: "devices:\n  - deviceID: nope\n",
+			err:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := readStateForTest(t, tc.state)
+			desired, _, err := planConfig(current, state, myID, tc.prune)
+			if tc.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			changes, err := diffPrepared(current, desired, myID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var paths []string
+			for _, change := range changes {
+				paths = append(paths, change.Path)
+			}
+			if !reflect.DeepEqual(paths, tc.changes) {
+				t.Errorf("got changes %v, expected %v", paths, tc.changes)
+			}
+
+			// Applying the same state again changes nothing.
+			applied := prepareForTest(t, desired, myID)
+			if _, _, ok := applied.Device(myID); !ok {
+				t.Error("local device removed")
+			}
+			desired, _, err = planConfig(applied, state, myID, tc.prune)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changes, err = diffPrepared(applied, desired, myID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) != 0 {
+				t.Errorf("unexpected changes applying again: %+v", changes)
+			}
+		})
+	}
+}
+
+func TestReadDesiredStateEnv(t *testing.T) {
+	t.Setenv("APPLY_TEST_NAME", "laptop")
+	t.Setenv("APPLY_TEST_KBPS", "100")
+
+	state := readStateForTest(t, "# Costs $5 a month\noptions:\n  maxSendKbps: $APPLY_TEST_KBPS\ndevices:\n  - deviceID: "+applyDevice1.String()+" # $HOME\n    name: ${APPLY_TEST_NAME}$$\n")
+	if kbps := state.Options["maxSendKbps"]; kbps != 100 {
+		t.Errorf("got maxSendKbps %#v, expected 100", kbps)
+	}
+	if name := state.Devices[0]["name"]; name != "laptop$" {
+		t.Errorf("got name %q, expected laptop$", name)
+	}
+
+	// Keys are left as they are.
+	state = readStateForTest(t, "options:\n  $APPLY_TEST_NAME: 1\n")
+	if _, ok := state.Options["$APPLY_TEST_NAME"]; !ok {
+		t.Errorf("expected the key to be unchanged, got %v", state.Options)
+	}
+
+	path := filepath.Join(t.TempDir(), "state.yaml")
+	if err := os.WriteFile(path, []byte("options:\n  maxSendKbps: $APPLY_TEST_UNDEFINED\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readDesiredState(path); err == nil {
+		t.Error("expected an error for an undefined variable")
+	}
+}
+
+func readStateForTest(t *testing.T, state string) desiredState {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "state.yaml")
+	if err := os.WriteFile(path, []byte(state), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	res, err := readDesiredState(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+// prepareForTest returns the configuration the way Syncthing stores it.
+func prepareForTest(t *testing.T, cfg config.Configuration, myID protocol.DeviceID) config.Configuration {
+	t.Helper()
+	bs, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = config.ReadJSON(bytes.NewReader(bs), myID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
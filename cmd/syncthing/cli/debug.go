@@ -39,7 +39,7 @@ func debugFile() cli.ActionFunc {
 		query := make(url.Values)
 		query.Set("folder", c.Args()[0])
 		query.Set("file", normalizePath(c.Args()[1]))
-		return indexDumpOutput("debug/file?" + query.Encode())(c)
+		return dumpOutput(c, "debug/file", query)
 	}
 }
 
@@ -7,11 +7,12 @@
 package cli
 
 import (
-	"errors"
-	"fmt"
+	"context"
 	"strings"
 
 	"github.com/urfave/cli"
+
+	"github.com/syncthing/syncthing/lib/api/apiclient"
 )
 
 var errorsCommand = cli.Command{
@@ -28,34 +29,17 @@ var errorsCommand = cli.Command{
 			Name:      "push",
 			Usage:     "Push an error to active clients",
 			ArgsUsage: "ERROR-MESSAGE",
-			Action:    expects(1, errorsPush),
+			Action:    expects(1, clientAction(errorsPush)),
 		},
 		{
 			Name:   "clear",
 			Usage:  "Clear pending errors",
-			Action: expects(0, emptyPost("system/error/clear")),
+			Action: expects(0, clientCall((*apiclient.Client).ClearErrors)),
 		},
 	},
 }
 
-func errorsPush(c *cli.Context) error {
-	client := c.App.Metadata["client"].(APIClient)
+func errorsPush(c *cli.Context, client *apiclient.Client) error {
 	errStr := strings.Join(c.Args(), " ")
-	response, err := client.Post("system/error", strings.TrimSpace(errStr))
-	if err != nil {
-		return err
-	}
-	if response.StatusCode != 200 {
-		errStr = fmt.Sprint("Failed to push error\nStatus code: ", response.StatusCode)
-		bytes, err := responseToBArray(response)
-		if err != nil {
-			return err
-		}
-		body := string(bytes)
-		if body != "" {
-			errStr += "\nBody: " + body
-		}
-		return errors.New(errStr)
-	}
-	return nil
+	return client.PushError(context.Background(), strings.TrimSpace(errStr))
 }
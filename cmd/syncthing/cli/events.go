@@ -0,0 +1,63 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/syncthing/syncthing/lib/api/apiclient"
+)
+
+var eventsCommand = cli.Command{
+	Name:  "events",
+	Usage: "Show events from the event journal",
+	Flags: []cli.Flag{
+		cli.StringFlag{Name: "since", Usage: "Show events from `TIME` on, in RFC 3339 format or as a duration before now (e.g. \"24h\")"},
+		cli.StringFlag{Name: "until", Usage: "Show events from before `TIME`, in RFC 3339 format or as a duration before now"},
+		cli.StringFlag{Name: "types", Usage: "Show only events of the comma separated `TYPES`"},
+		cli.StringFlag{Name: "folder", Usage: "Show only events concerning the comma separated `FOLDER-IDS`"},
+		cli.StringFlag{Name: "device", Usage: "Show only events concerning the comma separated `DEVICE-IDS`"},
+		cli.IntFlag{Name: "limit", Usage: "Show at most the `N` most recent events (default 1000, at most 10000)"},
+	},
+	Action: expects(0, clientAction(eventHistory)),
+}
+
+func eventHistory(c *cli.Context, client *apiclient.Client) error {
+	var q apiclient.EventQuery
+	for name, t := range map[string]*time.Time{"since": &q.Since, "until": &q.Until} {
+		if v := c.String(name); v != "" {
+			var err error
+			if *t, err = eventTime(v); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	}
+	for name, list := range map[string]*[]string{"types": &q.Types, "folder": &q.Folders, "device": &q.Devices} {
+		if v := c.String(name); v != "" {
+			*list = strings.Split(v, ",")
+		}
+	}
+	q.Limit = c.Int("limit")
+
+	evs, err := client.EventHistory(context.Background(), q)
+	if err != nil {
+		return err
+	}
+	return prettyPrintJSON(evs)
+}
+
+func eventTime(v string) (time.Time, error) {
+	if d, err := time.ParseDuration(v); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	return time.Parse(time.RFC3339, v)
+}
@@ -152,6 +152,11 @@ func indexDump(*cli.Context) error {
 			od.Unmarshal(it.Value())
 			fmt.Printf("[pendingDevice] D:%v V:%v\n", device, od)
 
+		case db.KeyTypeEventJournal:
+			t := time.Unix(0, int64(binary.BigEndian.Uint64(key[1:])))
+			id := binary.BigEndian.Uint64(key[9:])
+			fmt.Printf("[eventJournal] T:%v I:%d V:%s\n", t, id, it.Value())
+
 		default:
 			fmt.Printf("[??? %d]\n  %x\n  %x\n", key[0], key, it.Value())
 		}
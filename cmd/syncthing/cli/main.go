@@ -105,6 +105,8 @@ func runInternal(c preCli, cliArgs []string) error {
 			showCommand,
 			operationCommand,
 			errorsCommand,
+			eventsCommand,
+			apiKeysCommand,
 			debugCommand,
 			{
 				Name:     "-",
@@ -8,10 +8,12 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
 
+	"github.com/syncthing/syncthing/lib/api/apiclient"
 	"github.com/syncthing/syncthing/lib/config"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/urfave/cli"
@@ -25,72 +27,48 @@ var operationCommand = cli.Command{
 		{
 			Name:   "restart",
 			Usage:  "Restart syncthing",
-			Action: expects(0, emptyPost("system/restart")),
+			Action: expects(0, clientCall((*apiclient.Client).Restart)),
 		},
 		{
 			Name:   "shutdown",
 			Usage:  "Shutdown syncthing",
-			Action: expects(0, emptyPost("system/shutdown")),
+			Action: expects(0, clientCall((*apiclient.Client).Shutdown)),
 		},
 		{
 			Name:   "upgrade",
 			Usage:  "Upgrade syncthing (if a newer version is available)",
-			Action: expects(0, emptyPost("system/upgrade")),
+			Action: expects(0, clientCall((*apiclient.Client).Upgrade)),
 		},
 		{
 			Name:      "folder-override",
 			Usage:     "Override changes on folder (remote for sendonly, local for receiveonly). WARNING: Destructive - deletes/changes your data.",
 			ArgsUsage: "FOLDER-ID",
-			Action:    expects(1, foldersOverride),
+			Action:    expects(1, clientAction(foldersOverride)),
 		},
 		{
 			Name:      "default-ignores",
 			Usage:     "Set the default ignores (config) from a file",
 			ArgsUsage: "PATH",
-			Action:    expects(1, setDefaultIgnores),
+			Action:    expects(1, clientAction(setDefaultIgnores)),
 		},
 	},
 }
 
-func foldersOverride(c *cli.Context) error {
-	client, err := getClientFactory(c).getClient()
-	if err != nil {
+func foldersOverride(c *cli.Context, client *apiclient.Client) error {
+	ctx := context.Background()
+	rid := c.Args()[0]
+	if _, err := client.Folder(ctx, rid); errors.Is(err, apiclient.ErrNotFound) {
+		return fmt.Errorf("Folder %q not found", rid)
+	} else if err != nil {
 		return err
 	}
-	cfg, err := getConfig(client)
-	if err != nil {
-		return err
+	if err := client.OverrideFolder(ctx, rid); err != nil {
+		return fmt.Errorf("Failed to override changes: %w", err)
 	}
-	rid := c.Args()[0]
-	for _, folder := range cfg.Folders {
-		if folder.ID == rid {
-			response, err := client.Post("db/override", "")
-			if err != nil {
-				return err
-			}
-			if response.StatusCode != 200 {
-				errStr := fmt.Sprint("Failed to override changes\nStatus code: ", response.StatusCode)
-				bytes, err := responseToBArray(response)
-				if err != nil {
-					return err
-				}
-				body := string(bytes)
-				if body != "" {
-					errStr += "\nBody: " + body
-				}
-				return errors.New(errStr)
-			}
-			return nil
-		}
-	}
-	return fmt.Errorf("Folder %q not found", rid)
+	return nil
 }
 
-func setDefaultIgnores(c *cli.Context) error {
-	client, err := getClientFactory(c).getClient()
-	if err != nil {
-		return err
-	}
+func setDefaultIgnores(c *cli.Context, client *apiclient.Client) error {
 	dir, file := filepath.Split(c.Args()[0])
 	filesystem := fs.NewFilesystem(fs.FilesystemTypeBasic, dir)
 
@@ -108,6 +86,5 @@ func setDefaultIgnores(c *cli.Context) error {
 		return err
 	}
 
-	_, err = client.PutJSON("config/defaults/ignores", config.Ignores{Lines: lines})
-	return err
+	return client.SetDefaultIgnores(context.Background(), config.Ignores{Lines: lines})
 }
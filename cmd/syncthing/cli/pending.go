@@ -7,9 +7,12 @@
 package cli
 
 import (
-	"net/url"
+	"context"
 
 	"github.com/urfave/cli"
+
+	"github.com/syncthing/syncthing/lib/api/apiclient"
+	"github.com/syncthing/syncthing/lib/protocol"
 )
 
 var pendingCommand = cli.Command{
@@ -20,7 +23,7 @@ var pendingCommand = cli.Command{
 		{
 			Name:   "devices",
 			Usage:  "Show pending devices",
-			Action: expects(0, indexDumpOutput("cluster/pending/devices")),
+			Action: expects(0, clientAction(pendingDevices)),
 		},
 		{
 			Name:  "folders",
@@ -28,18 +31,30 @@ var pendingCommand = cli.Command{
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: "device", Usage: "Show pending folders offered by given device"},
 			},
-			Action: expects(0, folders()),
+			Action: expects(0, clientAction(pendingFolders)),
 		},
 	},
 }
 
-func folders() cli.ActionFunc {
-	return func(c *cli.Context) error {
-		if c.String("device") != "" {
-			query := make(url.Values)
-			query.Set("device", c.String("device"))
-			return indexDumpOutput("cluster/pending/folders?" + query.Encode())(c)
+func pendingDevices(_ *cli.Context, client *apiclient.Client) error {
+	devices, err := client.PendingDevices(context.Background())
+	if err != nil {
+		return err
+	}
+	return prettyPrintJSON(devices)
+}
+
+func pendingFolders(c *cli.Context, client *apiclient.Client) error {
+	var device protocol.DeviceID
+	if v := c.String("device"); v != "" {
+		var err error
+		if device, err = protocol.DeviceIDFromString(v); err != nil {
+			return err
 		}
-		return indexDumpOutput("cluster/pending/folders")(c)
 	}
+	folders, err := client.PendingFolders(context.Background(), device)
+	if err != nil {
+		return err
+	}
+	return prettyPrintJSON(folders)
 }
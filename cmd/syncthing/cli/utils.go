@@ -7,67 +7,75 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 
-	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/api/apiclient"
 	"github.com/syncthing/syncthing/lib/db/backend"
 	"github.com/syncthing/syncthing/lib/locations"
 	"github.com/urfave/cli"
 )
 
-func responseToBArray(response *http.Response) ([]byte, error) {
-	bytes, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
-	return bytes, response.Body.Close()
-}
-
-func emptyPost(url string) cli.ActionFunc {
+// clientAction returns an action that calls fn with a client for the REST
+// API.
+func clientAction(fn func(c *cli.Context, client *apiclient.Client) error) cli.ActionFunc {
 	return func(c *cli.Context) error {
 		client, err := getClientFactory(c).getClient()
 		if err != nil {
 			return err
 		}
-		_, err = client.Post(url, "")
-		return err
+		return fn(c, client)
 	}
 }
 
-func indexDumpOutput(url string) cli.ActionFunc {
+// clientCall returns an action that makes the call, such as
+// (*apiclient.Client).Restart.
+func clientCall(call func(*apiclient.Client, context.Context) error) cli.ActionFunc {
+	return clientAction(func(_ *cli.Context, client *apiclient.Client) error {
+		return call(client, context.Background())
+	})
+}
+
+func indexDumpOutput(path string) cli.ActionFunc {
 	return func(c *cli.Context) error {
-		client, err := getClientFactory(c).getClient()
-		if err != nil {
-			return err
-		}
-		response, err := client.Get(url)
-		if errors.Is(err, errNotFound) {
-			return errors.New("not found (folder/file not in database)")
-		}
-		if err != nil {
-			return err
-		}
-		return prettyPrintResponse(response)
+		return dumpOutput(c, path, nil)
 	}
 }
 
-func saveToFile(url string) cli.ActionFunc {
-	return func(c *cli.Context) error {
-		client, err := getClientFactory(c).getClient()
-		if err != nil {
-			return err
-		}
-		response, err := client.Get(url)
+// dumpOutput prints the response of the endpoint with the given path below
+// /rest/.
+func dumpOutput(c *cli.Context, path string, query url.Values) error {
+	client, err := getClientFactory(c).getClient()
+	if err != nil {
+		return err
+	}
+	var data interface{}
+	err = client.Do(context.Background(), http.MethodGet, path, query, nil, &data)
+	if errors.Is(err, apiclient.ErrNotFound) {
+		return errors.New("not found (folder/file not in database)")
+	}
+	if err != nil {
+		return err
+	}
+	// TODO: Check flag for pretty print format
+	return prettyPrintJSON(data)
+}
+
+func saveToFile(path string) cli.ActionFunc {
+	return clientAction(func(_ *cli.Context, client *apiclient.Client) error {
+		response, err := client.Raw(context.Background(), http.MethodGet, path, nil, nil)
 		if err != nil {
 			return err
 		}
+		defer response.Body.Close()
 		_, params, err := mime.ParseMediaType(response.Header.Get("Content-Disposition"))
 		if err != nil {
 			return err
@@ -76,7 +84,7 @@ func saveToFile(url string) cli.ActionFunc {
 		if filename == "" {
 			return errors.New("Missing filename in response")
 		}
-		bs, err := responseToBArray(response)
+		bs, err := io.ReadAll(response.Body)
 		if err != nil {
 			return err
 		}
@@ -91,24 +99,7 @@ func saveToFile(url string) cli.ActionFunc {
 		}
 		fmt.Println("Wrote results to", filename)
 		return err
-	}
-}
-
-func getConfig(c APIClient) (config.Configuration, error) {
-	cfg := config.Configuration{}
-	response, err := c.Get("system/config")
-	if err != nil {
-		return cfg, err
-	}
-	bytes, err := responseToBArray(response)
-	if err != nil {
-		return cfg, err
-	}
-	err = json.Unmarshal(bytes, &cfg)
-	if err != nil {
-		return config.Configuration{}, err
-	}
-	return cfg, nil
+	})
 }
 
 func expects(n int, actionFunc cli.ActionFunc) cli.ActionFunc {
@@ -130,19 +121,6 @@ func prettyPrintJSON(data interface{}) error {
 	return enc.Encode(data)
 }
 
-func prettyPrintResponse(response *http.Response) error {
-	bytes, err := responseToBArray(response)
-	if err != nil {
-		return err
-	}
-	var data interface{}
-	if err := json.Unmarshal(bytes, &data); err != nil {
-		return err
-	}
-	// TODO: Check flag for pretty print format
-	return prettyPrintJSON(data)
-}
-
 func getDB() (backend.Backend, error) {
 	return backend.OpenLevelDBRO(locations.Get(locations.Database))
 }
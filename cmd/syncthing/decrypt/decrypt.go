@@ -28,6 +28,10 @@ type CLI struct {
 	Path       string `arg:"" required:"1" help:"Path to encrypted folder"`
 	To         string `xor:"mode" placeholder:"PATH" help:"Destination directory, when decrypting"`
 	VerifyOnly bool   `xor:"mode" help:"Don't write decrypted files to disk (but verify plaintext hashes)"`
+	List       bool   `xor:"mode" help:"List the plaintext names and metadata of the files in the encrypted folder"`
+	Extract    string `xor:"mode" placeholder:"NAME" help:"Decrypt only the file with the given plaintext name"`
+	Serve      string `xor:"mode" placeholder:"ADDRESS" help:"Serve a read-only HTTP view of the decrypted folder on the given address"`
+	Output     string `placeholder:"PATH" help:"Destination file when using --extract (default standard output)"`
 	Password   string `help:"Folder password for decryption / verification" env:"FOLDER_PASSWORD"`
 	FolderID   string `help:"Folder ID of the encrypted folder, if it cannot be determined automatically"`
 	Continue   bool   `help:"Continue processing next file in case of error, instead of aborting"`
@@ -46,8 +50,11 @@ type storedEncryptionToken struct {
 func (c *CLI) Run() error {
 	log.SetFlags(0)
 
-	if c.To == "" && !c.VerifyOnly {
-		return errors.New("must set --to or --verify-only")
+	if c.To == "" && !c.VerifyOnly && !c.List && c.Extract == "" && c.Serve == "" {
+		return errors.New("must set --to, --verify-only, --list, --extract or --serve")
+	}
+	if c.Output != "" && c.Extract == "" {
+		return errors.New("--output can only be used with --extract")
 	}
 
 	if c.TokenPath == "" {
@@ -72,7 +79,16 @@ func (c *CLI) Run() error {
 	c.keyGen = protocol.NewKeyGenerator()
 	c.folderKey = c.keyGen.KeyFromPassword(c.FolderID, c.Password)
 
-	return c.walk()
+	switch {
+	case c.List:
+		return c.list(os.Stdout)
+	case c.Extract != "":
+		return c.extract(os.Stdout)
+	case c.Serve != "":
+		return c.serve()
+	default:
+		return c.walk()
+	}
 }
 
 // walk finds and processes every file in the encrypted folder
@@ -83,6 +99,14 @@ func (c *CLI) walk() error {
 		dstFs = fs.NewFilesystem(fs.FilesystemTypeBasic, c.To)
 	}
 
+	return c.walkEncrypted(srcFs, func(path string) error {
+		return c.process(srcFs, dstFs, path)
+	})
+}
+
+// walkEncrypted calls fn for every encrypted file in srcFs, skipping
+// directories and internal files.
+func (c *CLI) walkEncrypted(srcFs fs.Filesystem, fn func(path string) error) error {
 	return srcFs.Walk(".", func(path string, info fs.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -94,7 +118,7 @@ func (c *CLI) walk() error {
 			return nil
 		}
 
-		return c.withContinue(c.process(srcFs, dstFs, path))
+		return c.withContinue(fn(path))
 	})
 }
 
@@ -144,18 +168,9 @@ func (c *CLI) process(srcFs fs.Filesystem, dstFs fs.Filesystem, path string) err
 	}
 	defer encFd.Close()
 
-	encFi, err := loadEncryptedFileInfo(encFd)
+	encFi, plainFi, err := c.loadFileInfos(encFd, path)
 	if err != nil {
-		return fmt.Errorf("%s: loading metadata trailer: %w", path, err)
-	}
-
-	// Workaround for a bug in <= v1.15.0-rc.5 where we stored names
-	// in native format, while protocol expects wire format (slashes).
-	encFi.Name = osutil.NormalizedFilename(encFi.Name)
-
-	plainFi, err := protocol.DecryptFileInfo(c.keyGen, *encFi, c.folderKey)
-	if err != nil {
-		return fmt.Errorf("%s: decrypting metadata: %w", path, err)
+		return err
 	}
 
 	if c.Verbose {
@@ -178,7 +193,7 @@ func (c *CLI) process(srcFs fs.Filesystem, dstFs fs.Filesystem, path string) err
 		}
 	}
 
-	if err := c.decryptFile(encFi, &plainFi, encFd, plainFd); err != nil {
+	if err := c.decryptFile(encFi, plainFi, encFd, plainFd); err != nil {
 		// Decrypting the file failed, leaving it in an inconsistent state.
 		// Delete it. Even --continue currently doesn't mean "leave broken
 		// stuff in place", it just means "try the next file instead of
@@ -212,29 +227,14 @@ func (c *CLI) decryptFile(encFi *protocol.FileInfo, plainFi *protocol.FileInfo,
 	}
 
 	fileKey := c.keyGen.FileKey(plainFi.Name, c.folderKey)
-	for i, encBlock := range encFi.Blocks {
-		// Read the encrypted block
-		buf := make([]byte, encBlock.Size)
-		if _, err := src.ReadAt(buf, encBlock.Offset); err != nil {
-			return fmt.Errorf("encrypted block %d (%d bytes): %w", i, encBlock.Size, err)
-		}
-
-		// Decrypt it
-		dec, err := protocol.DecryptBytes(buf, fileKey)
+	for i := range encFi.Blocks {
+		dec, err := decryptBlock(encFi, plainFi, fileKey, src, i)
 		if err != nil {
-			return fmt.Errorf("encrypted block %d (%d bytes): %w", i, encBlock.Size, err)
-		}
-
-		// Verify the block size against the expected plaintext
-		plainBlock := plainFi.Blocks[i]
-		if i == len(plainFi.Blocks)-1 && len(dec) > plainBlock.Size {
-			// The last block might be padded, which is fine (we skip the padding)
-			dec = dec[:plainBlock.Size]
-		} else if len(dec) != plainBlock.Size {
-			return fmt.Errorf("plaintext block %d size mismatch, actual %d != expected %d", i, len(dec), plainBlock.Size)
+			return err
 		}
 
 		// Verify the hash against the plaintext block info
+		plainBlock := plainFi.Blocks[i]
 		if !scanner.Validate(dec, plainBlock.Hash, 0) {
 			// The block decrypted correctly but fails the hash check. This
 			// is odd and unexpected, but it it's still a valid block from
@@ -258,6 +258,54 @@ func (c *CLI) decryptFile(encFi *protocol.FileInfo, plainFi *protocol.FileInfo,
 	return nil
 }
 
+// decryptBlock reads, decrypts and size checks block number i of the
+// encrypted file src. The plaintext hash is not verified.
+func decryptBlock(encFi *protocol.FileInfo, plainFi *protocol.FileInfo, fileKey *[32]byte, src io.ReaderAt, i int) ([]byte, error) {
+	// Read the encrypted block
+	encBlock := encFi.Blocks[i]
+	buf := make([]byte, encBlock.Size)
+	if _, err := src.ReadAt(buf, encBlock.Offset); err != nil {
+		return nil, fmt.Errorf("encrypted block %d (%d bytes): %w", i, encBlock.Size, err)
+	}
+
+	// Decrypt it
+	dec, err := protocol.DecryptBytes(buf, fileKey)
+	if err != nil {
+		return nil, fmt.Errorf("encrypted block %d (%d bytes): %w", i, encBlock.Size, err)
+	}
+
+	// Verify the block size against the expected plaintext
+	plainBlock := plainFi.Blocks[i]
+	if i == len(plainFi.Blocks)-1 && len(dec) > plainBlock.Size {
+		// The last block might be padded, which is fine (we skip the padding)
+		dec = dec[:plainBlock.Size]
+	} else if len(dec) != plainBlock.Size {
+		return nil, fmt.Errorf("plaintext block %d size mismatch, actual %d != expected %d", i, len(dec), plainBlock.Size)
+	}
+
+	return dec, nil
+}
+
+// loadFileInfos loads the encrypted FileInfo trailer from the file at path
+// and decrypts it, returning both the encrypted and plaintext versions.
+func (c *CLI) loadFileInfos(encFd fs.File, path string) (*protocol.FileInfo, *protocol.FileInfo, error) {
+	encFi, err := loadEncryptedFileInfo(encFd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: loading metadata trailer: %w", path, err)
+	}
+
+	// Workaround for a bug in <= v1.15.0-rc.5 where we stored names
+	// in native format, while protocol expects wire format (slashes).
+	encFi.Name = osutil.NormalizedFilename(encFi.Name)
+
+	plainFi, err := protocol.DecryptFileInfo(c.keyGen, *encFi, c.folderKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: decrypting metadata: %w", path, err)
+	}
+
+	return encFi, &plainFi, nil
+}
+
 // loadEncryptedFileInfo loads the encrypted FileInfo trailer from a file on
 // disk.
 func loadEncryptedFileInfo(fd fs.File) (*protocol.FileInfo, error) {
@@ -0,0 +1,186 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package decrypt
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// The folder in testdata/encrypted was encrypted with this folder ID and
+// password, and holds the following files.
+const (
+	testFolderID = "decrypt-test"
+	testPassword = "secret"
+)
+
+var testFiles = map[string]string{
+	"hello.txt":     "hello, world\n",
+	"dir/sub/a.txt": strings.Repeat("abcdefghijklmnopqrstuvwxyz", 100)[:2500],
+}
+
+func newTestCLI() *CLI {
+	c := &CLI{
+		Path:     "testdata/encrypted",
+		FolderID: testFolderID,
+		keyGen:   protocol.NewKeyGenerator(),
+	}
+	c.folderKey = c.keyGen.KeyFromPassword(testFolderID, testPassword)
+	return c
+}
+
+func TestDecryptTo(t *testing.T) {
+	to := t.TempDir()
+	c := &CLI{Path: "testdata/encrypted", To: to, Password: testPassword}
+	if err := c.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if c.FolderID != testFolderID {
+		t.Errorf("got folder ID %q from the token", c.FolderID)
+	}
+	for name, data := range testFiles {
+		bs, err := os.ReadFile(filepath.Join(to, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(bs) != data {
+			t.Errorf("%s: got %q", name, bs)
+		}
+	}
+}
+
+func TestList(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newTestCLI().list(&buf); err != nil {
+		t.Fatal(err)
+	}
+	modTime := time.Unix(1600000000, 0).Format(time.RFC3339)
+	exp := "-rw-r-----\t2500\t" + modTime + "\tdir/sub/a.txt\n" +
+		"-rw-r-----\t13\t" + modTime + "\thello.txt\n"
+	if buf.String() != exp {
+		t.Errorf("got\n%s\nexpected\n%s", buf.String(), exp)
+	}
+}
+
+func TestListWrongPassword(t *testing.T) {
+	c := newTestCLI()
+	c.folderKey = c.keyGen.KeyFromPassword(testFolderID, "wrong")
+	if err := c.list(io.Discard); err == nil {
+		t.Error("expected an error with the wrong password")
+	}
+}
+
+func TestExtract(t *testing.T) {
+	for _, name := range []string{"dir/sub/a.txt", "/dir//sub/./a.txt"} {
+		c := newTestCLI()
+		c.Extract = name
+		var buf bytes.Buffer
+		if err := c.extract(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != testFiles["dir/sub/a.txt"] {
+			t.Errorf("%s: got %q", name, buf.String())
+		}
+	}
+
+	c := newTestCLI()
+	c.Extract = "hello.txt"
+	c.Output = filepath.Join(t.TempDir(), "hello.txt")
+	if err := c.extract(nil); err != nil {
+		t.Fatal(err)
+	}
+	bs, err := os.ReadFile(c.Output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != testFiles["hello.txt"] {
+		t.Errorf("got %q", bs)
+	}
+	if err := c.extract(nil); err == nil {
+		t.Error("expected an error as the output file exists")
+	}
+
+	c = newTestCLI()
+	c.Extract = "missing.txt"
+	if err := c.extract(io.Discard); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected not exist, got %v", err)
+	}
+}
+
+func TestOutputRequiresExtract(t *testing.T) {
+	c := &CLI{Path: "testdata/encrypted", List: true, Output: "out", Password: testPassword}
+	if err := c.Run(); err == nil || !strings.Contains(err.Error(), "--output") {
+		t.Errorf("expected an error for --output without --extract, got %v", err)
+	}
+}
+
+func TestServe(t *testing.T) {
+	handler, files, err := newTestCLI().serveHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if files != len(testFiles) {
+		t.Errorf("serving %d files, expected %d", files, len(testFiles))
+	}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	get := func(path string, header http.Header) (int, string) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, srv.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(bs)
+	}
+
+	if code, body := get("/hello.txt", nil); code != http.StatusOK || body != testFiles["hello.txt"] {
+		t.Errorf("hello.txt: %d %q", code, body)
+	}
+	// A range spanning the first and second block.
+	code, body := get("/dir/sub/a.txt", http.Header{"Range": {"bytes=1000-1099"}})
+	if code != http.StatusPartialContent || body != testFiles["dir/sub/a.txt"][1000:1100] {
+		t.Errorf("range: %d %q", code, body)
+	}
+	if code, body := get("/dir/", nil); code != http.StatusOK || !strings.Contains(body, `href="sub/"`) {
+		t.Errorf("dir: %d %q", code, body)
+	}
+	if code, _ := get("/missing.txt", nil); code != http.StatusNotFound {
+		t.Errorf("missing: %d", code)
+	}
+
+	resp, err := http.Post(srv.URL+"/hello.txt", "text/plain", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("POST: %d", resp.StatusCode)
+	}
+}
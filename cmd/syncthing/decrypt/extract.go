@@ -0,0 +1,231 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package decrypt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/fs"
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/scanner"
+)
+
+// indexEntry is the metadata of one file in the encrypted folder, as
+// loaded from its trailer.
+type indexEntry struct {
+	encPath string // native path to the encrypted file, relative to the folder root
+	encFi   *protocol.FileInfo
+	plainFi *protocol.FileInfo
+}
+
+// index loads and decrypts the metadata of every file in the encrypted
+// folder, without touching the file contents. The result is sorted by
+// plaintext name.
+func (c *CLI) index(srcFs fs.Filesystem) ([]indexEntry, error) {
+	var entries []indexEntry
+	err := c.walkEncrypted(srcFs, func(path string) error {
+		encFd, err := srcFs.Open(path)
+		if err != nil {
+			return err
+		}
+		defer encFd.Close()
+
+		encFi, plainFi, err := c.loadFileInfos(encFd, path)
+		if err != nil {
+			return err
+		}
+		if len(encFi.Blocks) != len(plainFi.Blocks) {
+			return fmt.Errorf("%s: block count mismatch: encrypted %d != plaintext %d", path, len(encFi.Blocks), len(plainFi.Blocks))
+		}
+
+		entries = append(entries, indexEntry{
+			encPath: path,
+			encFi:   encFi,
+			plainFi: plainFi,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].plainFi.Name < entries[b].plainFi.Name
+	})
+	return entries, nil
+}
+
+// list prints the plaintext metadata of every file in the encrypted
+// folder to w.
+func (c *CLI) list(w io.Writer) error {
+	srcFs := fs.NewFilesystem(fs.FilesystemTypeBasic, c.Path)
+	entries, err := c.index(srcFs)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if c.Verbose {
+			fmt.Fprintf(w, "%v\t%d\t%s\t%s\t%s\n", fs.FileMode(e.plainFi.Permissions), e.plainFi.Size, e.plainFi.ModTime().Format(time.RFC3339), e.plainFi.Name, e.encPath)
+			continue
+		}
+		fmt.Fprintf(w, "%v\t%d\t%s\t%s\n", fs.FileMode(e.plainFi.Permissions), e.plainFi.Size, e.plainFi.ModTime().Format(time.RFC3339), e.plainFi.Name)
+	}
+	return nil
+}
+
+// extract decrypts the single file named by --extract into --output, or to
+// stdout if none is given.
+func (c *CLI) extract(stdout io.Writer) error {
+	// Which filemode bits to preserve
+	const retainBits = fs.ModePerm | fs.ModeSetgid | fs.ModeSetuid | fs.ModeSticky
+
+	srcFs := fs.NewFilesystem(fs.FilesystemTypeBasic, c.Path)
+	entries, err := c.index(srcFs)
+	if err != nil {
+		return err
+	}
+
+	name := cleanPlaintextName(c.Extract)
+	idx := sort.Search(len(entries), func(i int) bool {
+		return entries[i].plainFi.Name >= name
+	})
+	if idx == len(entries) || entries[idx].plainFi.Name != name {
+		return fmt.Errorf("%s: %w", name, os.ErrNotExist)
+	}
+	e := entries[idx]
+
+	if c.Verbose {
+		log.Printf("Found %q as %q", e.plainFi.Name, e.encPath)
+	}
+
+	encFd, err := srcFs.Open(e.encPath)
+	if err != nil {
+		return err
+	}
+	defer encFd.Close()
+
+	if c.Output == "" || c.Output == "-" {
+		if err := c.decryptFile(e.encFi, e.plainFi, encFd, &sequentialWriter{w: stdout}); err != nil {
+			return fmt.Errorf("%s: %s: %w", e.encPath, e.plainFi.Name, err)
+		}
+		return nil
+	}
+
+	plainFd, err := os.OpenFile(c.Output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, os.FileMode(e.plainFi.Permissions&uint32(retainBits)))
+	if err != nil {
+		return err
+	}
+	defer plainFd.Close() // also closed explicitly below
+
+	if err := c.decryptFile(e.encFi, e.plainFi, encFd, plainFd); err != nil {
+		// Don't leave a partially decrypted file behind.
+		plainFd.Close()
+		_ = os.Remove(c.Output)
+		return fmt.Errorf("%s: %s: %w", e.encPath, e.plainFi.Name, err)
+	}
+	if err := plainFd.Close(); err != nil {
+		return err
+	}
+	return os.Chtimes(c.Output, e.plainFi.ModTime(), e.plainFi.ModTime())
+}
+
+// cleanPlaintextName converts a user supplied file name to the wire format
+// used in the plaintext FileInfo.
+func cleanPlaintextName(name string) string {
+	name = osutil.NormalizedFilename(name)
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
+
+// sequentialWriter adapts an io.Writer to an io.WriterAt, under the
+// condition that writes happen in order and without gaps, as is the case
+// when decrypting the blocks of a file.
+type sequentialWriter struct {
+	w      io.Writer
+	offset int64
+}
+
+func (s *sequentialWriter) WriteAt(data []byte, offset int64) (int, error) {
+	if offset != s.offset {
+		return 0, fmt.Errorf("non-sequential write at offset %d (expected %d)", offset, s.offset)
+	}
+	n, err := s.w.Write(data)
+	s.offset += int64(n)
+	return n, err
+}
+
+// decryptedReader is an io.ReaderAt for the plaintext contents of an
+// encrypted file. Blocks are decrypted and verified on demand.
+type decryptedReader struct {
+	src     io.ReaderAt
+	encFi   *protocol.FileInfo
+	plainFi *protocol.FileInfo
+	fileKey *[32]byte
+
+	// The most recently decrypted block, as reads are usually sequential
+	// and smaller than a block.
+	lastIdx   int
+	lastBlock []byte
+}
+
+func (c *CLI) newDecryptedReader(src io.ReaderAt, encFi, plainFi *protocol.FileInfo) *decryptedReader {
+	return &decryptedReader{
+		src:     src,
+		encFi:   encFi,
+		plainFi: plainFi,
+		fileKey: c.keyGen.FileKey(plainFi.Name, c.folderKey),
+		lastIdx: -1,
+	}
+}
+
+var errValidation = errors.New("plaintext block failed validation after decryption")
+
+func (r *decryptedReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("negative offset %d", off)
+	}
+
+	blocks := r.plainFi.Blocks
+	n := 0
+	for n < len(p) {
+		pos := off + int64(n)
+		if pos >= r.plainFi.Size {
+			return n, io.EOF
+		}
+
+		idx := sort.Search(len(blocks), func(i int) bool {
+			return blocks[i].Offset+int64(blocks[i].Size) > pos
+		})
+		if idx == len(blocks) {
+			return n, io.ErrUnexpectedEOF
+		}
+
+		if idx != r.lastIdx {
+			dec, err := decryptBlock(r.encFi, r.plainFi, r.fileKey, r.src, idx)
+			if err != nil {
+				return n, err
+			}
+			if !scanner.Validate(dec, blocks[idx].Hash, 0) {
+				return n, fmt.Errorf("block %d: %w", idx, errValidation)
+			}
+			r.lastIdx = idx
+			r.lastBlock = dec
+		}
+
+		n += copy(p[n:], r.lastBlock[pos-blocks[idx].Offset:])
+	}
+	return n, nil
+}
@@ -0,0 +1,217 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package decrypt
+
+import (
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/fs"
+)
+
+// serve runs a read-only HTTP server presenting the decrypted contents of
+// the folder. The index is loaded once at startup; file data is decrypted
+// on demand for each request.
+func (c *CLI) serve() error {
+	handler, files, err := c.serveHandler()
+	if err != nil {
+		return err
+	}
+
+	l, err := net.Listen("tcp", c.Serve)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	log.Printf("Serving %d decrypted files read-only on http://%s/", files, l.Addr())
+
+	srv := http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 15 * time.Second,
+	}
+	return srv.Serve(l)
+}
+
+// serveHandler returns the handler serving the decrypted folder, and the
+// number of files in it.
+func (c *CLI) serveHandler() (http.Handler, int, error) {
+	srcFs := fs.NewFilesystem(fs.FilesystemTypeBasic, c.Path)
+	entries, err := c.index(srcFs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fileServer := http.FileServer(newDecryptedFS(c, srcFs, entries))
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if c.Verbose {
+			log.Printf("%s %s %s", r.RemoteAddr, r.Method, r.URL.Path)
+		}
+		fileServer.ServeHTTP(w, r)
+	})
+	return handler, len(entries), nil
+}
+
+// decryptedFS is an http.FileSystem over the plaintext view of an
+// encrypted folder.
+type decryptedFS struct {
+	cli   *CLI
+	srcFs fs.Filesystem
+	files map[string]*indexEntry // plaintext name -> entry
+	dirs  map[string][]string    // plaintext directory name -> sorted child names
+}
+
+func newDecryptedFS(c *CLI, srcFs fs.Filesystem, entries []indexEntry) *decryptedFS {
+	hfs := &decryptedFS{
+		cli:   c,
+		srcFs: srcFs,
+		files: make(map[string]*indexEntry, len(entries)),
+		dirs:  map[string][]string{".": nil},
+	}
+	for i := range entries {
+		e := &entries[i]
+		name := e.plainFi.Name
+		hfs.files[name] = e
+
+		// Register the file and each of its parent directories with
+		// their parents.
+		for name != "." {
+			dir := path.Dir(name)
+			_, seen := hfs.dirs[dir]
+			hfs.dirs[dir] = append(hfs.dirs[dir], path.Base(name))
+			if seen {
+				break
+			}
+			name = dir
+		}
+	}
+	for _, children := range hfs.dirs {
+		sort.Strings(children)
+	}
+	return hfs
+}
+
+func (hfs *decryptedFS) Open(name string) (http.File, error) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		name = "."
+	}
+
+	if e, ok := hfs.files[name]; ok {
+		fd, err := hfs.srcFs.Open(e.encPath)
+		if err != nil {
+			return nil, err
+		}
+		r := hfs.cli.newDecryptedReader(fd, e.encFi, e.plainFi)
+		return &decryptedFile{
+			SectionReader: io.NewSectionReader(r, 0, e.plainFi.Size),
+			fd:            fd,
+			info:          hfs.stat(name),
+		}, nil
+	}
+
+	if _, ok := hfs.dirs[name]; ok {
+		return &decryptedDir{hfs: hfs, name: name}, nil
+	}
+
+	return nil, os.ErrNotExist
+}
+
+func (hfs *decryptedFS) stat(name string) decryptedFileInfo {
+	if e, ok := hfs.files[name]; ok {
+		return decryptedFileInfo{name: path.Base(name), size: e.plainFi.Size, mode: os.FileMode(e.plainFi.Permissions) & os.ModePerm, modTime: e.plainFi.ModTime()}
+	}
+	return decryptedFileInfo{name: path.Base(name), mode: os.ModeDir | 0o755}
+}
+
+// decryptedFile is an open plaintext file
+type decryptedFile struct {
+	*io.SectionReader
+	fd   fs.File
+	info decryptedFileInfo
+}
+
+func (f *decryptedFile) Close() error {
+	return f.fd.Close()
+}
+
+func (*decryptedFile) Readdir(int) ([]os.FileInfo, error) {
+	return nil, os.ErrInvalid
+}
+
+func (f *decryptedFile) Stat() (os.FileInfo, error) {
+	return f.info, nil
+}
+
+// decryptedDir is an open directory, implied by the names of the files in
+// the index.
+type decryptedDir struct {
+	hfs  *decryptedFS
+	name string
+	pos  int
+}
+
+func (*decryptedDir) Close() error {
+	return nil
+}
+
+func (*decryptedDir) Read([]byte) (int, error) {
+	return 0, os.ErrInvalid
+}
+
+func (*decryptedDir) Seek(int64, int) (int64, error) {
+	return 0, os.ErrInvalid
+}
+
+func (d *decryptedDir) Readdir(count int) ([]os.FileInfo, error) {
+	children := d.hfs.dirs[d.name][d.pos:]
+	if count > 0 {
+		if len(children) == 0 {
+			return nil, io.EOF
+		}
+		if len(children) > count {
+			children = children[:count]
+		}
+	}
+	d.pos += len(children)
+
+	infos := make([]os.FileInfo, len(children))
+	for i, child := range children {
+		infos[i] = d.hfs.stat(path.Join(d.name, child))
+	}
+	return infos, nil
+}
+
+func (d *decryptedDir) Stat() (os.FileInfo, error) {
+	return d.hfs.stat(d.name), nil
+}
+
+// decryptedFileInfo implements os.FileInfo for the plaintext view
+type decryptedFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi decryptedFileInfo) Name() string       { return fi.name }
+func (fi decryptedFileInfo) Size() int64        { return fi.size }
+func (fi decryptedFileInfo) Mode() os.FileMode  { return fi.mode }
+func (fi decryptedFileInfo) ModTime() time.Time { return fi.modTime }
+func (fi decryptedFileInfo) IsDir() bool        { return fi.mode.IsDir() }
+func (fi decryptedFileInfo) Sys() interface{}   { return nil }
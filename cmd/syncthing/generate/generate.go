@@ -30,6 +30,7 @@ type CLI struct {
 	cmdutil.CommonOptions
 	GUIUser     string `placeholder:"STRING" help:"Specify new GUI authentication user name"`
 	GUIPassword string `placeholder:"STRING" help:"Specify new GUI authentication password (use - to read from standard input)"`
+	RotateKey   bool   `help:"Replace the existing key and certificate, keeping the device's identity for other devices that connect to it"`
 }
 
 func (c *CLI) Run(l logger.Logger) error {
@@ -57,13 +58,13 @@ func (c *CLI) Run(l logger.Logger) error {
 		c.GUIPassword = string(password)
 	}
 
-	if err := Generate(l, c.ConfDir, c.GUIUser, c.GUIPassword, c.NoDefaultFolder, c.SkipPortProbing); err != nil {
+	if err := Generate(l, c.ConfDir, c.GUIUser, c.GUIPassword, c.NoDefaultFolder, c.SkipPortProbing, c.RotateKey); err != nil {
 		return fmt.Errorf("failed to generate config and keys: %w", err)
 	}
 	return nil
 }
 
-func Generate(l logger.Logger, confDir, guiUser, guiPassword string, noDefaultFolder, skipPortProbing bool) error {
+func Generate(l logger.Logger, confDir, guiUser, guiPassword string, noDefaultFolder, skipPortProbing, rotateKey bool) error {
 	dir, err := fs.ExpandTilde(confDir)
 	if err != nil {
 		return err
@@ -74,10 +75,22 @@ func Generate(l logger.Logger, confDir, guiUser, guiPassword string, noDefaultFo
 	}
 	locations.SetBaseDir(locations.ConfigBaseDir, dir)
 
-	var myID protocol.DeviceID
+	var myID, oldID protocol.DeviceID
 	certFile, keyFile := locations.Get(locations.CertFile), locations.Get(locations.KeyFile)
+	if err := syncthing.FinishCertificateRotation(certFile, keyFile, locations.Get(locations.Successions)); err != nil {
+		return fmt.Errorf("finish certificate rotation: %w", err)
+	}
 	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
-	if err == nil {
+	if err != nil && rotateKey {
+		return fmt.Errorf("no key to rotate: %w", err)
+	} else if rotateKey {
+		oldID = protocol.NewDeviceID(cert.Certificate[0])
+		cert, err = syncthing.RotateCertificate(certFile, keyFile, locations.Get(locations.Successions))
+		if err != nil {
+			return fmt.Errorf("rotate certificate: %w", err)
+		}
+		l.Infoln("Previous device ID:", oldID)
+	} else if err == nil {
 		l.Warnln("Key exists; will not overwrite.")
 	} else {
 		cert, err = syncthing.GenerateCertificate(certFile, keyFile)
@@ -104,6 +117,10 @@ func Generate(l logger.Logger, confDir, guiUser, guiPassword string, noDefaultFo
 
 	var updateErr error
 	waiter, err := cfg.Modify(func(cfg *config.Configuration) {
+		if oldID != protocol.EmptyDeviceID {
+			// Keep the name and folder shares of our device.
+			cfg.ReplaceDevice(oldID, myID)
+		}
 		updateErr = updateGUIAuthentication(l, &cfg.GUI, guiUser, guiPassword)
 	})
 	if err != nil {
@@ -351,7 +351,7 @@ func (options serveOptions) Run() error {
 	}
 
 	if options.GenerateDir != "" {
-		if err := generate.Generate(l, options.GenerateDir, "", "", options.NoDefaultFolder, options.SkipPortProbing); err != nil {
+		if err := generate.Generate(l, options.GenerateDir, "", "", options.NoDefaultFolder, options.SkipPortProbing, false); err != nil {
 			l.Warnln("Failed to generate config and keys:", err)
 			os.Exit(svcutil.ExitError.AsInt())
 		}
@@ -543,7 +543,16 @@ func syncthingMain(options serveOptions) {
 	// early etc. will have it available.
 	l.Infoln(build.LongVersion)
 
-	// Ensure that we have a certificate and key.
+	// Ensure that we have a certificate and key, matching each other even
+	// if we were interrupted rotating them.
+	if err := syncthing.FinishCertificateRotation(
+		locations.Get(locations.CertFile),
+		locations.Get(locations.KeyFile),
+		locations.Get(locations.Successions),
+	); err != nil {
+		l.Warnln("Failed to finish certificate rotation:", err)
+		os.Exit(1)
+	}
 	cert, err := syncthing.LoadOrGenerateCertificate(
 		locations.Get(locations.CertFile),
 		locations.Get(locations.KeyFile),
@@ -16,6 +16,9 @@ angular.module('syncthing.core')
             LocaleService.autoConfigLocale();
 
             if (!$scope.authenticated) {
+                $http.get(authUrlbase + '/methods').then(function (response) {
+                    $scope.login.methods = response.data;
+                });
                 // Can't proceed yet - wait for the page reload after successful login.
                 return;
             }
@@ -33,6 +36,7 @@ angular.module('syncthing.core')
             username: '',
             password: '',
             errors: {},
+            methods: ['password'],
         };
         $scope.completion = {};
         $scope.config = {};
@@ -117,9 +121,18 @@ angular.module('syncthing.core')
             });
         };
 
+        $scope.authenticateOIDC = function () {
+            location.href = authUrlbase + '/oidc/login';
+        };
+
         $scope.logout = function() {
             $http.post(authUrlbase + '/logout', {})
-            .then(function () {
+            .then(function (response) {
+                if (response.data && response.data.logoutURL) {
+                    // Log out at the identity provider too
+                    location.href = response.data.logoutURL;
+                    return;
+                }
                 location.reload();
             }).catch(function (response) {
                 console.log('Failed to log out:', response);
@@ -569,7 +582,7 @@ angular.module('syncthing.core')
             // This function should match IsAuthEnabled() in guiconfiguration.go
             var guiCfg = $scope.config && $scope.config.gui;
             if (guiCfg) {
-                return guiCfg.authMode === 'ldap' || (guiCfg.user && guiCfg.password);
+                return guiCfg.authMode === 'ldap' || guiCfg.authMode === 'oidc' || (guiCfg.user && guiCfg.password) || (guiCfg.accounts && guiCfg.accounts.length > 0);
             }
             return false;
         };
@@ -591,7 +604,7 @@ angular.module('syncthing.core')
                 && !$scope.isAuthEnabled()
                 && !guiCfg.insecureAdminAccess;
 
-            if ((guiCfg.user && guiCfg.password) || guiCfg.authMode === 'ldap') {
+            if ($scope.isAuthEnabled()) {
                 $scope.dismissNotification('authenticationUserAndPassword');
             }
         }
@@ -1437,6 +1450,59 @@ angular.module('syncthing.core')
             showModal('#connectivity-status');
         };
 
+        $scope.auditTrail = {
+            entries: null,
+            load: function () {
+                $http.get(urlbase + '/system/audit?limit=500').success(function (data) {
+                    // Most recent first
+                    $scope.auditTrail.entries = data.reverse();
+                }).error($scope.emitHTTPError);
+            },
+            export: function () {
+                $http.get(urlbase + '/system/audit/export', { responseType: 'blob' }).success(function (data) {
+                    var a = document.createElement('a');
+                    a.href = URL.createObjectURL(data);
+                    a.download = 'syncthing-audit-trail.jsonl';
+                    document.body.appendChild(a);
+                    a.click();
+                    document.body.removeChild(a);
+                    URL.revokeObjectURL(a.href);
+                }).error($scope.emitHTTPError);
+            },
+        };
+
+        $scope.configHistory = {
+            revisions: null,
+            changes: {},
+            load: function () {
+                $scope.configHistory.changes = {};
+                $http.get(urlbase + '/config/history').success(function (data) {
+                    // Most recent first
+                    $scope.configHistory.revisions = data.reverse();
+                }).error(function (data, status) {
+                    if (status === 404) {
+                        $scope.configHistory.revisions = [];
+                        return;
+                    }
+                    $scope.emitHTTPError(data, status);
+                });
+            },
+            diff: function (number) {
+                $http.get(urlbase + '/config/history/' + number + '/diff').success(function (data) {
+                    $scope.configHistory.changes[number] = data;
+                }).error($scope.emitHTTPError);
+            },
+            rollback: function (number) {
+                if (!confirm($translate.instant('Roll back the configuration to revision {%number%}?', { number: number }))) {
+                    return;
+                }
+                $http.post(urlbase + '/config/history/' + number + '/rollback').success(function () {
+                    $scope.configHistory.load();
+                    refreshConfig();
+                }).error($scope.emitHTTPError);
+            },
+        };
+
         $scope.logging = {
             facilities: {},
             refreshFacilities: function () {
@@ -31,7 +31,6 @@ import (
 	"unicode"
 
 	"github.com/calmh/incontainer"
-	"github.com/julienschmidt/httprouter"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rcrowley/go-metrics"
 	"github.com/thejerf/suture/v4"
@@ -58,6 +57,7 @@ import (
 	"github.com/syncthing/syncthing/lib/tlsutil"
 	"github.com/syncthing/syncthing/lib/upgrade"
 	"github.com/syncthing/syncthing/lib/ur"
+	"github.com/syncthing/syncthing/lib/webhook"
 )
 
 const (
@@ -83,6 +83,10 @@ type service struct {
 	discoverer           discover.Manager
 	connectionsService   connections.Service
 	fss                  model.FolderSummaryService
+	webhooks             webhook.Service
+	ll                   *db.Lowlevel
+	apiKeys              *apiKeyManager
+	auditTrail           *auditTrail
 	urService            *ur.Service
 	noUpgrade            bool
 	tlsDefaultCommonName string
@@ -105,7 +109,7 @@ type Service interface {
 	WaitForStart() error
 }
 
-func New(id protocol.DeviceID, cfg config.Wrapper, assetDir, tlsDefaultCommonName string, m model.Model, defaultSub, diskSub events.BufferedSubscription, evLogger events.Logger, discoverer discover.Manager, connectionsService connections.Service, urService *ur.Service, fss model.FolderSummaryService, errors, systemLog logger.Recorder, noUpgrade bool) Service {
+func New(id protocol.DeviceID, cfg config.Wrapper, assetDir, tlsDefaultCommonName string, m model.Model, defaultSub, diskSub events.BufferedSubscription, evLogger events.Logger, discoverer discover.Manager, connectionsService connections.Service, urService *ur.Service, fss model.FolderSummaryService, webhooks webhook.Service, ll *db.Lowlevel, errors, systemLog logger.Recorder, noUpgrade bool) Service {
 	return &service{
 		id:      id,
 		cfg:     cfg,
@@ -120,6 +124,10 @@ func New(id protocol.DeviceID, cfg config.Wrapper, assetDir, tlsDefaultCommonNam
 		discoverer:           discoverer,
 		connectionsService:   connectionsService,
 		fss:                  fss,
+		webhooks:             webhooks,
+		ll:                   ll,
+		apiKeys:              newAPIKeyManager(cfg, ll),
+		auditTrail:           newAuditTrail(locations.Get(locations.AuditTrail)),
 		urService:            urService,
 		guiErrors:            errors,
 		systemLog:            systemLog,
@@ -242,101 +250,10 @@ func (s *service) Serve(ctx context.Context) error {
 	s.cfg.Subscribe(s)
 	defer s.cfg.Unsubscribe(s)
 
-	restMux := httprouter.New()
-
-	// The GET handlers
-	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/devices", s.getPendingDevices) // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/folders", s.getPendingFolders) // [device]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/completion", s.getDBCompletion)             // [device] [folder]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/file", s.getDBFile)                         // folder file
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/ignores", s.getDBIgnores)                   // folder
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/need", s.getDBNeed)                         // folder [perpage] [page]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/remoteneed", s.getDBRemoteNeed)             // device folder [perpage] [page]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/localchanged", s.getDBLocalChanged)         // folder [perpage] [page]
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/status", s.getDBStatus)                     // folder
-	restMux.HandlerFunc(http.MethodGet, "/rest/db/browse", s.getDBBrowse)                     // folder [prefix] [dirsonly] [levels]
-	restMux.HandlerFunc(http.MethodGet, "/rest/folder/versions", s.getFolderVersions)         // folder
-	restMux.HandlerFunc(http.MethodGet, "/rest/folder/errors", s.getFolderErrors)             // folder [perpage] [page]
-	restMux.HandlerFunc(http.MethodGet, "/rest/folder/pullerrors", s.getFolderErrors)         // folder (deprecated)
-	restMux.HandlerFunc(http.MethodGet, "/rest/events", s.getIndexEvents)                     // [since] [limit] [timeout] [events]
-	restMux.HandlerFunc(http.MethodGet, "/rest/events/disk", s.getDiskEvents)                 // [since] [limit] [timeout]
-	restMux.HandlerFunc(http.MethodGet, "/rest/noauth/health", s.getHealth)                   // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/stats/device", s.getDeviceStats)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/stats/folder", s.getFolderStats)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/deviceid", s.getDeviceID)                  // id
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/lang", s.getLang)                          // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/report", s.getReport)                      // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/svc/random/string", s.getRandomString)         // [length]
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/browse", s.getSystemBrowse)             // current
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/connections", s.getSystemConnections)   // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/discovery", s.getSystemDiscovery)       // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/error", s.getSystemError)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/paths", s.getSystemPaths)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/ping", s.restPing)                      // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/status", s.getSystemStatus)             // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/upgrade", s.getSystemUpgrade)           // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/version", s.getSystemVersion)           // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/debug", s.getSystemDebug)               // -
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/log", s.getSystemLog)                   // [since]
-	restMux.HandlerFunc(http.MethodGet, "/rest/system/log.txt", s.getSystemLogTxt)            // [since]
-
-	// The POST handlers
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/prio", s.postDBPrio)                          // folder file
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/ignores", s.postDBIgnores)                    // folder
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/override", s.postDBOverride)                  // folder
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/revert", s.postDBRevert)                      // folder
-	restMux.HandlerFunc(http.MethodPost, "/rest/db/scan", s.postDBScan)                          // folder [sub...] [delay]
-	restMux.HandlerFunc(http.MethodPost, "/rest/folder/versions", s.postFolderVersionsRestore)   // folder <body>
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/error", s.postSystemError)                // <body>
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/error/clear", s.postSystemErrorClear)     // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/ping", s.restPing)                        // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/reset", s.postSystemReset)                // [folder]
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/restart", s.postSystemRestart)            // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/shutdown", s.postSystemShutdown)          // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/upgrade", s.postSystemUpgrade)            // -
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/pause", s.makeDevicePauseHandler(true))   // [device]
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/resume", s.makeDevicePauseHandler(false)) // [device]
-	restMux.HandlerFunc(http.MethodPost, "/rest/system/debug", s.postSystemDebug)                // [enable] [disable]
-
-	// The DELETE handlers
-	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/devices", s.deletePendingDevices) // device
-	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/folders", s.deletePendingFolders) // folder [device]
-
-	// Config endpoints
-
-	configBuilder := &configMuxBuilder{
-		Router: restMux,
-		id:     s.id,
-		cfg:    s.cfg,
-	}
-
-	configBuilder.registerConfig("/rest/config")
-	configBuilder.registerConfigInsync("/rest/config/insync") // deprecated
-	configBuilder.registerConfigRequiresRestart("/rest/config/restart-required")
-	configBuilder.registerFolders("/rest/config/folders")
-	configBuilder.registerDevices("/rest/config/devices")
-	configBuilder.registerFolder("/rest/config/folders/:id")
-	configBuilder.registerDevice("/rest/config/devices/:id")
-	configBuilder.registerDefaultFolder("/rest/config/defaults/folder")
-	configBuilder.registerDefaultDevice("/rest/config/defaults/device")
-	configBuilder.registerDefaultIgnores("/rest/config/defaults/ignores")
-	configBuilder.registerOptions("/rest/config/options")
-	configBuilder.registerLDAP("/rest/config/ldap")
-	configBuilder.registerGUI("/rest/config/gui")
+	guiCfg := s.cfg.GUI()
 
-	// Deprecated config endpoints
-	configBuilder.registerConfigDeprecated("/rest/system/config") // POST instead of PUT
-	configBuilder.registerConfigInsync("/rest/system/config/insync")
-
-	// Debug endpoints, not for general use
-	debugMux := http.NewServeMux()
-	debugMux.HandleFunc("/rest/debug/peerCompletion", s.getPeerCompletion)
-	debugMux.HandleFunc("/rest/debug/httpmetrics", s.getSystemHTTPMetrics)
-	debugMux.HandleFunc("/rest/debug/cpuprof", s.getCPUProf) // duration
-	debugMux.HandleFunc("/rest/debug/heapprof", s.getHeapProf)
-	debugMux.HandleFunc("/rest/debug/support", s.getSupportBundle)
-	debugMux.HandleFunc("/rest/debug/file", s.getDebugFile)
-	restMux.Handler(http.MethodGet, "/rest/debug/*method", s.whenDebugging(debugMux))
+	restMux := newRestRouter()
+	s.registerRoutes(restMux, guiCfg)
 
 	// A handler that disables caching
 	noCacheRestMux := noCacheMiddleware(metricsMiddleware(restMux))
@@ -361,26 +278,28 @@ func (s *service) Serve(ctx context.Context) error {
 		promHttpHandler.ServeHTTP(w, req)
 	})
 
-	guiCfg := s.cfg.GUI()
+	// Accept BEP connections over WebSocket, for when the GUI sits behind
+	// a reverse proxy that is the only way in.
+	if guiCfg.BEPWebSocketEnabled {
+		mux.Handle("/bep", s.connectionsService.WebSocketHandler())
+	}
 
 	// Wrap everything in CSRF protection. The /rest prefix should be
 	// protected, other requests will grant cookies.
-	var handler http.Handler = newCsrfManager(s.id.String()[:5], "/rest", guiCfg, mux, locations.Get(locations.CsrfTokens))
+	var handler http.Handler = newCsrfManager(s.id.String()[:5], "/rest", s.apiKeys, auditMiddleware(s.auditTrail, s.cfg, authorizationMiddleware(mux)), locations.Get(locations.CsrfTokens))
 
 	// Add our version and ID as a header to responses
 	handler = withDetailsMiddleware(s.id, handler)
 
 	// Wrap everything in basic auth, if user/password is set.
 	if guiCfg.IsAuthEnabled() {
-		sessionCookieName := "sessionid-" + s.id.String()[:5]
-		handler = basicAuthAndSessionMiddleware(sessionCookieName, guiCfg, s.cfg.LDAP(), handler, s.evLogger)
-		handlePasswordAuth := passwordAuthHandler(sessionCookieName, guiCfg, s.cfg.LDAP(), s.evLogger)
-		restMux.Handler(http.MethodPost, "/rest/noauth/auth/password", handlePasswordAuth)
-
-		// Logout is a no-op without a valid session cookie, so /noauth/ is fine here
-		restMux.Handler(http.MethodPost, "/rest/noauth/auth/logout", handleLogout(sessionCookieName))
+		handler = basicAuthAndSessionMiddleware(s.sessionCookieName(), guiCfg, s.cfg.LDAP(), handler, s.evLogger)
 	}
 
+	// Named API keys always act as their account, also without
+	// authentication for the GUI.
+	handler = apiKeyMiddleware(s.apiKeys, handler)
+
 	// Redirect to HTTPS if we are supposed to
 	if guiCfg.UseTLS() {
 		handler = redirectToHTTPSMiddleware(handler)
@@ -462,6 +381,142 @@ func (s *service) Serve(ctx context.Context) error {
 
 // Complete implements suture.IsCompletable, which signifies to the supervisor
 // whether to stop restarting the service.
+// registerRoutes registers the REST API handlers. The routes are listed in
+// openapi.yaml as well, which is checked against them by the tests.
+func (s *service) registerRoutes(restMux *restRouter, guiCfg config.GUIConfiguration) {
+	// The GET handlers
+	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/devices", s.getPendingDevices) // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/cluster/pending/folders", s.getPendingFolders) // [device]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/completion", s.getDBCompletion)             // [device] [folder]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/file", s.getDBFile)                         // folder file
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/ignores", s.getDBIgnores)                   // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/need", s.getDBNeed)                         // folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/remoteneed", s.getDBRemoteNeed)             // device folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/localchanged", s.getDBLocalChanged)         // folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/status", s.getDBStatus)                     // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/db/browse", s.getDBBrowse)                     // folder [prefix] [dirsonly] [levels]
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/versions", s.getFolderVersions)         // folder
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/errors", s.getFolderErrors)             // folder [perpage] [page]
+	restMux.HandlerFunc(http.MethodGet, "/rest/folder/pullerrors", s.getFolderErrors)         // folder (deprecated)
+	restMux.HandlerFunc(http.MethodGet, "/rest/events", s.getIndexEvents)                     // [since] [limit] [timeout] [events]
+	restMux.HandlerFunc(http.MethodGet, "/rest/events/disk", s.getDiskEvents)                 // [since] [limit] [timeout]
+	restMux.HandlerFunc(http.MethodGet, "/rest/events/stream", s.getEventStream)              // [events] [folder] [device]
+	restMux.HandlerFunc(http.MethodGet, "/rest/events/ws", s.getEventWebSocket)               // [events] [folder] [device] [lastEventID]
+	restMux.HandlerFunc(http.MethodGet, "/rest/events/history", s.getEventHistory)            // [since] [until] [events] [folder] [device] [limit]
+	restMux.HandlerFunc(http.MethodGet, "/rest/noauth/health", s.getHealth)                   // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/stats/device", s.getDeviceStats)               // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/stats/folder", s.getFolderStats)               // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/deviceid", s.getDeviceID)                  // id
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/lang", s.getLang)                          // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/openapi", getOpenAPI)                      // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/report", s.getReport)                      // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/svc/random/string", s.getRandomString)         // [length]
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/audit", s.getAuditTrail)                // [since] [limit]
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/audit/export", s.getAuditTrailExport)   // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/browse", s.getSystemBrowse)             // current
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/connections", s.getSystemConnections)   // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/discovery", s.getSystemDiscovery)       // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/error", s.getSystemError)               // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/paths", s.getSystemPaths)               // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/ping", s.restPing)                      // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/status", s.getSystemStatus)             // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/upgrade", s.getSystemUpgrade)           // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/version", s.getSystemVersion)           // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/webhooks", s.getSystemWebhooks)         // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/debug", s.getSystemDebug)               // -
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/log", s.getSystemLog)                   // [since]
+	restMux.HandlerFunc(http.MethodGet, "/rest/system/log.txt", s.getSystemLogTxt)            // [since]
+
+	// The POST handlers
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/prio", s.postDBPrio)                          // folder file
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/ignores", s.postDBIgnores)                    // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/override", s.postDBOverride)                  // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/revert", s.postDBRevert)                      // folder
+	restMux.HandlerFunc(http.MethodPost, "/rest/db/scan", s.postDBScan)                          // folder [sub...] [delay]
+	restMux.HandlerFunc(http.MethodPost, "/rest/folder/versions", s.postFolderVersionsRestore)   // folder <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/error", s.postSystemError)                // <body>
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/error/clear", s.postSystemErrorClear)     // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/ping", s.restPing)                        // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/reset", s.postSystemReset)                // [folder]
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/restart", s.postSystemRestart)            // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/shutdown", s.postSystemShutdown)          // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/upgrade", s.postSystemUpgrade)            // -
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/pause", s.makeDevicePauseHandler(true))   // [device]
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/resume", s.makeDevicePauseHandler(false)) // [device]
+	restMux.HandlerFunc(http.MethodPost, "/rest/system/debug", s.postSystemDebug)                // [enable] [disable]
+
+	// The DELETE handlers
+	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/devices", s.deletePendingDevices) // device
+	restMux.HandlerFunc(http.MethodDelete, "/rest/cluster/pending/folders", s.deletePendingFolders) // folder [device]
+
+	// Config endpoints
+
+	configBuilder := &configMuxBuilder{
+		restRouter: restMux,
+		id:         s.id,
+		cfg:        s.cfg,
+		apiKeys:    s.apiKeys,
+	}
+
+	configBuilder.registerConfig("/rest/config")
+	configBuilder.registerConfigInsync("/rest/config/insync") // deprecated
+	configBuilder.registerConfigRequiresRestart("/rest/config/restart-required")
+	configBuilder.registerFolders("/rest/config/folders")
+	configBuilder.registerDevices("/rest/config/devices")
+	configBuilder.registerFolder("/rest/config/folders/:id")
+	configBuilder.registerDevice("/rest/config/devices/:id")
+	configBuilder.registerDefaultFolder("/rest/config/defaults/folder")
+	configBuilder.registerDefaultDevice("/rest/config/defaults/device")
+	configBuilder.registerDefaultIgnores("/rest/config/defaults/ignores")
+	configBuilder.registerOptions("/rest/config/options")
+	configBuilder.registerLDAP("/rest/config/ldap")
+	configBuilder.registerOIDC("/rest/config/oidc")
+	configBuilder.registerOrganization("/rest/config/organization")
+	configBuilder.registerGUI("/rest/config/gui")
+	configBuilder.registerAPIKeys("/rest/config/gui/apikeys")
+	configBuilder.registerHistory("/rest/config/history")
+
+	// Deprecated config endpoints
+	configBuilder.registerConfigDeprecated("/rest/system/config") // POST instead of PUT
+	configBuilder.registerConfigInsync("/rest/system/config/insync")
+
+	// Debug endpoints, not for general use
+	debugMux := http.NewServeMux()
+	debugMux.HandleFunc("/rest/debug/peerCompletion", s.getPeerCompletion)
+	debugMux.HandleFunc("/rest/debug/httpmetrics", s.getSystemHTTPMetrics)
+	debugMux.HandleFunc("/rest/debug/cpuprof", s.getCPUProf) // duration
+	debugMux.HandleFunc("/rest/debug/heapprof", s.getHeapProf)
+	debugMux.HandleFunc("/rest/debug/support", s.getSupportBundle)
+	debugMux.HandleFunc("/rest/debug/file", s.getDebugFile)
+	restMux.Handler(http.MethodGet, "/rest/debug/*method", s.whenDebugging(debugMux))
+
+	// Logging in and out, when authentication is enabled
+	if guiCfg.IsAuthEnabled() {
+		sessionCookieName := s.sessionCookieName()
+		handlePasswordAuth := passwordAuthHandler(sessionCookieName, guiCfg, s.cfg.LDAP(), s.evLogger)
+		restMux.Handler(http.MethodPost, "/rest/noauth/auth/password", handlePasswordAuth)
+
+		var oidc *oidcProvider
+		methods := []string{"password"}
+		if guiCfg.AuthMode == config.AuthModeOIDC {
+			oidc = newOIDCProvider(s.cfg.OIDC(), sessionCookieName+"-oidc")
+			methods = append(methods, "oidc")
+			restMux.HandlerFunc(http.MethodGet, oidcLoginPath, oidc.handleLogin)
+			restMux.Handler(http.MethodGet, oidcCallbackPath, oidc.handleCallback(sessionCookieName, guiCfg, s.evLogger))
+		}
+		restMux.HandlerFunc(http.MethodGet, "/rest/noauth/auth/methods", func(w http.ResponseWriter, _ *http.Request) {
+			sendJSON(w, methods)
+		})
+
+		// Logout is a no-op without a valid session cookie, so /noauth/ is fine here
+		restMux.Handler(http.MethodPost, "/rest/noauth/auth/logout", handleLogout(sessionCookieName, oidc))
+	}
+}
+
+func (s *service) sessionCookieName() string {
+	return "sessionid-" + s.id.String()[:5]
+}
+
 func (s *service) Complete() bool {
 	select {
 	case <-s.startedOnce:
@@ -476,6 +531,9 @@ func (s *service) String() string {
 }
 
 func (*service) VerifyConfiguration(_, to config.Configuration) error {
+	if err := verifyAPIKeys(to.GUI.APIKeys); err != nil {
+		return err
+	}
 	if to.GUI.Network() != "tcp" {
 		return nil
 	}
@@ -486,8 +544,15 @@ func (*service) VerifyConfiguration(_, to config.Configuration) error {
 func (s *service) CommitConfiguration(from, to config.Configuration) bool {
 	// No action required when this changes, so mask the fact that it changed at all.
 	from.GUI.Debugging = to.GUI.Debugging
+	// Named API keys are looked up in the current config on every request.
+	from.GUI.APIKeys = to.GUI.APIKeys
+
+	// No accounts may be nil or empty.
+	if len(from.GUI.Accounts) == 0 && len(to.GUI.Accounts) == 0 {
+		from.GUI.Accounts = to.GUI.Accounts
+	}
 
-	if to.GUI == from.GUI {
+	if reflect.DeepEqual(to.GUI, from.GUI) && oidcConfigEqual(to.OIDC, from.OIDC) {
 		// No GUI changes, we're done here.
 		return true
 	}
@@ -502,6 +567,19 @@ func (s *service) CommitConfiguration(from, to config.Configuration) bool {
 	return true
 }
 
+// oidcConfigEqual compares the configurations, treating empty and nil lists
+// alike.
+func oidcConfigEqual(a, b config.OIDCConfiguration) bool {
+	for _, cfg := range []*config.OIDCConfiguration{&a, &b} {
+		for _, list := range []*[]string{&cfg.Scopes, &cfg.AdminGroups, &cfg.OperatorGroups, &cfg.ViewerGroups} {
+			if len(*list) == 0 {
+				*list = nil
+			}
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
 func (s *service) fatal(err *svcutil.FatalErr) {
 	// s.exitChan is 1-buffered and whoever is first gets handled.
 	select {
@@ -937,12 +1015,18 @@ func (s *service) getDeviceStats(w http.ResponseWriter, _ *http.Request) {
 	sendJSON(w, stats)
 }
 
-func (s *service) getFolderStats(w http.ResponseWriter, _ *http.Request) {
+func (s *service) getFolderStats(w http.ResponseWriter, r *http.Request) {
 	stats, err := s.model.FolderStatistics()
 	if err != nil {
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return
 	}
+	account := accountFromRequest(r)
+	for folder := range stats {
+		if !account.HasFolder(folder) {
+			delete(stats, folder)
+		}
+	}
 	sendJSON(w, stats)
 }
 
@@ -1423,6 +1507,9 @@ func (s *service) getEvents(w http.ResponseWriter, r *http.Request, eventSub eve
 
 	// If there are no events available return an empty slice, as this gets serialized as `[]`
 	evs := eventSub.Since(since, []events.Event{}, timeout)
+	if account := accountFromRequest(r); account.IsScoped() {
+		evs = filterEventsForAccount(eventSub, evs, timeout, account)
+	}
 	if 0 < limit && limit < len(evs) {
 		evs = evs[len(evs)-limit:]
 	}
@@ -1430,6 +1517,27 @@ func (s *service) getEvents(w http.ResponseWriter, r *http.Request, eventSub eve
 	sendJSON(w, evs)
 }
 
+// filterEventsForAccount removes the events the account may not see. When
+// none are left it waits for more, within the timeout, rather than having
+// the client come back for the same events right away.
+func filterEventsForAccount(eventSub events.BufferedSubscription, evs []events.Event, timeout time.Duration, account config.GUIAccountConfiguration) []events.Event {
+	filter := eventFilterForAccount(events.Filter{Mask: events.AllEvents}, account)
+	deadline := time.Now().Add(timeout)
+	for {
+		filtered := []events.Event{}
+		for _, ev := range evs {
+			if filter.Matches(ev) {
+				filtered = append(filtered, ev)
+			}
+		}
+		remaining := time.Until(deadline)
+		if len(filtered) > 0 || len(evs) == 0 || remaining <= 0 {
+			return filtered
+		}
+		evs = eventSub.Since(evs[len(evs)-1].GlobalID, []events.Event{}, remaining)
+	}
+}
+
 func (*service) getEventMask(evs string) events.EventType {
 	eventMask := DefaultEventMask
 	if evs != "" {
@@ -1455,6 +1563,10 @@ func (s *service) getEventSub(mask events.EventType) events.BufferedSubscription
 	return bufsub
 }
 
+func (s *service) getSystemWebhooks(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, s.webhooks.Status())
+}
+
 func (s *service) getSystemUpgrade(w http.ResponseWriter, _ *http.Request) {
 	if s.noUpgrade {
 		http.Error(w, upgrade.ErrUpgradeUnsupported.Error(), http.StatusNotImplemented)
@@ -1532,7 +1644,7 @@ func (s *service) makeDevicePauseHandler(paused bool) http.HandlerFunc {
 
 		var msg string
 		var status int
-		_, err := s.cfg.Modify(func(cfg *config.Configuration) {
+		_, err := modifyConfig(r, s.cfg, func(cfg *config.Configuration) {
 			if deviceStr == "" {
 				for i := range cfg.Devices {
 					cfg.Devices[i].Paused = paused
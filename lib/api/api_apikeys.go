@@ -0,0 +1,322 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"golang.org/x/exp/slices"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// Besides the API key in the GUI configuration, which has full access,
+// there are named API keys. Each has a role and may be restricted to some
+// folders, like a GUI account, and to some groups of endpoints. Named keys
+// are looked up in the current configuration on every request, so that
+// adding and revoking them takes effect without restarting the GUI.
+
+// The endpoint groups API keys may be restricted to, by the path prefixes
+// they contain
+var apiKeyEndpointGroups = map[string][]string{
+	"cluster": {"/rest/cluster/"},
+	"config":  {"/rest/config", "/rest/system/config"},
+	"db":      {"/rest/db/"},
+	"debug":   {"/rest/debug/"},
+	"events":  {"/rest/events"},
+	"folder":  {"/rest/folder/"},
+	"metrics": {"/metrics"},
+	"stats":   {"/rest/stats/"},
+	"svc":     {"/rest/svc/"},
+	"system":  {"/rest/system/"},
+}
+
+const (
+	apiKeyLastUsedPrefix = "apiKeyLastUsed/"
+	// The last use is kept in memory and saved to the database at most
+	// this often per key.
+	apiKeyLastUsedSaveInterval = time.Minute
+)
+
+type apiKeyManager struct {
+	cfg config.Wrapper
+	kv  *db.NamespacedKV // nil when there is no database, as in tests
+
+	mut      sync.Mutex
+	lastUsed map[string]time.Time // by name
+	saved    map[string]time.Time // by name
+}
+
+func newAPIKeyManager(cfg config.Wrapper, ll *db.Lowlevel) *apiKeyManager {
+	m := &apiKeyManager{
+		cfg:      cfg,
+		mut:      sync.NewMutex(),
+		lastUsed: make(map[string]time.Time),
+		saved:    make(map[string]time.Time),
+	}
+	if ll != nil {
+		m.kv = db.NewMiscDataNamespace(ll)
+	}
+	return m
+}
+
+// IsValidAPIKey returns whether the key is the API key of the GUI
+// configuration or a named key that hasn't expired, for any request.
+func (m *apiKeyManager) IsValidAPIKey(key string) bool {
+	guiCfg := m.cfg.GUI()
+	if guiCfg.IsValidAPIKey(key) {
+		return true
+	}
+	named, ok := guiCfg.NamedAPIKey(key)
+	return ok && !named.IsExpired(time.Now())
+}
+
+// authenticate returns the account a request with the given named API key
+// is made by, and false if the key is unknown, has expired or may not be
+// used for the request.
+func (m *apiKeyManager) authenticate(key string, r *http.Request) (config.GUIAccountConfiguration, bool) {
+	named, ok := m.cfg.GUI().NamedAPIKey(key)
+	if !ok {
+		return config.GUIAccountConfiguration{}, false
+	}
+	now := time.Now()
+	if named.IsExpired(now) {
+		l.Debugf("Request with expired API key %q", named.Name)
+		return config.GUIAccountConfiguration{}, false
+	}
+	if !apiKeyAllowsPath(named, r.URL.Path) {
+		l.Debugf("Request for %s with API key %q, restricted to %v", r.URL.Path, named.Name, named.Endpoints)
+		return config.GUIAccountConfiguration{}, false
+	}
+	m.markUsed(named.Name, now)
+	return config.GUIAccountConfiguration{
+		Name:    "apikey:" + named.Name,
+		Role:    named.Role,
+		Folders: named.Folders,
+	}, true
+}
+
+// apiKeyMiddleware makes the requests with a named API key on behalf of the
+// key's account, whether or not authentication is enabled for the GUI.
+// Requests with a named key that has expired or may not be used for the
+// request are refused; other requests are left alone.
+func apiKeyMiddleware(apiKeys *apiKeyManager, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := apiKeyFromRequest(r)
+		if _, ok := apiKeys.cfg.GUI().NamedAPIKey(key); !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		account, ok := apiKeys.authenticate(key, r)
+		if !ok {
+			forbidden(w)
+			return
+		}
+		next.ServeHTTP(w, withAccount(r, account))
+	})
+}
+
+func apiKeyAllowsPath(key config.APIKeyConfiguration, path string) bool {
+	if len(key.Endpoints) == 0 || isNoAuthPath(path) {
+		return true
+	}
+	for _, group := range key.Endpoints {
+		if slices.ContainsFunc(apiKeyEndpointGroups[group], func(prefix string) bool {
+			return strings.HasPrefix(path, prefix)
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *apiKeyManager) markUsed(name string, now time.Time) {
+	m.mut.Lock()
+	m.lastUsed[name] = now
+	save := m.kv != nil && now.Sub(m.saved[name]) >= apiKeyLastUsedSaveInterval
+	if save {
+		m.saved[name] = now
+	}
+	m.mut.Unlock()
+
+	if save {
+		if err := m.kv.PutTime(apiKeyLastUsedPrefix+name, now); err != nil {
+			l.Debugln("Saving last use of API key:", err)
+		}
+	}
+}
+
+// lastUsedAt returns when the named key was last used, or the zero time.
+func (m *apiKeyManager) lastUsedAt(name string) time.Time {
+	m.mut.Lock()
+	t, ok := m.lastUsed[name]
+	m.mut.Unlock()
+	if ok || m.kv == nil {
+		return t
+	}
+	t, _, err := m.kv.Time(apiKeyLastUsedPrefix + name)
+	if err != nil {
+		l.Debugln("Loading last use of API key:", err)
+	}
+	return t
+}
+
+func (m *apiKeyManager) forget(name string) {
+	m.mut.Lock()
+	delete(m.lastUsed, name)
+	delete(m.saved, name)
+	m.mut.Unlock()
+	if m.kv != nil {
+		if err := m.kv.Delete(apiKeyLastUsedPrefix + name); err != nil {
+			l.Debugln("Removing last use of API key:", err)
+		}
+	}
+}
+
+// verifyAPIKeys checks that the API keys are restricted to known endpoint
+// groups only.
+func verifyAPIKeys(keys []config.APIKeyConfiguration) error {
+	for _, key := range keys {
+		for _, group := range key.Endpoints {
+			if _, ok := apiKeyEndpointGroups[group]; !ok {
+				return fmt.Errorf("API key %q: unknown endpoint group %q", key.Name, group)
+			}
+		}
+	}
+	return nil
+}
+
+// apiKeyInfo is a named API key as returned by the REST API
+type apiKeyInfo struct {
+	config.APIKeyConfiguration
+	LastUsed *time.Time `json:"lastUsed"`
+}
+
+func (m *apiKeyManager) info(key config.APIKeyConfiguration) apiKeyInfo {
+	info := apiKeyInfo{APIKeyConfiguration: key}
+	if t := m.lastUsedAt(key.Name); !t.IsZero() {
+		info.LastUsed = &t
+	}
+	return info
+}
+
+func (c *configMuxBuilder) registerAPIKeys(path string) {
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
+		keys := c.cfg.GUI().APIKeys
+		res := make([]apiKeyInfo, len(keys))
+		for i, key := range keys {
+			res[i] = c.apiKeys.info(key)
+		}
+		sendJSON(w, res)
+	})
+
+	c.HandlerFunc(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
+		var key config.APIKeyConfiguration
+		if err := unmarshalTo(r.Body, &key); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if _, ok := c.apiKey(key.Name); ok {
+			http.Error(w, "An API key with the given name exists", http.StatusConflict)
+			return
+		}
+		c.adjustAPIKey(w, r, key)
+	})
+
+	namePath := path + "/:name"
+
+	c.Handle(http.MethodGet, namePath, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+		key, ok := c.apiKey(p.ByName("name"))
+		if !ok {
+			http.Error(w, "No API key with given name", http.StatusNotFound)
+			return
+		}
+		sendJSON(w, c.apiKeys.info(key))
+	})
+
+	c.Handle(http.MethodPatch, namePath, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		key, ok := c.apiKey(p.ByName("name"))
+		if !ok {
+			http.Error(w, "No API key with given name", http.StatusNotFound)
+			return
+		}
+		if err := unmarshalTo(r.Body, &key); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if key.Name != p.ByName("name") {
+			http.Error(w, "API keys cannot be renamed", http.StatusBadRequest)
+			return
+		}
+		c.adjustAPIKey(w, r, key)
+	})
+
+	c.Handle(http.MethodDelete, namePath, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		name := p.ByName("name")
+		if _, ok := c.apiKey(name); !ok {
+			http.Error(w, "No API key with given name", http.StatusNotFound)
+			return
+		}
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
+			cfg.GUI.APIKeys = slices.DeleteFunc(cfg.GUI.APIKeys, func(key config.APIKeyConfiguration) bool {
+				return key.Name == name
+			})
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		c.finish(w, waiter)
+		c.apiKeys.forget(name)
+	})
+}
+
+func (c *configMuxBuilder) apiKey(name string) (config.APIKeyConfiguration, bool) {
+	for _, key := range c.cfg.GUI().APIKeys {
+		if key.Name == name {
+			return key, true
+		}
+	}
+	return config.APIKeyConfiguration{}, false
+}
+
+// adjustAPIKey adds or replaces the key and responds with the result,
+// including the generated key when none was given.
+func (c *configMuxBuilder) adjustAPIKey(w http.ResponseWriter, r *http.Request, key config.APIKeyConfiguration) {
+	if err := verifyAPIKeys([]config.APIKeyConfiguration{key}); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
+		for i := range cfg.GUI.APIKeys {
+			if cfg.GUI.APIKeys[i].Name == key.Name {
+				cfg.GUI.APIKeys[i] = key
+				return
+			}
+		}
+		cfg.GUI.APIKeys = append(cfg.GUI.APIKeys, key)
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	waiter.Wait()
+	if err := c.cfg.Save(); err != nil {
+		l.Warnln("Saving config:", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if key, ok := c.apiKey(key.Name); ok {
+		sendJSON(w, c.apiKeys.info(key))
+	}
+}
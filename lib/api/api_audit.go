@@ -0,0 +1,281 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// Every mutating REST request is recorded in the audit trail, with who made
+// it from where, the outcome and the changes to the configuration. The
+// trail is a file of JSON lines that is only ever appended to.
+
+type auditEntry struct {
+	Time time.Time `json:"time"`
+	// The GUI account, "apikey" for the API key in the GUI configuration
+	// or "apikey:" and the name of a named API key; empty when
+	// authentication is disabled.
+	User          string          `json:"user"`
+	RemoteAddress string          `json:"remoteAddress"`
+	Method        string          `json:"method"`
+	Path          string          `json:"path"`
+	Query         string          `json:"query,omitempty"`
+	Status        int             `json:"status"`
+	Changes       []config.Change `json:"changes,omitempty"`
+}
+
+type auditTrail struct {
+	path string
+	mut  sync.Mutex
+}
+
+func newAuditTrail(path string) *auditTrail {
+	return &auditTrail{
+		path: path,
+		mut:  sync.NewMutex(),
+	}
+}
+
+func (a *auditTrail) append(entry auditEntry) error {
+	bs, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	bs = append(bs, '\n')
+
+	a.mut.Lock()
+	defer a.mut.Unlock()
+	fd, err := os.OpenFile(a.path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	if info, err := fd.Stat(); err == nil && info.Size() > 0 {
+		// Start on a line of its own after a line torn by a crash.
+		last := make([]byte, 1)
+		if _, err := fd.ReadAt(last, info.Size()-1); err == nil && last[0] != '\n' {
+			bs = append([]byte{'\n'}, bs...)
+		}
+	}
+	if _, err := fd.Write(bs); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
+
+// entries returns the entries from the given time on, at most the given
+// number of most recent ones unless zero.
+func (a *auditTrail) entries(since time.Time, limit int) ([]auditEntry, error) {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+	fd, err := os.Open(a.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []auditEntry{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	entries := []auditEntry{}
+	scanner := bufio.NewScanner(fd)
+	scanner.Buffer(nil, 16<<20) // config changes can make for long lines
+	for scanner.Scan() {
+		var entry auditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// A line torn by a crash while appending
+			l.Debugln("Skipping invalid audit trail entry:", err)
+			continue
+		}
+		if entry.Time.Before(since) {
+			continue
+		}
+		entries = append(entries, entry)
+		if limit > 0 && len(entries) > limit {
+			entries = entries[1:]
+		}
+	}
+	return entries, scanner.Err()
+}
+
+// export writes the whole trail, as JSON lines.
+func (a *auditTrail) export(w io.Writer) error {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+	fd, err := os.Open(a.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer fd.Close()
+	_, err = io.Copy(w, fd)
+	return err
+}
+
+func isAuditedRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return strings.HasPrefix(r.URL.Path, "/rest/") && !isNoAuthPath(r.URL.Path) && r.URL.Path != "/rest/system/ping"
+}
+
+// auditMiddleware records the mutating requests, including the refused
+// ones, in the audit trail.
+func auditMiddleware(trail *auditTrail, cfg config.Wrapper, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isAuditedRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		changes := &auditChanges{mut: sync.NewMutex()}
+		rw := &statusResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rw, r.WithContext(context.WithValue(r.Context(), auditChangesContextKey{}, changes)))
+
+		entry := auditEntry{
+			Time:          time.Now().Truncate(time.Millisecond),
+			User:          auditUser(r, cfg),
+			RemoteAddress: r.RemoteAddr,
+			Method:        r.Method,
+			Path:          r.URL.Path,
+			Query:         r.URL.RawQuery,
+			Status:        rw.status,
+			Changes:       changes.get(),
+		}
+		if err := trail.append(entry); err != nil {
+			l.Warnln("Writing audit trail:", err)
+		}
+	})
+}
+
+type auditChangesContextKey struct{}
+
+// auditChanges collects the configuration changes made by a request, as
+// committed, so that concurrent changes from elsewhere aren't attributed to
+// it.
+type auditChanges struct {
+	mut     sync.Mutex
+	changes []config.Change
+}
+
+func (c *auditChanges) add(changes []config.Change) {
+	c.mut.Lock()
+	c.changes = append(c.changes, changes...)
+	c.mut.Unlock()
+}
+
+func (c *auditChanges) get() []config.Change {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.changes
+}
+
+// modifyConfig modifies the configuration on behalf of the request, with
+// the request as the origin of the change.
+func modifyConfig(r *http.Request, cfg config.Wrapper, fn config.ModifyFunction) (config.Waiter, error) {
+	waiter, err := cfg.ModifyFrom(requestOrigin(r, cfg), fn)
+	if err == nil {
+		recordConfigChanges(r, waiter)
+	}
+	return waiter, err
+}
+
+// recordConfigChanges adds the changes of the committed modification to
+// the request's entry in the audit trail.
+func recordConfigChanges(r *http.Request, waiter config.Waiter) {
+	if changes, ok := r.Context().Value(auditChangesContextKey{}).(*auditChanges); ok {
+		changes.add(config.ChangesOf(waiter))
+	}
+}
+
+func auditUser(r *http.Request, cfg config.Wrapper) string {
+	if account, ok := r.Context().Value(accountContextKey{}).(config.GUIAccountConfiguration); ok {
+		return account.Name
+	}
+	key := apiKeyFromRequest(r)
+	if key == "" {
+		return ""
+	}
+	guiCfg := cfg.GUI()
+	if named, ok := guiCfg.NamedAPIKey(key); ok {
+		return "apikey:" + named.Name
+	}
+	if guiCfg.IsValidAPIKey(key) {
+		return "apikey"
+	}
+	return ""
+}
+
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusResponseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusResponseWriter) Write(bs []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(bs)
+}
+
+func (w *statusResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (s *service) getAuditTrail(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	var since time.Time
+	if v := qs.Get("since"); v != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	limit, err := strconv.Atoi(qs.Get("limit"))
+	if err != nil && qs.Get("limit") != "" {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	entries, err := s.auditTrail.entries(since, limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sendJSON(w, entries)
+}
+
+func (s *service) getAuditTrailExport(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", `attachment; filename="syncthing-audit-trail.jsonl"`)
+	if err := s.auditTrail.export(w); err != nil {
+		l.Warnln("Exporting audit trail:", err)
+	}
+}
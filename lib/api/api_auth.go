@@ -23,10 +23,35 @@ import (
 )
 
 var (
-	sessions    = make(map[string]bool)
+	sessions    = make(map[string]session) // session ID -> session
 	sessionsMut = sync.NewMutex()
 )
 
+type session struct {
+	username string
+	// The account of users from an identity provider, with the role of
+	// their groups, unless an account is bound to their subject
+	account *config.GUIAccountConfiguration
+	// The subject of users from an identity provider with a bound account
+	oidcSubject string
+	// The ID token the identity provider issued, for logging out there
+	idToken string
+}
+
+// sessionAccount returns the account of the session, or false when the
+// account has been removed since the session was created. Users from an
+// identity provider never get an account by their name.
+func sessionAccount(sess session, guiCfg config.GUIConfiguration) (config.GUIAccountConfiguration, bool) {
+	switch {
+	case sess.account != nil:
+		return *sess.account, true
+	case sess.oidcSubject != "":
+		return guiCfg.AccountForOIDCSubject(sess.oidcSubject)
+	default:
+		return accountFor(sess.username, guiCfg)
+	}
+}
+
 func emitLoginAttempt(success bool, username, address string, evLogger events.Logger) {
 	evLogger.Log(events.LoginAttempt, map[string]interface{}{
 		"success":       success,
@@ -58,6 +83,7 @@ func isNoAuthPath(path string) bool {
 		"/index.html",
 		"/modal.html",
 		"/rest/svc/lang", // Required to load language settings on login page
+		"/bep",           // BEP over WebSocket, authenticated by its own TLS
 	}
 
 	// Local variable instead of module var to prevent accidental mutation
@@ -80,7 +106,12 @@ func isNoAuthPath(path string) bool {
 
 func basicAuthAndSessionMiddleware(cookieName string, guiCfg config.GUIConfiguration, ldapCfg config.LDAPConfiguration, next http.Handler, evLogger events.Logger) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if hasValidAPIKeyHeader(r, guiCfg) {
+		if guiCfg.IsValidAPIKey(apiKeyFromRequest(r)) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if _, ok := r.Context().Value(accountContextKey{}).(config.GUIAccountConfiguration); ok {
+			// Authenticated with a named API key
 			next.ServeHTTP(w, r)
 			return
 		}
@@ -88,19 +119,23 @@ func basicAuthAndSessionMiddleware(cookieName string, guiCfg config.GUIConfigura
 		cookie, err := r.Cookie(cookieName)
 		if err == nil && cookie != nil {
 			sessionsMut.Lock()
-			_, ok := sessions[cookie.Value]
+			sess, ok := sessions[cookie.Value]
 			sessionsMut.Unlock()
 			if ok {
-				next.ServeHTTP(w, r)
-				return
+				if account, ok := sessionAccount(sess, guiCfg); ok {
+					next.ServeHTTP(w, withAccount(r, account))
+					return
+				}
 			}
 		}
 
 		// Fall back to Basic auth if provided
 		if username, ok := attemptBasicAuth(r, guiCfg, ldapCfg, evLogger); ok {
-			createSession(cookieName, username, guiCfg, evLogger, w, r)
-			next.ServeHTTP(w, r)
-			return
+			createSession(cookieName, session{username: username}, guiCfg, evLogger, w, r)
+			if account, ok := accountFor(username, guiCfg); ok {
+				next.ServeHTTP(w, withAccount(r, account))
+				return
+			}
 		}
 
 		// Exception for static assets and REST calls that don't require authentication.
@@ -133,7 +168,7 @@ func passwordAuthHandler(cookieName string, guiCfg config.GUIConfiguration, ldap
 		}
 
 		if auth(req.Username, req.Password, guiCfg, ldapCfg) {
-			createSession(cookieName, req.Username, guiCfg, evLogger, w, r)
+			createSession(cookieName, session{username: req.Username}, guiCfg, evLogger, w, r)
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
@@ -167,21 +202,15 @@ func attemptBasicAuth(r *http.Request, guiCfg config.GUIConfiguration, ldapCfg c
 	return "", false
 }
 
-func createSession(cookieName string, username string, guiCfg config.GUIConfiguration, evLogger events.Logger, w http.ResponseWriter, r *http.Request) {
+func createSession(cookieName string, sess session, guiCfg config.GUIConfiguration, evLogger events.Logger, w http.ResponseWriter, r *http.Request) {
 	sessionid := rand.String(32)
 	sessionsMut.Lock()
-	sessions[sessionid] = true
+	sessions[sessionid] = sess
 	sessionsMut.Unlock()
 
-	// Best effort detection of whether the connection is HTTPS --
-	// either directly to us, or as used by the client towards a reverse
-	// proxy who sends us headers.
-	connectionIsHTTPS := r.TLS != nil ||
-		strings.ToLower(r.Header.Get("x-forwarded-proto")) == "https" ||
-		strings.Contains(strings.ToLower(r.Header.Get("forwarded")), "proto=https")
 	// If the connection is HTTPS, or *should* be HTTPS, set the Secure
 	// bit in cookies.
-	useSecureCookie := connectionIsHTTPS || guiCfg.UseTLS()
+	useSecureCookie := isHTTPS(r) || guiCfg.UseTLS()
 
 	http.SetCookie(w, &http.Cookie{
 		Name:  cookieName,
@@ -193,14 +222,27 @@ func createSession(cookieName string, username string, guiCfg config.GUIConfigur
 		Path:   "/",
 	})
 
-	emitLoginAttempt(true, username, r.RemoteAddr, evLogger)
+	emitLoginAttempt(true, sess.username, r.RemoteAddr, evLogger)
 }
 
-func handleLogout(cookieName string) http.Handler {
+// isHTTPS is a best effort detection of whether the connection is HTTPS --
+// either directly to us, or as used by the client towards a reverse proxy
+// who sends us headers.
+func isHTTPS(r *http.Request) bool {
+	return r.TLS != nil ||
+		strings.ToLower(r.Header.Get("x-forwarded-proto")) == "https" ||
+		strings.Contains(strings.ToLower(r.Header.Get("forwarded")), "proto=https")
+}
+
+// handleLogout ends the session. Sessions from an identity provider are
+// ended there too, by the GUI following the returned logout URL.
+func handleLogout(cookieName string, oidc *oidcProvider) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var sess session
 		cookie, err := r.Cookie(cookieName)
 		if err == nil && cookie != nil {
 			sessionsMut.Lock()
+			sess = sessions[cookie.Value]
 			delete(sessions, cookie.Value)
 			sessionsMut.Unlock()
 		}
@@ -213,6 +255,13 @@ func handleLogout(cookieName string) http.Handler {
 			Secure: true,
 			Path:   "/",
 		})
+
+		if oidc != nil && sess.idToken != "" {
+			if logoutURL := oidc.logoutURL(r, sess.idToken); logoutURL != "" {
+				sendJSON(w, map[string]string{"logoutURL": logoutURL})
+				return
+			}
+		}
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
@@ -226,7 +275,21 @@ func auth(username string, password string, guiCfg config.GUIConfiguration, ldap
 }
 
 func authStatic(username string, password string, guiCfg config.GUIConfiguration) bool {
-	return guiCfg.CompareHashedPassword(password) == nil && username == guiCfg.User
+	account, ok := guiCfg.Account(username)
+	return ok && account.CompareHashedPassword(password) == nil
+}
+
+// accountFor returns the account of an authenticated user. LDAP users
+// without an account of their own are admins, as they were before there
+// were accounts.
+func accountFor(username string, guiCfg config.GUIConfiguration) (config.GUIAccountConfiguration, bool) {
+	if account, ok := guiCfg.Account(username); ok {
+		return account, true
+	}
+	if guiCfg.AuthMode == config.AuthModeLDAP {
+		return config.GUIAccountConfiguration{Name: username, Role: config.GUIRoleAdmin}, true
+	}
+	return config.GUIAccountConfiguration{}, false
 }
 
 func authLDAP(username string, password string, cfg config.LDAPConfiguration) bool {
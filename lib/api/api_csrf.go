@@ -180,12 +180,18 @@ func (m *csrfManager) load() {
 }
 
 func hasValidAPIKeyHeader(r *http.Request, validator apiKeyValidator) bool {
-	if key := r.Header.Get("X-API-Key"); validator.IsValidAPIKey(key) {
-		return true
+	key := apiKeyFromRequest(r)
+	return key != "" && validator.IsValidAPIKey(key)
+}
+
+// apiKeyFromRequest returns the API key given in the X-API-Key header or as
+// bearer token.
+func apiKeyFromRequest(r *http.Request) string {
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		return key
 	}
 	if auth := r.Header.Get("Authorization"); strings.HasPrefix(strings.ToLower(auth), "bearer ") {
-		bearerToken := auth[len("bearer "):]
-		return validator.IsValidAPIKey(bearerToken)
+		return auth[len("bearer "):]
 	}
-	return false
+	return ""
 }
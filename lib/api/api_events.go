@@ -0,0 +1,378 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// The streaming event endpoints send events as they happen, over
+// Server-Sent Events or a WebSocket, instead of in batches per request. Each
+// stream has a subscription of its own, filtered by event type, folder and
+// device. Stream event IDs are the global event IDs, so that a client can
+// resume with the Last-Event-ID of the last event it saw, as long as the
+// events in between are still buffered. Events that are lost, because the
+// client doesn't keep up or because they are no longer buffered on resume,
+// are reported in their place as a "dropped" message.
+
+const (
+	eventStreamQueueSize = EventSubBufferSize
+	eventStreamKeepalive = 30 * time.Second
+	// An unknown number of events were dropped
+	eventsDroppedUnknown = -1
+
+	// The number of journalled events returned when the client doesn't
+	// limit them itself, and the most it can ask for.
+	eventHistoryDefaultLimit = 1000
+	eventHistoryMaxLimit     = 10000
+)
+
+type eventsDropped struct {
+	Type  string `json:"type"`
+	Count int    `json:"count"`
+}
+
+func (s *service) parseEventStreamFilter(qs url.Values) (events.Filter, error) {
+	var folders, devices []string
+	if folder := qs.Get("folder"); folder != "" {
+		folders = strings.Split(folder, ",")
+	}
+	if device := qs.Get("device"); device != "" {
+		devices = strings.Split(device, ",")
+	}
+	return events.NewFilter(s.getEventMask(qs.Get("events")), folders, devices)
+}
+
+// requestEventFilter returns the event filter from the request's query,
+// restricted to the folders the account making the request may see.
+func (s *service) requestEventFilter(r *http.Request) (events.Filter, error) {
+	filter, err := s.parseEventStreamFilter(r.URL.Query())
+	if err != nil {
+		return events.Filter{}, err
+	}
+	return eventFilterForAccount(filter, accountFromRequest(r)), nil
+}
+
+// eventStreamWriter writes the messages of an event stream in the format of
+// the transport.
+type eventStreamWriter interface {
+	event(ev events.Event) error
+	dropped(count int) error
+	keepalive() error
+}
+
+// eventStream queues the events from a dedicated subscription for a single
+// client. The queue is bounded, so that a slow client doesn't hold up the
+// event logger; events that don't fit are counted as dropped, as are events
+// the logger dropped for the subscription.
+type eventStream struct {
+	sub    events.Subscription
+	filter events.Filter
+
+	mut       sync.Mutex
+	queue     []events.Event
+	dropped   int
+	lastSubID int
+	notify    chan struct{}
+	done      chan struct{}
+}
+
+func newEventStream(sub events.Subscription, filter events.Filter) *eventStream {
+	es := &eventStream{
+		sub:    sub,
+		filter: filter,
+		mut:    sync.NewMutex(),
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	go es.receive()
+	return es
+}
+
+func (es *eventStream) receive() {
+	defer close(es.done)
+	for ev := range es.sub.C() {
+		match := es.filter.Matches(ev)
+
+		es.mut.Lock()
+		if gap := ev.SubscriptionID - es.lastSubID - 1; es.lastSubID > 0 && gap > 0 {
+			es.dropped += gap
+		}
+		es.lastSubID = ev.SubscriptionID
+		if match {
+			if len(es.queue) < eventStreamQueueSize {
+				es.queue = append(es.queue, ev)
+			} else {
+				es.dropped++
+			}
+		}
+		es.mut.Unlock()
+
+		select {
+		case es.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// take returns the queued events and the number of events dropped since
+// the last call.
+func (es *eventStream) take() ([]events.Event, int) {
+	es.mut.Lock()
+	defer es.mut.Unlock()
+	evs, dropped := es.queue, es.dropped
+	es.queue, es.dropped = nil, 0
+	return evs, dropped
+}
+
+func (s *service) getEventStream(w http.ResponseWriter, r *http.Request) {
+	filter, err := s.requestEventFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+	f.Flush()
+
+	s.streamEvents(r.Context(), filter, lastEventID(r), &sseWriter{w: w, f: f})
+}
+
+func (s *service) getEventWebSocket(w http.ResponseWriter, r *http.Request) {
+	filter, err := s.requestEventFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	last := lastEventID(r)
+
+	websocket.Server{
+		// Requests have already been through the CSRF and API key checks
+		// by now.
+		Handshake: func(*websocket.Config, *http.Request) error {
+			return nil
+		},
+		Handler: func(ws *websocket.Conn) {
+			// The server's read timeout would otherwise still apply.
+			_ = ws.SetDeadline(time.Time{})
+
+			ctx, cancel := context.WithCancel(r.Context())
+			defer cancel()
+			go func() {
+				// Nothing is expected from the client; reading tells us
+				// when it goes away.
+				var msg []byte
+				for websocket.Message.Receive(ws, &msg) == nil {
+				}
+				cancel()
+			}()
+
+			s.streamEvents(ctx, filter, last, &webSocketEventWriter{ws: ws})
+		},
+	}.ServeHTTP(w, r)
+}
+
+// lastEventID returns the global ID of the last event the client saw, from
+// the Last-Event-ID header or, for clients that can't set it, the query.
+func lastEventID(r *http.Request) int {
+	idStr := r.Header.Get("Last-Event-ID")
+	if idStr == "" {
+		idStr = r.URL.Query().Get("lastEventID")
+	}
+	id, _ := strconv.Atoi(idStr)
+	return id
+}
+
+// getEventHistory returns the most recent events from the event journal,
+// oldest first. The time range is given in RFC 3339 format.
+func (s *service) getEventHistory(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	filter, err := s.requestEventFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	q := db.EventJournalQuery{Filter: filter}
+	for param, t := range map[string]*time.Time{"since": &q.Since, "until": &q.Until} {
+		if v := qs.Get(param); v != "" {
+			if *t, err = time.Parse(time.RFC3339, v); err != nil {
+				http.Error(w, fmt.Sprintf("%s: %v", param, err), http.StatusBadRequest)
+				return
+			}
+		}
+	}
+	q.Limit, _ = strconv.Atoi(qs.Get("limit"))
+	if q.Limit <= 0 {
+		q.Limit = eventHistoryDefaultLimit
+	} else if q.Limit > eventHistoryMaxLimit {
+		q.Limit = eventHistoryMaxLimit
+	}
+
+	evs, err := s.ll.JournalledEvents(q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if evs == nil {
+		// Serialized as `[]`
+		evs = []events.Event{}
+	}
+	sendJSON(w, evs)
+}
+
+func (s *service) streamEvents(ctx context.Context, filter events.Filter, last int, w eventStreamWriter) {
+	// Subscribe before looking at the buffered events, so that nothing
+	// falls in between. The buffered subscription for the same event types
+	// is what allows resuming later on.
+	es := newEventStream(s.evLogger.Subscribe(filter.Mask), filter)
+	defer es.sub.Unsubscribe()
+	bufSub := s.getEventSub(filter.Mask)
+
+	onEventRequest := func() {
+		if filter.Mask&(events.FolderSummary|events.FolderCompletion) != 0 {
+			s.fss.OnEventRequest()
+		}
+	}
+	onEventRequest()
+
+	if last > 0 {
+		buffered := bufSub.Since(0, nil, 0)
+		if len(buffered) == 0 || buffered[0].GlobalID > last || buffered[len(buffered)-1].GlobalID < last {
+			// The events since then are no longer buffered, or the IDs
+			// are from before a restart.
+			if err := w.dropped(eventsDroppedUnknown); err != nil {
+				return
+			}
+			last = 0
+		}
+		for _, ev := range buffered {
+			if ev.GlobalID <= last || !filter.Matches(ev) {
+				continue
+			}
+			if err := w.event(ev); err != nil {
+				return
+			}
+			last = ev.GlobalID
+		}
+	}
+
+	keepalive := time.NewTicker(eventStreamKeepalive)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepalive.C:
+			onEventRequest()
+			if err := w.keepalive(); err != nil {
+				return
+			}
+			continue
+		case <-es.notify:
+		case <-es.done:
+		}
+
+		evs, dropped := es.take()
+		if dropped > 0 {
+			if err := w.dropped(dropped); err != nil {
+				return
+			}
+		}
+		for _, ev := range evs {
+			if ev.GlobalID <= last {
+				// Already sent from the buffer
+				continue
+			}
+			if err := w.event(ev); err != nil {
+				return
+			}
+			last = ev.GlobalID
+		}
+
+		select {
+		case <-es.done:
+			// The event logger is gone
+			return
+		default:
+		}
+	}
+}
+
+type sseWriter struct {
+	w http.ResponseWriter
+	f http.Flusher
+}
+
+func (w *sseWriter) event(ev events.Event) error {
+	bs, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return w.write(fmt.Sprintf("id: %d\nevent: %s\ndata: %s\n\n", ev.GlobalID, ev.Type, bs))
+}
+
+func (w *sseWriter) dropped(count int) error {
+	bs, err := json.Marshal(eventsDropped{Type: "dropped", Count: count})
+	if err != nil {
+		return err
+	}
+	return w.write(fmt.Sprintf("event: dropped\ndata: %s\n\n", bs))
+}
+
+func (w *sseWriter) keepalive() error {
+	return w.write(": keepalive\n\n")
+}
+
+func (w *sseWriter) write(msg string) error {
+	if _, err := w.w.Write([]byte(msg)); err != nil {
+		return err
+	}
+	w.f.Flush()
+	return nil
+}
+
+type webSocketEventWriter struct {
+	ws *websocket.Conn
+}
+
+func (w *webSocketEventWriter) event(ev events.Event) error {
+	return websocket.JSON.Send(w.ws, ev)
+}
+
+func (w *webSocketEventWriter) dropped(count int) error {
+	return websocket.JSON.Send(w.ws, eventsDropped{Type: "dropped", Count: count})
+}
+
+func (w *webSocketEventWriter) keepalive() error {
+	return webSocketPing.Send(w.ws, nil)
+}
+
+var webSocketPing = websocket.Codec{
+	Marshal: func(interface{}) ([]byte, byte, error) {
+		return nil, websocket.PingFrame, nil
+	},
+}
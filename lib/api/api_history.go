@@ -0,0 +1,156 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/syncthing/syncthing/lib/config"
+)
+
+// requestOrigin returns the origin recorded in the configuration history
+// for changes made by the request.
+func requestOrigin(r *http.Request, cfg config.Wrapper) string {
+	if user := auditUser(r, cfg); user != "" {
+		return "api:" + user
+	}
+	return "api"
+}
+
+func (c *configMuxBuilder) registerHistory(path string) {
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
+		history, ok := c.history(w)
+		if !ok {
+			return
+		}
+		revisions, err := history.Revisions()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sendJSON(w, revisions)
+	})
+
+	numberPath := path + "/:number"
+
+	c.Handle(http.MethodGet, numberPath, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+		if _, cfg, ok := c.revision(w, p.ByName("number")); ok {
+			sendJSON(w, cfg)
+		}
+	})
+
+	// [to], the current configuration by default
+	c.Handle(http.MethodGet, numberPath+"/diff", func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		_, from, ok := c.revision(w, p.ByName("number"))
+		if !ok {
+			return
+		}
+		to := c.cfg.RawCopy()
+		if number := r.URL.Query().Get("to"); number != "" {
+			if _, to, ok = c.revision(w, number); !ok {
+				return
+			}
+		}
+		changes := config.Diff(from, to)
+		if changes == nil {
+			changes = []config.Change{}
+		}
+		sendJSON(w, changes)
+	})
+
+	c.Handle(http.MethodPost, numberPath+"/rollback", func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		rev, to, ok := c.revision(w, p.ByName("number"))
+		if !ok {
+			return
+		}
+		if to.Version != config.CurrentVersion {
+			http.Error(w, fmt.Sprintf("Revision %d has configuration version %d, not the current %d", rev.Number, to.Version, config.CurrentVersion), http.StatusBadRequest)
+			return
+		}
+		origin := fmt.Sprintf("%s (rollback to %d)", requestOrigin(r, c.cfg), rev.Number)
+		waiter, err := c.cfg.ModifyFrom(origin, func(cfg *config.Configuration) {
+			current := *cfg
+			*cfg = to.Copy()
+			keepCredentials(cfg, current)
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		l.Infof("Rolled back configuration to revision %d, keeping the current credentials", rev.Number)
+		recordConfigChanges(r, waiter)
+		c.finish(w, waiter)
+	})
+}
+
+// keepCredentials carries the credentials of the current configuration
+// over into cfg, so that rolling back never brings back a password, API key
+// or secret that has since been changed or revoked.
+func keepCredentials(cfg *config.Configuration, current config.Configuration) {
+	cfg.GUI.User = current.GUI.User
+	cfg.GUI.Password = current.GUI.Password
+	cfg.GUI.APIKey = current.GUI.APIKey
+	cfg.GUI.APIKeys = current.GUI.APIKeys
+	cfg.GUI.Accounts = current.GUI.Accounts
+	cfg.OIDC.ClientSecret = current.OIDC.ClientSecret
+
+	for i, hook := range cfg.Webhooks {
+		for _, cur := range current.Webhooks {
+			if cur.ID == hook.ID {
+				cfg.Webhooks[i].Secret = cur.Secret
+			}
+		}
+	}
+	for i, folder := range cfg.Folders {
+		cur, _, ok := current.Folder(folder.ID)
+		if !ok {
+			continue
+		}
+		for j, dev := range folder.Devices {
+			for _, curDev := range cur.Devices {
+				if curDev.DeviceID == dev.DeviceID {
+					cfg.Folders[i].Devices[j].EncryptionPassword = curDev.EncryptionPassword
+				}
+			}
+		}
+	}
+}
+
+func (c *configMuxBuilder) history(w http.ResponseWriter) (*config.History, bool) {
+	history := c.cfg.History()
+	if history == nil {
+		http.Error(w, "No configuration history is kept", http.StatusNotFound)
+		return nil, false
+	}
+	return history, true
+}
+
+func (c *configMuxBuilder) revision(w http.ResponseWriter, number string) (config.Revision, config.Configuration, bool) {
+	history, ok := c.history(w)
+	if !ok {
+		return config.Revision{}, config.Configuration{}, false
+	}
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return config.Revision{}, config.Configuration{}, false
+	}
+	rev, cfg, err := history.Load(n)
+	if errors.Is(err, config.ErrNoSuchRevision) {
+		http.Error(w, "No revision with given number", http.StatusNotFound)
+		return config.Revision{}, config.Configuration{}, false
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return config.Revision{}, config.Configuration{}, false
+	}
+	return rev, cfg, true
+}
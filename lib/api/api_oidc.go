@@ -0,0 +1,554 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// Login with OpenID Connect uses the authorization code flow with PKCE.
+// The login endpoint sends the browser to the identity provider, which
+// sends it back to the callback endpoint with a code. The code is exchanged
+// for an ID token, which says who the user is and which groups they are in.
+// The user then gets a session like any other, with the account bound to
+// their subject or else the role of their groups. The state of a login is
+// bound to the browser that started it by a cookie.
+
+const (
+	oidcLoginPath    = "/rest/noauth/auth/oidc/login"
+	oidcCallbackPath = "/rest/noauth/auth/oidc/callback"
+	oidcLoginTimeout = 10 * time.Minute
+	oidcMaxLogins    = 100 // logins in progress at the same time
+	oidcClockSkew    = time.Minute
+	oidcHTTPTimeout  = 30 * time.Second
+)
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+	EndSessionEndpoint    string `json:"end_session_endpoint"`
+}
+
+// A login in progress, by state
+type oidcLogin struct {
+	verifier    string
+	nonce       string
+	redirectURL string
+	started     time.Time
+}
+
+type oidcProvider struct {
+	cfg         config.OIDCConfiguration
+	client      *http.Client
+	stateCookie string
+
+	mut       sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]crypto.PublicKey // by key ID
+	logins    map[string]oidcLogin
+}
+
+func newOIDCProvider(cfg config.OIDCConfiguration, stateCookie string) *oidcProvider {
+	return &oidcProvider{
+		cfg:         cfg,
+		client:      &http.Client{Timeout: oidcHTTPTimeout},
+		stateCookie: stateCookie,
+		mut:         sync.NewMutex(),
+		logins:      make(map[string]oidcLogin),
+	}
+}
+
+// discover returns the provider metadata, which is fetched once.
+func (p *oidcProvider) discover(ctx context.Context) (*oidcDiscovery, error) {
+	p.mut.Lock()
+	disc := p.discovery
+	p.mut.Unlock()
+	if disc != nil {
+		return disc, nil
+	}
+
+	issuer := strings.TrimSuffix(p.cfg.IssuerURL, "/")
+	disc = new(oidcDiscovery)
+	if err := p.getJSON(ctx, issuer+"/.well-known/openid-configuration", disc); err != nil {
+		return nil, fmt.Errorf("discovery: %w", err)
+	}
+	if strings.TrimSuffix(disc.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("discovery: issuer %q does not match %q", disc.Issuer, p.cfg.IssuerURL)
+	}
+	if disc.AuthorizationEndpoint == "" || disc.TokenEndpoint == "" || disc.JWKSURI == "" {
+		return nil, errors.New("discovery: missing endpoints")
+	}
+
+	p.mut.Lock()
+	p.discovery = disc
+	p.mut.Unlock()
+	return disc, nil
+}
+
+func (p *oidcProvider) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// callbackURL returns the URL the identity provider sends the browser back
+// to, as configured or as the GUI was accessed at.
+func (p *oidcProvider) callbackURL(r *http.Request) string {
+	if p.cfg.RedirectURL != "" {
+		return p.cfg.RedirectURL
+	}
+	return requestBaseURL(r) + oidcCallbackPath
+}
+
+func requestBaseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil || strings.ToLower(r.Header.Get("x-forwarded-proto")) == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+func (p *oidcProvider) handleLogin(w http.ResponseWriter, r *http.Request) {
+	disc, err := p.discover(r.Context())
+	if err != nil {
+		l.Warnln("OpenID Connect:", err)
+		http.Error(w, "Identity provider unavailable", http.StatusBadGateway)
+		return
+	}
+
+	state := rand.String(32)
+	login := oidcLogin{
+		verifier:    rand.String(64),
+		nonce:       rand.String(32),
+		redirectURL: p.callbackURL(r),
+		started:     time.Now(),
+	}
+	p.mut.Lock()
+	for s, pending := range p.logins {
+		if time.Since(pending.started) > oidcLoginTimeout {
+			delete(p.logins, s)
+		}
+	}
+	if len(p.logins) >= oidcMaxLogins {
+		p.mut.Unlock()
+		http.Error(w, "Too many logins in progress", http.StatusTooManyRequests)
+		return
+	}
+	p.logins[state] = login
+	p.mut.Unlock()
+
+	// The identity provider sends the browser back with a top level
+	// navigation, which Lax cookies are sent with.
+	http.SetCookie(w, &http.Cookie{
+		Name:     p.stateCookie,
+		Value:    state,
+		Path:     oidcCallbackPath,
+		MaxAge:   int(oidcLoginTimeout / time.Second),
+		Secure:   isHTTPS(r),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	challenge := sha256.Sum256([]byte(login.verifier))
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.cfg.ClientID},
+		"redirect_uri":          {login.redirectURL},
+		"scope":                 {strings.Join(p.cfg.RequestScopes(), " ")},
+		"state":                 {state},
+		"nonce":                 {login.nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+	http.Redirect(w, r, addQuery(disc.AuthorizationEndpoint, params), http.StatusFound)
+}
+
+func addQuery(endpoint string, params url.Values) string {
+	if strings.Contains(endpoint, "?") {
+		return endpoint + "&" + params.Encode()
+	}
+	return endpoint + "?" + params.Encode()
+}
+
+func (p *oidcProvider) handleCallback(cookieName string, guiCfg config.GUIConfiguration, evLogger events.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		qs := r.URL.Query()
+		state := qs.Get("state")
+		cookie, err := r.Cookie(p.stateCookie)
+		if err != nil || state == "" || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+			http.Error(w, "Login not started by this browser", http.StatusBadRequest)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{
+			Name:   p.stateCookie,
+			Path:   oidcCallbackPath,
+			MaxAge: -1,
+		})
+
+		p.mut.Lock()
+		login, ok := p.logins[state]
+		delete(p.logins, state)
+		p.mut.Unlock()
+		if !ok || time.Since(login.started) > oidcLoginTimeout {
+			http.Error(w, "Unknown or expired login", http.StatusBadRequest)
+			return
+		}
+		if errStr := qs.Get("error"); errStr != "" {
+			l.Infof("OpenID Connect login failed at the identity provider: %s %s", errStr, qs.Get("error_description"))
+			emitLoginAttempt(false, "", r.RemoteAddr, evLogger)
+			forbidden(w)
+			return
+		}
+
+		idToken, claims, err := p.exchange(r.Context(), qs.Get("code"), login)
+		if err != nil {
+			l.Warnln("OpenID Connect:", err)
+			emitLoginAttempt(false, "", r.RemoteAddr, evLogger)
+			forbidden(w)
+			return
+		}
+
+		subject := claimString(claims, "sub")
+		username := claimString(claims, p.cfg.UsernameClaim)
+		if username == "" {
+			username = subject
+		}
+		sess := session{username: username, idToken: idToken}
+		if account, ok := guiCfg.AccountForOIDCSubject(subject); ok {
+			sess.username = account.Name
+			sess.oidcSubject = subject
+		} else {
+			// The name is the provider's to give, so it doesn't make the
+			// user one of the accounts.
+			role, ok := p.cfg.RoleForGroups(claimStrings(claims, p.cfg.GroupsClaim))
+			if !ok {
+				l.Infof("OpenID Connect user %q is in none of the configured groups", username)
+				emitLoginAttempt(false, username, r.RemoteAddr, evLogger)
+				forbidden(w)
+				return
+			}
+			sess.account = &config.GUIAccountConfiguration{Name: username, Role: role}
+		}
+
+		createSession(cookieName, sess, guiCfg, evLogger, w, r)
+		http.Redirect(w, r, "/", http.StatusFound)
+	})
+}
+
+// exchange trades the code for tokens, and returns the verified ID token
+// and its claims.
+func (p *oidcProvider) exchange(ctx context.Context, code string, login oidcLogin) (string, map[string]interface{}, error) {
+	disc, err := p.discover(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {login.redirectURL},
+		"code_verifier": {login.verifier},
+	}
+	if p.cfg.ClientSecret == "" {
+		form.Set("client_id", p.cfg.ClientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, disc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var tokens struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&tokens); err != nil {
+		return "", nil, fmt.Errorf("token response: %s: %w", resp.Status, err)
+	}
+	if tokens.Error != "" {
+		return "", nil, fmt.Errorf("token request: %s %s", tokens.Error, tokens.ErrorDescription)
+	}
+	if tokens.IDToken == "" {
+		return "", nil, errors.New("token response without ID token")
+	}
+
+	claims, err := p.verifyIDToken(ctx, tokens.IDToken, login.nonce)
+	if err != nil {
+		return "", nil, fmt.Errorf("ID token: %w", err)
+	}
+	return tokens.IDToken, claims, nil
+}
+
+// verifyIDToken checks the signature and the claims of the ID token and
+// returns the claims.
+func (p *oidcProvider) verifyIDToken(ctx context.Context, token, nonce string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("signature: %w", err)
+	}
+	key, err := p.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyJWTSignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("claims: %w", err)
+	}
+	disc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if iss := claimString(claims, "iss"); iss != disc.Issuer {
+		return nil, fmt.Errorf("issuer %q does not match", iss)
+	}
+	if !containsString(claimStrings(claims, "aud"), p.cfg.ClientID) {
+		return nil, errors.New("not issued for this client")
+	}
+	exp, _ := claims["exp"].(float64)
+	if time.Unix(int64(exp), 0).Add(oidcClockSkew).Before(time.Now()) {
+		return nil, errors.New("expired")
+	}
+	if claimString(claims, "nonce") != nonce {
+		return nil, errors.New("nonce does not match")
+	}
+	return claims, nil
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	bs, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bs, v)
+}
+
+func verifyJWTSignature(alg string, key crypto.PublicKey, signed string, sig []byte) error {
+	hash := sha256.Sum256([]byte(signed))
+	switch alg {
+	case "RS256":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("key type does not match algorithm")
+		}
+		return rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, hash[:], sig)
+	case "ES256":
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok || len(sig) != 64 {
+			return errors.New("key type does not match algorithm")
+		}
+		r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+		if !ecdsa.Verify(ecKey, hash[:], r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+}
+
+// key returns the signing key with the given ID. The keys are fetched again
+// when the ID is unknown, as the provider may have rotated them.
+func (p *oidcProvider) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.mut.Lock()
+	key, ok := p.keys[kid]
+	p.mut.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	disc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(ctx, disc.JWKSURI, &jwks); err != nil {
+		return nil, fmt.Errorf("signing keys: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			l.Debugf("Skipping signing key %q: %v", jwk.Kid, err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	p.mut.Lock()
+	p.keys = keys
+	p.mut.Unlock()
+
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		if !key.Curve.IsOnCurve(x, y) {
+			return nil, errors.New("point not on curve")
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(bs), nil
+}
+
+// logoutURL returns where to send the browser to log out at the identity
+// provider as well, if it supports that.
+func (p *oidcProvider) logoutURL(r *http.Request, idToken string) string {
+	disc, err := p.discover(r.Context())
+	if err != nil || disc.EndSessionEndpoint == "" {
+		return ""
+	}
+	params := url.Values{
+		"client_id":                {p.cfg.ClientID},
+		"post_logout_redirect_uri": {requestBaseURL(r) + "/"},
+	}
+	if idToken != "" {
+		params.Set("id_token_hint", idToken)
+	}
+	return addQuery(disc.EndSessionEndpoint, params)
+}
+
+func claimString(claims map[string]interface{}, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
+
+// claimStrings returns a claim that is either a string or a list of them,
+// like the audience and, depending on the provider, the groups.
+func claimStrings(claims map[string]interface{}, name string) []string {
+	switch v := claims[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		res := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				res = append(res, s)
+			}
+		}
+		return res
+	default:
+		return nil
+	}
+}
+
+func containsString(ss []string, s string) bool {
+	for _, e := range ss {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,398 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+// fakeIdP is an OpenID Connect provider logging in whoever is set as the
+// user, without asking.
+type fakeIdP struct {
+	*httptest.Server
+	t        *testing.T
+	key      *rsa.PrivateKey
+	clientID string
+	secret   string
+
+	mut    sync.Mutex
+	user   string
+	groups []string
+	codes  map[string]url.Values // authorization request by code
+}
+
+func newFakeIdP(t *testing.T) *fakeIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp := &fakeIdP{
+		t:        t,
+		key:      key,
+		clientID: "syncthing",
+		secret:   "s3cret",
+		codes:    make(map[string]url.Values),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", idp.discovery)
+	mux.HandleFunc("/authorize", idp.authorize)
+	mux.HandleFunc("/token", idp.token)
+	mux.HandleFunc("/keys", idp.keys)
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+	return idp
+}
+
+func (idp *fakeIdP) setUser(user string, groups ...string) {
+	idp.mut.Lock()
+	idp.user = user
+	idp.groups = groups
+	idp.mut.Unlock()
+}
+
+func (idp *fakeIdP) discovery(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, oidcDiscovery{
+		Issuer:                idp.URL,
+		AuthorizationEndpoint: idp.URL + "/authorize",
+		TokenEndpoint:         idp.URL + "/token",
+		JWKSURI:               idp.URL + "/keys",
+		EndSessionEndpoint:    idp.URL + "/logout",
+	})
+}
+
+func (idp *fakeIdP) authorize(w http.ResponseWriter, r *http.Request) {
+	qs := r.URL.Query()
+	if qs.Get("client_id") != idp.clientID || qs.Get("code_challenge_method") != "S256" || !strings.Contains(qs.Get("scope"), "openid") {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	code := base64.RawURLEncoding.EncodeToString(big.NewInt(time.Now().UnixNano()).Bytes())
+	idp.mut.Lock()
+	idp.codes[code] = qs
+	idp.mut.Unlock()
+	http.Redirect(w, r, qs.Get("redirect_uri")+"?"+url.Values{"code": {code}, "state": {qs.Get("state")}}.Encode(), http.StatusFound)
+}
+
+func (idp *fakeIdP) token(w http.ResponseWriter, r *http.Request) {
+	if user, pass, _ := r.BasicAuth(); user != idp.clientID || pass != idp.secret {
+		http.Error(w, "bad client", http.StatusUnauthorized)
+		return
+	}
+	idp.mut.Lock()
+	auth, ok := idp.codes[r.FormValue("code")]
+	delete(idp.codes, r.FormValue("code"))
+	user, groups := idp.user, idp.groups
+	idp.mut.Unlock()
+	challenge := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(challenge[:]) != auth.Get("code_challenge") || r.FormValue("redirect_uri") != auth.Get("redirect_uri") {
+		sendJSON(w, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	sendJSON(w, map[string]string{
+		"access_token": "unused",
+		"token_type":   "Bearer",
+		"id_token": idp.sign(map[string]interface{}{
+			"iss":                idp.URL,
+			"aud":                idp.clientID,
+			"sub":                "id-" + user,
+			"exp":                time.Now().Add(time.Hour).Unix(),
+			"nonce":              auth.Get("nonce"),
+			"preferred_username": user,
+			"groups":             groups,
+		}),
+	})
+}
+
+func (idp *fakeIdP) sign(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "k1"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	hash := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, idp.key, crypto.SHA256, hash[:])
+	if err != nil {
+		idp.t.Error(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func (idp *fakeIdP) keys(w http.ResponseWriter, _ *http.Request) {
+	sendJSON(w, map[string][]jsonWebKey{"keys": {{
+		Kty: "RSA",
+		Kid: "k1",
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(idp.key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(idp.key.E)).Bytes()),
+	}}})
+}
+
+func TestOIDCLogin(t *testing.T) {
+	t.Parallel()
+
+	idp := newFakeIdP(t)
+
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	go evLogger.Serve(ctx)
+	t.Cleanup(cancel)
+	sub := evLogger.Subscribe(events.LoginAttempt)
+	t.Cleanup(sub.Unsubscribe)
+
+	cfg := newMockedConfig()
+	cfg.GUIReturns(config.GUIConfiguration{
+		RawAddress: "127.0.0.1:0",
+		AuthMode:   config.AuthModeOIDC,
+		Accounts: []config.GUIAccountConfiguration{
+			// Takes precedence over the groups
+			{Name: "carol", Role: config.GUIRoleViewer, OIDCSubject: "id-carol"},
+			// Not bound, so not for users of the same name
+			{Name: "root", Role: config.GUIRoleAdmin},
+		},
+	})
+	cfg.OIDCReturns(config.OIDCConfiguration{
+		IssuerURL:      idp.URL,
+		ClientID:       idp.clientID,
+		ClientSecret:   idp.secret,
+		UsernameClaim:  "preferred_username",
+		GroupsClaim:    "groups",
+		AdminGroups:    []string{"admins"},
+		OperatorGroups: []string{"ops"},
+	})
+	baseURL, cancelHTTP, err := startHTTPWithEvents(cfg, evLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cancelHTTP)
+
+	expectLoginAttempt := func(success bool, username string) {
+		t.Helper()
+		ev, err := sub.Poll(time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data := ev.Data.(map[string]interface{})
+		if data["success"] != success || data["username"] != username {
+			t.Errorf("unexpected login attempt %v", data)
+		}
+	}
+
+	login := func(user string, groups ...string) (*http.Client, *http.Response) {
+		t.Helper()
+		idp.setUser(user, groups...)
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cli := &http.Client{Jar: jar, Timeout: time.Minute}
+		resp, err := cli.Get(baseURL + oidcLoginPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return cli, resp
+	}
+
+	request := func(cli *http.Client, method, path string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, baseURL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cli.Jar != nil {
+			u, _ := url.Parse(baseURL)
+			for _, cookie := range cli.Jar.Cookies(u) {
+				if strings.HasPrefix(cookie.Name, "CSRF-Token") {
+					req.Header.Set("X-"+cookie.Name, cookie.Value)
+				}
+			}
+		}
+		resp, err := cli.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	t.Run("methods", func(t *testing.T) {
+		resp := request(http.DefaultClient, http.MethodGet, "/rest/noauth/auth/methods")
+		var methods []string
+		if err := json.NewDecoder(resp.Body).Decode(&methods); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(methods, ",") != "password,oidc" {
+			t.Errorf("unexpected methods %v", methods)
+		}
+	})
+
+	t.Run("admin", func(t *testing.T) {
+		cli, resp := login("alice", "users", "admins")
+		if resp.StatusCode != http.StatusOK || resp.Request.URL.Path != "/" {
+			t.Fatalf("login ended with %s at %s", resp.Status, resp.Request.URL)
+		}
+		expectLoginAttempt(true, "alice")
+
+		// Request the GUI once more for the CSRF cookie, issued to
+		// authenticated clients.
+		request(cli, http.MethodGet, "/")
+		if resp := request(cli, http.MethodGet, "/rest/system/log"); resp.StatusCode != http.StatusOK {
+			t.Errorf("admin request: %s", resp.Status)
+		}
+
+		resp = request(cli, http.MethodPost, "/rest/noauth/auth/logout")
+		var logout struct {
+			LogoutURL string `json:"logoutURL"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&logout); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(logout.LogoutURL, idp.URL+"/logout?") || !strings.Contains(logout.LogoutURL, "id_token_hint=") {
+			t.Errorf("unexpected logout URL %q", logout.LogoutURL)
+		}
+		if resp := request(cli, http.MethodGet, "/rest/system/version"); resp.StatusCode != http.StatusForbidden {
+			t.Errorf("request after logout: %s", resp.Status)
+		}
+	})
+
+	t.Run("operator", func(t *testing.T) {
+		cli, resp := login("dave", "ops")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("login ended with %s", resp.Status)
+		}
+		expectLoginAttempt(true, "dave")
+		request(cli, http.MethodGet, "/")
+		if resp := request(cli, http.MethodGet, "/rest/system/version"); resp.StatusCode != http.StatusOK {
+			t.Errorf("operator request: %s", resp.Status)
+		}
+		if resp := request(cli, http.MethodGet, "/rest/system/log"); resp.StatusCode != http.StatusForbidden {
+			t.Errorf("admin request by operator: %s", resp.Status)
+		}
+	})
+
+	t.Run("account", func(t *testing.T) {
+		cli, resp := login("carol", "admins")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("login ended with %s", resp.Status)
+		}
+		expectLoginAttempt(true, "carol")
+		request(cli, http.MethodGet, "/")
+		if resp := request(cli, http.MethodGet, "/rest/system/log"); resp.StatusCode != http.StatusForbidden {
+			t.Errorf("admin request by viewer account: %s", resp.Status)
+		}
+	})
+
+	t.Run("account name", func(t *testing.T) {
+		_, resp := login("root", "users")
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("login with the name of an unbound account: %s", resp.Status)
+		}
+		expectLoginAttempt(false, "root")
+
+		cli, resp := login("root", "ops")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("login ended with %s", resp.Status)
+		}
+		expectLoginAttempt(true, "root")
+		request(cli, http.MethodGet, "/")
+		if resp := request(cli, http.MethodGet, "/rest/system/log"); resp.StatusCode != http.StatusForbidden {
+			t.Errorf("admin request by operator with the name of an admin account: %s", resp.Status)
+		}
+	})
+
+	t.Run("other browser", func(t *testing.T) {
+		idp.setUser("alice", "admins")
+		noRedirects := &http.Client{
+			Timeout: time.Minute,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+		// The login is started in one browser and the callback URL, with
+		// the state, opened in another.
+		location := baseURL + oidcLoginPath
+		for _, cli := range []*http.Client{noRedirects, noRedirects, http.DefaultClient} {
+			resp, err := cli.Get(location)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			location = resp.Header.Get("Location")
+			if cli == http.DefaultClient && resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("callback in another browser: %s", resp.Status)
+			}
+		}
+	})
+
+	t.Run("no role", func(t *testing.T) {
+		_, resp := login("bob", "users")
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("login without a role: %s", resp.Status)
+		}
+		expectLoginAttempt(false, "bob")
+	})
+
+	t.Run("unknown state", func(t *testing.T) {
+		resp := request(http.DefaultClient, http.MethodGet, oidcCallbackPath+"?code=x&state=y")
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("callback with unknown state: %s", resp.Status)
+		}
+	})
+}
+
+func TestOIDCLoginLimit(t *testing.T) {
+	t.Parallel()
+
+	idp := newFakeIdP(t)
+	p := newOIDCProvider(config.OIDCConfiguration{IssuerURL: idp.URL, ClientID: idp.clientID}, "sessionid-test-oidc")
+
+	login := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		p.handleLogin(rec, httptest.NewRequest(http.MethodGet, oidcLoginPath, nil))
+		return rec
+	}
+	for i := 0; i < oidcMaxLogins; i++ {
+		if rec := login(); rec.Code != http.StatusFound {
+			t.Fatalf("login %d: %d", i, rec.Code)
+		}
+	}
+	if rec := login(); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("login past the limit: %d", rec.Code)
+	}
+
+	// Expired logins make room again.
+	p.mut.Lock()
+	for state, pending := range p.logins {
+		pending.started = pending.started.Add(-2 * oidcLoginTimeout)
+		p.logins[state] = pending
+		break
+	}
+	p.mut.Unlock()
+	rec := login()
+	if rec.Code != http.StatusFound {
+		t.Errorf("login after one expired: %d", rec.Code)
+	}
+	if cookies := rec.Result().Cookies(); len(cookies) != 1 || cookies[0].Name != "sessionid-test-oidc" || !cookies[0].HttpOnly {
+		t.Errorf("unexpected state cookie %v", cookies)
+	}
+}
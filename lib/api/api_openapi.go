@@ -0,0 +1,55 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// The OpenAPI description of the REST API. The tests check that it lists
+// exactly the registered routes.
+//
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+func getOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Write(openAPISpec)
+}
+
+// A restRouter is the router for the REST API, which remembers the routes
+// registered on it.
+type restRouter struct {
+	*httprouter.Router
+	routes []restRoute
+}
+
+type restRoute struct {
+	method, path string
+}
+
+func newRestRouter() *restRouter {
+	return &restRouter{Router: httprouter.New()}
+}
+
+func (r *restRouter) Handle(method, path string, handle httprouter.Handle) {
+	r.routes = append(r.routes, restRoute{method, path})
+	r.Router.Handle(method, path, handle)
+}
+
+func (r *restRouter) Handler(method, path string, handler http.Handler) {
+	r.routes = append(r.routes, restRoute{method, path})
+	r.Router.Handler(method, path, handler)
+}
+
+func (r *restRouter) HandlerFunc(method, path string, handler http.HandlerFunc) {
+	r.routes = append(r.routes, restRoute{method, path})
+	r.Router.HandlerFunc(method, path, handler)
+}
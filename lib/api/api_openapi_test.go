@@ -0,0 +1,408 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+
+	"github.com/syncthing/syncthing/lib/api/apiclient"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+var (
+	routeParamExp = regexp.MustCompile(`:(\w+)`)
+	specParamExp  = regexp.MustCompile(`\\\{\w+\\\}`)
+)
+
+func TestOpenAPIRoutes(t *testing.T) {
+	t.Parallel()
+
+	var spec struct {
+		Paths map[string]map[string]interface{} `yaml:"paths"`
+	}
+	if err := yaml.Unmarshal(openAPISpec, &spec); err != nil {
+		t.Fatal(err)
+	}
+	described := make(map[restRoute]bool)
+	for path, item := range spec.Paths {
+		for method := range item {
+			if method != "parameters" {
+				described[restRoute{strings.ToUpper(method), path}] = true
+			}
+		}
+	}
+
+	// With OpenID Connect, so that all the login routes are registered.
+	svc := &service{id: protocol.LocalDeviceID, cfg: newMockedConfig()}
+	restMux := newRestRouter()
+	svc.registerRoutes(restMux, config.GUIConfiguration{AuthMode: config.AuthModeOIDC})
+
+	for _, route := range restMux.routes {
+		path := routeParamExp.ReplaceAllString(route.path, "{$1}")
+		if prefix, ok := strings.CutSuffix(path, "*method"); ok {
+			// The debug endpoints are served by a mux of their own.
+			found := false
+			for r := range described {
+				if r.method == route.method && strings.HasPrefix(r.path, prefix) {
+					delete(described, r)
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("No endpoints under %s %s are described in openapi.yaml", route.method, prefix)
+			}
+			continue
+		}
+		r := restRoute{route.method, path}
+		if !described[r] {
+			t.Errorf("%s %s is not described in openapi.yaml", r.method, r.path)
+		}
+		delete(described, r)
+	}
+	for r := range described {
+		t.Errorf("%s %s is described in openapi.yaml but not registered", r.method, r.path)
+	}
+}
+
+// TestAPIClient uses the client against the REST API, checking that the
+// responses are as described in openapi.yaml and have no fields the client
+// doesn't know about.
+func TestAPIClient(t *testing.T) {
+	t.Parallel()
+
+	var spec map[string]interface{}
+	if err := yaml.Unmarshal(openAPISpec, &spec); err != nil {
+		t.Fatal(err)
+	}
+
+	tmpFile, err := os.CreateTemp("", "syncthing-testConfig-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+	tmpFile.Close()
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.GUI.RawAddress = "127.0.0.1:0"
+	cfg.GUI.APIKey = testAPIKey
+	w := config.Wrap(tmpFile.Name(), cfg, protocol.LocalDeviceID, events.NoopLogger)
+	cfgCtx, cfgCancel := context.WithCancel(context.Background())
+	go w.Serve(cfgCtx)
+	defer cfgCancel()
+	w.SetHistory(config.NewHistory(t.TempDir(), protocol.LocalDeviceID))
+	baseURL, cancel, err := startHTTP(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	ctx := context.Background()
+	c := apiclient.New(baseURL, testAPIKey, nil)
+
+	// check makes the request and validates the response against the
+	// schema in openapi.yaml, and decodes it strictly into v unless nil.
+	check := func(method, path string, body interface{}, v interface{}) {
+		t.Helper()
+		var reqBody io.Reader
+		if body != nil {
+			bs, err := json.Marshal(body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			reqBody = bytes.NewReader(bs)
+		}
+		resp, err := c.Raw(ctx, method, path, nil, reqBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s %s: %s", method, path, resp.Status)
+		}
+
+		schema, err := responseSchema(spec, method, "/rest/"+strings.SplitN(path, "?", 2)[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var value interface{}
+		if err := json.Unmarshal(bs, &value); err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+		for _, err := range validateSchema(spec, schema, value, "") {
+			t.Errorf("%s %s: %v", method, path, err)
+		}
+
+		if v != nil {
+			dec := json.NewDecoder(bytes.NewReader(bs))
+			dec.DisallowUnknownFields()
+			if err := dec.Decode(v); err != nil {
+				t.Errorf("%s %s: %v", method, path, err)
+			}
+		}
+	}
+
+	var status apiclient.SystemStatus
+	check(http.MethodGet, "system/status", nil, &status)
+	if status.MyID != protocol.LocalDeviceID {
+		t.Errorf("unexpected device ID %v", status.MyID)
+	}
+	check(http.MethodGet, "system/version", nil, &apiclient.SystemVersion{})
+	check(http.MethodGet, "system/ping", nil, nil)
+	check(http.MethodGet, "system/error", nil, nil)
+	check(http.MethodGet, "config", nil, nil)
+	check(http.MethodGet, "config/restart-required", nil, nil)
+	check(http.MethodGet, "config/defaults/ignores", nil, nil)
+	check(http.MethodGet, "config/devices/"+protocol.LocalDeviceID.String(), nil, nil)
+	check(http.MethodGet, "cluster/pending/devices", nil, nil)
+	check(http.MethodGet, "cluster/pending/folders", nil, nil)
+	check(http.MethodGet, "events/history", nil, nil)
+	check(http.MethodPost, "config/gui/apikeys", config.APIKeyConfiguration{Name: "spec", Role: config.GUIRoleViewer}, &apiclient.APIKey{})
+	check(http.MethodGet, "config/gui/apikeys", nil, &[]apiclient.APIKey{})
+	if err := c.RevokeAPIKey(ctx, "spec"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Ping(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Errors(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.AddFolder(ctx, map[string]string{"id": "client", "path": "client"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.PatchFolder(ctx, "client", map[string]string{"label": "Client"}); err != nil {
+		t.Fatal(err)
+	}
+	check(http.MethodGet, "config/folders/client", nil, nil)
+	folder, err := c.Folder(ctx, "client")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if folder.Label != "Client" || folder.Path != "client" {
+		t.Errorf("unexpected folder %+v", folder)
+	}
+	if _, err := c.Folder(ctx, "missing"); !errors.Is(err, apiclient.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	key, err := c.AddAPIKey(ctx, config.APIKeyConfiguration{Name: "client", Role: config.GUIRoleViewer})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Key == "" {
+		t.Error("expected a generated key")
+	}
+	if keys, err := c.APIKeys(ctx); err != nil || len(keys) != 1 || keys[0].Name != "client" {
+		t.Errorf("unexpected API keys %v, %v", keys, err)
+	}
+	if err := c.RevokeAPIKey(ctx, "client"); err != nil {
+		t.Fatal(err)
+	}
+
+	revisions, err := c.ConfigHistory(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) < 2 {
+		t.Fatalf("expected revisions, got %v", revisions)
+	}
+	check(http.MethodGet, "config/history", nil, nil)
+	check(http.MethodGet, fmt.Sprintf("config/history/%d", revisions[0].Number), nil, nil)
+	check(http.MethodGet, fmt.Sprintf("config/history/%d/diff", revisions[0].Number), nil, nil)
+	changes, err := c.ConfigRevisionDiff(ctx, revisions[0].Number, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) == 0 {
+		t.Error("expected changes since the first revision")
+	}
+	if err := c.RollbackConfig(ctx, revisions[0].Number); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Folder(ctx, "client"); !errors.Is(err, apiclient.ErrNotFound) {
+		t.Errorf("expected the folder to be rolled back, got %v", err)
+	}
+}
+
+// responseSchema returns the schema of the successful JSON response to the
+// request, as described in the spec.
+func responseSchema(spec map[string]interface{}, method, path string) (map[string]interface{}, error) {
+	paths, _ := spec["paths"].(map[string]interface{})
+	for specPath, item := range paths {
+		exp := regexp.MustCompile("^" + specParamExp.ReplaceAllString(regexp.QuoteMeta(specPath), `[^/]+`) + "$")
+		if !exp.MatchString(path) || specPath != path && paths[path] != nil {
+			continue
+		}
+		op, _ := item.(map[string]interface{})[strings.ToLower(method)].(map[string]interface{})
+		responses, _ := op["responses"].(map[string]interface{})
+		resp := resolveRef(spec, responses["200"])
+		content, _ := resp["content"].(map[string]interface{})
+		media, _ := content["application/json"].(map[string]interface{})
+		schema, ok := media["schema"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s %s has no JSON response described", method, specPath)
+		}
+		return schema, nil
+	}
+	return nil, fmt.Errorf("%s %s is not described", method, path)
+}
+
+// resolveRef returns the object referred to by a $ref, or the object itself.
+func resolveRef(spec map[string]interface{}, v interface{}) map[string]interface{} {
+	obj, _ := v.(map[string]interface{})
+	ref, ok := obj["$ref"].(string)
+	if !ok {
+		return obj
+	}
+	var cur interface{} = spec
+	for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		m, _ := cur.(map[string]interface{})
+		cur = m[part]
+	}
+	return resolveRef(spec, cur)
+}
+
+// flattenSchema resolves the references in the schema and merges the
+// schemas in its allOf.
+func flattenSchema(spec map[string]interface{}, v interface{}) map[string]interface{} {
+	schema := resolveRef(spec, v)
+	allOf, ok := schema["allOf"].([]interface{})
+	if !ok {
+		return schema
+	}
+	merged := make(map[string]interface{})
+	props := make(map[string]interface{})
+	var required []interface{}
+	parts := []map[string]interface{}{schema}
+	for _, part := range allOf {
+		parts = append(parts, flattenSchema(spec, part))
+	}
+	for _, part := range parts {
+		for key, val := range part {
+			switch key {
+			case "allOf":
+			case "properties":
+				for name, prop := range val.(map[string]interface{}) {
+					props[name] = prop
+				}
+			case "required":
+				required = append(required, val.([]interface{})...)
+			default:
+				merged[key] = val
+			}
+		}
+	}
+	merged["properties"] = props
+	if required != nil {
+		merged["required"] = required
+	}
+	return merged
+}
+
+// validateSchema returns the ways in which the decoded JSON value doesn't
+// match the schema. Objects with properties described may have only those,
+// unless additionalProperties says otherwise.
+func validateSchema(spec map[string]interface{}, v interface{}, value interface{}, path string) []error {
+	schema := flattenSchema(spec, v)
+	if value == nil {
+		if _, typed := schema["type"]; typed && schema["nullable"] != true {
+			return []error{fmt.Errorf("%s: null is not nullable", path)}
+		}
+		return nil
+	}
+
+	var errs []error
+	mismatch := func() []error {
+		return []error{fmt.Errorf("%s: %#v is not of type %v", path, value, schema["type"])}
+	}
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return mismatch()
+		}
+		props, hasProps := schema["properties"].(map[string]interface{})
+		required, _ := schema["required"].([]interface{})
+		for _, name := range required {
+			if _, ok := obj[name.(string)]; !ok {
+				errs = append(errs, fmt.Errorf("%s: missing required property %s", path, name))
+			}
+		}
+		for name, val := range obj {
+			switch additional := schema["additionalProperties"].(type) {
+			case nil:
+				if prop, ok := props[name]; ok {
+					errs = append(errs, validateSchema(spec, prop, val, path+"."+name)...)
+				} else if hasProps {
+					errs = append(errs, fmt.Errorf("%s: property %s is not described", path, name))
+				}
+			case map[string]interface{}:
+				if prop, ok := props[name]; ok {
+					errs = append(errs, validateSchema(spec, prop, val, path+"."+name)...)
+				} else {
+					errs = append(errs, validateSchema(spec, additional, val, path+"."+name)...)
+				}
+			}
+		}
+	case "array":
+		arr, ok := value.([]interface{})
+		if !ok {
+			return mismatch()
+		}
+		for i, val := range arr {
+			errs = append(errs, validateSchema(spec, schema["items"], val, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return mismatch()
+		}
+		if schema["format"] == "date-time" {
+			if _, err := time.Parse(time.RFC3339, str); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %v", path, err))
+			}
+		}
+		if enum, ok := schema["enum"].([]interface{}); ok && !slices.Contains(enum, value) {
+			errs = append(errs, fmt.Errorf("%s: %q is not one of %v", path, str, enum))
+		}
+	case "integer":
+		if n, ok := value.(float64); !ok || n != math.Trunc(n) {
+			return mismatch()
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return mismatch()
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return mismatch()
+		}
+	}
+	return errs
+}
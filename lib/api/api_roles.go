@@ -0,0 +1,254 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+// Requests are made on behalf of a GUI account. Admins may do anything.
+// Viewers may look at everything but the paths below, while operators may
+// additionally scan, override, revert and pause. Operators and viewers may
+// be restricted to some folders, in which case requests about other folders
+// are refused and the responses listing folders, or events, are filtered.
+
+// Paths only admins may access
+var adminOnlyPrefixes = []string{
+	"/metrics",
+	"/rest/config/defaults/",
+	"/rest/config/gui",
+	"/rest/config/history",
+	"/rest/config/ldap",
+	"/rest/config/oidc",
+	"/rest/config/organization",
+	"/rest/debug/",
+	"/rest/svc/report",
+	"/rest/system/audit",
+	"/rest/system/browse",
+	"/rest/system/debug",
+	"/rest/system/log",
+	"/rest/system/paths",
+	"/rest/system/webhooks",
+}
+
+// Paths operators may POST to
+var operatorPaths = []string{
+	"/rest/db/override",
+	"/rest/db/prio",
+	"/rest/db/revert",
+	"/rest/db/scan",
+	"/rest/system/pause",
+	"/rest/system/resume",
+}
+
+// Paths scoped accounts may only access for one of their folders, as they
+// otherwise aggregate over all folders
+var folderRequiredPaths = []string{
+	"/rest/db/completion",
+}
+
+const folderConfigPrefix = "/rest/config/folders/"
+
+type accountContextKey struct{}
+
+func withAccount(r *http.Request, account config.GUIAccountConfiguration) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), accountContextKey{}, account))
+}
+
+// accountFromRequest returns the account the request is made by. Requests
+// without authentication, or authenticated with the API key, are made by an
+// admin.
+func accountFromRequest(r *http.Request) config.GUIAccountConfiguration {
+	if account, ok := r.Context().Value(accountContextKey{}).(config.GUIAccountConfiguration); ok {
+		return account
+	}
+	return config.GUIAccountConfiguration{Role: config.GUIRoleAdmin}
+}
+
+func authorizationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isAllowed(accountFromRequest(r), r) {
+			forbidden(w)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func isAllowed(account config.GUIAccountConfiguration, r *http.Request) bool {
+	if account.Role == config.GUIRoleAdmin || isNoAuthPath(r.URL.Path) {
+		return true
+	}
+
+	path := r.URL.Path
+	if slices.ContainsFunc(adminOnlyPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(path, prefix)
+	}) {
+		return false
+	}
+
+	folders := requestFolders(r)
+	for _, folder := range folders {
+		if !account.HasFolder(folder) {
+			return false
+		}
+	}
+	if account.IsScoped() && len(folders) == 0 && slices.Contains(folderRequiredPaths, path) {
+		return false
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	}
+	if path == "/rest/system/ping" {
+		return true
+	}
+	if account.Role != config.GUIRoleOperator {
+		return false
+	}
+	if account.IsScoped() && len(folders) == 0 {
+		// Changes that aren't about one of their folders, such as
+		// scanning all folders or pausing a device.
+		return false
+	}
+	if r.Method == http.MethodPost && slices.Contains(operatorPaths, path) {
+		return true
+	}
+	return r.Method == http.MethodPatch && strings.HasPrefix(path, folderConfigPrefix) && isPauseOnly(r)
+}
+
+// requestFolders returns the folders a request is about, from the folder
+// parameter or the path of the folder config.
+func requestFolders(r *http.Request) []string {
+	if id := strings.TrimPrefix(r.URL.Path, folderConfigPrefix); id != r.URL.Path && id != "" {
+		return []string{id}
+	}
+	if folder := r.URL.Query().Get("folder"); folder != "" {
+		return strings.Split(folder, ",")
+	}
+	return nil
+}
+
+// eventFilterForAccount restricts the event filter to the folders the
+// account may see. Scoped accounts thus don't get the events that aren't
+// about a folder.
+func eventFilterForAccount(filter events.Filter, account config.GUIAccountConfiguration) events.Filter {
+	if !account.IsScoped() {
+		return filter
+	}
+	folders := make(map[string]struct{}, len(account.Folders))
+	for _, folder := range account.Folders {
+		if _, ok := filter.Folders[folder]; ok || filter.Folders == nil {
+			folders[folder] = struct{}{}
+		}
+	}
+	filter.Folders = folders
+	return filter
+}
+
+// isPauseOnly returns whether the request body changes nothing but whether
+// a folder is paused. The body is kept for the handler.
+func isPauseOnly(r *http.Request) bool {
+	bs, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(bs))
+	if err != nil {
+		return false
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bs, &fields); err != nil {
+		return false
+	}
+	_, ok := fields["paused"]
+	return ok && len(fields) == 1
+}
+
+// filterConfigForAccount removes the folders the account may not see and,
+// for anyone but admins, the secrets and proxy credentials from the
+// configuration.
+func filterConfigForAccount(cfg config.Configuration, account config.GUIAccountConfiguration) config.Configuration {
+	if account.Role == config.GUIRoleAdmin {
+		return cfg
+	}
+	cfg.Folders = filterFoldersForAccount(cfg.Folders, account)
+	cfg.GUI.Password = ""
+	cfg.GUI.APIKey = ""
+	cfg.OIDC.ClientSecret = ""
+	for i := range cfg.GUI.Accounts {
+		cfg.GUI.Accounts[i].Password = ""
+	}
+	for i := range cfg.GUI.APIKeys {
+		cfg.GUI.APIKeys[i].Key = ""
+	}
+	for i := range cfg.Webhooks {
+		cfg.Webhooks[i].Secret = ""
+	}
+	for i := range cfg.Defaults.Folder.Devices {
+		cfg.Defaults.Folder.Devices[i].EncryptionPassword = ""
+	}
+	cfg.Options = filterOptionsForAccount(cfg.Options, account)
+	for i := range cfg.Devices {
+		cfg.Devices[i] = filterDeviceForAccount(cfg.Devices[i], account)
+	}
+	cfg.Defaults.Device = filterDeviceForAccount(cfg.Defaults.Device, account)
+	return cfg
+}
+
+// filterOptionsForAccount returns the options without the credentials of
+// the proxy for anyone but admins.
+func filterOptionsForAccount(opts config.OptionsConfiguration, account config.GUIAccountConfiguration) config.OptionsConfiguration {
+	if account.Role != config.GUIRoleAdmin {
+		opts.ProxyURL = config.RedactProxyURL(opts.ProxyURL)
+	}
+	return opts
+}
+
+// filterDeviceForAccount returns the device without the credentials of its
+// proxy for anyone but admins.
+func filterDeviceForAccount(device config.DeviceConfiguration, account config.GUIAccountConfiguration) config.DeviceConfiguration {
+	if account.Role != config.GUIRoleAdmin {
+		device.ProxyURL = config.RedactProxyURL(device.ProxyURL)
+	}
+	return device
+}
+
+// filterFoldersForAccount returns the folders the account may see, without
+// their encryption passwords for anyone but admins.
+func filterFoldersForAccount(folders []config.FolderConfiguration, account config.GUIAccountConfiguration) []config.FolderConfiguration {
+	if account.Role == config.GUIRoleAdmin {
+		return folders
+	}
+	res := make([]config.FolderConfiguration, 0, len(folders))
+	for _, folder := range folders {
+		if account.HasFolder(folder.ID) {
+			res = append(res, filterFolderForAccount(folder, account))
+		}
+	}
+	return res
+}
+
+func filterFolderForAccount(folder config.FolderConfiguration, account config.GUIAccountConfiguration) config.FolderConfiguration {
+	if account.Role == config.GUIRoleAdmin {
+		return folder
+	}
+	folder = folder.Copy()
+	for i := range folder.Devices {
+		folder.Devices[i].EncryptionPassword = ""
+	}
+	return folder
+}
@@ -28,7 +28,10 @@ import (
 	"github.com/syncthing/syncthing/lib/assets"
 	"github.com/syncthing/syncthing/lib/build"
 	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/connections"
 	connmocks "github.com/syncthing/syncthing/lib/connections/mocks"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/db/backend"
 	discovermocks "github.com/syncthing/syncthing/lib/discover/mocks"
 	"github.com/syncthing/syncthing/lib/events"
 	eventmocks "github.com/syncthing/syncthing/lib/events/mocks"
@@ -44,7 +47,9 @@ import (
 	"github.com/syncthing/syncthing/lib/sync"
 	"github.com/syncthing/syncthing/lib/tlsutil"
 	"github.com/syncthing/syncthing/lib/ur"
+	"github.com/syncthing/syncthing/lib/webhook"
 	"github.com/thejerf/suture/v4"
+	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/exp/slices"
 )
 
@@ -64,10 +69,18 @@ func init() {
 func TestMain(m *testing.M) {
 	orig := locations.GetBaseDir(locations.ConfigBaseDir)
 	locations.SetBaseDir(locations.ConfigBaseDir, confDir)
+	origData := locations.GetBaseDir(locations.DataBaseDir)
+	dataDir, err := os.MkdirTemp("", "syncthing-api-test-")
+	if err != nil {
+		panic(err)
+	}
+	locations.SetBaseDir(locations.DataBaseDir, dataDir)
 
 	exitCode := m.Run()
 
 	locations.SetBaseDir(locations.ConfigBaseDir, orig)
+	locations.SetBaseDir(locations.DataBaseDir, origData)
+	os.RemoveAll(dataDir)
 
 	os.Exit(exitCode)
 }
@@ -148,7 +161,7 @@ func TestStopAfterBrokenConfig(t *testing.T) {
 	}
 	w := config.Wrap("/dev/null", cfg, protocol.LocalDeviceID, events.NoopLogger)
 
-	srv := New(protocol.LocalDeviceID, w, "", "syncthing", nil, nil, nil, events.NoopLogger, nil, nil, nil, nil, nil, nil, false).(*service)
+	srv := New(protocol.LocalDeviceID, w, "", "syncthing", nil, nil, nil, events.NoopLogger, nil, nil, nil, nil, nil, nil, nil, nil, false).(*service)
 	defer os.Remove(token)
 
 	srv.started = make(chan string)
@@ -419,6 +432,24 @@ func TestAPIServiceRequests(t *testing.T) {
 			Type:   "application/json",
 			Prefix: "{",
 		},
+		{
+			URL:    "/rest/system/webhooks",
+			Code:   200,
+			Type:   "application/json",
+			Prefix: "[",
+		},
+		{
+			URL:    "/rest/events/history?since=2023-01-01T00:00:00Z",
+			Code:   200,
+			Type:   "application/json",
+			Prefix: "[",
+		},
+		{
+			URL:    "/rest/events/history?until=yesterday",
+			Code:   400,
+			Type:   "text/plain",
+			Prefix: "",
+		},
 		{
 			URL:    "/rest/system/debug",
 			Code:   200,
@@ -493,6 +524,12 @@ func TestAPIServiceRequests(t *testing.T) {
 			Type:   "application/json",
 			Prefix: "{",
 		},
+		{
+			URL:    "/rest/config/organization",
+			Code:   200,
+			Type:   "application/json",
+			Prefix: "{",
+		},
 	}
 
 	for _, tc := range cases {
@@ -753,6 +790,155 @@ func TestHTTPLogin(t *testing.T) {
 	testWith(false, http.StatusNotFound, http.StatusForbidden, "/any-path/that/does/nooooooot/match-any/noauth-pattern")
 }
 
+func TestGUIAccountRoles(t *testing.T) {
+	t.Parallel()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("pass"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := newMockedConfig()
+	cfg.GUIReturns(config.GUIConfiguration{
+		User:       "admin",
+		Password:   string(hash),
+		RawAddress: "127.0.0.1:0",
+		APIKey:     testAPIKey,
+		Accounts: []config.GUIAccountConfiguration{
+			{Name: "viewer", Password: string(hash), Role: config.GUIRoleViewer},
+			{Name: "ops", Password: string(hash), Role: config.GUIRoleOperator, Folders: []string{"default"}},
+		},
+	})
+	cfg.FolderListReturns([]config.FolderConfiguration{{ID: "default"}, {ID: "other"}})
+	baseURL, cancel, err := startHTTP(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cancel)
+
+	resp, err := http.Get(baseURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	var csrfTokenName, csrfTokenValue string
+	for _, cookie := range resp.Cookies() {
+		if strings.HasPrefix(cookie.Name, "CSRF-Token") {
+			csrfTokenName = cookie.Name
+			csrfTokenValue = cookie.Value
+			break
+		}
+	}
+
+	request := func(method, user, path string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, baseURL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.SetBasicAuth(user, "pass")
+		req.Header.Set("X-"+csrfTokenName, csrfTokenValue)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	cases := []struct {
+		method, user, path string
+		code               int
+	}{
+		{http.MethodGet, "viewer", "/rest/system/version", http.StatusOK},
+		{http.MethodGet, "viewer", "/rest/system/log", http.StatusForbidden},
+		{http.MethodGet, "viewer", "/rest/config/gui", http.StatusForbidden},
+		{http.MethodPost, "viewer", "/rest/db/scan?folder=default", http.StatusForbidden},
+		{http.MethodPost, "viewer", "/rest/system/ping", http.StatusOK},
+		{http.MethodPost, "ops", "/rest/db/scan?folder=default", http.StatusOK},
+		{http.MethodPost, "ops", "/rest/db/scan?folder=other", http.StatusForbidden},
+		{http.MethodPost, "ops", "/rest/db/scan", http.StatusForbidden},
+		{http.MethodGet, "ops", "/rest/config/folders/other", http.StatusForbidden},
+		{http.MethodGet, "ops", "/rest/db/completion", http.StatusForbidden},
+		{http.MethodGet, "ops", "/rest/events/history", http.StatusOK},
+		{http.MethodGet, "ops", "/rest/events/history?folder=other", http.StatusForbidden},
+		{http.MethodPost, "ops", "/rest/system/shutdown", http.StatusForbidden},
+		{http.MethodGet, "admin", "/rest/system/log", http.StatusOK},
+	}
+	for _, tc := range cases {
+		if resp := request(tc.method, tc.user, tc.path); resp.StatusCode != tc.code {
+			t.Errorf("%s %s as %s: expected %d, got %s", tc.method, tc.path, tc.user, tc.code, resp.Status)
+		}
+	}
+
+	for user, expected := range map[string][]string{"ops": {"default"}, "viewer": {"default", "other"}} {
+		resp := request(http.MethodGet, user, "/rest/config/folders")
+		var folders []config.FolderConfiguration
+		if err := json.NewDecoder(resp.Body).Decode(&folders); err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, folder := range folders {
+			ids = append(ids, folder.ID)
+		}
+		if !slices.Equal(ids, expected) {
+			t.Errorf("folders for %s: expected %v, got %v", user, expected, ids)
+		}
+	}
+}
+
+func TestScopedConfigUnchanged(t *testing.T) {
+	t.Parallel()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("pass"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.GUI.RawAddress = "127.0.0.1:0"
+	cfg.GUI.APIKey = testAPIKey
+	cfg.GUI.Accounts = []config.GUIAccountConfiguration{{Name: "viewer", Password: string(hash), Role: config.GUIRoleViewer}}
+	cfg.Defaults.Folder.Devices = []config.FolderDeviceConfiguration{{DeviceID: protocol.LocalDeviceID, EncryptionPassword: "secret"}}
+	w := config.Wrap("/dev/null", cfg, protocol.LocalDeviceID, events.NoopLogger)
+	baseURL, cancel, err := startHTTP(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cancel)
+
+	resp, err := http.Get(baseURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	req, err := http.NewRequest(http.MethodGet, baseURL+"/rest/config", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth("viewer", "pass")
+	for _, cookie := range resp.Cookies() {
+		if strings.HasPrefix(cookie.Name, "CSRF-Token") {
+			req.Header.Set("X-"+cookie.Name, cookie.Value)
+		}
+	}
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var got config.Configuration
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if pw := got.Defaults.Folder.Devices[0].EncryptionPassword; pw != "" {
+		t.Errorf("expected the encryption password to be removed, got %q", pw)
+	}
+
+	// The secrets are removed from a copy, not from the live config.
+	if pw := w.RawCopy().Defaults.Folder.Devices[0].EncryptionPassword; pw != "secret" {
+		t.Errorf("expected the wrapper's encryption password to be unchanged, got %q", pw)
+	}
+}
+
 func TestHtmlFormLogin(t *testing.T) {
 	t.Parallel()
 
@@ -904,12 +1090,16 @@ func TestApiCache(t *testing.T) {
 }
 
 func startHTTP(cfg config.Wrapper) (string, context.CancelFunc, error) {
+	return startHTTPWithEvents(cfg, events.NoopLogger)
+}
+
+func startHTTPWithEvents(cfg config.Wrapper, evLogger events.Logger) (string, context.CancelFunc, error) {
 	m := new(modelmocks.Model)
 	assetDir := "../../gui"
 	eventSub := new(eventmocks.BufferedSubscription)
 	diskEventSub := new(eventmocks.BufferedSubscription)
 	discoverer := new(discovermocks.Manager)
-	connections := new(connmocks.Service)
+	connectionsService := new(connmocks.Service)
 	errorLog := new(loggermocks.Recorder)
 	systemLog := new(loggermocks.Recorder)
 	for _, l := range []*loggermocks.Recorder{errorLog, systemLog} {
@@ -923,10 +1113,20 @@ func startHTTP(cfg config.Wrapper) (string, context.CancelFunc, error) {
 	addrChan := make(chan string)
 	mockedSummary := &modelmocks.FolderSummaryService{}
 	mockedSummary.SummaryReturns(new(model.FolderSummary), nil)
+	// As returned by the real services when there is nothing to report
+	m.PendingDevicesReturns(map[protocol.DeviceID]db.ObservedDevice{}, nil)
+	m.PendingFoldersReturns(map[string]db.PendingFolder{}, nil)
+	connectionsService.ListenerStatusReturns(map[string]connections.ListenerStatusEntry{})
+	connectionsService.ConnectionStatusReturns(map[string]connections.ConnectionStatusEntry{})
 
 	// Instantiate the API service
-	urService := ur.New(cfg, m, connections, false)
-	svc := New(protocol.LocalDeviceID, cfg, assetDir, "syncthing", m, eventSub, diskEventSub, events.NoopLogger, discoverer, connections, urService, mockedSummary, errorLog, systemLog, false).(*service)
+	urService := ur.New(cfg, m, connectionsService, false)
+	ll, err := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	if err != nil {
+		return "", nil, err
+	}
+	webhooks := webhook.New(cfg, events.NoopLogger, db.NewMiscDataNamespace(ll))
+	svc := New(protocol.LocalDeviceID, cfg, assetDir, "syncthing", m, eventSub, diskEventSub, evLogger, discoverer, connectionsService, urService, mockedSummary, webhooks, ll, errorLog, systemLog, false).(*service)
 	defer os.Remove(token)
 	svc.started = addrChan
 
@@ -1467,7 +1667,7 @@ func TestEventMasks(t *testing.T) {
 	cfg := newMockedConfig()
 	defSub := new(eventmocks.BufferedSubscription)
 	diskSub := new(eventmocks.BufferedSubscription)
-	svc := New(protocol.LocalDeviceID, cfg, "", "syncthing", nil, defSub, diskSub, events.NoopLogger, nil, nil, nil, nil, nil, nil, false).(*service)
+	svc := New(protocol.LocalDeviceID, cfg, "", "syncthing", nil, defSub, diskSub, events.NoopLogger, nil, nil, nil, nil, nil, nil, nil, nil, false).(*service)
 	defer os.Remove(token)
 
 	if mask := svc.getEventMask(""); mask != DefaultEventMask {
@@ -1758,3 +1958,590 @@ func runningInContainer() bool {
 	}
 	return false
 }
+
+type fakeEventStreamWriter struct {
+	evs   chan events.Event
+	drops chan int
+}
+
+func (w *fakeEventStreamWriter) event(ev events.Event) error {
+	w.evs <- ev
+	return nil
+}
+
+func (w *fakeEventStreamWriter) dropped(count int) error {
+	w.drops <- count
+	return nil
+}
+
+func (*fakeEventStreamWriter) keepalive() error {
+	return nil
+}
+
+func TestEventStream(t *testing.T) {
+	t.Parallel()
+
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go evLogger.Serve(ctx)
+
+	cfg := newMockedConfig()
+	svc := New(protocol.LocalDeviceID, cfg, "", "syncthing", nil, nil, nil, evLogger, nil, nil, nil, nil, nil, nil, nil, nil, false).(*service)
+	defer os.Remove(token)
+
+	filter, err := svc.parseEventStreamFilter(map[string][]string{
+		"events": {"StateChanged"},
+		"folder": {"a"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectEvent := func(w *fakeEventStreamWriter, folder string) events.Event {
+		t.Helper()
+		select {
+		case ev := <-w.evs:
+			if data := ev.Data.(map[string]string); data["folder"] != folder {
+				t.Errorf("got event for folder %q, expected %q", data["folder"], folder)
+			}
+			return ev
+		case count := <-w.drops:
+			t.Fatalf("unexpected %d dropped events", count)
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+		return events.Event{}
+	}
+
+	run := func(last int) (*fakeEventStreamWriter, context.CancelFunc, chan struct{}) {
+		w := &fakeEventStreamWriter{evs: make(chan events.Event, 10), drops: make(chan int, 10)}
+		streamCtx, streamCancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			svc.streamEvents(streamCtx, filter, last, w)
+			close(done)
+		}()
+		return w, streamCancel, done
+	}
+
+	w, streamCancel, done := run(0)
+	// Make sure the stream is subscribed before logging
+	for {
+		svc.eventSubsMut.Lock()
+		_, ok := svc.eventSubs[filter.Mask]
+		svc.eventSubsMut.Unlock()
+		if ok {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "b"})
+	evLogger.Log(events.LocalIndexUpdated, map[string]string{"folder": "a"})
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "a"})
+	last := expectEvent(w, "a").GlobalID
+	streamCancel()
+	<-done
+
+	// Events happening while disconnected are sent on resume
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "a", "state": "missed"})
+	for {
+		buffered := svc.getEventSub(filter.Mask).Since(0, nil, 0)
+		if len(buffered) > 0 && buffered[len(buffered)-1].GlobalID > last {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	w, streamCancel, done = run(last)
+	if ev := expectEvent(w, "a"); ev.Data.(map[string]string)["state"] != "missed" {
+		t.Errorf("expected the missed event, got %v", ev)
+	}
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "a", "state": "live"})
+	if ev := expectEvent(w, "a"); ev.Data.(map[string]string)["state"] != "live" {
+		t.Errorf("expected the live event, got %v", ev)
+	}
+	streamCancel()
+	<-done
+
+	// Resuming with an ID from before a restart reports dropped events
+	w, streamCancel, done = run(last + 1000)
+	defer func() {
+		streamCancel()
+		<-done
+	}()
+	select {
+	case count := <-w.drops:
+		if count != eventsDroppedUnknown {
+			t.Errorf("expected unknown number of dropped events, got %d", count)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for dropped events")
+	}
+}
+
+func TestNamedAPIKeys(t *testing.T) {
+	t.Parallel()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("pass"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("auth", func(t *testing.T) {
+		t.Parallel()
+		testNamedAPIKeys(t, config.GUIConfiguration{
+			RawAddress: "127.0.0.1:0",
+			User:       "admin",
+			Password:   string(hash),
+			APIKey:     testAPIKey,
+		})
+	})
+
+	// Named keys are restricted just the same when the GUI doesn't
+	// require authentication.
+	t.Run("no auth", func(t *testing.T) {
+		t.Parallel()
+		testNamedAPIKeys(t, config.GUIConfiguration{
+			RawAddress: "127.0.0.1:0",
+			APIKey:     testAPIKey,
+		})
+	})
+}
+
+func testNamedAPIKeys(t *testing.T, guiCfg config.GUIConfiguration) {
+	tmpFile, err := os.CreateTemp("", "syncthing-testConfig-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+	tmpFile.Close()
+	w := config.Wrap(tmpFile.Name(), config.Configuration{GUI: guiCfg}, protocol.LocalDeviceID, events.NoopLogger)
+	cfgCtx, cfgCancel := context.WithCancel(context.Background())
+	go w.Serve(cfgCtx)
+	defer cfgCancel()
+	baseURL, cancel, err := startHTTP(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	request := func(method, key, path, body string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, baseURL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("X-API-Key", key)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	resp := request(http.MethodPost, testAPIKey, "/rest/config/gui/apikeys", `{"name": "monitor", "endpoints": ["db"], "folders": ["default"]}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("adding API key: %s", resp.Status)
+	}
+	var monitor apiKeyInfo
+	if err := json.NewDecoder(resp.Body).Decode(&monitor); err != nil {
+		t.Fatal(err)
+	}
+	if len(monitor.Key) != 32 || monitor.Role != config.GUIRoleViewer || monitor.LastUsed != nil {
+		t.Fatalf("unexpected API key %+v", monitor)
+	}
+
+	for body, status := range map[string]int{
+		`{"name": "monitor"}`:                      http.StatusConflict,
+		`{"name": "other", "endpoints": ["nope"]}`: http.StatusBadRequest,
+		`{"endpoints": ["db"]}`:                    http.StatusBadRequest,
+	} {
+		if resp := request(http.MethodPost, testAPIKey, "/rest/config/gui/apikeys", body); resp.StatusCode != status {
+			t.Errorf("adding API key %s: expected %d, got %s", body, status, resp.Status)
+		}
+	}
+
+	cases := []struct {
+		method, path string
+		allowed      bool
+	}{
+		{http.MethodGet, "/rest/db/status?folder=default", true},
+		{http.MethodGet, "/rest/db/status?folder=other", false},
+		{http.MethodPost, "/rest/db/scan?folder=default", false},
+		{http.MethodGet, "/rest/system/version", false},
+		{http.MethodGet, "/rest/config/gui/apikeys", false},
+	}
+	for _, tc := range cases {
+		resp := request(tc.method, monitor.Key, tc.path, "")
+		if allowed := resp.StatusCode != http.StatusForbidden; allowed != tc.allowed {
+			t.Errorf("%s %s: expected allowed %v, got %s", tc.method, tc.path, tc.allowed, resp.Status)
+		}
+	}
+
+	resp = request(http.MethodGet, testAPIKey, "/rest/config/gui/apikeys/monitor", "")
+	var used apiKeyInfo
+	if err := json.NewDecoder(resp.Body).Decode(&used); err != nil {
+		t.Fatal(err)
+	}
+	if used.LastUsed == nil || time.Since(*used.LastUsed) > time.Minute {
+		t.Errorf("unexpected last use %v", used.LastUsed)
+	}
+
+	// Expiring and revoking take effect right away
+	resp = request(http.MethodPost, testAPIKey, "/rest/config/gui/apikeys", fmt.Sprintf(`{"name": "expired", "expires": %q}`, time.Now().Add(-time.Minute).Format(time.RFC3339)))
+	var expired apiKeyInfo
+	if err := json.NewDecoder(resp.Body).Decode(&expired); err != nil {
+		t.Fatal(err)
+	}
+	if resp := request(http.MethodGet, expired.Key, "/rest/system/version", ""); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("request with expired key: %s", resp.Status)
+	}
+	if resp := request(http.MethodDelete, testAPIKey, "/rest/config/gui/apikeys/monitor", ""); resp.StatusCode != http.StatusOK {
+		t.Fatalf("revoking API key: %s", resp.Status)
+	}
+	if resp := request(http.MethodGet, monitor.Key, "/rest/db/status?folder=default", ""); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("request with revoked key: %s", resp.Status)
+	}
+}
+
+func TestAuditTrail(t *testing.T) {
+	// Not parallel, as the audit trail is shared by the tests.
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("pass"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpFile, err := os.CreateTemp("", "syncthing-testConfig-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+	tmpFile.Close()
+	w := config.Wrap(tmpFile.Name(), config.Configuration{
+		GUI: config.GUIConfiguration{
+			RawAddress: "127.0.0.1:0",
+			APIKey:     testAPIKey,
+			Accounts: []config.GUIAccountConfiguration{
+				// Empty folders as when prepared, for the GUI config not
+				// to change on the first modification.
+				{Name: "auditor", Password: string(hash), Role: config.GUIRoleAdmin, Folders: []string{}},
+				{Name: "auditee", Password: string(hash), Role: config.GUIRoleViewer, Folders: []string{}},
+			},
+		},
+		Folders: []config.FolderConfiguration{{ID: "audited", Path: "audited"}},
+	}, protocol.LocalDeviceID, events.NoopLogger)
+	cfgCtx, cfgCancel := context.WithCancel(context.Background())
+	go w.Serve(cfgCtx)
+	defer cfgCancel()
+	baseURL, cancel, err := startHTTP(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	request := func(method, path, body string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, baseURL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("X-API-Key", testAPIKey)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	// Requests by an account need a CSRF token
+	accountRequest := func(method, user, path, body string) *http.Response {
+		t.Helper()
+		resp, err := http.Get(baseURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		req, err := http.NewRequest(method, baseURL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.SetBasicAuth(user, "pass")
+		for _, cookie := range resp.Cookies() {
+			if strings.HasPrefix(cookie.Name, "CSRF-Token") {
+				req.Header.Set("X-"+cookie.Name, cookie.Value)
+			}
+		}
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	if resp := accountRequest(http.MethodPatch, "auditor", "/rest/config/folders/audited", `{"paused": true}`); resp.StatusCode != http.StatusOK {
+		t.Fatalf("pausing folder: %s", resp.Status)
+	}
+	if resp := accountRequest(http.MethodPost, "auditee", "/rest/db/scan?folder=audited", ""); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("scan by viewer: %s", resp.Status)
+	}
+	accountRequest(http.MethodGet, "auditee", "/rest/system/version", "")
+	if resp := request(http.MethodPatch, "/rest/config/options", `{"urAccepted": -1}`); resp.StatusCode != http.StatusOK {
+		t.Fatalf("changing options: %s", resp.Status)
+	}
+
+	resp := accountRequest(http.MethodGet, "auditee", "/rest/system/audit", "")
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("audit trail for viewer: %s", resp.Status)
+	}
+	resp = request(http.MethodGet, "/rest/system/audit?limit=100", "")
+	var entries []auditEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		t.Fatal(err)
+	}
+	var paused, scan, options, version bool
+	for _, entry := range entries {
+		switch {
+		case entry.User == "auditor" && entry.Path == "/rest/config/folders/audited":
+			// The changes include those from preparing the config.
+			paused = entry.Method == http.MethodPatch && entry.Status == http.StatusOK &&
+				slices.ContainsFunc(entry.Changes, func(c config.Change) bool {
+					return c.Path == "folders[audited].paused" && c.From == false && c.To == true
+				})
+		case entry.User == "auditee" && entry.Path == "/rest/db/scan":
+			scan = entry.Status == http.StatusForbidden && entry.Query == "folder=audited" && len(entry.Changes) == 0
+		case entry.User == "auditee":
+			version = true
+		case entry.User == "apikey" && entry.Path == "/rest/config/options":
+			options = slices.ContainsFunc(entry.Changes, func(c config.Change) bool {
+				return c.Path == "options.urAccepted"
+			})
+		}
+	}
+	if !paused || !scan || !options || version {
+		t.Errorf("unexpected audit trail (pause %v, scan %v, options %v, get %v): %+v", paused, scan, options, version, entries)
+	}
+
+	resp = request(http.MethodGet, "/rest/system/audit/export", "")
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(bs)), "\n")
+	if resp.Header.Get("Content-Type") != "application/x-ndjson" || len(lines) < 3 {
+		t.Fatalf("unexpected export %s: %s", resp.Header.Get("Content-Type"), bs)
+	}
+	for _, line := range lines {
+		var entry auditEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Errorf("invalid line %q: %v", line, err)
+		}
+	}
+}
+
+func TestAuditTrailChanges(t *testing.T) {
+	t.Parallel()
+
+	w := config.Wrap("", config.New(protocol.LocalDeviceID), protocol.LocalDeviceID, events.NoopLogger)
+	ctx, cancel := context.WithCancel(context.Background())
+	go w.Serve(ctx)
+	defer cancel()
+
+	trailPath := filepath.Join(t.TempDir(), "audit.jsonl")
+	trail := newAuditTrail(trailPath)
+	handler := auditMiddleware(trail, w, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		// A change from elsewhere while the request is handled
+		waiter, err := w.Modify(func(cfg *config.Configuration) {
+			cfg.GUI.Theme = "dark"
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		waiter.Wait()
+		waiter, err = modifyConfig(r, w, func(cfg *config.Configuration) {
+			cfg.Options.URAccepted--
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		waiter.Wait()
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPatch, "/rest/config/options", nil))
+	// A line torn by a crash, which is skipped
+	fd, err := os.OpenFile(trailPath, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.WriteString(`{"time": "2023-`)
+	fd.Close()
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPatch, "/rest/config/options", nil))
+
+	entries, err := trail.entries(time.Time{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected two entries, got %+v", entries)
+	}
+	for _, entry := range entries {
+		if len(entry.Changes) != 1 || entry.Changes[0].Path != "options.urAccepted" {
+			t.Errorf("unexpected changes %+v", entry.Changes)
+		}
+	}
+}
+
+func TestKeepCredentials(t *testing.T) {
+	t.Parallel()
+
+	old := config.New(protocol.LocalDeviceID)
+	old.GUI.User = "olduser"
+	old.GUI.Password = "oldpassword"
+	old.GUI.APIKey = "oldkey"
+	old.GUI.APIKeys = []config.APIKeyConfiguration{{Name: "revoked", Key: "revokedkey"}}
+	old.GUI.Accounts = []config.GUIAccountConfiguration{{Name: "revoked", Password: "oldpassword"}}
+	old.OIDC.ClientSecret = "oldsecret"
+	old.Webhooks = []config.WebhookConfiguration{{ID: "hook", Secret: "oldsecret"}, {ID: "removed", Secret: "removedsecret"}}
+	old.Folders = []config.FolderConfiguration{{ID: "folder", Devices: []config.FolderDeviceConfiguration{{DeviceID: protocol.LocalDeviceID, EncryptionPassword: "oldpassword"}}}}
+
+	current := old.Copy()
+	current.GUI.User = "user"
+	current.GUI.Password = "password"
+	current.GUI.APIKey = "key"
+	current.GUI.APIKeys = nil
+	current.GUI.Accounts = nil
+	current.OIDC.ClientSecret = "secret"
+	current.Webhooks = current.Webhooks[:1]
+	current.Webhooks[0].Secret = "secret"
+	current.Folders[0].Devices[0].EncryptionPassword = "password"
+
+	cfg := old.Copy()
+	keepCredentials(&cfg, current)
+
+	if cfg.GUI.User != "user" || cfg.GUI.Password != "password" || cfg.GUI.APIKey != "key" {
+		t.Errorf("GUI credentials not kept: %q %q %q", cfg.GUI.User, cfg.GUI.Password, cfg.GUI.APIKey)
+	}
+	if len(cfg.GUI.APIKeys) != 0 || len(cfg.GUI.Accounts) != 0 {
+		t.Errorf("revoked keys or accounts restored: %+v %+v", cfg.GUI.APIKeys, cfg.GUI.Accounts)
+	}
+	if cfg.OIDC.ClientSecret != "secret" {
+		t.Errorf("OIDC client secret not kept: %q", cfg.OIDC.ClientSecret)
+	}
+	if cfg.Webhooks[0].Secret != "secret" || cfg.Webhooks[1].Secret != "removedsecret" {
+		t.Errorf("unexpected webhook secrets %+v", cfg.Webhooks)
+	}
+	if pw := cfg.Folders[0].Devices[0].EncryptionPassword; pw != "password" {
+		t.Errorf("encryption password not kept: %q", pw)
+	}
+	if old.GUI.Password != "oldpassword" || old.Folders[0].Devices[0].EncryptionPassword != "oldpassword" {
+		t.Error("revision modified")
+	}
+}
+
+func TestConfigHistory(t *testing.T) {
+	t.Parallel()
+
+	tmpFile, err := os.CreateTemp("", "syncthing-testConfig-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+	tmpFile.Close()
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.GUI.RawAddress = "127.0.0.1:0"
+	cfg.GUI.APIKey = testAPIKey
+	cfg.GUI.APIKeys = []config.APIKeyConfiguration{{Name: "revoked", Key: "revokedkey"}}
+	cfg.Folders = []config.FolderConfiguration{cfg.Defaults.Folder.Copy()}
+	cfg.Folders[0].ID = "history"
+	cfg.Folders[0].Path = "history"
+	w := config.Wrap(tmpFile.Name(), cfg, protocol.LocalDeviceID, events.NoopLogger)
+	cfgCtx, cfgCancel := context.WithCancel(context.Background())
+	go w.Serve(cfgCtx)
+	defer cfgCancel()
+	w.SetHistory(config.NewHistory(t.TempDir(), protocol.LocalDeviceID))
+	baseURL, cancel, err := startHTTP(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	request := func(method, path, body string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, baseURL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("X-API-Key", testAPIKey)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+	revisions := func() []config.Revision {
+		t.Helper()
+		var revisions []config.Revision
+		if err := json.NewDecoder(request(http.MethodGet, "/rest/config/history", "").Body).Decode(&revisions); err != nil {
+			t.Fatal(err)
+		}
+		return revisions
+	}
+
+	if resp := request(http.MethodPatch, "/rest/config/folders/history", `{"paused": true}`); resp.StatusCode != http.StatusOK {
+		t.Fatalf("pausing folder: %s", resp.Status)
+	}
+	if revs := revisions(); len(revs) != 2 || revs[0].Origin != config.OriginStartup || revs[1].Origin != "api:apikey" {
+		t.Fatalf("unexpected revisions %+v", revs)
+	}
+
+	var changes []config.Change
+	if err := json.NewDecoder(request(http.MethodGet, "/rest/config/history/1/diff", "").Body).Decode(&changes); err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Path != "folders[history].paused" || changes[0].From != false || changes[0].To != true {
+		t.Errorf("unexpected changes %+v", changes)
+	}
+
+	if resp := request(http.MethodPost, "/rest/config/history/1/rollback", ""); resp.StatusCode != http.StatusOK {
+		t.Fatalf("rolling back: %s", resp.Status)
+	}
+	if folder, _ := w.Folder("history"); folder.Paused {
+		t.Error("folder still paused after rolling back")
+	}
+	if revs := revisions(); len(revs) != 3 || revs[2].Origin != "api:apikey (rollback to 1)" {
+		t.Errorf("unexpected revisions after rollback %+v", revs)
+	}
+
+	if resp := request(http.MethodDelete, "/rest/config/folders/history", ""); resp.StatusCode != http.StatusOK {
+		t.Fatalf("removing folder: %s", resp.Status)
+	}
+	if revs := revisions(); len(revs) != 4 || revs[3].Origin != "api:apikey" {
+		t.Errorf("unexpected revisions after removing folder %+v", revs)
+	}
+
+	// API keys revoked since a revision stay revoked when rolling back to
+	// it.
+	waiter, err := w.Modify(func(cfg *config.Configuration) {
+		cfg.GUI.APIKeys = nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter.Wait()
+	if resp := request(http.MethodPost, "/rest/config/history/1/rollback", ""); resp.StatusCode != http.StatusOK {
+		t.Fatalf("rolling back: %s", resp.Status)
+	}
+	if _, ok := w.Folder("history"); !ok {
+		t.Error("folder missing after rolling back")
+	}
+	if keys := w.GUI().APIKeys; len(keys) != 0 {
+		t.Errorf("revoked API keys restored by rollback: %+v", keys)
+	}
+
+	if resp := request(http.MethodGet, "/rest/config/history/9", ""); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unknown revision: %s", resp.Status)
+	}
+}
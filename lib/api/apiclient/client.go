@@ -0,0 +1,127 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package apiclient is a client for the Syncthing REST API, as described in
+// lib/api/openapi.yaml.
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrNotFound matches the errors for responses with status 404, for
+// objects or endpoints that don't exist.
+var ErrNotFound = errors.New("not found")
+
+// An Error is returned for responses with a status other than 200.
+type Error struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *Error) Error() string {
+	if e.StatusCode == http.StatusUnauthorized {
+		return "invalid API key"
+	}
+	if e.Body == "" {
+		return fmt.Sprintf("unexpected HTTP status returned: %s", e.Status)
+	}
+	return fmt.Sprintf("unexpected HTTP status returned: %s\n%s", e.Status, e.Body)
+}
+
+func (e *Error) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// A Client makes requests to the REST API of a Syncthing instance.
+type Client struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+// New returns a client for the REST API at the given base URL, such as
+// "http://127.0.0.1:8384", authenticating with the API key. The HTTP
+// client is the default one if nil.
+func New(baseURL, apiKey string, client *http.Client) *Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		apiKey:  apiKey,
+		client:  client,
+	}
+}
+
+// Do makes a request to the endpoint with the given path below /rest/, such
+// as "system/status". The body is sent as JSON unless nil, and the response
+// is decoded into result unless nil.
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, body, result interface{}) error {
+	var r io.Reader
+	if body != nil {
+		bs, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(bs)
+	}
+	resp, err := c.Raw(ctx, method, path, query, r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if result == nil {
+		_, err = io.Copy(io.Discard, resp.Body)
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// Raw makes a request like Do and returns the response, unless its status
+// is other than 200. The caller must close the response body.
+func (c *Client) Raw(ctx context.Context, method, path string, query url.Values, body io.Reader) (*http.Response, error) {
+	u := c.baseURL + "/rest/" + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-API-Key", c.apiKey)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		bs, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, &Error{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Body:       strings.TrimSpace(string(bs)),
+		}
+	}
+	return resp, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, result interface{}) error {
+	return c.Do(ctx, http.MethodGet, path, query, nil, result)
+}
+
+func (c *Client) post(ctx context.Context, path string, query url.Values, body interface{}) error {
+	return c.Do(ctx, http.MethodPost, path, query, body, nil)
+}
@@ -0,0 +1,281 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/logger"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+type openAPISpec struct {
+	Paths      map[string]openAPIPath `yaml:"paths"`
+	Components struct {
+		Parameters map[string]openAPIParameter `yaml:"parameters"`
+		Schemas    map[string]openAPISchema    `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+type openAPIPath struct {
+	Parameters []openAPIParameter          `yaml:"parameters"`
+	Operations map[string]openAPIOperation `yaml:",inline"`
+}
+
+type openAPIOperation struct {
+	Parameters []openAPIParameter `yaml:"parameters"`
+}
+
+type openAPIParameter struct {
+	Ref  string `yaml:"$ref"`
+	Name string `yaml:"name"`
+	In   string `yaml:"in"`
+}
+
+type openAPISchema struct {
+	Ref        string                   `yaml:"$ref"`
+	AllOf      []openAPISchema          `yaml:"allOf"`
+	Properties map[string]openAPISchema `yaml:"properties"`
+}
+
+func loadSpec(t *testing.T) openAPISpec {
+	t.Helper()
+	bs, err := os.ReadFile("../openapi.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec openAPISpec
+	if err := yaml.Unmarshal(bs, &spec); err != nil {
+		t.Fatal(err)
+	}
+	return spec
+}
+
+// queryParameters returns the names of the query parameters of the
+// operation, or false if the spec has no such operation.
+func (s openAPISpec) queryParameters(method, path string) (map[string]bool, bool) {
+	for template, item := range s.Paths {
+		exp := regexp.MustCompile("^" + regexp.MustCompile(`\\\{\w+\\\}`).ReplaceAllString(regexp.QuoteMeta(template), `[^/]+`) + "$")
+		if !exp.MatchString(path) {
+			continue
+		}
+		op, ok := item.Operations[strings.ToLower(method)]
+		if !ok {
+			continue
+		}
+		names := make(map[string]bool)
+		for _, param := range append(item.Parameters, op.Parameters...) {
+			if ref, ok := strings.CutPrefix(param.Ref, "#/components/parameters/"); ok {
+				param = s.Components.Parameters[ref]
+			}
+			if param.In == "query" {
+				names[param.Name] = true
+			}
+		}
+		return names, true
+	}
+	return nil, false
+}
+
+func (s openAPISpec) properties(schema openAPISchema) []string {
+	if ref, ok := strings.CutPrefix(schema.Ref, "#/components/schemas/"); ok {
+		return s.properties(s.Components.Schemas[ref])
+	}
+	var names []string
+	for _, sub := range schema.AllOf {
+		names = append(names, s.properties(sub)...)
+	}
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func jsonFields(t reflect.Type) []string {
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch {
+		case name == "-" || !field.IsExported():
+		case field.Anonymous && name == "":
+			names = append(names, jsonFields(field.Type)...)
+		case name == "":
+			names = append(names, field.Name)
+		default:
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TestEndpointsDescribed makes every request of the client and checks that
+// the spec describes the endpoint and query parameters.
+func TestEndpointsDescribed(t *testing.T) {
+	t.Parallel()
+
+	spec := loadSpec(t)
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("X-API-Key") != "key" {
+			t.Errorf("%s %s: missing API key", r.Method, r.URL.Path)
+		}
+		params, ok := spec.queryParameters(r.Method, r.URL.Path)
+		if !ok {
+			t.Errorf("%s %s is not described in openapi.yaml", r.Method, r.URL.Path)
+		}
+		for name := range r.URL.Query() {
+			if !params[name] {
+				t.Errorf("%s %s: query parameter %q is not described in openapi.yaml", r.Method, r.URL.Path, name)
+			}
+		}
+		w.Write([]byte("null"))
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL, "key", nil)
+	ctx := context.Background()
+	device := protocol.LocalDeviceID
+	calls := []func() error{
+		func() error { return c.Ping(ctx) },
+		func() error { _, err := c.SystemStatus(ctx); return err },
+		func() error { _, err := c.SystemVersion(ctx); return err },
+		func() error { return c.Restart(ctx) },
+		func() error { return c.Shutdown(ctx) },
+		func() error { return c.Upgrade(ctx) },
+		func() error { _, err := c.Errors(ctx); return err },
+		func() error { return c.PushError(ctx, "message") },
+		func() error { return c.ClearErrors(ctx) },
+		func() error { _, err := c.Config(ctx); return err },
+		func() error { return c.SetConfig(ctx, config.Configuration{}) },
+		func() error { _, err := c.RestartRequired(ctx); return err },
+		func() error { _, err := c.Folder(ctx, "a b"); return err },
+		func() error { return c.AddFolder(ctx, map[string]string{"id": "a"}) },
+		func() error { return c.PatchFolder(ctx, "a", map[string]string{"label": "b"}) },
+		func() error { return c.RemoveFolder(ctx, "a") },
+		func() error { _, err := c.Device(ctx, device); return err },
+		func() error { return c.AddDevice(ctx, map[string]string{"deviceID": device.String()}) },
+		func() error { return c.PatchDevice(ctx, device, map[string]string{"name": "b"}) },
+		func() error { return c.RemoveDevice(ctx, device) },
+		func() error { _, err := c.DefaultIgnores(ctx); return err },
+		func() error { return c.SetDefaultIgnores(ctx, config.Ignores{}) },
+		func() error { return c.PatchOptions(ctx, map[string]bool{"natEnabled": false}) },
+		func() error { return c.PatchLDAP(ctx, map[string]string{"address": "a"}) },
+		func() error { return c.PatchGUI(ctx, map[string]string{"theme": "dark"}) },
+		func() error { _, err := c.APIKeys(ctx); return err },
+		func() error { _, err := c.AddAPIKey(ctx, config.APIKeyConfiguration{Name: "a"}); return err },
+		func() error { return c.RevokeAPIKey(ctx, "a") },
+		func() error { _, err := c.ConfigHistory(ctx); return err },
+		func() error { _, err := c.ConfigRevision(ctx, 1); return err },
+		func() error { _, err := c.ConfigRevisionDiff(ctx, 1, 2); return err },
+		func() error { return c.RollbackConfig(ctx, 1) },
+		func() error { _, err := c.PendingDevices(ctx); return err },
+		func() error { _, err := c.PendingFolders(ctx, device); return err },
+		func() error { return c.ScanFolder(ctx, "a", "b") },
+		func() error { return c.OverrideFolder(ctx, "a") },
+		func() error { return c.RevertFolder(ctx, "a") },
+		func() error {
+			_, err := c.EventHistory(ctx, EventQuery{
+				Since:   time.Now().Add(-time.Hour),
+				Until:   time.Now(),
+				Types:   []string{"ConfigSaved"},
+				Folders: []string{"a"},
+				Devices: []string{device.String()},
+				Limit:   10,
+			})
+			return err
+		},
+	}
+	for _, call := range calls {
+		if err := call(); err != nil {
+			t.Error(err)
+		}
+	}
+	if n := int(requests.Load()); n != len(calls) {
+		t.Errorf("made %d requests for %d calls", n, len(calls))
+	}
+}
+
+// TestSchemas checks that the types used by the client have the fields of
+// the schemas in the spec, and no others.
+func TestSchemas(t *testing.T) {
+	t.Parallel()
+
+	spec := loadSpec(t)
+	types := map[string]interface{}{
+		"APIKey":              APIKey{},
+		"APIKeyConfiguration": config.APIKeyConfiguration{},
+		"Change":              config.Change{},
+		"Event":               events.Event{},
+		"Ignores":             config.Ignores{},
+		"LogLine":             logger.Line{},
+		"ObservedDevice":      db.ObservedDevice{},
+		"ObservedFolder":      db.ObservedFolder{},
+		"PendingFolder":       db.PendingFolder{},
+		"Revision":            config.Revision{},
+		"SystemStatus":        SystemStatus{},
+		"SystemVersion":       SystemVersion{},
+	}
+	for name, v := range types {
+		schema, ok := spec.Components.Schemas[name]
+		if !ok {
+			t.Errorf("schema %s is not in openapi.yaml", name)
+			continue
+		}
+		if got, want := jsonFields(reflect.TypeOf(v)), spec.properties(schema); !reflect.DeepEqual(got, want) {
+			t.Errorf("%T has the fields %v, but schema %s has %v", v, got, name, want)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/config/folders/missing":
+			http.Error(w, "No folder with given ID", http.StatusNotFound)
+		default:
+			http.Error(w, "Not Authorized", http.StatusUnauthorized)
+		}
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL+"/", "key", nil)
+	_, err := c.Folder(context.Background(), "missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Body != "No folder with given ID" {
+		t.Errorf("unexpected error %#v", err)
+	}
+
+	err = c.Ping(context.Background())
+	if errors.Is(err, ErrNotFound) || err == nil || err.Error() != "invalid API key" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// The fields to change are given to the Patch methods as any value that
+// encodes to the JSON object of them, such as a map, leaving the others
+// as they are.
+
+func (c *Client) Config(ctx context.Context) (config.Configuration, error) {
+	var cfg config.Configuration
+	err := c.get(ctx, "config", nil, &cfg)
+	return cfg, err
+}
+
+// SetConfig replaces the whole configuration.
+func (c *Client) SetConfig(ctx context.Context, cfg config.Configuration) error {
+	return c.Do(ctx, http.MethodPut, "config", nil, cfg, nil)
+}
+
+// RestartRequired returns whether a restart is required for configuration
+// changes to take effect.
+func (c *Client) RestartRequired(ctx context.Context) (bool, error) {
+	var res struct {
+		RequiresRestart bool `json:"requiresRestart"`
+	}
+	err := c.get(ctx, "config/restart-required", nil, &res)
+	return res.RequiresRestart, err
+}
+
+func (c *Client) Folder(ctx context.Context, id string) (config.FolderConfiguration, error) {
+	var folder config.FolderConfiguration
+	err := c.get(ctx, "config/folders/"+url.PathEscape(id), nil, &folder)
+	return folder, err
+}
+
+// AddFolder adds or replaces the folder, with defaults for the fields not
+// set in fields.
+func (c *Client) AddFolder(ctx context.Context, fields interface{}) error {
+	return c.post(ctx, "config/folders", nil, fields)
+}
+
+func (c *Client) PatchFolder(ctx context.Context, id string, fields interface{}) error {
+	return c.Do(ctx, http.MethodPatch, "config/folders/"+url.PathEscape(id), nil, fields, nil)
+}
+
+func (c *Client) RemoveFolder(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, "config/folders/"+url.PathEscape(id), nil, nil, nil)
+}
+
+func (c *Client) Device(ctx context.Context, id protocol.DeviceID) (config.DeviceConfiguration, error) {
+	var device config.DeviceConfiguration
+	err := c.get(ctx, "config/devices/"+id.String(), nil, &device)
+	return device, err
+}
+
+// AddDevice adds or replaces the device, with defaults for the fields not
+// set in fields.
+func (c *Client) AddDevice(ctx context.Context, fields interface{}) error {
+	return c.post(ctx, "config/devices", nil, fields)
+}
+
+func (c *Client) PatchDevice(ctx context.Context, id protocol.DeviceID, fields interface{}) error {
+	return c.Do(ctx, http.MethodPatch, "config/devices/"+id.String(), nil, fields, nil)
+}
+
+func (c *Client) RemoveDevice(ctx context.Context, id protocol.DeviceID) error {
+	return c.Do(ctx, http.MethodDelete, "config/devices/"+id.String(), nil, nil, nil)
+}
+
+func (c *Client) DefaultIgnores(ctx context.Context) (config.Ignores, error) {
+	var ignores config.Ignores
+	err := c.get(ctx, "config/defaults/ignores", nil, &ignores)
+	return ignores, err
+}
+
+func (c *Client) SetDefaultIgnores(ctx context.Context, ignores config.Ignores) error {
+	return c.Do(ctx, http.MethodPut, "config/defaults/ignores", nil, ignores, nil)
+}
+
+func (c *Client) PatchOptions(ctx context.Context, fields interface{}) error {
+	return c.Do(ctx, http.MethodPatch, "config/options", nil, fields, nil)
+}
+
+func (c *Client) PatchLDAP(ctx context.Context, fields interface{}) error {
+	return c.Do(ctx, http.MethodPatch, "config/ldap", nil, fields, nil)
+}
+
+// PatchGUI changes the GUI settings, which may restart the REST API.
+func (c *Client) PatchGUI(ctx context.Context, fields interface{}) error {
+	return c.Do(ctx, http.MethodPatch, "config/gui", nil, fields, nil)
+}
+
+// An APIKey is a named API key, as returned by the REST API.
+type APIKey struct {
+	config.APIKeyConfiguration
+	LastUsed *time.Time `json:"lastUsed"`
+}
+
+func (c *Client) APIKeys(ctx context.Context) ([]APIKey, error) {
+	var keys []APIKey
+	err := c.get(ctx, "config/gui/apikeys", nil, &keys)
+	return keys, err
+}
+
+// AddAPIKey adds the named API key and returns it, including the key that
+// is generated if none is given.
+func (c *Client) AddAPIKey(ctx context.Context, key config.APIKeyConfiguration) (APIKey, error) {
+	var res APIKey
+	err := c.Do(ctx, http.MethodPost, "config/gui/apikeys", nil, key, &res)
+	return res, err
+}
+
+func (c *Client) RevokeAPIKey(ctx context.Context, name string) error {
+	return c.Do(ctx, http.MethodDelete, "config/gui/apikeys/"+url.PathEscape(name), nil, nil, nil)
+}
+
+// ConfigHistory returns the revisions in the configuration history, oldest
+// first.
+func (c *Client) ConfigHistory(ctx context.Context) ([]config.Revision, error) {
+	var revisions []config.Revision
+	err := c.get(ctx, "config/history", nil, &revisions)
+	return revisions, err
+}
+
+func (c *Client) ConfigRevision(ctx context.Context, number int) (config.Configuration, error) {
+	var cfg config.Configuration
+	err := c.get(ctx, "config/history/"+strconv.Itoa(number), nil, &cfg)
+	return cfg, err
+}
+
+// ConfigRevisionDiff returns the changes from the revision to the revision
+// numbered to, or to the current configuration if to is zero.
+func (c *Client) ConfigRevisionDiff(ctx context.Context, number, to int) ([]config.Change, error) {
+	query := make(url.Values)
+	if to != 0 {
+		query.Set("to", strconv.Itoa(to))
+	}
+	var changes []config.Change
+	err := c.get(ctx, "config/history/"+strconv.Itoa(number)+"/diff", query, &changes)
+	return changes, err
+}
+
+// RollbackConfig replaces the configuration with that of the revision.
+func (c *Client) RollbackConfig(ctx context.Context, number int) error {
+	return c.post(ctx, "config/history/"+strconv.Itoa(number)+"/rollback", nil, nil)
+}
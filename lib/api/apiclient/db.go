@@ -0,0 +1,95 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package apiclient
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// PendingDevices returns the devices that tried to connect and are not
+// added.
+func (c *Client) PendingDevices(ctx context.Context) (map[protocol.DeviceID]db.ObservedDevice, error) {
+	var devices map[protocol.DeviceID]db.ObservedDevice
+	err := c.get(ctx, "cluster/pending/devices", nil, &devices)
+	return devices, err
+}
+
+// PendingFolders returns the folders offered by other devices, or by the
+// given device unless empty, and not added.
+func (c *Client) PendingFolders(ctx context.Context, device protocol.DeviceID) (map[string]db.PendingFolder, error) {
+	query := make(url.Values)
+	if device != protocol.EmptyDeviceID {
+		query.Set("device", device.String())
+	}
+	var folders map[string]db.PendingFolder
+	err := c.get(ctx, "cluster/pending/folders", query, &folders)
+	return folders, err
+}
+
+// ScanFolder scans the folder, only the given paths in it unless none are
+// given.
+func (c *Client) ScanFolder(ctx context.Context, folder string, subs ...string) error {
+	query := url.Values{"folder": {folder}}
+	if len(subs) > 0 {
+		query["sub"] = subs
+	}
+	return c.post(ctx, "db/scan", query, nil)
+}
+
+// OverrideFolder overrides the remote changes to a send only folder.
+func (c *Client) OverrideFolder(ctx context.Context, folder string) error {
+	return c.post(ctx, "db/override", url.Values{"folder": {folder}}, nil)
+}
+
+// RevertFolder reverts the local changes to a receive only folder.
+func (c *Client) RevertFolder(ctx context.Context, folder string) error {
+	return c.post(ctx, "db/revert", url.Values{"folder": {folder}}, nil)
+}
+
+// An EventQuery selects events from the event journal. The zero value of
+// each field selects all events.
+type EventQuery struct {
+	Since, Until time.Time
+	Types        []string
+	Folders      []string
+	Devices      []string
+	Limit        int // of the most recent events
+}
+
+// EventHistory returns the events from the event journal, oldest first.
+func (c *Client) EventHistory(ctx context.Context, q EventQuery) ([]events.Event, error) {
+	query := make(url.Values)
+	if !q.Since.IsZero() {
+		query.Set("since", q.Since.Format(time.RFC3339))
+	}
+	if !q.Until.IsZero() {
+		query.Set("until", q.Until.Format(time.RFC3339))
+	}
+	if len(q.Types) > 0 {
+		query.Set("events", strings.Join(q.Types, ","))
+	}
+	if len(q.Folders) > 0 {
+		query.Set("folder", strings.Join(q.Folders, ","))
+	}
+	if len(q.Devices) > 0 {
+		query.Set("device", strings.Join(q.Devices, ","))
+	}
+	if q.Limit > 0 {
+		query.Set("limit", strconv.Itoa(q.Limit))
+	}
+	var evs []events.Event
+	err := c.get(ctx, "events/history", query, &evs)
+	return evs, err
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/connections"
+	"github.com/syncthing/syncthing/lib/logger"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// SystemStatus is the response of GET /rest/system/status.
+type SystemStatus struct {
+	MyID                    protocol.DeviceID                            `json:"myID"`
+	Goroutines              int                                          `json:"goroutines"`
+	Alloc                   uint64                                       `json:"alloc"`
+	Sys                     uint64                                       `json:"sys"`
+	Tilde                   string                                       `json:"tilde"`
+	DiscoveryEnabled        bool                                         `json:"discoveryEnabled,omitempty"`
+	DiscoveryStatus         map[string]DiscoveryStatus                   `json:"discoveryStatus,omitempty"`
+	DiscoveryMethods        int                                          `json:"discoveryMethods,omitempty"` // deprecated
+	DiscoveryErrors         map[string]*string                           `json:"discoveryErrors,omitempty"`  // deprecated
+	ConnectionServiceStatus map[string]connections.ListenerStatusEntry   `json:"connectionServiceStatus"`
+	LastDialStatus          map[string]connections.ConnectionStatusEntry `json:"lastDialStatus"`
+	CPUPercent              float64                                      `json:"cpuPercent"` // deprecated
+	PathSeparator           string                                       `json:"pathSeparator"`
+	URVersionMax            int                                          `json:"urVersionMax"`
+	Uptime                  int                                          `json:"uptime"`
+	StartTime               time.Time                                    `json:"startTime"`
+	GUIAddressOverridden    bool                                         `json:"guiAddressOverridden"`
+	GUIAddressUsed          string                                       `json:"guiAddressUsed"`
+}
+
+type DiscoveryStatus struct {
+	Error *string `json:"error"`
+}
+
+// SystemVersion is the response of GET /rest/system/version.
+type SystemVersion struct {
+	Version     string    `json:"version"`
+	Codename    string    `json:"codename"`
+	LongVersion string    `json:"longVersion"`
+	Extra       string    `json:"extra"`
+	OS          string    `json:"os"`
+	Arch        string    `json:"arch"`
+	IsBeta      bool      `json:"isBeta"`
+	IsCandidate bool      `json:"isCandidate"`
+	IsRelease   bool      `json:"isRelease"`
+	Date        time.Time `json:"date"`
+	Tags        []string  `json:"tags"`
+	Stamp       string    `json:"stamp"`
+	User        string    `json:"user"`
+	Container   bool      `json:"container"`
+}
+
+func (c *Client) Ping(ctx context.Context) error {
+	return c.get(ctx, "system/ping", nil, nil)
+}
+
+func (c *Client) SystemStatus(ctx context.Context) (SystemStatus, error) {
+	var status SystemStatus
+	err := c.get(ctx, "system/status", nil, &status)
+	return status, err
+}
+
+func (c *Client) SystemVersion(ctx context.Context) (SystemVersion, error) {
+	var version SystemVersion
+	err := c.get(ctx, "system/version", nil, &version)
+	return version, err
+}
+
+func (c *Client) Restart(ctx context.Context) error {
+	return c.post(ctx, "system/restart", nil, nil)
+}
+
+func (c *Client) Shutdown(ctx context.Context) error {
+	return c.post(ctx, "system/shutdown", nil, nil)
+}
+
+// Upgrade upgrades to the latest release, if newer, and restarts.
+func (c *Client) Upgrade(ctx context.Context) error {
+	return c.post(ctx, "system/upgrade", nil, nil)
+}
+
+// Errors returns the recent errors, as shown in the GUI.
+func (c *Client) Errors(ctx context.Context) ([]logger.Line, error) {
+	var res struct {
+		Errors []logger.Line `json:"errors"`
+	}
+	err := c.get(ctx, "system/error", nil, &res)
+	return res.Errors, err
+}
+
+// PushError logs the message as an error, showing it in the GUI.
+func (c *Client) PushError(ctx context.Context, message string) error {
+	resp, err := c.Raw(ctx, http.MethodPost, "system/error", nil, strings.NewReader(message))
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (c *Client) ClearErrors(ctx context.Context) error {
+	return c.post(ctx, "system/error/clear", nil, nil)
+}
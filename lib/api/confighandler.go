@@ -19,14 +19,15 @@ import (
 )
 
 type configMuxBuilder struct {
-	*httprouter.Router
-	id  protocol.DeviceID
-	cfg config.Wrapper
+	*restRouter
+	id      protocol.DeviceID
+	cfg     config.Wrapper
+	apiKeys *apiKeyManager
 }
 
 func (c *configMuxBuilder) registerConfig(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.RawCopy())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, filterConfigForAccount(c.cfg.RawCopy(), accountFromRequest(r)))
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -35,8 +36,8 @@ func (c *configMuxBuilder) registerConfig(path string) {
 }
 
 func (c *configMuxBuilder) registerConfigDeprecated(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.RawCopy())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, filterConfigForAccount(c.cfg.RawCopy(), accountFromRequest(r)))
 	})
 
 	c.HandlerFunc(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
@@ -57,8 +58,8 @@ func (c *configMuxBuilder) registerConfigRequiresRestart(path string) {
 }
 
 func (c *configMuxBuilder) registerFolders(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.FolderList())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, filterFoldersForAccount(c.cfg.FolderList(), accountFromRequest(r)))
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -76,7 +77,7 @@ func (c *configMuxBuilder) registerFolders(path string) {
 				return
 			}
 		}
-		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 			cfg.SetFolders(folders)
 		})
 		if err != nil {
@@ -92,8 +93,12 @@ func (c *configMuxBuilder) registerFolders(path string) {
 }
 
 func (c *configMuxBuilder) registerDevices(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.DeviceList())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		devices := c.cfg.DeviceList()
+		for i := range devices {
+			devices[i] = filterDeviceForAccount(devices[i], accountFromRequest(r))
+		}
+		sendJSON(w, devices)
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -111,7 +116,7 @@ func (c *configMuxBuilder) registerDevices(path string) {
 				return
 			}
 		}
-		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 			cfg.SetDevices(devices)
 		})
 		if err != nil {
@@ -127,13 +132,13 @@ func (c *configMuxBuilder) registerDevices(path string) {
 }
 
 func (c *configMuxBuilder) registerFolder(path string) {
-	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		folder, ok := c.cfg.Folder(p.ByName("id"))
 		if !ok {
 			http.Error(w, "No folder with given ID", http.StatusNotFound)
 			return
 		}
-		sendJSON(w, folder)
+		sendJSON(w, filterFolderForAccount(folder, accountFromRequest(r)))
 	})
 
 	c.Handle(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
@@ -149,12 +154,13 @@ func (c *configMuxBuilder) registerFolder(path string) {
 		c.adjustFolder(w, r, folder, false)
 	})
 
-	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
-		waiter, err := c.cfg.RemoveFolder(p.ByName("id"))
+	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		waiter, err := c.cfg.RemoveFolderFrom(requestOrigin(r, c.cfg), p.ByName("id"))
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
+		recordConfigChanges(r, waiter)
 		c.finish(w, waiter)
 	})
 }
@@ -174,9 +180,9 @@ func (c *configMuxBuilder) registerDevice(path string) {
 		return device, true
 	}
 
-	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+	c.Handle(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		if device, ok := deviceFromParams(w, p); ok {
-			sendJSON(w, device)
+			sendJSON(w, filterDeviceForAccount(device, accountFromRequest(r)))
 		}
 	})
 
@@ -190,17 +196,18 @@ func (c *configMuxBuilder) registerDevice(path string) {
 		}
 	})
 
-	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, _ *http.Request, p httprouter.Params) {
+	c.Handle(http.MethodDelete, path, func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		id, err := protocol.DeviceIDFromString(p.ByName("id"))
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		waiter, err := c.cfg.RemoveDevice(id)
+		waiter, err := c.cfg.RemoveDeviceFrom(requestOrigin(r, c.cfg), id)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
+		recordConfigChanges(r, waiter)
 		c.finish(w, waiter)
 	})
 }
@@ -222,8 +229,8 @@ func (c *configMuxBuilder) registerDefaultFolder(path string) {
 }
 
 func (c *configMuxBuilder) registerDefaultDevice(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.DefaultDevice())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, filterDeviceForAccount(c.cfg.DefaultDevice(), accountFromRequest(r)))
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -248,7 +255,7 @@ func (c *configMuxBuilder) registerDefaultIgnores(path string) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+		waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 			cfg.Defaults.Ignores = ignores
 		})
 		if err != nil {
@@ -260,8 +267,8 @@ func (c *configMuxBuilder) registerDefaultIgnores(path string) {
 }
 
 func (c *configMuxBuilder) registerOptions(path string) {
-	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
-		sendJSON(w, c.cfg.Options())
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		sendJSON(w, filterOptionsForAccount(c.cfg.Options(), accountFromRequest(r)))
 	})
 
 	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
@@ -291,6 +298,38 @@ func (c *configMuxBuilder) registerLDAP(path string) {
 	})
 }
 
+func (c *configMuxBuilder) registerOIDC(path string) {
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
+		sendJSON(w, c.cfg.OIDC())
+	})
+
+	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
+		var cfg config.OIDCConfiguration
+		structutil.SetDefaults(&cfg)
+		c.adjustOIDC(w, r, cfg)
+	})
+
+	c.HandlerFunc(http.MethodPatch, path, func(w http.ResponseWriter, r *http.Request) {
+		c.adjustOIDC(w, r, c.cfg.OIDC())
+	})
+}
+
+func (c *configMuxBuilder) registerOrganization(path string) {
+	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
+		sendJSON(w, c.cfg.Organization())
+	})
+
+	c.HandlerFunc(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
+		var cfg config.OrganizationConfiguration
+		structutil.SetDefaults(&cfg)
+		c.adjustOrganization(w, r, cfg)
+	})
+
+	c.HandlerFunc(http.MethodPatch, path, func(w http.ResponseWriter, r *http.Request) {
+		c.adjustOrganization(w, r, c.cfg.Organization())
+	})
+}
+
 func (c *configMuxBuilder) registerGUI(path string) {
 	c.HandlerFunc(http.MethodGet, path, func(w http.ResponseWriter, _ *http.Request) {
 		sendJSON(w, c.cfg.GUI())
@@ -317,7 +356,7 @@ func (c *configMuxBuilder) adjustConfig(w http.ResponseWriter, r *http.Request)
 	}
 	var errMsg string
 	var status int
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if to.GUI.Password != cfg.GUI.Password {
 			if err := to.GUI.SetPassword(to.GUI.Password); err != nil {
 				l.Warnln("hashing password:", err)
@@ -342,7 +381,7 @@ func (c *configMuxBuilder) adjustFolder(w http.ResponseWriter, r *http.Request,
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if defaults {
 			cfg.Defaults.Folder = folder
 		} else {
@@ -361,7 +400,7 @@ func (c *configMuxBuilder) adjustDevice(w http.ResponseWriter, r *http.Request,
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if defaults {
 			cfg.Defaults.Device = device
 		} else {
@@ -380,7 +419,7 @@ func (c *configMuxBuilder) adjustOptions(w http.ResponseWriter, r *http.Request,
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		cfg.Options = opts
 	})
 	if err != nil {
@@ -399,7 +438,7 @@ func (c *configMuxBuilder) adjustGUI(w http.ResponseWriter, r *http.Request, gui
 	}
 	var errMsg string
 	var status int
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		if gui.Password != oldPassword {
 			if err := gui.SetPassword(gui.Password); err != nil {
 				l.Warnln("hashing password:", err)
@@ -424,7 +463,7 @@ func (c *configMuxBuilder) adjustLDAP(w http.ResponseWriter, r *http.Request, ld
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	waiter, err := c.cfg.Modify(func(cfg *config.Configuration) {
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
 		cfg.LDAP = ldap
 	})
 	if err != nil {
@@ -434,6 +473,42 @@ func (c *configMuxBuilder) adjustLDAP(w http.ResponseWriter, r *http.Request, ld
 	c.finish(w, waiter)
 }
 
+func (c *configMuxBuilder) adjustOIDC(w http.ResponseWriter, r *http.Request, oidc config.OIDCConfiguration) {
+	if err := unmarshalTo(r.Body, &oidc); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
+		cfg.OIDC = oidc
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	c.finish(w, waiter)
+}
+
+func (c *configMuxBuilder) adjustOrganization(w http.ResponseWriter, r *http.Request, org config.OrganizationConfiguration) {
+	if err := unmarshalTo(r.Body, &org); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if org.Enabled {
+		if _, err := org.CertPool(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	waiter, err := modifyConfig(r, c.cfg, func(cfg *config.Configuration) {
+		cfg.Organization = org
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	c.finish(w, waiter)
+}
+
 // Unmarshals the content of the given body and stores it in to (i.e. to must be a pointer).
 func unmarshalTo(body io.ReadCloser, to interface{}) error {
 	bs, err := io.ReadAll(body)
@@ -17,6 +17,9 @@ import (
 func getRedactedConfig(s *service) config.Configuration {
 	rawConf := s.cfg.RawCopy()
 	rawConf.GUI.APIKey = "REDACTED"
+	for i := range rawConf.GUI.APIKeys {
+		rawConf.GUI.APIKeys[i].Key = "REDACTED"
+	}
 	if rawConf.GUI.Password != "" {
 		rawConf.GUI.Password = "REDACTED"
 	}
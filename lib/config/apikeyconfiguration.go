@@ -0,0 +1,37 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"errors"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/syncthing/syncthing/lib/rand"
+)
+
+func (k APIKeyConfiguration) Copy() APIKeyConfiguration {
+	k.Endpoints = slices.Clone(k.Endpoints)
+	k.Folders = slices.Clone(k.Folders)
+	return k
+}
+
+// IsExpired returns whether the key has expired at the given time.
+func (k APIKeyConfiguration) IsExpired(now time.Time) bool {
+	return !k.Expires.IsZero() && !now.Before(k.Expires)
+}
+
+func (k *APIKeyConfiguration) prepare() error {
+	if k.Name == "" {
+		return errors.New("API key without name")
+	}
+	if k.Key == "" {
+		k.Key = rand.String(32)
+	}
+	return nil
+}
@@ -0,0 +1,550 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/apikeyconfiguration.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A named API key, in addition to the API key set in the GUI configuration,
+// which always has full access.
+type APIKeyConfiguration struct {
+	Name string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name" xml:"name,attr"`
+	Key  string  `protobuf:"bytes,2,opt,name=key,proto3" json:"key" xml:"key"`
+	Role GUIRole `protobuf:"varint,3,opt,name=role,proto3,enum=config.GUIRole" json:"role" xml:"role,attr"`
+	// The endpoint groups the key is restricted to, such as "db" or
+	// "events"; empty for all endpoints the role allows.
+	Endpoints []string `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints" xml:"endpoint"`
+	// The folders the key is restricted to; empty for all folders.
+	Folders []string `protobuf:"bytes,5,rep,name=folders,proto3" json:"folders" xml:"folder"`
+	// The key is not valid after this time, unless zero.
+	Expires time.Time `protobuf:"bytes,6,opt,name=expires,proto3,stdtime" json:"expires" xml:"expires,omitempty"`
+}
+
+func (m *APIKeyConfiguration) Reset()         { *m = APIKeyConfiguration{} }
+func (m *APIKeyConfiguration) String() string { return proto.CompactTextString(m) }
+func (*APIKeyConfiguration) ProtoMessage()    {}
+func (*APIKeyConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2b74f42493cd723, []int{0}
+}
+func (m *APIKeyConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *APIKeyConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_APIKeyConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *APIKeyConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeyConfiguration.Merge(m, src)
+}
+func (m *APIKeyConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *APIKeyConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeyConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeyConfiguration proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*APIKeyConfiguration)(nil), "config.APIKeyConfiguration")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/apikeyconfiguration.proto", fileDescriptor_b2b74f42493cd723)
+}
+
+var fileDescriptor_b2b74f42493cd723 = []byte{
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0xb6, 0x76, 0xcd, 0x28, 0xbb, 0x18, 0x05, 0x43, 0x0f, 0x33, 0x25, 0x54, 0x89,
+	0xb8, 0x24, 0xb0, 0xde, 0x44, 0x10, 0xbb, 0x07, 0x29, 0x8b, 0x20, 0x41, 0x2f, 0xde, 0xd2, 0xee,
+	0x34, 0x3b, 0x34, 0xc9, 0x84, 0x64, 0x02, 0xcd, 0xb7, 0xd8, 0x8f, 0xe0, 0xc7, 0xe9, 0x6d, 0xeb,
+	0xcd, 0xd3, 0xc8, 0x6e, 0x6e, 0x39, 0xe6, 0x13, 0xc8, 0xcc, 0x34, 0x4d, 0xc1, 0xdb, 0xfb, 0xfd,
+	0xe7, 0xff, 0xfe, 0xef, 0xc1, 0x1b, 0x38, 0x8d, 0xe9, 0xc2, 0x5f, 0xb2, 0x74, 0x45, 0x23, 0x3f,
+	0xcc, 0xe8, 0x9a, 0x54, 0x1a, 0xca, 0x3c, 0xe4, 0x94, 0xa5, 0x5e, 0x96, 0x33, 0xce, 0xac, 0x91,
+	0x16, 0xc7, 0x38, 0x62, 0x2c, 0x8a, 0x89, 0xaf, 0xd4, 0x45, 0xb9, 0xf2, 0x39, 0x4d, 0x48, 0xc1,
+	0xc3, 0x24, 0xd3, 0xc6, 0xb1, 0x7d, 0x14, 0x17, 0x95, 0x34, 0x67, 0x31, 0xd9, 0xbf, 0x98, 0x64,
+	0xc3, 0x75, 0xe9, 0xfc, 0x1e, 0xc0, 0x17, 0x9f, 0xbf, 0xcd, 0xaf, 0x48, 0x75, 0x79, 0x3c, 0xcb,
+	0xfa, 0x08, 0x87, 0x69, 0x98, 0x10, 0x1b, 0x4c, 0x80, 0x6b, 0xce, 0xdc, 0x46, 0x60, 0xc5, 0xad,
+	0xc0, 0x67, 0x9b, 0x24, 0xfe, 0xe0, 0x48, 0x38, 0x0f, 0x39, 0xcf, 0x9d, 0xe6, 0x6e, 0x6a, 0x1e,
+	0x28, 0x50, 0x2e, 0xeb, 0x0d, 0x1c, 0xac, 0x49, 0x65, 0x3f, 0x52, 0xcd, 0x2f, 0x1b, 0x81, 0x25,
+	0xb6, 0x02, 0x9b, 0xaa, 0x77, 0x4d, 0x2a, 0x27, 0x90, 0x8a, 0xf5, 0x15, 0x0e, 0xe5, 0x5a, 0xf6,
+	0x60, 0x02, 0xdc, 0xd3, 0x8b, 0x33, 0x4f, 0x6f, 0xeb, 0x7d, 0xf9, 0x31, 0x0f, 0x58, 0x4c, 0xf4,
+	0x58, 0x69, 0x38, 0x8c, 0x95, 0xd0, 0x8f, 0x3d, 0x50, 0xa0, 0x5c, 0xd6, 0x1c, 0x9a, 0x24, 0xbd,
+	0xce, 0x18, 0x4d, 0x79, 0x61, 0x0f, 0x27, 0x03, 0xd7, 0x9c, 0xbd, 0x6b, 0x04, 0xee, 0xc5, 0x56,
+	0xe0, 0x53, 0x95, 0xd3, 0x29, 0x32, 0xe6, 0x49, 0x07, 0x41, 0x6f, 0xb4, 0x3e, 0xc1, 0x93, 0x15,
+	0x8b, 0xaf, 0x49, 0x5e, 0xd8, 0x8f, 0x55, 0xd0, 0xeb, 0x46, 0xe0, 0x4e, 0x6a, 0x05, 0x7e, 0xa6,
+	0x62, 0x34, 0xcb, 0x90, 0x91, 0x2e, 0x83, 0xce, 0x62, 0x55, 0xf0, 0x84, 0x6c, 0x32, 0x9a, 0x93,
+	0xc2, 0x1e, 0x4d, 0x80, 0xfb, 0xf4, 0x62, 0xec, 0xe9, 0x83, 0x79, 0xdd, 0xc1, 0xbc, 0xef, 0xdd,
+	0xc1, 0x66, 0x97, 0x5b, 0x81, 0x0d, 0x39, 0x60, 0xdf, 0xd2, 0x0a, 0xfc, 0x4a, 0xef, 0xa9, 0xf9,
+	0x9c, 0x25, 0x94, 0x93, 0x24, 0xe3, 0x95, 0x73, 0xfb, 0x17, 0x83, 0xe6, 0x6e, 0xfa, 0xfc, 0xbf,
+	0x97, 0xa0, 0x6b, 0x9e, 0x5d, 0x6d, 0xef, 0x91, 0xb1, 0xbb, 0x47, 0xc6, 0xf6, 0x01, 0x81, 0xdd,
+	0x03, 0x02, 0xb7, 0x35, 0x32, 0x7e, 0xd5, 0x08, 0xec, 0x6a, 0x64, 0xfc, 0xa9, 0x91, 0xf1, 0xf3,
+	0x6d, 0x44, 0xf9, 0x4d, 0xb9, 0xf0, 0x96, 0x2c, 0xf1, 0x8b, 0x2a, 0x5d, 0xf2, 0x1b, 0x9a, 0x46,
+	0x47, 0x55, 0xff, 0x73, 0x16, 0x23, 0xb5, 0xee, 0xfb, 0x7f, 0x03, 0x00, 0x99, 0x65, 0xad, 0x79,
+	0x9d, 0x02, 0x00, 0x00,
+}
+
+func (m *APIKeyConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIKeyConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *APIKeyConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expires, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expires):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintApikeyconfiguration(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Folders[iNdEx])
+			copy(dAtA[i:], m.Folders[iNdEx])
+			i = encodeVarintApikeyconfiguration(dAtA, i, uint64(len(m.Folders[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Endpoints[iNdEx])
+			copy(dAtA[i:], m.Endpoints[iNdEx])
+			i = encodeVarintApikeyconfiguration(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Role != 0 {
+		i = encodeVarintApikeyconfiguration(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintApikeyconfiguration(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApikeyconfiguration(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApikeyconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApikeyconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *APIKeyConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApikeyconfiguration(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovApikeyconfiguration(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovApikeyconfiguration(uint64(m.Role))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, s := range m.Endpoints {
+			l = len(s)
+			n += 1 + l + sovApikeyconfiguration(uint64(l))
+		}
+	}
+	if len(m.Folders) > 0 {
+		for _, s := range m.Folders {
+			l = len(s)
+			n += 1 + l + sovApikeyconfiguration(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expires)
+	n += 1 + l + sovApikeyconfiguration(uint64(l))
+	return n
+}
+
+func sovApikeyconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApikeyconfiguration(x uint64) (n int) {
+	return sovApikeyconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *APIKeyConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApikeyconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIKeyConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIKeyConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikeyconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApikeyconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikeyconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikeyconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApikeyconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikeyconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikeyconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= GUIRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikeyconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApikeyconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikeyconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikeyconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApikeyconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikeyconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApikeyconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApikeyconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApikeyconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expires, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApikeyconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApikeyconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipApikeyconfiguration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowApikeyconfiguration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApikeyconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApikeyconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthApikeyconfiguration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupApikeyconfiguration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthApikeyconfiguration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthApikeyconfiguration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowApikeyconfiguration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupApikeyconfiguration = fmt.Errorf("proto: unexpected end of group")
+)
@@ -221,8 +221,19 @@ func (cfg Configuration) Copy() Configuration {
 		newCfg.Devices[i] = cfg.Devices[i].Copy()
 	}
 
+	newCfg.Defaults.Folder = cfg.Defaults.Folder.Copy()
+	newCfg.Defaults.Device = cfg.Defaults.Device.Copy()
+	newCfg.Defaults.Ignores = cfg.Defaults.Ignores.Copy()
+
 	newCfg.Options = cfg.Options.Copy()
 	newCfg.GUI = cfg.GUI.Copy()
+	newCfg.Organization = cfg.Organization.Copy()
+	newCfg.OIDC = cfg.OIDC.Copy()
+
+	newCfg.Webhooks = make([]WebhookConfiguration, len(cfg.Webhooks))
+	for i := range newCfg.Webhooks {
+		newCfg.Webhooks[i] = cfg.Webhooks[i].Copy()
+	}
 
 	// DeviceIDs are values
 	newCfg.IgnoredDevices = make([]ObservedDevice, len(cfg.IgnoredDevices))
@@ -251,9 +262,19 @@ func (cfg *Configuration) prepare(myID protocol.DeviceID) error {
 		return err
 	}
 
-	cfg.GUI.prepare()
+	if err := cfg.prepareWebhooks(); err != nil {
+		return err
+	}
+
+	if err := cfg.prepareProxyURLs(); err != nil {
+		return err
+	}
+
+	if err := cfg.GUI.prepare(); err != nil {
+		return err
+	}
 
-	guiPWIsSet := cfg.GUI.User != "" && cfg.GUI.Password != ""
+	guiPWIsSet := (cfg.GUI.User != "" && cfg.GUI.Password != "") || len(cfg.GUI.Accounts) > 0
 	cfg.Options.prepare(guiPWIsSet)
 
 	cfg.prepareIgnoredDevices(existingDevices)
@@ -356,6 +377,21 @@ func (cfg *Configuration) prepareFolders(myID protocol.DeviceID, existingDevices
 	return sharedFolders, nil
 }
 
+func (cfg *Configuration) prepareWebhooks() error {
+	existing := make(map[string]struct{}, len(cfg.Webhooks))
+	for i := range cfg.Webhooks {
+		hook := &cfg.Webhooks[i]
+		if err := hook.prepare(); err != nil {
+			return err
+		}
+		if _, ok := existing[hook.ID]; ok {
+			return fmt.Errorf("webhook %q: %w", hook.ID, errWebhookIDDuplicate)
+		}
+		existing[hook.ID] = struct{}{}
+	}
+	return nil
+}
+
 func (cfg *Configuration) prepareDevices(sharedFolders map[protocol.DeviceID][]string) {
 	for i := range cfg.Devices {
 		cfg.Devices[i].prepare(sharedFolders[cfg.Devices[i].DeviceID])
@@ -438,6 +474,62 @@ func (cfg *Configuration) SetDevices(devices []DeviceConfiguration) {
 	cfg.Devices = append(cfg.Devices, filtered...)
 }
 
+// ReplaceDevice carries the configuration of the device with the old ID,
+// including its folder shares and the devices and folders it introduced,
+// over to the new ID. A device already configured with the new ID is
+// dropped in favour of it. It returns false if there is no device with the
+// old ID.
+func (cfg *Configuration) ReplaceDevice(oldID, newID protocol.DeviceID) bool {
+	if _, _, ok := cfg.Device(oldID); !ok {
+		return false
+	}
+
+	devices := make([]DeviceConfiguration, 0, len(cfg.Devices))
+	for _, device := range cfg.Devices {
+		switch device.DeviceID {
+		case newID:
+			continue
+		case oldID:
+			device.DeviceID = newID
+		}
+		if device.IntroducedBy == oldID {
+			device.IntroducedBy = newID
+		}
+		devices = append(devices, device)
+	}
+	cfg.Devices = devices
+
+	for i := range cfg.Folders {
+		cfg.Folders[i].Devices = replaceFolderDevice(cfg.Folders[i].Devices, oldID, newID)
+	}
+	cfg.Defaults.Folder.Devices = replaceFolderDevice(cfg.Defaults.Folder.Devices, oldID, newID)
+	return true
+}
+
+func replaceFolderDevice(devices []FolderDeviceConfiguration, oldID, newID protocol.DeviceID) []FolderDeviceConfiguration {
+	hasOld := false
+	for _, device := range devices {
+		if device.DeviceID == oldID {
+			hasOld = true
+			break
+		}
+	}
+	replaced := make([]FolderDeviceConfiguration, 0, len(devices))
+	for _, device := range devices {
+		if device.DeviceID == newID && hasOld {
+			continue
+		}
+		if device.DeviceID == oldID {
+			device.DeviceID = newID
+		}
+		if device.IntroducedBy == oldID {
+			device.IntroducedBy = newID
+		}
+		replaced = append(replaced, device)
+	}
+	return replaced
+}
+
 func (cfg *Configuration) Folder(id string) (FolderConfiguration, int, bool) {
 	for i, folder := range cfg.Folders {
 		if folder.ID == id {
@@ -24,15 +24,18 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Configuration struct {
-	Version                  int                   `protobuf:"varint,1,opt,name=version,proto3,casttype=int" json:"version" xml:"version,attr"`
-	Folders                  []FolderConfiguration `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders" xml:"folder"`
-	Devices                  []DeviceConfiguration `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices" xml:"device"`
-	GUI                      GUIConfiguration      `protobuf:"bytes,4,opt,name=gui,proto3" json:"gui" xml:"gui"`
-	LDAP                     LDAPConfiguration     `protobuf:"bytes,5,opt,name=ldap,proto3" json:"ldap" xml:"ldap"`
-	Options                  OptionsConfiguration  `protobuf:"bytes,6,opt,name=options,proto3" json:"options" xml:"options"`
-	IgnoredDevices           []ObservedDevice      `protobuf:"bytes,7,rep,name=ignored_devices,json=ignoredDevices,proto3" json:"remoteIgnoredDevices" xml:"remoteIgnoredDevice"`
-	DeprecatedPendingDevices []ObservedDevice      `protobuf:"bytes,8,rep,name=pending_devices,json=pendingDevices,proto3" json:"-" xml:"pendingDevice,omitempty"` // Deprecated: Do not use.
-	Defaults                 Defaults              `protobuf:"bytes,9,opt,name=defaults,proto3" json:"defaults" xml:"defaults"`
+	Version                  int                       `protobuf:"varint,1,opt,name=version,proto3,casttype=int" json:"version" xml:"version,attr"`
+	Folders                  []FolderConfiguration     `protobuf:"bytes,2,rep,name=folders,proto3" json:"folders" xml:"folder"`
+	Devices                  []DeviceConfiguration     `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices" xml:"device"`
+	GUI                      GUIConfiguration          `protobuf:"bytes,4,opt,name=gui,proto3" json:"gui" xml:"gui"`
+	LDAP                     LDAPConfiguration         `protobuf:"bytes,5,opt,name=ldap,proto3" json:"ldap" xml:"ldap"`
+	Options                  OptionsConfiguration      `protobuf:"bytes,6,opt,name=options,proto3" json:"options" xml:"options"`
+	IgnoredDevices           []ObservedDevice          `protobuf:"bytes,7,rep,name=ignored_devices,json=ignoredDevices,proto3" json:"remoteIgnoredDevices" xml:"remoteIgnoredDevice"`
+	DeprecatedPendingDevices []ObservedDevice          `protobuf:"bytes,8,rep,name=pending_devices,json=pendingDevices,proto3" json:"-" xml:"pendingDevice,omitempty"` // Deprecated: Do not use.
+	Defaults                 Defaults                  `protobuf:"bytes,9,opt,name=defaults,proto3" json:"defaults" xml:"defaults"`
+	Organization             OrganizationConfiguration `protobuf:"bytes,10,opt,name=organization,proto3" json:"organization" xml:"organization"`
+	Webhooks                 []WebhookConfiguration    `protobuf:"bytes,11,rep,name=webhooks,proto3" json:"webhooks" xml:"webhook"`
+	OIDC                     OIDCConfiguration         `protobuf:"bytes,12,opt,name=oidc,proto3" json:"oidc" xml:"oidc"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
@@ -153,52 +156,59 @@ func init() {
 func init() { proto.RegisterFile("lib/config/config.proto", fileDescriptor_baadf209193dc627) }
 
 var fileDescriptor_baadf209193dc627 = []byte{
-	// 709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4b, 0x6f, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0xa6, 0x8d, 0x9b, 0xed, 0x0b, 0x19, 0x44, 0x5d, 0x1e, 0xde, 0xb0, 0x0a, 0x28,
-	0xa0, 0x3e, 0xa4, 0x72, 0xa9, 0xb8, 0x11, 0x22, 0x4a, 0x55, 0x24, 0x2a, 0xa3, 0x22, 0xe0, 0x82,
-	0x92, 0x78, 0xeb, 0xae, 0x94, 0xd8, 0x96, 0xed, 0x54, 0xed, 0x91, 0x23, 0x37, 0xc4, 0x27, 0xe0,
-	0x84, 0xc4, 0x37, 0xe9, 0xad, 0x39, 0x72, 0x5a, 0xa9, 0xcd, 0xcd, 0x47, 0x1f, 0x39, 0xa1, 0x7d,
-	0x39, 0xb6, 0x6a, 0xe0, 0x94, 0xcc, 0xfc, 0xff, 0xf3, 0xdb, 0xd5, 0xec, 0x8c, 0xc1, 0xea, 0x80,
-	0xf4, 0xb6, 0xfa, 0xbe, 0x77, 0x44, 0x5c, 0xf9, 0xb3, 0x19, 0x84, 0x7e, 0xec, 0x1b, 0x35, 0x11,
-	0xdd, 0x69, 0xe6, 0x0c, 0x47, 0xfe, 0xc0, 0xc1, 0xa1, 0x08, 0x46, 0x61, 0x37, 0x26, 0xbe, 0x27,
-	0xdc, 0x05, 0x97, 0x83, 0x4f, 0x48, 0x1f, 0x97, 0xb9, 0x1e, 0xe4, 0x5c, 0xee, 0x88, 0x94, 0x59,
-	0x50, 0xce, 0x32, 0x70, 0xba, 0x41, 0x99, 0xe7, 0x61, 0xce, 0xe3, 0x07, 0x4c, 0x88, 0xca, 0x6c,
-	0x6b, 0x79, 0x5b, 0x2f, 0xc2, 0xe1, 0x09, 0x76, 0xa4, 0x54, 0xc7, 0xa7, 0xb1, 0xf8, 0x8b, 0x7e,
-	0xe8, 0x60, 0xe9, 0x45, 0xbe, 0xda, 0xb0, 0x81, 0x7e, 0x82, 0xc3, 0x88, 0xf8, 0x9e, 0xa9, 0x35,
-	0xb4, 0xd6, 0x5c, 0x7b, 0x27, 0xa1, 0x50, 0xa5, 0x52, 0x0a, 0x8d, 0xd3, 0xe1, 0xe0, 0x19, 0x92,
-	0xf1, 0x7a, 0x37, 0x8e, 0x43, 0xf4, 0x9b, 0xc2, 0x2a, 0xf1, 0xe2, 0xe4, 0xa2, 0xb9, 0x98, 0xcf,
-	0xdb, 0xaa, 0xca, 0x78, 0x07, 0x74, 0xd1, 0xbc, 0xc8, 0x9c, 0x69, 0x54, 0x5b, 0x0b, 0xdb, 0x77,
-	0x37, 0x65, 0xb7, 0x5f, 0xf2, 0x74, 0xe1, 0x06, 0x6d, 0x78, 0x4e, 0x61, 0x85, 0x1d, 0x2a, 0x6b,
-	0x52, 0x0a, 0x17, 0xf9, 0xa1, 0x22, 0x46, 0xb6, 0x12, 0x18, 0x57, 0xb4, 0x3b, 0x32, 0xab, 0x45,
-	0x6e, 0x87, 0xa7, 0xff, 0xc2, 0x95, 0x35, 0x19, 0x57, 0xc4, 0xc8, 0x56, 0x82, 0x61, 0x83, 0xaa,
-	0x3b, 0x22, 0xe6, 0x6c, 0x43, 0x6b, 0x2d, 0x6c, 0x9b, 0x8a, 0xb9, 0x7b, 0xb8, 0x57, 0x04, 0x3e,
-	0x62, 0xc0, 0x2b, 0x0a, 0xab, 0xbb, 0x87, 0x7b, 0x09, 0x85, 0xac, 0x26, 0xa5, 0xb0, 0xce, 0x99,
-	0xee, 0x88, 0xa0, 0x6f, 0xe3, 0x26, 0x93, 0x6c, 0x26, 0x18, 0x1f, 0xc0, 0x2c, 0x7b, 0x51, 0x73,
-	0x8e, 0x43, 0xd7, 0x14, 0xf4, 0x75, 0xe7, 0xf9, 0x41, 0x91, 0xfa, 0x44, 0x52, 0x67, 0x99, 0x94,
-	0x50, 0xc8, 0xcb, 0x52, 0x0a, 0x01, 0xe7, 0xb2, 0x80, 0x81, 0xb9, 0x6a, 0x73, 0xcd, 0x78, 0x0f,
-	0x74, 0x39, 0x08, 0x66, 0x8d, 0xd3, 0xef, 0x29, 0xfa, 0x1b, 0x91, 0x2e, 0x1e, 0xd0, 0x50, 0x7d,
-	0x90, 0x45, 0x29, 0x85, 0x4b, 0x9c, 0x2d, 0x63, 0x64, 0x2b, 0xc5, 0xf8, 0xa9, 0x81, 0x15, 0xe2,
-	0x7a, 0x7e, 0x88, 0x9d, 0x4f, 0xaa, 0xd3, 0x3a, 0xef, 0xf4, 0xed, 0xec, 0x08, 0x39, 0x5b, 0xa2,
-	0xe3, 0xed, 0x63, 0x09, 0xbf, 0x15, 0xe2, 0xa1, 0x1f, 0xe3, 0x3d, 0x51, 0xdc, 0xc9, 0x3a, 0xbe,
-	0xc6, 0x4f, 0x2a, 0x11, 0x51, 0x72, 0xd1, 0xbc, 0x59, 0x92, 0x4f, 0x2f, 0x9a, 0xa5, 0x2c, 0x7b,
-	0x99, 0x14, 0x62, 0xe3, 0x8b, 0x06, 0x56, 0x02, 0xec, 0x39, 0xc4, 0x73, 0xb3, 0xbb, 0xce, 0xff,
-	0xf3, 0xae, 0xaf, 0x64, 0xa7, 0xcd, 0x0e, 0x0e, 0x42, 0xdc, 0xef, 0xc6, 0xd8, 0x39, 0x10, 0x00,
-	0xc9, 0x4c, 0x28, 0xd4, 0x36, 0x52, 0x0a, 0xef, 0xf3, 0x4b, 0x07, 0x79, 0x6d, 0xdd, 0x1f, 0x92,
-	0x18, 0x0f, 0x83, 0xf8, 0x0c, 0x99, 0x9a, 0xbd, 0x5c, 0xd0, 0x22, 0xe3, 0x00, 0xcc, 0x3b, 0xf8,
-	0xa8, 0x3b, 0x1a, 0xc4, 0x91, 0x59, 0xe7, 0x4f, 0x72, 0x63, 0x3a, 0x99, 0x22, 0xdf, 0x46, 0xb2,
-	0x53, 0x99, 0x33, 0xa5, 0x70, 0x59, 0xce, 0xa3, 0x48, 0x20, 0x3b, 0xd3, 0xd0, 0xe7, 0x19, 0x30,
-	0xaf, 0x4a, 0x8d, 0xb7, 0xa0, 0x26, 0x56, 0x80, 0xaf, 0xe8, 0x7f, 0xd6, 0xc9, 0x92, 0xe7, 0xc8,
-	0x92, 0x6b, 0xdb, 0x24, 0xf3, 0x0c, 0x2a, 0xda, 0x66, 0xce, 0x14, 0xa1, 0x65, 0xbb, 0x94, 0x41,
-	0x45, 0xc9, 0xb5, 0x55, 0x92, 0x79, 0x63, 0x1f, 0xe8, 0xe2, 0x99, 0xd8, 0x86, 0x32, 0xea, 0x8a,
-	0xa2, 0x8a, 0xd7, 0x8c, 0xa6, 0xd3, 0x28, 0x7d, 0xd9, 0x34, 0xca, 0x18, 0xd9, 0x4a, 0x41, 0x3b,
-	0x40, 0x97, 0x55, 0xc6, 0x06, 0x98, 0x1b, 0x10, 0x0f, 0x47, 0xa6, 0xd6, 0xa8, 0xb6, 0xea, 0xed,
-	0xd5, 0x84, 0x42, 0x91, 0x98, 0x2e, 0x0a, 0xf1, 0x30, 0xb2, 0x45, 0xb2, 0xbd, 0x7f, 0x7e, 0x69,
-	0x55, 0xc6, 0x97, 0x56, 0xe5, 0xfc, 0xca, 0xd2, 0xc6, 0x57, 0x96, 0xf6, 0x75, 0x62, 0x55, 0xbe,
-	0x4f, 0x2c, 0x6d, 0x3c, 0xb1, 0x2a, 0xbf, 0x26, 0x56, 0xe5, 0xe3, 0x63, 0x97, 0xc4, 0xc7, 0xa3,
-	0xde, 0x66, 0xdf, 0x1f, 0x6e, 0x45, 0x67, 0x5e, 0x3f, 0x3e, 0x26, 0x9e, 0x9b, 0xfb, 0x37, 0xfd,
-	0x9a, 0xf6, 0x6a, 0xfc, 0xd3, 0xf9, 0xf4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0x7d, 0xcb,
-	0x2a, 0x3d, 0x06, 0x00, 0x00,
+	// 828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x3b, 0x6f, 0xe3, 0x46,
+	0x10, 0x16, 0x2d, 0x5b, 0x8f, 0xb5, 0x6c, 0x07, 0x4c, 0x10, 0xd3, 0x79, 0x70, 0xe5, 0x85, 0x12,
+	0x28, 0x86, 0x1f, 0x80, 0xd3, 0x18, 0xe9, 0x22, 0x0b, 0x71, 0x04, 0x07, 0xb0, 0xb1, 0x81, 0xf3,
+	0x6a, 0x02, 0x49, 0x5c, 0x51, 0x8b, 0x48, 0xa4, 0x42, 0x52, 0x3e, 0xfb, 0xba, 0x2b, 0xaf, 0x3b,
+	0xdc, 0x2f, 0xb8, 0xf6, 0xfe, 0x89, 0x3b, 0xab, 0xbc, 0xe2, 0xb0, 0x80, 0xad, 0x8e, 0x25, 0xcb,
+	0xab, 0x0e, 0xfb, 0x20, 0x45, 0xc2, 0xf4, 0xb9, 0x12, 0xe7, 0xfb, 0xbe, 0xf9, 0x96, 0x9c, 0x9d,
+	0x19, 0x81, 0xcd, 0x11, 0xed, 0x1d, 0xf4, 0x5d, 0x67, 0x40, 0x6d, 0xf5, 0xb3, 0x3f, 0xf1, 0xdc,
+	0xc0, 0xd5, 0x4b, 0x32, 0xfa, 0xaa, 0x91, 0x12, 0x0c, 0xdc, 0x91, 0x45, 0x3c, 0x19, 0x4c, 0xbd,
+	0x6e, 0x40, 0x5d, 0x47, 0xaa, 0x33, 0x2a, 0x8b, 0x5c, 0xd2, 0x3e, 0xc9, 0x53, 0x6d, 0xa7, 0x54,
+	0xf6, 0x94, 0xe6, 0x49, 0x50, 0x4a, 0x32, 0xb2, 0xba, 0x93, 0xa7, 0x34, 0x2e, 0xb5, 0xfa, 0x79,
+	0x9a, 0xef, 0xd2, 0x9a, 0x09, 0x27, 0xfc, 0x3c, 0xd9, 0x4e, 0x5a, 0xe6, 0xd9, 0x5d, 0x87, 0x3e,
+	0x17, 0xf4, 0x53, 0x96, 0xcf, 0x48, 0x6f, 0xe8, 0xba, 0xff, 0xe5, 0xc9, 0xb6, 0xd2, 0x96, 0x3d,
+	0x9f, 0x78, 0x97, 0xc4, 0x52, 0x54, 0x95, 0x5c, 0x05, 0xf2, 0x11, 0xbd, 0xaf, 0x82, 0xb5, 0xe3,
+	0x74, 0xb6, 0x8e, 0x41, 0xf9, 0x92, 0x78, 0x3e, 0x75, 0x1d, 0x43, 0xab, 0x6b, 0xcd, 0x95, 0xd6,
+	0x51, 0xc8, 0x60, 0x0c, 0x45, 0x0c, 0xea, 0x57, 0xe3, 0xd1, 0x4f, 0x48, 0xc5, 0xbb, 0xdd, 0x20,
+	0xf0, 0xd0, 0x07, 0x06, 0x8b, 0xd4, 0x09, 0xc2, 0xdb, 0x46, 0x2d, 0x8d, 0xe3, 0x38, 0x4b, 0xff,
+	0x03, 0x94, 0xe5, 0x9d, 0xf9, 0xc6, 0x52, 0xbd, 0xd8, 0x5c, 0x3d, 0xfc, 0x7a, 0x5f, 0x5d, 0xf2,
+	0x2f, 0x02, 0xce, 0xbc, 0x41, 0x0b, 0xde, 0x30, 0x58, 0xe0, 0x87, 0xaa, 0x9c, 0x88, 0xc1, 0x9a,
+	0x38, 0x54, 0xc6, 0x08, 0xc7, 0x04, 0xf7, 0x95, 0xb7, 0xec, 0x1b, 0xc5, 0xac, 0x6f, 0x5b, 0xc0,
+	0x8f, 0xf8, 0xaa, 0x9c, 0xc4, 0x57, 0xc6, 0x08, 0xc7, 0x84, 0x8e, 0x41, 0xd1, 0x9e, 0x52, 0x63,
+	0xb9, 0xae, 0x35, 0x57, 0x0f, 0x8d, 0xd8, 0xf3, 0xe4, 0xa2, 0x93, 0x35, 0xfc, 0x9e, 0x1b, 0xde,
+	0x33, 0x58, 0x3c, 0xb9, 0xe8, 0x84, 0x0c, 0xf2, 0x9c, 0x88, 0xc1, 0xaa, 0xf0, 0xb4, 0xa7, 0x14,
+	0xbd, 0x9e, 0x35, 0x38, 0x85, 0x39, 0xa1, 0xff, 0x0d, 0x96, 0x79, 0x23, 0x19, 0x2b, 0xc2, 0x74,
+	0x2b, 0x36, 0xfd, 0xad, 0xfd, 0xf3, 0x79, 0xd6, 0x75, 0x47, 0xb9, 0x2e, 0x73, 0x2a, 0x64, 0x50,
+	0xa4, 0x45, 0x0c, 0x02, 0xe1, 0xcb, 0x03, 0x6e, 0x2c, 0x58, 0x2c, 0x38, 0xfd, 0x2f, 0x50, 0x56,
+	0xbd, 0x65, 0x94, 0x84, 0xfb, 0x37, 0xb1, 0xfb, 0x99, 0x84, 0xb3, 0x07, 0xd4, 0xe3, 0x3a, 0xa8,
+	0xa4, 0x88, 0xc1, 0x35, 0xe1, 0xad, 0x62, 0x84, 0x63, 0x46, 0x7f, 0xab, 0x81, 0x0d, 0x6a, 0x3b,
+	0xae, 0x47, 0xac, 0x7f, 0xe3, 0x4a, 0x97, 0x45, 0xa5, 0xbf, 0x4c, 0x8e, 0x50, 0xbd, 0x25, 0x2b,
+	0xde, 0x1a, 0x2a, 0xf3, 0x2f, 0x3c, 0x32, 0x76, 0x03, 0xd2, 0x91, 0xc9, 0xed, 0xa4, 0xe2, 0x5b,
+	0xe2, 0xa4, 0x1c, 0x12, 0x85, 0xb7, 0x8d, 0xcf, 0x73, 0xf0, 0xe8, 0xb6, 0x91, 0xeb, 0x85, 0xd7,
+	0x69, 0x26, 0xd6, 0x5f, 0x6a, 0x60, 0x63, 0x42, 0x1c, 0x8b, 0x3a, 0x76, 0xf2, 0xae, 0x95, 0x4f,
+	0xbe, 0xeb, 0xaf, 0xaa, 0xd2, 0x46, 0x9b, 0x4c, 0x3c, 0xd2, 0xef, 0x06, 0xc4, 0x3a, 0x97, 0x06,
+	0xca, 0x33, 0x64, 0x50, 0xdb, 0x8b, 0x18, 0xfc, 0x56, 0xbc, 0xf4, 0x24, 0xcd, 0xed, 0xba, 0x63,
+	0x1a, 0x90, 0xf1, 0x24, 0xb8, 0x46, 0x86, 0x86, 0xd7, 0x33, 0x9c, 0xaf, 0x9f, 0x83, 0x8a, 0x45,
+	0x06, 0xdd, 0xe9, 0x28, 0xf0, 0x8d, 0xaa, 0xb8, 0x92, 0xcf, 0x16, 0x9d, 0x29, 0xf1, 0x16, 0x52,
+	0x95, 0x4a, 0x94, 0x11, 0x83, 0xeb, 0xaa, 0x1f, 0x25, 0x80, 0x70, 0xc2, 0xe9, 0xff, 0x83, 0x5a,
+	0x7a, 0x31, 0x18, 0x40, 0xb8, 0x6e, 0x27, 0x5f, 0x96, 0xe2, 0x72, 0xda, 0x29, 0x64, 0x30, 0x93,
+	0x9e, 0xcc, 0x71, 0x1a, 0x44, 0x38, 0xa3, 0xd1, 0x07, 0xa0, 0xa2, 0xf6, 0x8b, 0x6f, 0xac, 0xd6,
+	0x8b, 0xe9, 0xbe, 0xfa, 0x53, 0xe2, 0xd9, 0x93, 0x76, 0xe3, 0x0f, 0x8a, 0xb3, 0x92, 0xc6, 0x52,
+	0x00, 0xbf, 0xe2, 0xb2, 0x7a, 0xc6, 0x89, 0x8a, 0x4f, 0x06, 0x5f, 0x9f, 0x46, 0x2d, 0x3b, 0x19,
+	0x67, 0x9d, 0xf6, 0xf1, 0x23, 0x93, 0xc1, 0x29, 0x3e, 0x19, 0x3c, 0x2d, 0x99, 0x0c, 0x1e, 0x88,
+	0xc9, 0xe0, 0x2c, 0x16, 0x1c, 0x7a, 0xb1, 0x04, 0x2a, 0x71, 0xc1, 0xf5, 0xdf, 0x41, 0x49, 0x2e,
+	0x0e, 0xb1, 0xd8, 0x9e, 0x58, 0x42, 0xa6, 0xfa, 0x18, 0x95, 0xf2, 0x60, 0x07, 0x29, 0x9c, 0x9b,
+	0xca, 0x66, 0x33, 0x96, 0xb2, 0xa6, 0x79, 0x1b, 0x28, 0x31, 0x95, 0x29, 0x0f, 0x16, 0x90, 0xc2,
+	0xf5, 0x53, 0x50, 0x96, 0xcd, 0xcd, 0xf7, 0x1a, 0x77, 0xdd, 0x88, 0x5d, 0xe5, 0x0c, 0xf8, 0x8b,
+	0x19, 0x56, 0xba, 0xa4, 0xd4, 0x2a, 0x46, 0x38, 0x66, 0xd0, 0x11, 0x28, 0xab, 0x2c, 0x7d, 0x0f,
+	0xac, 0x8c, 0xa8, 0x43, 0x7c, 0x43, 0xab, 0x17, 0x9b, 0xd5, 0xd6, 0x66, 0xc8, 0xa0, 0x04, 0x16,
+	0xeb, 0x85, 0x3a, 0x04, 0x61, 0x09, 0xb6, 0x4e, 0x6f, 0xee, 0xcc, 0xc2, 0xec, 0xce, 0x2c, 0xdc,
+	0xdc, 0x9b, 0xda, 0xec, 0xde, 0xd4, 0x5e, 0xcd, 0xcd, 0xc2, 0x9b, 0xb9, 0xa9, 0xcd, 0xe6, 0x66,
+	0xe1, 0xdd, 0xdc, 0x2c, 0xfc, 0xf3, 0x83, 0x4d, 0x83, 0xe1, 0xb4, 0xb7, 0xdf, 0x77, 0xc7, 0x07,
+	0xfe, 0xb5, 0xd3, 0x0f, 0x86, 0xd4, 0xb1, 0x53, 0x4f, 0x8b, 0xff, 0xa0, 0x5e, 0x49, 0xfc, 0xe1,
+	0xfc, 0xf8, 0x71, 0x00, 0xac, 0xdd, 0xc5, 0xa7, 0xea, 0x07, 0x00, 0x00,
 }
 
 func (m *Configuration) Marshal() (dAtA []byte, err error) {
@@ -221,6 +231,40 @@ func (m *Configuration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.OIDC.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConfig(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.Webhooks) > 0 {
+		for iNdEx := len(m.Webhooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Webhooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConfig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.Organization.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConfig(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	{
 		size, err := m.Defaults.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -462,6 +506,16 @@ func (m *Configuration) ProtoSize() (n int) {
 	}
 	l = m.Defaults.ProtoSize()
 	n += 1 + l + sovConfig(uint64(l))
+	l = m.Organization.ProtoSize()
+	n += 1 + l + sovConfig(uint64(l))
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.ProtoSize()
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	l = m.OIDC.ProtoSize()
+	n += 1 + l + sovConfig(uint64(l))
 	return n
 }
 
@@ -817,6 +871,106 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Organization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, WebhookConfiguration{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OIDC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(dAtA[iNdEx:])
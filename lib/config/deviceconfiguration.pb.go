@@ -45,6 +45,9 @@ type DeviceConfiguration struct {
 	Untrusted                bool                                                 `protobuf:"varint,17,opt,name=untrusted,proto3" json:"untrusted" xml:"untrusted"`
 	RemoteGUIPort            int                                                  `protobuf:"varint,18,opt,name=remote_gui_port,json=remoteGuiPort,proto3,casttype=int" json:"remoteGUIPort" xml:"remoteGUIPort"`
 	RawNumConnections        int                                                  `protobuf:"varint,19,opt,name=num_connections,json=numConnections,proto3,casttype=int" json:"numConnections" xml:"numConnections"`
+	DiscoveryDomain          string                                               `protobuf:"bytes,20,opt,name=discovery_domain,json=discoveryDomain,proto3" json:"discoveryDomain" xml:"discoveryDomain,omitempty"`
+	ProxyURL                 string                                               `protobuf:"bytes,21,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxyURL" xml:"proxyURL,omitempty"`
+	OrganizationTrusted      bool                                                 `protobuf:"varint,22,opt,name=organization_trusted,json=organizationTrusted,proto3" json:"organizationTrusted" xml:"organizationTrusted,omitempty"`
 }
 
 func (m *DeviceConfiguration) Reset()         { *m = DeviceConfiguration{} }
@@ -89,74 +92,82 @@ func init() {
 }
 
 var fileDescriptor_744b782bd13071dd = []byte{
-	// 1057 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0xe9, 0x6e, 0xb7, 0x99, 0x6d, 0x9b, 0xc6, 0x65, 0xbb, 0xde, 0x4a, 0x9b, 0x89, 0x42,
-	0x0e, 0x41, 0xec, 0xa6, 0xa8, 0x70, 0xaa, 0x00, 0x89, 0xb4, 0x82, 0xad, 0x2a, 0xba, 0x65, 0x10,
-	0x97, 0xdd, 0x83, 0x71, 0x3c, 0xd3, 0xac, 0xd5, 0x78, 0xc6, 0xd8, 0xe3, 0xb4, 0x95, 0x38, 0x72,
-	0x80, 0x1b, 0xaa, 0xc4, 0x89, 0xcb, 0xc2, 0xdf, 0xe0, 0xc0, 0xb5, 0xb7, 0xe6, 0x08, 0x1c, 0x46,
-	0xda, 0xf4, 0xe6, 0xa3, 0x8f, 0x9c, 0xd0, 0x8c, 0x1d, 0xc7, 0x76, 0x37, 0x2b, 0x24, 0x6e, 0x9e,
-	0xef, 0x7b, 0xf3, 0x7d, 0xf3, 0x9e, 0xdf, 0x9b, 0x01, 0xed, 0xa1, 0xd3, 0xdf, 0xb2, 0x19, 0x3d,
-	0x76, 0x06, 0x5b, 0x98, 0x8c, 0x1c, 0x9b, 0x24, 0x8b, 0xd0, 0xb7, 0xb8, 0xc3, 0x68, 0xd7, 0xf3,
-	0x19, 0x67, 0xfa, 0x62, 0x02, 0x6e, 0x6e, 0xc8, 0x68, 0x05, 0xd9, 0x6c, 0xb8, 0xd5, 0x27, 0x5e,
-	0xc2, 0x6f, 0x3e, 0xc8, 0xa9, 0xb0, 0x7e, 0x40, 0xfc, 0x11, 0xc1, 0x29, 0x55, 0x25, 0x67, 0x3c,
-	0xf9, 0x6c, 0xfd, 0x55, 0x07, 0xeb, 0x7b, 0xca, 0x63, 0x37, 0xef, 0xa1, 0xff, 0xa1, 0x81, 0x6a,
-	0xe2, 0x6d, 0x3a, 0xd8, 0xd0, 0x9a, 0x5a, 0x67, 0xb9, 0xf7, 0xab, 0x76, 0x29, 0x60, 0xe5, 0x6f,
-	0x01, 0x3f, 0x1c, 0x38, 0xfc, 0x45, 0xd8, 0xef, 0xda, 0xcc, 0xdd, 0x0a, 0xce, 0xa9, 0xcd, 0x5f,
-	0x38, 0x74, 0x90, 0xfb, 0xca, 0x9f, 0xa8, 0x9b, 0xa8, 0xef, 0xef, 0x4d, 0x04, 0x5c, 0x9a, 0x7e,
-	0x47, 0x02, 0x2e, 0xe1, 0xf4, 0x3b, 0x16, 0xb0, 0x71, 0xe6, 0x0e, 0x77, 0x5a, 0x0e, 0x7e, 0x64,
-	0x71, 0xee, 0xb7, 0x9a, 0x94, 0x61, 0x72, 0x6c, 0x85, 0x43, 0xbe, 0xd3, 0xe2, 0x7e, 0x48, 0x5a,
-	0xd1, 0x55, 0xfb, 0x4e, 0x4a, 0xc6, 0x57, 0xed, 0x6c, 0xe3, 0x0f, 0xe3, 0xb6, 0x76, 0x31, 0x6e,
-	0x67, 0xa2, 0x2f, 0xc7, 0x6d, 0x0d, 0x4d, 0x59, 0xac, 0x1f, 0x81, 0x5b, 0xd4, 0x72, 0x89, 0xf1,
-	0x56, 0x53, 0xeb, 0x54, 0x7b, 0x1f, 0x45, 0x02, 0xaa, 0x75, 0x2c, 0xe0, 0x03, 0x65, 0x27, 0x17,
-	0x4a, 0xf3, 0x11, 0x73, 0x1d, 0x4e, 0x5c, 0x8f, 0x9f, 0x4b, 0xa7, 0xf5, 0xd7, 0xe0, 0x48, 0xed,
-	0xd4, 0x9f, 0x83, 0xaa, 0x85, 0xb1, 0x4f, 0x82, 0x80, 0x04, 0xc6, 0x42, 0x73, 0xa1, 0x53, 0xed,
-	0x7d, 0x1c, 0x09, 0x38, 0x03, 0x63, 0x01, 0xef, 0x2b, 0xed, 0x14, 0x29, 0x2a, 0xd7, 0x6f, 0xa0,
-	0x68, 0xb6, 0x55, 0x1f, 0x81, 0xbb, 0x36, 0x73, 0x3d, 0xb9, 0x72, 0x18, 0x35, 0x6e, 0x35, 0xb5,
-	0xce, 0xea, 0xf6, 0xbd, 0x6e, 0x56, 0xc6, 0xdd, 0x19, 0xa9, 0x5c, 0xf3, 0xd1, 0xb1, 0x80, 0x1b,
-	0xca, 0x37, 0x87, 0x25, 0xb5, 0x8c, 0xae, 0xda, 0x6b, 0x65, 0x10, 0xe5, 0xb7, 0xea, 0x04, 0x54,
-	0x6d, 0xe2, 0x73, 0x53, 0xd5, 0xea, 0xb6, 0xaa, 0xd5, 0x13, 0xf9, 0x7b, 0x24, 0x78, 0x98, 0xd4,
-	0xeb, 0x61, 0xa2, 0x9d, 0x02, 0xaf, 0xa9, 0xd9, 0xfd, 0x39, 0x1c, 0xca, 0x54, 0xf4, 0x67, 0x00,
-	0x38, 0x94, 0xfb, 0x0c, 0x87, 0x36, 0xf1, 0x8d, 0xc5, 0xa6, 0xd6, 0x59, 0xea, 0xed, 0x44, 0x02,
-	0xe6, 0xd0, 0x58, 0xc0, 0x7b, 0x49, 0x23, 0x64, 0x50, 0x96, 0x44, 0xad, 0x84, 0xa1, 0xdc, 0x3e,
-	0xfd, 0x37, 0x0d, 0x6c, 0x06, 0x27, 0x8e, 0x67, 0x4e, 0x31, 0xd9, 0xc1, 0xa6, 0x4f, 0x5c, 0x36,
-	0xb2, 0x86, 0x81, 0x71, 0x47, 0x99, 0xe1, 0x48, 0x40, 0x43, 0x46, 0xed, 0xe7, 0x82, 0x50, 0x1a,
-	0x13, 0x0b, 0xf8, 0x8e, 0xb2, 0x9e, 0x17, 0x90, 0x1d, 0xe4, 0xe1, 0x1b, 0x23, 0xd0, 0x5c, 0x07,
-	0xfd, 0x77, 0x0d, 0xac, 0x64, 0x67, 0xc6, 0x66, 0xff, 0xdc, 0x58, 0x52, 0x43, 0xf5, 0xf3, 0xff,
-	0x1a, 0xaa, 0x48, 0xc0, 0xe5, 0x99, 0x6a, 0xef, 0x3c, 0x16, 0xb0, 0x53, 0xac, 0x21, 0xee, 0x9d,
-	0xcf, 0x1f, 0xab, 0xfa, 0x8d, 0x30, 0x39, 0x54, 0x6a, 0x90, 0x0a, 0xb2, 0xfa, 0x36, 0x58, 0xf4,
-	0xac, 0x30, 0x20, 0xd8, 0xa8, 0xaa, 0x6a, 0x6e, 0x46, 0x02, 0xa6, 0x48, 0x2c, 0xe0, 0xb2, 0xb2,
-	0x4c, 0x96, 0x2d, 0x94, 0xe2, 0xfa, 0x77, 0x60, 0xcd, 0x1a, 0x0e, 0xd9, 0x29, 0xc1, 0x26, 0x25,
-	0xfc, 0x94, 0xf9, 0x27, 0x81, 0x01, 0xd4, 0xd4, 0x7c, 0x19, 0x09, 0x58, 0x4b, 0xb9, 0xc3, 0x94,
-	0xca, 0xae, 0x81, 0x22, 0x5e, 0x6c, 0x34, 0x63, 0x1e, 0x89, 0xca, 0x72, 0xfa, 0x37, 0x60, 0xdd,
-	0x0a, 0x39, 0x33, 0x2d, 0xdb, 0x26, 0x1e, 0x37, 0x8f, 0xd9, 0x10, 0x13, 0x3f, 0x30, 0xee, 0xaa,
-	0xe3, 0xbf, 0x1f, 0x09, 0x58, 0x97, 0xf4, 0xa7, 0x8a, 0xfd, 0x2c, 0x21, 0x67, 0xe3, 0x5b, 0x66,
-	0x5a, 0xe8, 0x66, 0xb4, 0xfe, 0x14, 0xac, 0xb8, 0xd6, 0x99, 0x19, 0x10, 0x8a, 0xcd, 0x93, 0xbe,
-	0x17, 0x18, 0xcb, 0x4d, 0xad, 0x73, 0xbb, 0xf7, 0x9e, 0x1c, 0x4e, 0xd7, 0x3a, 0xfb, 0x8a, 0x50,
-	0x7c, 0xd0, 0xf7, 0xa4, 0x6a, 0x5d, 0xa9, 0xe6, 0xb0, 0xd6, 0x3f, 0x02, 0x2e, 0x38, 0x94, 0xa3,
-	0x7c, 0xe0, 0x54, 0xd0, 0x27, 0xf6, 0x28, 0x11, 0x5c, 0x29, 0x08, 0x22, 0x62, 0x8f, 0xca, 0x82,
-	0x53, 0xac, 0x20, 0x38, 0x05, 0x75, 0x0a, 0x6a, 0xce, 0x80, 0x32, 0x9f, 0xe0, 0x2c, 0xff, 0xd5,
-	0xe6, 0x42, 0xe7, 0xee, 0xf6, 0x46, 0x37, 0x79, 0x18, 0xba, 0x4f, 0xd3, 0x87, 0x21, 0xc9, 0xa9,
-	0xf7, 0x58, 0xf6, 0x62, 0x24, 0xe0, 0x6a, 0xba, 0x6d, 0x56, 0x98, 0xf5, 0xa4, 0xab, 0xf2, 0x70,
-	0x0b, 0x95, 0xc2, 0xf4, 0x1f, 0x35, 0x50, 0xf3, 0x08, 0xc5, 0x0e, 0x1d, 0x64, 0x86, 0xb5, 0x37,
-	0x1a, 0x3e, 0x91, 0x86, 0x13, 0x01, 0x8d, 0x3d, 0xe2, 0xf9, 0xc4, 0xb6, 0x38, 0xc1, 0x47, 0x89,
-	0x40, 0xaa, 0x19, 0x09, 0xa8, 0x3d, 0xce, 0xee, 0x20, 0x2f, 0xcf, 0xe5, 0x5a, 0xc3, 0xd0, 0xd0,
-	0x6a, 0x81, 0x0b, 0xf4, 0x5f, 0x34, 0x50, 0x4b, 0xaa, 0xf9, 0x6d, 0x48, 0x02, 0x6e, 0x9e, 0x38,
-	0x7d, 0x63, 0x4d, 0xd5, 0x33, 0x98, 0x08, 0xb8, 0xf2, 0x85, 0x2c, 0x93, 0x62, 0x0e, 0x9c, 0x5e,
-	0x24, 0xe0, 0x8a, 0x9b, 0x07, 0xb2, 0x84, 0x0b, 0xe8, 0xb4, 0xc8, 0xd1, 0x55, 0xbb, 0x14, 0x5e,
-	0x06, 0x2e, 0xc6, 0xed, 0xa2, 0x03, 0x2a, 0xf0, 0x7d, 0xfd, 0x13, 0x50, 0x0d, 0x29, 0xf7, 0xc3,
-	0x80, 0x13, 0x6c, 0xd4, 0x55, 0x4f, 0x36, 0xe5, 0x53, 0x92, 0x81, 0xb1, 0x80, 0x35, 0x75, 0x82,
-	0x0c, 0x69, 0xa1, 0x19, 0xab, 0xb2, 0x93, 0x17, 0x1c, 0x27, 0xe6, 0x20, 0x74, 0x4c, 0x8f, 0xf9,
-	0xdc, 0xd0, 0x67, 0xd9, 0x21, 0x45, 0x7d, 0xfe, 0xf5, 0xfe, 0x11, 0xf3, 0xb9, 0xcc, 0xce, 0xcf,
-	0x03, 0x59, 0x76, 0x05, 0x34, 0x9f, 0x5d, 0x31, 0xbc, 0x0c, 0xc8, 0xec, 0x0a, 0x0e, 0x68, 0xca,
-	0x87, 0x8e, 0x5c, 0xea, 0xdf, 0x6b, 0xa0, 0x46, 0x43, 0xd7, 0xb4, 0x19, 0xa5, 0x44, 0x5d, 0x83,
-	0x81, 0xb1, 0xae, 0x4e, 0xf7, 0x7c, 0x22, 0x60, 0x1d, 0x59, 0xa7, 0x87, 0xa1, 0xbb, 0x3b, 0x23,
-	0x65, 0xc7, 0xd1, 0x02, 0x12, 0x0b, 0xf8, 0x76, 0xf2, 0x4a, 0x17, 0xe0, 0xe9, 0x19, 0x2f, 0xc6,
-	0xed, 0x9b, 0x2a, 0xa8, 0xa4, 0xd1, 0x3b, 0xb8, 0x7c, 0xd5, 0xa8, 0x8c, 0x5f, 0x35, 0x2a, 0x97,
-	0x93, 0x86, 0x36, 0x9e, 0x34, 0xb4, 0x9f, 0xae, 0x1b, 0x95, 0x97, 0xd7, 0x0d, 0x6d, 0x7c, 0xdd,
-	0xa8, 0xfc, 0x79, 0xdd, 0xa8, 0x3c, 0x7b, 0xf7, 0x3f, 0xdc, 0xb9, 0x49, 0xe3, 0xf6, 0x17, 0xd5,
-	0xdd, 0xfb, 0xc1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0x19, 0xf5, 0x92, 0x9d, 0x09, 0x00,
-	0x00,
+	// 1191 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x49, 0x9b, 0x66, 0xa7, 0x49, 0x36, 0x71, 0xd2, 0xd4, 0x8d, 0xd4, 0x9d, 0xd5, 0xb2,
+	0x87, 0x45, 0xb4, 0x1b, 0x54, 0x38, 0x55, 0x80, 0xc4, 0x36, 0x82, 0x56, 0x85, 0x36, 0x4c, 0xe9,
+	0xa5, 0x3d, 0x18, 0xaf, 0x67, 0xba, 0xb5, 0xb2, 0xf6, 0x98, 0xf1, 0x78, 0x9b, 0x45, 0x08, 0x2e,
+	0x1c, 0xe0, 0x86, 0x22, 0x71, 0xe2, 0x52, 0xf8, 0x37, 0x38, 0x70, 0xed, 0x2d, 0x7b, 0x44, 0x1c,
+	0x46, 0xea, 0xe6, 0xe6, 0x0b, 0x92, 0x8f, 0x9c, 0xd0, 0x8c, 0x7f, 0xac, 0xed, 0x64, 0x23, 0x24,
+	0x6e, 0x33, 0xdf, 0xf7, 0xe6, 0xfb, 0xe6, 0x3d, 0xbf, 0x99, 0x31, 0x68, 0x0f, 0x9d, 0xfe, 0xae,
+	0x4d, 0xbd, 0x67, 0xce, 0x60, 0x17, 0x93, 0x91, 0x63, 0x93, 0x64, 0x12, 0x32, 0x8b, 0x3b, 0xd4,
+	0xeb, 0xfa, 0x8c, 0x72, 0xaa, 0x2f, 0x25, 0xe0, 0xce, 0xb6, 0x8c, 0x56, 0x90, 0x4d, 0x87, 0xbb,
+	0x7d, 0xe2, 0x27, 0xfc, 0xce, 0xb5, 0x82, 0x0a, 0xed, 0x07, 0x84, 0x8d, 0x08, 0x4e, 0xa9, 0x1a,
+	0x39, 0xe4, 0xc9, 0xb0, 0xf5, 0xf7, 0x16, 0xd8, 0xdc, 0x53, 0x1e, 0x77, 0x8a, 0x1e, 0xfa, 0x1f,
+	0x1a, 0xa8, 0x25, 0xde, 0xa6, 0x83, 0x0d, 0xad, 0xa9, 0x75, 0x56, 0x7a, 0xbf, 0x6a, 0xaf, 0x04,
+	0x5c, 0xf8, 0x4b, 0xc0, 0xf7, 0x06, 0x0e, 0x7f, 0x1e, 0xf6, 0xbb, 0x36, 0x75, 0x77, 0x83, 0xb1,
+	0x67, 0xf3, 0xe7, 0x8e, 0x37, 0x28, 0x8c, 0x8a, 0x3b, 0xea, 0x26, 0xea, 0xf7, 0xf6, 0xa6, 0x02,
+	0x2e, 0x67, 0xe3, 0x48, 0xc0, 0x65, 0x9c, 0x8e, 0x63, 0x01, 0x1b, 0x87, 0xee, 0xf0, 0x76, 0xcb,
+	0xc1, 0x37, 0x2c, 0xce, 0x59, 0xab, 0xe9, 0x51, 0x4c, 0x9e, 0x59, 0xe1, 0x90, 0xdf, 0x6e, 0x71,
+	0x16, 0x92, 0x56, 0x74, 0xdc, 0xbe, 0x94, 0x92, 0xf1, 0x71, 0x3b, 0x5f, 0xf8, 0xc3, 0xa4, 0xad,
+	0x1d, 0x4d, 0xda, 0xb9, 0xe8, 0xcb, 0x49, 0x5b, 0x43, 0x19, 0x8b, 0xf5, 0x7d, 0x70, 0xc1, 0xb3,
+	0x5c, 0x62, 0xbc, 0xd1, 0xd4, 0x3a, 0xb5, 0xde, 0xfb, 0x91, 0x80, 0x6a, 0x1e, 0x0b, 0x78, 0x4d,
+	0xd9, 0xc9, 0x89, 0xd2, 0xbc, 0x41, 0x5d, 0x87, 0x13, 0xd7, 0xe7, 0x63, 0xe9, 0xb4, 0x79, 0x06,
+	0x8e, 0xd4, 0x4a, 0xfd, 0x29, 0xa8, 0x59, 0x18, 0x33, 0x12, 0x04, 0x24, 0x30, 0x16, 0x9b, 0x8b,
+	0x9d, 0x5a, 0xef, 0x83, 0x48, 0xc0, 0x19, 0x18, 0x0b, 0x78, 0x55, 0x69, 0xa7, 0x48, 0x59, 0x79,
+	0xe3, 0x14, 0x8a, 0x66, 0x4b, 0xf5, 0x11, 0xb8, 0x6c, 0x53, 0xd7, 0x97, 0x33, 0x87, 0x7a, 0xc6,
+	0x85, 0xa6, 0xd6, 0x59, 0xbb, 0x75, 0xa5, 0x9b, 0x97, 0xf1, 0xce, 0x8c, 0x54, 0xae, 0xc5, 0xe8,
+	0x58, 0xc0, 0x6d, 0xe5, 0x5b, 0xc0, 0x92, 0x5a, 0x46, 0xc7, 0xed, 0xf5, 0x2a, 0x88, 0x8a, 0x4b,
+	0x75, 0x02, 0x6a, 0x36, 0x61, 0xdc, 0x54, 0xb5, 0xba, 0xa8, 0x6a, 0x75, 0x57, 0x7e, 0x1e, 0x09,
+	0x3e, 0x48, 0xea, 0x75, 0x3d, 0xd1, 0x4e, 0x81, 0x33, 0x6a, 0x76, 0x75, 0x0e, 0x87, 0x72, 0x15,
+	0xfd, 0x09, 0x00, 0x8e, 0xc7, 0x19, 0xc5, 0xa1, 0x4d, 0x98, 0xb1, 0xd4, 0xd4, 0x3a, 0xcb, 0xbd,
+	0xdb, 0x91, 0x80, 0x05, 0x34, 0x16, 0xf0, 0x4a, 0xd2, 0x08, 0x39, 0x94, 0x27, 0x51, 0xaf, 0x60,
+	0xa8, 0xb0, 0x4e, 0xff, 0x4d, 0x03, 0x3b, 0xc1, 0x81, 0xe3, 0x9b, 0x19, 0x26, 0x3b, 0xd8, 0x64,
+	0xc4, 0xa5, 0x23, 0x6b, 0x18, 0x18, 0x97, 0x94, 0x19, 0x8e, 0x04, 0x34, 0x64, 0xd4, 0xbd, 0x42,
+	0x10, 0x4a, 0x63, 0x62, 0x01, 0xdf, 0x54, 0xd6, 0xf3, 0x02, 0xf2, 0x8d, 0x5c, 0x3f, 0x37, 0x02,
+	0xcd, 0x75, 0xd0, 0x7f, 0xd7, 0xc0, 0x6a, 0xbe, 0x67, 0x6c, 0xf6, 0xc7, 0xc6, 0xb2, 0x3a, 0x54,
+	0x3f, 0xff, 0xaf, 0x43, 0x15, 0x09, 0xb8, 0x32, 0x53, 0xed, 0x8d, 0x63, 0x01, 0x3b, 0xe5, 0x1a,
+	0xe2, 0xde, 0x78, 0xfe, 0xb1, 0xda, 0x38, 0x15, 0x26, 0x0f, 0x95, 0x3a, 0x48, 0x25, 0x59, 0xfd,
+	0x16, 0x58, 0xf2, 0xad, 0x30, 0x20, 0xd8, 0xa8, 0xa9, 0x6a, 0xee, 0x44, 0x02, 0xa6, 0x48, 0x2c,
+	0xe0, 0x8a, 0xb2, 0x4c, 0xa6, 0x2d, 0x94, 0xe2, 0xfa, 0x37, 0x60, 0xdd, 0x1a, 0x0e, 0xe9, 0x0b,
+	0x82, 0x4d, 0x8f, 0xf0, 0x17, 0x94, 0x1d, 0x04, 0x06, 0x50, 0xa7, 0xe6, 0xf3, 0x48, 0xc0, 0x7a,
+	0xca, 0x3d, 0x48, 0xa9, 0xfc, 0x1a, 0x28, 0xe3, 0xe5, 0x46, 0x33, 0xe6, 0x91, 0xa8, 0x2a, 0xa7,
+	0x7f, 0x09, 0x36, 0xad, 0x90, 0x53, 0xd3, 0xb2, 0x6d, 0xe2, 0x73, 0xf3, 0x19, 0x1d, 0x62, 0xc2,
+	0x02, 0xe3, 0xb2, 0xda, 0xfe, 0x3b, 0x91, 0x80, 0x1b, 0x92, 0xfe, 0x48, 0xb1, 0x1f, 0x27, 0xe4,
+	0xec, 0xf8, 0x56, 0x99, 0x16, 0x3a, 0x1d, 0xad, 0x3f, 0x04, 0xab, 0xae, 0x75, 0x68, 0x06, 0xc4,
+	0xc3, 0xe6, 0x41, 0xdf, 0x0f, 0x8c, 0x95, 0xa6, 0xd6, 0xb9, 0xd8, 0x7b, 0x5b, 0x1e, 0x4e, 0xd7,
+	0x3a, 0x7c, 0x44, 0x3c, 0x7c, 0xbf, 0xef, 0x4b, 0xd5, 0x0d, 0xa5, 0x5a, 0xc0, 0x5a, 0xff, 0x08,
+	0xb8, 0xe8, 0x78, 0x1c, 0x15, 0x03, 0x33, 0x41, 0x46, 0xec, 0x51, 0x22, 0xb8, 0x5a, 0x12, 0x44,
+	0xc4, 0x1e, 0x55, 0x05, 0x33, 0xac, 0x24, 0x98, 0x81, 0xba, 0x07, 0xea, 0xce, 0xc0, 0xa3, 0x8c,
+	0xe0, 0x3c, 0xff, 0xb5, 0xe6, 0x62, 0xe7, 0xf2, 0xad, 0xed, 0x6e, 0xf2, 0x30, 0x74, 0x1f, 0xa6,
+	0x0f, 0x43, 0x92, 0x53, 0xef, 0xa6, 0xec, 0xc5, 0x48, 0xc0, 0xb5, 0x74, 0xd9, 0xac, 0x30, 0x9b,
+	0x49, 0x57, 0x15, 0xe1, 0x16, 0xaa, 0x84, 0xe9, 0x3f, 0x6a, 0xa0, 0xee, 0x13, 0x0f, 0x3b, 0xde,
+	0x20, 0x37, 0xac, 0x9f, 0x6b, 0x78, 0x57, 0x1a, 0x4e, 0x05, 0x34, 0xf6, 0x88, 0xcf, 0x88, 0x6d,
+	0x71, 0x82, 0xf7, 0x13, 0x81, 0x54, 0x33, 0x12, 0x50, 0xbb, 0x99, 0xdf, 0x41, 0x7e, 0x91, 0x2b,
+	0xb4, 0x86, 0xa1, 0xa1, 0xb5, 0x12, 0x17, 0xe8, 0xbf, 0x68, 0xa0, 0x9e, 0x54, 0xf3, 0xab, 0x90,
+	0x04, 0xdc, 0x3c, 0x70, 0xfa, 0xc6, 0xba, 0xaa, 0x67, 0x30, 0x15, 0x70, 0xf5, 0x33, 0x59, 0x26,
+	0xc5, 0xdc, 0x77, 0x7a, 0x91, 0x80, 0xab, 0x6e, 0x11, 0xc8, 0x13, 0x2e, 0xa1, 0x59, 0x91, 0xa3,
+	0xe3, 0x76, 0x25, 0xbc, 0x0a, 0x1c, 0x4d, 0xda, 0x65, 0x07, 0x54, 0xe2, 0xfb, 0xfa, 0x87, 0xa0,
+	0x16, 0x7a, 0x9c, 0x85, 0x01, 0x27, 0xd8, 0xd8, 0x50, 0x3d, 0xd9, 0x94, 0x4f, 0x49, 0x0e, 0xc6,
+	0x02, 0xd6, 0xd5, 0x0e, 0x72, 0xa4, 0x85, 0x66, 0xac, 0xca, 0x4e, 0x5e, 0x70, 0x9c, 0x98, 0x83,
+	0xd0, 0x31, 0x7d, 0xca, 0xb8, 0xa1, 0xcf, 0xb2, 0x43, 0x8a, 0xfa, 0xe4, 0xf1, 0xbd, 0x7d, 0xca,
+	0xb8, 0xcc, 0x8e, 0x15, 0x81, 0x3c, 0xbb, 0x12, 0x5a, 0xcc, 0xae, 0x1c, 0x5e, 0x05, 0x64, 0x76,
+	0x25, 0x07, 0x94, 0xf1, 0xa1, 0x23, 0xa7, 0xfa, 0xf7, 0x1a, 0xa8, 0x7b, 0xa1, 0x6b, 0xda, 0xd4,
+	0xf3, 0x88, 0xba, 0x06, 0x03, 0x63, 0x53, 0xed, 0xee, 0xe9, 0x54, 0xc0, 0x0d, 0x64, 0xbd, 0x78,
+	0x10, 0xba, 0x77, 0x66, 0xa4, 0xec, 0x38, 0xaf, 0x84, 0xc4, 0x02, 0x6e, 0x25, 0xaf, 0x74, 0x09,
+	0xce, 0xf6, 0x78, 0x34, 0x69, 0x9f, 0x56, 0x41, 0x15, 0x0d, 0xfd, 0x5b, 0xb0, 0x8e, 0x9d, 0xc0,
+	0xa6, 0x23, 0xc2, 0xc6, 0x26, 0xa6, 0xae, 0xe5, 0x78, 0xc6, 0x96, 0x7a, 0xe1, 0x1e, 0xc9, 0x0b,
+	0x28, 0xe7, 0xf6, 0x14, 0x15, 0x0b, 0x08, 0x95, 0x65, 0x05, 0x2f, 0xdf, 0x40, 0xd7, 0xe6, 0xb2,
+	0xa8, 0x2a, 0xa8, 0x7f, 0x07, 0x6a, 0x3e, 0xa3, 0x87, 0x63, 0x33, 0x64, 0x43, 0xe3, 0x8a, 0x32,
+	0xee, 0xcb, 0xbf, 0xa0, 0x7d, 0x09, 0x3e, 0x46, 0x9f, 0xca, 0x67, 0xd6, 0x4f, 0xc7, 0xb1, 0x80,
+	0x46, 0xd2, 0xe2, 0x29, 0x50, 0xb6, 0xd5, 0x4f, 0xc3, 0xf2, 0x57, 0x28, 0x43, 0xe5, 0x6f, 0x50,
+	0xa6, 0x8a, 0x52, 0x94, 0x0d, 0xf5, 0x23, 0x0d, 0x6c, 0x51, 0x36, 0xb0, 0x3c, 0xe7, 0x6b, 0xf5,
+	0x57, 0x67, 0x66, 0x1d, 0xb7, 0xad, 0x3a, 0xce, 0x8c, 0x04, 0xdc, 0x2c, 0xf2, 0x5f, 0xe4, 0xbd,
+	0x97, 0xbc, 0x86, 0x67, 0x70, 0xe5, 0x6d, 0x5d, 0x3f, 0x37, 0x02, 0x9d, 0x25, 0xde, 0xbb, 0xff,
+	0xea, 0x75, 0x63, 0x61, 0xf2, 0xba, 0xb1, 0xf0, 0x6a, 0xda, 0xd0, 0x26, 0xd3, 0x86, 0xf6, 0xd3,
+	0x49, 0x63, 0xe1, 0xe5, 0x49, 0x43, 0x9b, 0x9c, 0x34, 0x16, 0xfe, 0x3c, 0x69, 0x2c, 0x3c, 0x79,
+	0xeb, 0x3f, 0xbc, 0x84, 0xc9, 0x75, 0xd2, 0x5f, 0x52, 0x2f, 0xe2, 0xbb, 0xff, 0x0e, 0x00, 0xb9,
+	0x98, 0xda, 0x56, 0x33, 0x0b, 0x00, 0x00,
 }
 
 func (m *DeviceConfiguration) Marshal() (dAtA []byte, err error) {
@@ -179,6 +190,36 @@ func (m *DeviceConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OrganizationTrusted {
+		i--
+		if m.OrganizationTrusted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.ProxyURL) > 0 {
+		i -= len(m.ProxyURL)
+		copy(dAtA[i:], m.ProxyURL)
+		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(len(m.ProxyURL)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.DiscoveryDomain) > 0 {
+		i -= len(m.DiscoveryDomain)
+		copy(dAtA[i:], m.DiscoveryDomain)
+		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(len(m.DiscoveryDomain)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.RawNumConnections != 0 {
 		i = encodeVarintDeviceconfiguration(dAtA, i, uint64(m.RawNumConnections))
 		i--
@@ -436,6 +477,17 @@ func (m *DeviceConfiguration) ProtoSize() (n int) {
 	if m.RawNumConnections != 0 {
 		n += 2 + sovDeviceconfiguration(uint64(m.RawNumConnections))
 	}
+	l = len(m.DiscoveryDomain)
+	if l > 0 {
+		n += 2 + l + sovDeviceconfiguration(uint64(l))
+	}
+	l = len(m.ProxyURL)
+	if l > 0 {
+		n += 2 + l + sovDeviceconfiguration(uint64(l))
+	}
+	if m.OrganizationTrusted {
+		n += 3
+	}
 	return n
 }
 
@@ -950,6 +1002,90 @@ func (m *DeviceConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiscoveryDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeviceconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationTrusted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeviceconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OrganizationTrusted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDeviceconfiguration(dAtA[iNdEx:])
@@ -0,0 +1,221 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// A Change is a difference between two configurations. The path is made of
+// the JSON names of the fields, with list elements identified by their ID or
+// name, as in "folders[default].paused". Lists of elements without one, like
+// "options.listenAddresses", change as a whole. A value that is missing on
+// one side is nil.
+type Change struct {
+	Path string      `json:"path"`
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// Redacted replaces the values of secrets, such as passwords and API keys,
+// and the credentials in URLs, in changes.
+const Redacted = "REDACTED"
+
+// The JSON names of fields holding secrets
+var secretFields = map[string]bool{
+	"apiKey":             true,
+	"clientSecret":       true,
+	"encryptionPassword": true,
+	"key":                true,
+	"password":           true,
+	"secret":             true,
+}
+
+// The JSON names of fields holding URLs that may include credentials
+var urlFields = map[string]bool{
+	"proxyURL": true,
+}
+
+// The JSON names of fields identifying list elements, in order of preference
+var identifyingFields = []string{"id", "deviceID", "name"}
+
+// Diff returns the changes from one configuration to another, with secrets
+// redacted, ordered by path.
+func Diff(from, to Configuration) []Change {
+	var changes []Change
+	diffValues("", toJSONValue(from), toJSONValue(to), &changes)
+	return changes
+}
+
+func toJSONValue(cfg Configuration) interface{} {
+	bs, err := json.Marshal(cfg)
+	if err != nil {
+		panic("bug: marshalling config: " + err.Error())
+	}
+	var v interface{}
+	if err := json.Unmarshal(bs, &v); err != nil {
+		panic("bug: unmarshalling config: " + err.Error())
+	}
+	return v
+}
+
+func diffValues(path string, from, to interface{}, changes *[]Change) {
+	if reflect.DeepEqual(from, to) || isEmptyValue(from) && isEmptyValue(to) {
+		return
+	}
+
+	switch fromV := from.(type) {
+	case map[string]interface{}:
+		if toV, ok := to.(map[string]interface{}); ok {
+			diffObjects(path, fromV, toV, changes)
+			return
+		}
+	case []interface{}:
+		if toV, ok := to.([]interface{}); ok {
+			if field := identifyingField(fromV, toV); field != "" {
+				diffIdentifiedLists(path, field, fromV, toV, changes)
+				return
+			}
+		}
+	}
+
+	*changes = append(*changes, Change{Path: path, From: redact(path, from), To: redact(path, to)})
+}
+
+func diffObjects(path string, from, to map[string]interface{}, changes *[]Change) {
+	keys := make([]string, 0, len(from)+len(to))
+	for key := range from {
+		keys = append(keys, key)
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+		diffValues(keyPath, from[key], to[key], changes)
+	}
+}
+
+// isEmptyValue returns whether the value is missing, null or an empty list
+// or object, which are all the same to us.
+func isEmptyValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// identifyingField returns the field identifying the elements of both
+// lists, or the empty string if they are not all objects with a unique
+// value for one of the identifying fields.
+func identifyingField(lists ...[]interface{}) string {
+	if len(lists[0])+len(lists[1]) == 0 {
+		return ""
+	}
+fields:
+	for _, field := range identifyingFields {
+		for _, list := range lists {
+			seen := make(map[string]bool, len(list))
+			for _, elem := range list {
+				obj, ok := elem.(map[string]interface{})
+				if !ok {
+					return ""
+				}
+				id, ok := obj[field].(string)
+				if !ok || id == "" || seen[id] {
+					continue fields
+				}
+				seen[id] = true
+			}
+		}
+		return field
+	}
+	return ""
+}
+
+func diffIdentifiedLists(path, field string, from, to []interface{}, changes *[]Change) {
+	byID := func(list []interface{}) (map[string]interface{}, []string) {
+		m := make(map[string]interface{}, len(list))
+		ids := make([]string, 0, len(list))
+		for _, elem := range list {
+			id := elem.(map[string]interface{})[field].(string)
+			m[id] = elem
+			ids = append(ids, id)
+		}
+		return m, ids
+	}
+	fromByID, ids := byID(from)
+	toByID, toIDs := byID(to)
+	for _, id := range toIDs {
+		if _, ok := fromByID[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		diffValues(fmt.Sprintf("%s[%s]", path, id), fromByID[id], toByID[id], changes)
+	}
+}
+
+// redact returns the value with secrets replaced, for the value at the
+// given path.
+func redact(path string, v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	if secretFields[lastPathField(path)] {
+		if s, ok := v.(string); ok && s == "" {
+			return s
+		}
+		return Redacted
+	}
+	if urlFields[lastPathField(path)] {
+		if s, ok := v.(string); ok {
+			return RedactProxyURL(s)
+		}
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			res[key] = redact(key, elem)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, elem := range v {
+			res[i] = redact("", elem)
+		}
+		return res
+	default:
+		return v
+	}
+}
+
+func lastPathField(path string) string {
+	for i := len(path) - 1; i >= 0; i-- {
+		if path[i] == '.' {
+			return path[i+1:]
+		}
+	}
+	return path
+}
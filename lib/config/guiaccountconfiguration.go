@@ -0,0 +1,57 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/exp/slices"
+)
+
+func (a GUIAccountConfiguration) Copy() GUIAccountConfiguration {
+	a.Folders = slices.Clone(a.Folders)
+	return a
+}
+
+// CompareHashedPassword returns nil when the given plaintext password
+// matches the stored hash.
+func (a GUIAccountConfiguration) CompareHashedPassword(password string) error {
+	if a.Password == "" {
+		// Accounts for LDAP users have no password of their own
+		return bcrypt.ErrMismatchedHashAndPassword
+	}
+	return bcrypt.CompareHashAndPassword([]byte(a.Password), []byte(password))
+}
+
+// IsScoped returns whether the account is restricted to some folders.
+// Admins are never restricted.
+func (a GUIAccountConfiguration) IsScoped() bool {
+	return a.Role != GUIRoleAdmin && len(a.Folders) > 0
+}
+
+// HasFolder returns whether the account has access to the given folder.
+func (a GUIAccountConfiguration) HasFolder(id string) bool {
+	return !a.IsScoped() || slices.Contains(a.Folders, id)
+}
+
+func (a *GUIAccountConfiguration) prepare() error {
+	if a.Name == "" {
+		return errors.New("GUI account without name")
+	}
+	if a.Password != "" {
+		// Plaintext passwords from the config file or the REST API are
+		// never stored as such.
+		hash, err := hashPassword(a.Password)
+		if err != nil {
+			return fmt.Errorf("GUI account %q: hashing password: %w", a.Name, err)
+		}
+		a.Password = hash
+	}
+	return nil
+}
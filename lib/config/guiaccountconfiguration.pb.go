@@ -0,0 +1,497 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/guiaccountconfiguration.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// An account for the GUI and REST API, in addition to the user set in the
+// GUI configuration, which is always an admin.
+type GUIAccountConfiguration struct {
+	Name     string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name" xml:"name,attr"`
+	Password string  `protobuf:"bytes,2,opt,name=password,proto3" json:"password" xml:"password,omitempty"`
+	Role     GUIRole `protobuf:"varint,3,opt,name=role,proto3,enum=config.GUIRole" json:"role" xml:"role,attr"`
+	// The folders an operator or viewer is restricted to; empty for all
+	// folders.
+	Folders []string `protobuf:"bytes,4,rep,name=folders,proto3" json:"folders" xml:"folder"`
+	// The subject ("sub" claim) of the user at the OpenID Connect provider
+	// who logs in as this account. Users from the provider only get the
+	// account through this binding, never by their name.
+	OIDCSubject string `protobuf:"bytes,5,opt,name=oidc_subject,json=oidcSubject,proto3" json:"oidcSubject" xml:"oidcSubject,omitempty"`
+}
+
+func (m *GUIAccountConfiguration) Reset()         { *m = GUIAccountConfiguration{} }
+func (m *GUIAccountConfiguration) String() string { return proto.CompactTextString(m) }
+func (*GUIAccountConfiguration) ProtoMessage()    {}
+func (*GUIAccountConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f3560ad2305c67a, []int{0}
+}
+func (m *GUIAccountConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GUIAccountConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GUIAccountConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GUIAccountConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GUIAccountConfiguration.Merge(m, src)
+}
+func (m *GUIAccountConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GUIAccountConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_GUIAccountConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GUIAccountConfiguration proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GUIAccountConfiguration)(nil), "config.GUIAccountConfiguration")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/guiaccountconfiguration.proto", fileDescriptor_2f3560ad2305c67a)
+}
+
+var fileDescriptor_2f3560ad2305c67a = []byte{
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x8b, 0xda, 0x40,
+	0x14, 0xc7, 0x93, 0x6a, 0x6d, 0x13, 0xa5, 0x42, 0xa0, 0x34, 0x58, 0x98, 0x11, 0xb1, 0x90, 0x82,
+	0x44, 0x68, 0x6f, 0xa5, 0xb4, 0x54, 0x0b, 0x22, 0xa5, 0x14, 0x52, 0xbc, 0x78, 0x29, 0x49, 0x8c,
+	0x71, 0x4a, 0x92, 0x91, 0x64, 0x42, 0xf5, 0x5b, 0x94, 0xbd, 0xec, 0x75, 0x3f, 0x8e, 0x37, 0x73,
+	0xdc, 0xcb, 0x0e, 0x68, 0x6e, 0x39, 0xe6, 0x13, 0x2c, 0x99, 0x59, 0x63, 0x64, 0xf7, 0xf6, 0xfe,
+	0xff, 0xf9, 0xbf, 0x5f, 0xde, 0x0b, 0x4f, 0xd6, 0x3c, 0x64, 0x0d, 0x6d, 0x1c, 0x2c, 0x91, 0x3b,
+	0x74, 0x63, 0x64, 0xda, 0x36, 0x8e, 0x03, 0xc2, 0x8d, 0x38, 0x34, 0x09, 0xc2, 0x81, 0xbe, 0x0e,
+	0x31, 0xc1, 0x4a, 0x83, 0x9b, 0x1d, 0xf5, 0xb2, 0x23, 0xc4, 0x9e, 0xc3, 0x13, 0x1d, 0xc9, 0xd9,
+	0x10, 0x5e, 0xf6, 0xee, 0x6a, 0xf2, 0x9b, 0xc9, 0x6c, 0xfa, 0x8d, 0xe3, 0xc6, 0x55, 0x9c, 0xf2,
+	0x59, 0xae, 0x07, 0xa6, 0xef, 0xa8, 0x62, 0x57, 0xd4, 0xa4, 0x91, 0x96, 0x51, 0xc8, 0x74, 0x4e,
+	0x61, 0x7b, 0xe3, 0x7b, 0x9f, 0x7a, 0x85, 0x18, 0x98, 0x84, 0x84, 0xbd, 0x6c, 0xdf, 0x97, 0x4a,
+	0x65, 0xb0, 0x94, 0x32, 0x97, 0x5f, 0xae, 0xcd, 0x28, 0xfa, 0x87, 0xc3, 0x85, 0xfa, 0x8c, 0x11,
+	0xbe, 0x64, 0x14, 0x96, 0x5e, 0x4e, 0xa1, 0xca, 0x28, 0x27, 0x63, 0x80, 0x7d, 0x44, 0x1c, 0x7f,
+	0x4d, 0xb6, 0x05, 0x4e, 0x79, 0x6c, 0x1b, 0x65, 0xaf, 0xf2, 0x53, 0xae, 0x17, 0xeb, 0xa8, 0xb5,
+	0xae, 0xa8, 0xbd, 0xfa, 0xd0, 0xd6, 0xf9, 0x96, 0xfa, 0x64, 0x36, 0x35, 0xb0, 0xe7, 0xf0, 0x51,
+	0x8b, 0x40, 0x39, 0x6a, 0x21, 0xce, 0xa3, 0x96, 0xca, 0x60, 0x29, 0xe5, 0xab, 0xfc, 0x62, 0x89,
+	0xbd, 0x85, 0x13, 0x46, 0x6a, 0xbd, 0x5b, 0xd3, 0xa4, 0xd1, 0xbb, 0x8c, 0xc2, 0x93, 0x95, 0x53,
+	0xd8, 0x62, 0x0c, 0xae, 0x0b, 0x40, 0x83, 0x97, 0xc6, 0x29, 0xa2, 0x5c, 0x8b, 0x72, 0x0b, 0xa3,
+	0x85, 0xfd, 0x27, 0x8a, 0xad, 0xbf, 0x8e, 0x4d, 0xd4, 0xe7, 0x6c, 0x61, 0x72, 0xa4, 0xb0, 0xf9,
+	0x6b, 0xfa, 0x7d, 0xfc, 0x9b, 0xdb, 0x19, 0x85, 0xcd, 0x22, 0xf6, 0x20, 0x73, 0x0a, 0xdf, 0x32,
+	0x72, 0xc5, 0xbb, 0xfc, 0x0b, 0xaf, 0x9f, 0x7c, 0xc9, 0xf7, 0xfd, 0x2a, 0xe6, 0x2a, 0xe9, 0x57,
+	0x3f, 0x62, 0x54, 0xdf, 0x46, 0x3f, 0x76, 0x07, 0x20, 0x24, 0x07, 0x20, 0xec, 0x8e, 0x40, 0x4c,
+	0x8e, 0x40, 0xfc, 0x9f, 0x02, 0xe1, 0x26, 0x05, 0x62, 0x92, 0x02, 0xe1, 0x36, 0x05, 0xc2, 0xfc,
+	0xbd, 0x8b, 0xc8, 0x2a, 0xb6, 0x74, 0x1b, 0xfb, 0xc3, 0x68, 0x1b, 0xd8, 0x64, 0x85, 0x02, 0xb7,
+	0x52, 0x9d, 0xaf, 0xc8, 0x6a, 0xb0, 0x9b, 0xf9, 0x78, 0x3f, 0x00, 0x1d, 0xfe, 0x0f, 0x3e, 0x8c,
+	0x02, 0x00, 0x00,
+}
+
+func (m *GUIAccountConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GUIAccountConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GUIAccountConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OIDCSubject) > 0 {
+		i -= len(m.OIDCSubject)
+		copy(dAtA[i:], m.OIDCSubject)
+		i = encodeVarintGuiaccountconfiguration(dAtA, i, uint64(len(m.OIDCSubject)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Folders[iNdEx])
+			copy(dAtA[i:], m.Folders[iNdEx])
+			i = encodeVarintGuiaccountconfiguration(dAtA, i, uint64(len(m.Folders[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Role != 0 {
+		i = encodeVarintGuiaccountconfiguration(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintGuiaccountconfiguration(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGuiaccountconfiguration(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGuiaccountconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGuiaccountconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GUIAccountConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGuiaccountconfiguration(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovGuiaccountconfiguration(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovGuiaccountconfiguration(uint64(m.Role))
+	}
+	if len(m.Folders) > 0 {
+		for _, s := range m.Folders {
+			l = len(s)
+			n += 1 + l + sovGuiaccountconfiguration(uint64(l))
+		}
+	}
+	l = len(m.OIDCSubject)
+	if l > 0 {
+		n += 1 + l + sovGuiaccountconfiguration(uint64(l))
+	}
+	return n
+}
+
+func sovGuiaccountconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGuiaccountconfiguration(x uint64) (n int) {
+	return sovGuiaccountconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GUIAccountConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuiaccountconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GUIAccountConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GUIAccountConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiaccountconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiaccountconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiaccountconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiaccountconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiaccountconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiaccountconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiaccountconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= GUIRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiaccountconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiaccountconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiaccountconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDCSubject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiaccountconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuiaccountconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiaccountconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OIDCSubject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuiaccountconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuiaccountconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGuiaccountconfiguration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGuiaccountconfiguration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuiaccountconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuiaccountconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGuiaccountconfiguration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGuiaccountconfiguration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGuiaccountconfiguration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGuiaccountconfiguration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGuiaccountconfiguration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGuiaccountconfiguration = fmt.Errorf("proto: unexpected end of group")
+)
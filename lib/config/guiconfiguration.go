@@ -7,6 +7,7 @@
 package config
 
 import (
+	"fmt"
 	"net/url"
 	"os"
 	"regexp"
@@ -20,7 +21,7 @@ import (
 
 func (c GUIConfiguration) IsAuthEnabled() bool {
 	// This function should match isAuthEnabled() in syncthingController.js
-	return c.AuthMode == AuthModeLDAP || (len(c.User) > 0 && len(c.Password) > 0)
+	return c.AuthMode == AuthModeLDAP || c.AuthMode == AuthModeOIDC || (len(c.User) > 0 && len(c.Password) > 0) || len(c.Accounts) > 0
 }
 
 func (GUIConfiguration) IsOverridden() bool {
@@ -120,16 +121,11 @@ var bcryptExpr = regexp.MustCompile(`^\$2[aby]\$\d+\$.{50,}`)
 // Plaintext passwords are hashed. Returns an error if the password is not
 // valid.
 func (c *GUIConfiguration) SetPassword(password string) error {
-	if bcryptExpr.MatchString(password) {
-		// Already hashed
-		c.Password = password
-		return nil
-	}
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hash, err := hashPassword(password)
 	if err != nil {
 		return err
 	}
-	c.Password = string(hash)
+	c.Password = hash
 	return nil
 }
 
@@ -140,6 +136,46 @@ func (c GUIConfiguration) CompareHashedPassword(password string) error {
 	return bcrypt.CompareHashAndPassword(configPasswordBytes, passwordBytes)
 }
 
+func hashPassword(password string) (string, error) {
+	if bcryptExpr.MatchString(password) {
+		// Already hashed
+		return password, nil
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// Account returns the account with the given name. The user set in the GUI
+// configuration is returned as an admin account.
+func (c GUIConfiguration) Account(name string) (GUIAccountConfiguration, bool) {
+	if c.User != "" && name == c.User {
+		return GUIAccountConfiguration{Name: c.User, Password: c.Password, Role: GUIRoleAdmin}, true
+	}
+	for _, account := range c.Accounts {
+		if account.Name == name {
+			return account, true
+		}
+	}
+	return GUIAccountConfiguration{}, false
+}
+
+// AccountForOIDCSubject returns the account bound to the given OpenID
+// Connect subject.
+func (c GUIConfiguration) AccountForOIDCSubject(subject string) (GUIAccountConfiguration, bool) {
+	if subject == "" {
+		return GUIAccountConfiguration{}, false
+	}
+	for _, account := range c.Accounts {
+		if account.OIDCSubject == subject {
+			return account, true
+		}
+	}
+	return GUIAccountConfiguration{}, false
+}
+
 // IsValidAPIKey returns true when the given API key is valid, including both
 // the value in config and any overrides
 func (c GUIConfiguration) IsValidAPIKey(apiKey string) bool {
@@ -155,12 +191,78 @@ func (c GUIConfiguration) IsValidAPIKey(apiKey string) bool {
 	}
 }
 
-func (c *GUIConfiguration) prepare() {
+// NamedAPIKey returns the named API key with the given value, expired or
+// not.
+func (c GUIConfiguration) NamedAPIKey(apiKey string) (APIKeyConfiguration, bool) {
+	if apiKey == "" {
+		return APIKeyConfiguration{}, false
+	}
+	for _, key := range c.APIKeys {
+		if key.Key == apiKey {
+			return key, true
+		}
+	}
+	return APIKeyConfiguration{}, false
+}
+
+func (c *GUIConfiguration) prepare() error {
 	if c.APIKey == "" {
 		c.APIKey = rand.String(32)
 	}
+
+	seen := make(map[string]struct{}, len(c.Accounts)+1)
+	if c.User != "" {
+		seen[c.User] = struct{}{}
+	}
+	subjects := make(map[string]struct{})
+	for i := range c.Accounts {
+		if err := c.Accounts[i].prepare(); err != nil {
+			return err
+		}
+		if _, ok := seen[c.Accounts[i].Name]; ok {
+			return fmt.Errorf("GUI account %q: duplicate name", c.Accounts[i].Name)
+		}
+		seen[c.Accounts[i].Name] = struct{}{}
+		if subject := c.Accounts[i].OIDCSubject; subject != "" {
+			if _, ok := subjects[subject]; ok {
+				return fmt.Errorf("GUI account %q: duplicate OpenID Connect subject", c.Accounts[i].Name)
+			}
+			subjects[subject] = struct{}{}
+		}
+	}
+
+	names := make(map[string]struct{}, len(c.APIKeys))
+	keys := map[string]struct{}{c.APIKey: {}}
+	for i := range c.APIKeys {
+		if err := c.APIKeys[i].prepare(); err != nil {
+			return err
+		}
+		if _, ok := names[c.APIKeys[i].Name]; ok {
+			return fmt.Errorf("API key %q: duplicate name", c.APIKeys[i].Name)
+		}
+		if _, ok := keys[c.APIKeys[i].Key]; ok {
+			return fmt.Errorf("API key %q: duplicate key", c.APIKeys[i].Name)
+		}
+		names[c.APIKeys[i].Name] = struct{}{}
+		keys[c.APIKeys[i].Key] = struct{}{}
+	}
+	return nil
 }
 
 func (c GUIConfiguration) Copy() GUIConfiguration {
+	if c.Accounts != nil {
+		accounts := make([]GUIAccountConfiguration, len(c.Accounts))
+		for i, account := range c.Accounts {
+			accounts[i] = account.Copy()
+		}
+		c.Accounts = accounts
+	}
+	if c.APIKeys != nil {
+		keys := make([]APIKeyConfiguration, len(c.APIKeys))
+		for i, key := range c.APIKeys {
+			keys[i] = key.Copy()
+		}
+		c.APIKeys = keys
+	}
 	return c
 }
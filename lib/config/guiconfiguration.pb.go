@@ -38,6 +38,10 @@ type GUIConfiguration struct {
 	InsecureSkipHostCheck     bool     `protobuf:"varint,12,opt,name=insecure_skip_host_check,json=insecureSkipHostCheck,proto3" json:"insecureSkipHostcheck" xml:"insecureSkipHostcheck,omitempty"`
 	InsecureAllowFrameLoading bool     `protobuf:"varint,13,opt,name=insecure_allow_frame_loading,json=insecureAllowFrameLoading,proto3" json:"insecureAllowFrameLoading" xml:"insecureAllowFrameLoading,omitempty"`
 	SendBasicAuthPrompt       bool     `protobuf:"varint,14,opt,name=send_basic_auth_prompt,json=sendBasicAuthPrompt,proto3" json:"sendBasicAuthPrompt" xml:"sendBasicAuthPrompt,attr"`
+	// Accept BEP connections over WebSocket at /bep on the GUI address.
+	BEPWebSocketEnabled bool                      `protobuf:"varint,15,opt,name=bep_websocket_enabled,json=bepWebsocketEnabled,proto3" json:"bepWebSocketEnabled" xml:"bepWebSocketEnabled,omitempty"`
+	Accounts            []GUIAccountConfiguration `protobuf:"bytes,16,rep,name=accounts,proto3" json:"accounts" xml:"account"`
+	APIKeys             []APIKeyConfiguration     `protobuf:"bytes,17,rep,name=api_keys,json=apiKeys,proto3" json:"apiKeys" xml:"apiKey"`
 }
 
 func (m *GUIConfiguration) Reset()         { *m = GUIConfiguration{} }
@@ -80,63 +84,74 @@ func init() {
 func init() { proto.RegisterFile("lib/config/guiconfiguration.proto", fileDescriptor_2a9586d611855d64) }
 
 var fileDescriptor_2a9586d611855d64 = []byte{
-	// 888 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0x5b, 0x47, 0xb2, 0xb6, 0x89, 0x60, 0xb0, 0x4d, 0xca, 0x04, 0x0d, 0xd7, 0x51, 0xd8,
-	0xc2, 0x01, 0x02, 0x39, 0x71, 0x5a, 0x24, 0xf0, 0xa1, 0x80, 0x1c, 0x20, 0x4d, 0x60, 0x17, 0x30,
-	0xe8, 0xfa, 0x92, 0x0b, 0xb1, 0x22, 0xd7, 0xd2, 0x42, 0xfc, 0x2b, 0x77, 0x09, 0x5b, 0x87, 0xf6,
-	0x01, 0x7a, 0x2a, 0xdc, 0x73, 0x81, 0x3e, 0x43, 0x2f, 0x7d, 0x85, 0xdc, 0xa4, 0x53, 0xd1, 0xd3,
-	0x02, 0x91, 0xd1, 0x0b, 0x8f, 0x3c, 0xe6, 0x54, 0xec, 0xf2, 0x47, 0xa2, 0x4c, 0x37, 0xb9, 0xed,
-	0x7c, 0xf3, 0xcd, 0x7c, 0x33, 0xc3, 0x19, 0x10, 0xdc, 0x73, 0xc9, 0x60, 0xdb, 0x0e, 0xfc, 0x13,
-	0x32, 0xdc, 0x1e, 0xc6, 0x24, 0x7b, 0xc5, 0x11, 0x62, 0x24, 0xf0, 0x7b, 0x61, 0x14, 0xb0, 0x40,
-	0x6d, 0x66, 0xe0, 0x9d, 0xdb, 0x4b, 0x54, 0x14, 0xb3, 0x91, 0x17, 0x38, 0x38, 0xa3, 0xdc, 0x69,
-	0xe3, 0x33, 0x96, 0x3d, 0xbb, 0xff, 0xde, 0x00, 0x1b, 0xdf, 0x1d, 0xbf, 0x7a, 0xbe, 0x9c, 0x48,
-	0x1d, 0x80, 0x16, 0xf6, 0xd1, 0xc0, 0xc5, 0x8e, 0xa6, 0x6c, 0x2a, 0x5b, 0xeb, 0x7b, 0x2f, 0x13,
-	0x0e, 0x0b, 0x28, 0xe5, 0xf0, 0xde, 0x99, 0xe7, 0xee, 0x76, 0x73, 0xfb, 0x21, 0x62, 0x2c, 0xea,
-	0x6e, 0x3a, 0xf8, 0x04, 0xc5, 0x2e, 0xdb, 0xed, 0xb2, 0x28, 0xc6, 0xdd, 0x64, 0x6a, 0x5c, 0x5f,
-	0xf6, 0xbf, 0x9b, 0x1a, 0x6b, 0xc2, 0x61, 0x16, 0x59, 0xd4, 0x9f, 0x40, 0x0b, 0x39, 0x4e, 0x84,
-	0x29, 0xd5, 0x3e, 0xda, 0x54, 0xb6, 0xda, 0x7b, 0xf6, 0x9c, 0x43, 0x60, 0xa2, 0xd3, 0x7e, 0x86,
-	0x0a, 0xc5, 0x9c, 0x90, 0x72, 0xf8, 0x95, 0x54, 0xcc, 0xed, 0x25, 0xb1, 0xc7, 0x3b, 0x4f, 0x7b,
-	0x8f, 0x7a, 0x8f, 0x7a, 0x8f, 0x77, 0x9f, 0x3d, 0x79, 0xf6, 0x75, 0xf7, 0xdd, 0xd4, 0xe8, 0x54,
-	0xa1, 0xf3, 0x99, 0xb1, 0x94, 0xd4, 0x2c, 0x52, 0xaa, 0x7f, 0x2b, 0xe0, 0xf3, 0xd8, 0x27, 0x67,
-	0x16, 0x0d, 0xec, 0x31, 0x66, 0x56, 0x88, 0x23, 0x8f, 0x50, 0x4a, 0x02, 0x9f, 0x6a, 0x1f, 0xcb,
-	0x7a, 0x7e, 0x57, 0xe6, 0x1c, 0x6a, 0x26, 0x3a, 0x3d, 0xf6, 0xc9, 0xd9, 0x91, 0x64, 0x1d, 0x2e,
-	0x48, 0x09, 0x87, 0x37, 0xe3, 0x3a, 0x47, 0xca, 0xe1, 0x97, 0xb2, 0xd8, 0x5a, 0xef, 0xc3, 0xc0,
-	0x23, 0x0c, 0x7b, 0x21, 0x9b, 0x88, 0x11, 0xc1, 0xf7, 0x70, 0xce, 0x67, 0xc6, 0x95, 0x05, 0x98,
-	0xf5, 0xf2, 0xea, 0x0b, 0xb0, 0x16, 0x53, 0x1c, 0x69, 0x6b, 0xb2, 0x89, 0x9d, 0x84, 0x43, 0x69,
-	0xa7, 0x1c, 0x7e, 0x96, 0x95, 0x45, 0x71, 0x54, 0xad, 0xa2, 0x53, 0x85, 0x4c, 0xc9, 0x57, 0x5f,
-	0x83, 0xf5, 0x10, 0x51, 0x7a, 0x1a, 0x44, 0x8e, 0x76, 0x4d, 0xe6, 0xfa, 0x36, 0xe1, 0xb0, 0xc4,
-	0x52, 0x0e, 0x35, 0x99, 0xaf, 0x00, 0xaa, 0x39, 0xd5, 0xcb, 0xb0, 0x59, 0xc6, 0xaa, 0x1e, 0x68,
-	0x8b, 0x8d, 0xb4, 0xc4, 0x4a, 0x6a, 0xcd, 0x4d, 0x65, 0xab, 0xb3, 0xb3, 0xd1, 0xcb, 0x56, 0xb5,
-	0xd7, 0x8f, 0xd9, 0xe8, 0xfb, 0xc0, 0xc1, 0x99, 0x1c, 0xca, 0xad, 0x52, 0xae, 0x00, 0x56, 0xe4,
-	0x2e, 0xc3, 0x66, 0x19, 0xab, 0x62, 0xd0, 0x8a, 0x29, 0xb6, 0x98, 0x4b, 0xb5, 0x96, 0x5c, 0xe7,
-	0x83, 0x39, 0x87, 0x6d, 0x31, 0x58, 0x8a, 0x7f, 0x38, 0x38, 0x4a, 0x38, 0x6c, 0xc6, 0xf2, 0x95,
-	0x72, 0xd8, 0x91, 0x2a, 0xcc, 0xa5, 0xd9, 0x5a, 0x27, 0x53, 0x63, 0xbd, 0x30, 0xd2, 0xa9, 0x91,
-	0xf3, 0xce, 0x67, 0xc6, 0x22, 0xdc, 0x94, 0xa0, 0x4b, 0x85, 0x0c, 0x0a, 0x89, 0x35, 0xc6, 0x13,
-	0x6d, 0x5d, 0x0e, 0x4c, 0xc8, 0x34, 0xfb, 0x87, 0xaf, 0xf6, 0xf1, 0x44, 0x68, 0xa0, 0x90, 0xec,
-	0xe3, 0x49, 0xca, 0xe1, 0xad, 0xac, 0x93, 0x90, 0x8c, 0xf1, 0xa4, 0xda, 0xc7, 0xc6, 0x2a, 0x78,
-	0x3e, 0x33, 0xf2, 0x0c, 0x66, 0x1e, 0xaf, 0xfe, 0xa6, 0x80, 0x9b, 0xc4, 0xa7, 0xd8, 0x8e, 0x23,
-	0x6c, 0x21, 0xc7, 0x23, 0xbe, 0x85, 0x6c, 0x5b, 0xdc, 0x51, 0x5b, 0x36, 0x67, 0x25, 0x1c, 0x7e,
-	0x5a, 0x10, 0xfa, 0xc2, 0xdf, 0x97, 0xee, 0x94, 0xc3, 0xfb, 0x52, 0xb8, 0xc6, 0x57, 0xad, 0xe2,
-	0xee, 0xff, 0x32, 0xcc, 0xba, 0xe4, 0xea, 0x3e, 0xb8, 0xc6, 0x46, 0xd8, 0xc3, 0x1a, 0x90, 0xad,
-	0x7f, 0x93, 0x70, 0x98, 0x01, 0x29, 0x87, 0x77, 0xb3, 0x99, 0x0a, 0x6b, 0xe9, 0x74, 0xf3, 0x87,
-	0xb8, 0xd9, 0x56, 0xfe, 0x36, 0xb3, 0x10, 0xf5, 0x18, 0xb4, 0x1d, 0x3c, 0x88, 0x87, 0x43, 0xe2,
-	0x0f, 0xb5, 0x4f, 0x64, 0x57, 0x4f, 0x13, 0x0e, 0x17, 0x60, 0xb9, 0xcd, 0x25, 0x52, 0x7e, 0xae,
-	0x4e, 0x15, 0x32, 0x17, 0x41, 0xea, 0x5f, 0x0a, 0xd0, 0xca, 0xc9, 0xd1, 0x31, 0x09, 0xad, 0x51,
-	0x40, 0x99, 0x65, 0x8f, 0xb0, 0x3d, 0xd6, 0xae, 0x4b, 0x99, 0x9f, 0xc5, 0x5d, 0x17, 0x9c, 0xa3,
-	0x31, 0x09, 0x5f, 0x06, 0x94, 0x49, 0x42, 0x79, 0xd7, 0xb5, 0xde, 0x95, 0xbb, 0x7e, 0x0f, 0x27,
-	0x9d, 0x1a, 0xf5, 0x22, 0xe6, 0x25, 0xf8, 0xb9, 0x80, 0xd5, 0x3f, 0x15, 0xf0, 0xc5, 0xe2, 0x9b,
-	0xbb, 0x6e, 0x70, 0x6a, 0x9d, 0x44, 0xc8, 0xc3, 0x96, 0x1b, 0x20, 0x47, 0x0c, 0xe9, 0x86, 0xac,
-	0xfe, 0xc7, 0x84, 0xc3, 0xdb, 0xe5, 0xd7, 0x11, 0xb4, 0x17, 0x82, 0x75, 0x90, 0x91, 0x52, 0x0e,
-	0x1f, 0x54, 0x17, 0x60, 0x95, 0x51, 0xed, 0xe2, 0xfe, 0x07, 0xf0, 0xcc, 0xab, 0xe5, 0xd4, 0x5f,
-	0x14, 0x70, 0x8b, 0x62, 0xdf, 0xb1, 0x06, 0x88, 0x12, 0xdb, 0x92, 0x17, 0x1f, 0x46, 0x81, 0x17,
-	0x32, 0xad, 0x23, 0xcb, 0x3d, 0x16, 0x9b, 0x2a, 0x18, 0x7b, 0x82, 0x20, 0x0e, 0xff, 0x50, 0xba,
-	0x53, 0x0e, 0x75, 0x59, 0x68, 0x8d, 0xaf, 0xfc, 0xce, 0xda, 0x55, 0x4e, 0xb3, 0x2e, 0xe5, 0xde,
-	0xfe, 0x9b, 0xb7, 0x7a, 0x63, 0xf6, 0x56, 0x6f, 0xbc, 0x99, 0xeb, 0xca, 0x6c, 0xae, 0x2b, 0xbf,
-	0x5e, 0xe8, 0x8d, 0x3f, 0x2e, 0x74, 0x65, 0x76, 0xa1, 0x37, 0xfe, 0xb9, 0xd0, 0x1b, 0xaf, 0x1f,
-	0x0c, 0x09, 0x1b, 0xc5, 0x83, 0x9e, 0x1d, 0x78, 0xdb, 0x74, 0xe2, 0xdb, 0x6c, 0x44, 0xfc, 0xe1,
-	0xd2, 0x6b, 0xf1, 0x3b, 0x1d, 0x34, 0xe5, 0xbf, 0xf3, 0xc9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xfe, 0x19, 0xb2, 0x3c, 0x8e, 0x07, 0x00, 0x00,
+	// 1071 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x3f, 0x6f, 0xdb, 0xc6,
+	0x1b, 0x16, 0x7f, 0x71, 0x2c, 0x89, 0x71, 0xf4, 0x73, 0x98, 0x3a, 0x65, 0xd2, 0x46, 0xe7, 0x28,
+	0x6c, 0xe1, 0x00, 0x86, 0x9c, 0x38, 0x2d, 0x12, 0x78, 0x28, 0x20, 0x05, 0xf9, 0xe3, 0xda, 0x05,
+	0x0c, 0xba, 0x46, 0x81, 0x2c, 0x04, 0x45, 0x9e, 0xa5, 0x83, 0xc4, 0x3f, 0xe5, 0x1d, 0x61, 0x6b,
+	0x68, 0x87, 0x8e, 0x9d, 0x0a, 0x75, 0x2e, 0xd0, 0xa1, 0x5f, 0xa0, 0x5d, 0xfa, 0x15, 0xbc, 0x49,
+	0x53, 0xd1, 0x2e, 0x07, 0x44, 0xde, 0x38, 0x72, 0xcc, 0x54, 0xdc, 0x1d, 0x49, 0x89, 0x32, 0x9d,
+	0x74, 0xbb, 0x7b, 0x9e, 0xe7, 0xde, 0xe7, 0xbd, 0xe3, 0xbd, 0xef, 0x51, 0xbe, 0x37, 0x40, 0x9d,
+	0x2d, 0xcb, 0x73, 0x8f, 0x51, 0x77, 0xab, 0x1b, 0x22, 0x31, 0x0a, 0x03, 0x93, 0x20, 0xcf, 0x6d,
+	0xfa, 0x81, 0x47, 0x3c, 0x65, 0x59, 0x80, 0x77, 0xb4, 0x39, 0xa9, 0xe9, 0xa3, 0x3e, 0x1c, 0x16,
+	0xa8, 0xef, 0xdc, 0x9e, 0x57, 0x85, 0xa4, 0xe7, 0x78, 0x36, 0x4c, 0xa8, 0x8d, 0xbc, 0x97, 0x69,
+	0x59, 0x5e, 0xe8, 0x92, 0xa2, 0x20, 0x55, 0x78, 0x4a, 0xc4, 0xb0, 0xf1, 0xfb, 0x0d, 0x79, 0xf5,
+	0xe5, 0xd1, 0xee, 0xb3, 0x79, 0x95, 0xd2, 0x91, 0xcb, 0xd0, 0x35, 0x3b, 0x03, 0x68, 0xab, 0xd2,
+	0xba, 0xb4, 0x51, 0x69, 0xbf, 0x8a, 0x28, 0x48, 0xa1, 0x98, 0x82, 0x7b, 0xa7, 0xce, 0x60, 0xa7,
+	0x91, 0xcc, 0x37, 0x4d, 0x42, 0x82, 0xc6, 0xba, 0x0d, 0x8f, 0xcd, 0x70, 0x40, 0x76, 0x1a, 0x24,
+	0x08, 0x61, 0x23, 0x1a, 0x6b, 0x2b, 0xf3, 0xfc, 0xdb, 0xb1, 0xb6, 0xc4, 0x08, 0x3d, 0x8d, 0xa2,
+	0x7c, 0x27, 0x97, 0x4d, 0xdb, 0x0e, 0x20, 0xc6, 0xea, 0xff, 0xd6, 0xa5, 0x8d, 0x6a, 0xdb, 0x9a,
+	0x52, 0x20, 0xeb, 0xe6, 0x49, 0x4b, 0xa0, 0xcc, 0x31, 0x11, 0xc4, 0x14, 0x7c, 0xca, 0x1d, 0x93,
+	0xf9, 0x9c, 0xd9, 0xa3, 0xed, 0x27, 0xcd, 0x87, 0xcd, 0x87, 0xcd, 0x47, 0x3b, 0x4f, 0x1f, 0x3f,
+	0xfd, 0xac, 0xf1, 0x76, 0xac, 0xd5, 0xf2, 0xd0, 0x68, 0xa2, 0xcd, 0x05, 0xd5, 0xd3, 0x90, 0xca,
+	0x5f, 0x92, 0xfc, 0x61, 0xe8, 0xa2, 0x53, 0x03, 0x7b, 0x56, 0x1f, 0x12, 0xc3, 0x87, 0x81, 0x83,
+	0x30, 0x46, 0x9e, 0x8b, 0xd5, 0x2b, 0x3c, 0x9f, 0x5f, 0xa4, 0x29, 0x05, 0xaa, 0x6e, 0x9e, 0x1c,
+	0xb9, 0xe8, 0xf4, 0x90, 0xab, 0x0e, 0x66, 0xa2, 0x88, 0x82, 0xb5, 0xb0, 0x88, 0x88, 0x29, 0xf8,
+	0x84, 0x27, 0x5b, 0xc8, 0x6e, 0x7a, 0x0e, 0x22, 0xd0, 0xf1, 0xc9, 0x90, 0x1d, 0x11, 0x78, 0x8f,
+	0x66, 0x34, 0xd1, 0x2e, 0x4d, 0x40, 0x2f, 0xb6, 0x57, 0x5e, 0xc8, 0x4b, 0x21, 0x86, 0x81, 0xba,
+	0xc4, 0x37, 0xb1, 0x1d, 0x51, 0xc0, 0xe7, 0x31, 0x05, 0x1f, 0x88, 0xb4, 0x30, 0x0c, 0xf2, 0x59,
+	0xd4, 0xf2, 0x90, 0xce, 0xf5, 0xca, 0x6b, 0xb9, 0xe2, 0x9b, 0x18, 0x9f, 0x78, 0x81, 0xad, 0x5e,
+	0xe5, 0xb1, 0xbe, 0x88, 0x28, 0xc8, 0xb0, 0x98, 0x02, 0x95, 0xc7, 0x4b, 0x81, 0x7c, 0x4c, 0xe5,
+	0x22, 0xac, 0x67, 0x6b, 0x15, 0x47, 0xae, 0xb2, 0xbb, 0x6b, 0xb0, 0xcb, 0xab, 0x2e, 0xaf, 0x4b,
+	0x1b, 0xb5, 0xed, 0xd5, 0xa6, 0xb8, 0xa8, 0xcd, 0x56, 0x48, 0x7a, 0x5f, 0x79, 0x36, 0x14, 0x76,
+	0x66, 0x32, 0xcb, 0xec, 0x52, 0x60, 0xc1, 0xee, 0x22, 0xac, 0x67, 0x6b, 0x15, 0x28, 0x97, 0x43,
+	0x0c, 0x0d, 0x32, 0xc0, 0x6a, 0x99, 0x5f, 0xe7, 0xfd, 0x29, 0x05, 0x55, 0x76, 0xb0, 0x18, 0x7e,
+	0xbd, 0x7f, 0x18, 0x51, 0xb0, 0x1c, 0xf2, 0x51, 0x4c, 0x41, 0x8d, 0xbb, 0x90, 0x01, 0x16, 0xd7,
+	0x3a, 0x1a, 0x6b, 0x95, 0x74, 0x12, 0x8f, 0xb5, 0x44, 0x37, 0x9a, 0x68, 0xb3, 0xe5, 0x3a, 0x07,
+	0x07, 0x98, 0xd9, 0x98, 0x3e, 0x32, 0xfa, 0x70, 0xa8, 0x56, 0xf8, 0x81, 0x31, 0x9b, 0xe5, 0xd6,
+	0xc1, 0xee, 0x1e, 0x1c, 0x32, 0x0f, 0xd3, 0x47, 0x7b, 0x70, 0x18, 0x53, 0x70, 0x4b, 0xec, 0x84,
+	0xd7, 0x78, 0x7e, 0x1f, 0xab, 0x8b, 0xe0, 0x68, 0xa2, 0x25, 0x11, 0xf4, 0x64, 0xbd, 0xf2, 0xb3,
+	0x24, 0xaf, 0x21, 0x17, 0x43, 0x2b, 0x0c, 0xa0, 0x61, 0xda, 0x0e, 0x72, 0x0d, 0xd3, 0xb2, 0x58,
+	0x1d, 0x55, 0xf9, 0xe6, 0x8c, 0x88, 0x82, 0x9b, 0xa9, 0xa0, 0xc5, 0xf8, 0x16, 0xa7, 0x63, 0x0a,
+	0xee, 0x73, 0xe3, 0x02, 0x2e, 0x9f, 0xc5, 0xdd, 0x77, 0x2a, 0xf4, 0xa2, 0xe0, 0xca, 0x9e, 0x7c,
+	0x95, 0xf4, 0xa0, 0x03, 0x55, 0x99, 0x6f, 0xfd, 0xf3, 0x88, 0x02, 0x01, 0xc4, 0x14, 0xdc, 0x15,
+	0x67, 0xca, 0x66, 0x73, 0xa5, 0x9b, 0x0c, 0x58, 0xcd, 0x96, 0x93, 0xb1, 0x2e, 0x96, 0x28, 0x47,
+	0x72, 0xd5, 0x86, 0x9d, 0xb0, 0xdb, 0x45, 0x6e, 0x57, 0xbd, 0xc6, 0x77, 0xf5, 0x24, 0xa2, 0x60,
+	0x06, 0x66, 0xb7, 0x39, 0x43, 0xb2, 0xcf, 0x55, 0xcb, 0x43, 0xfa, 0x6c, 0x91, 0xf2, 0xa7, 0x24,
+	0xab, 0xd9, 0xc9, 0xe1, 0x3e, 0xf2, 0x8d, 0x9e, 0x87, 0x89, 0x61, 0xf5, 0xa0, 0xd5, 0x57, 0x57,
+	0xb8, 0xcd, 0xf7, 0xac, 0xae, 0x53, 0xcd, 0x61, 0x1f, 0xf9, 0xaf, 0x3c, 0x4c, 0xb8, 0x20, 0xab,
+	0xeb, 0x42, 0x76, 0xa1, 0xae, 0xdf, 0xa3, 0x89, 0xc7, 0x5a, 0xb1, 0x89, 0x7e, 0x01, 0x7e, 0xc6,
+	0x60, 0xe5, 0x0f, 0x49, 0xfe, 0x78, 0xf6, 0xcd, 0x07, 0x03, 0xef, 0xc4, 0x38, 0x0e, 0x4c, 0x07,
+	0x1a, 0x03, 0xcf, 0xb4, 0xd9, 0x21, 0x5d, 0xe7, 0xd9, 0x7f, 0x1b, 0x51, 0x70, 0x3b, 0xfb, 0x3a,
+	0x4c, 0xf6, 0x82, 0xa9, 0xf6, 0x85, 0x28, 0xa6, 0xe0, 0x41, 0xfe, 0x02, 0x2c, 0x2a, 0xf2, 0xbb,
+	0xb8, 0xff, 0x1f, 0x74, 0xfa, 0xe5, 0x76, 0xca, 0x8f, 0x92, 0x7c, 0x0b, 0x43, 0xd7, 0x36, 0x3a,
+	0x26, 0x46, 0x96, 0xc1, 0x2b, 0xde, 0x0f, 0x3c, 0xc7, 0x27, 0x6a, 0x8d, 0xa7, 0x7b, 0xc4, 0x6e,
+	0x2a, 0x53, 0xb4, 0x99, 0x80, 0x15, 0xfe, 0x01, 0xa7, 0x63, 0x0a, 0xea, 0x3c, 0xd1, 0x02, 0x2e,
+	0xfb, 0xce, 0xea, 0x65, 0xa4, 0x5e, 0x14, 0x52, 0xf9, 0x47, 0x92, 0xd7, 0x3a, 0xd0, 0x37, 0x4e,
+	0x60, 0x27, 0xe9, 0xf8, 0xe9, 0x0b, 0xf7, 0x7f, 0x9e, 0xcb, 0x6f, 0xac, 0xdb, 0xdf, 0x6c, 0x3f,
+	0x3f, 0xf8, 0x06, 0x76, 0x44, 0x53, 0x7d, 0x2e, 0x78, 0x96, 0x63, 0x07, 0xfa, 0x8b, 0x70, 0x56,
+	0x4d, 0x05, 0xdc, 0x42, 0x35, 0xbd, 0x53, 0x11, 0x8f, 0xb5, 0xa2, 0xf0, 0xa3, 0x89, 0x56, 0x94,
+	0x8c, 0x9e, 0x68, 0xf1, 0x3c, 0xa8, 0x20, 0xb9, 0x92, 0x3c, 0xf6, 0x58, 0x5d, 0x5d, 0xbf, 0xb2,
+	0x71, 0x6d, 0x1b, 0xa4, 0xdd, 0xf4, 0xe5, 0xd1, 0x6e, 0x4b, 0x50, 0xb9, 0x17, 0xbe, 0xbd, 0x79,
+	0x46, 0x41, 0x89, 0x37, 0xd8, 0x64, 0x61, 0x4c, 0xc1, 0x75, 0xd1, 0x96, 0x04, 0xc0, 0x32, 0x2f,
+	0x27, 0x63, 0x3d, 0x53, 0x29, 0x3f, 0x48, 0x72, 0x25, 0x69, 0x72, 0x58, 0xbd, 0xc1, 0xbd, 0x3e,
+	0xca, 0x3a, 0x37, 0xef, 0x53, 0x79, 0x9f, 0x2f, 0x99, 0xcf, 0x94, 0x82, 0xb2, 0x20, 0xc5, 0xab,
+	0xce, 0xfb, 0x18, 0x73, 0x5c, 0x49, 0x1b, 0xe1, 0x1e, 0xe4, 0x47, 0x95, 0xb6, 0x48, 0x66, 0x2d,
+	0x44, 0xa3, 0x89, 0x96, 0x2e, 0xd5, 0x53, 0xac, 0xbd, 0x77, 0xf6, 0xa6, 0x5e, 0x9a, 0xbc, 0xa9,
+	0x97, 0xce, 0xa6, 0x75, 0x69, 0x32, 0xad, 0x4b, 0x3f, 0x9d, 0xd7, 0x4b, 0xbf, 0x9e, 0xd7, 0xa5,
+	0xc9, 0x79, 0xbd, 0xf4, 0xf7, 0x79, 0xbd, 0xf4, 0xfa, 0x41, 0x17, 0x91, 0x5e, 0xd8, 0x69, 0x5a,
+	0x9e, 0xb3, 0x85, 0x87, 0xae, 0x45, 0x7a, 0xc8, 0xed, 0xce, 0x8d, 0x66, 0x7f, 0x4a, 0x9d, 0x65,
+	0xfe, 0x1f, 0xf4, 0xf8, 0xdf, 0x01, 0x00, 0x9b, 0x66, 0x67, 0x81, 0xaa, 0x09, 0x00, 0x00,
 }
 
 func (m *GUIConfiguration) Marshal() (dAtA []byte, err error) {
@@ -159,6 +174,48 @@ func (m *GUIConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.APIKeys) > 0 {
+		for iNdEx := len(m.APIKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.APIKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGuiconfiguration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.BEPWebSocketEnabled {
+		i--
+		if m.BEPWebSocketEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.SendBasicAuthPrompt {
 		i--
 		if m.SendBasicAuthPrompt {
@@ -344,6 +401,21 @@ func (m *GUIConfiguration) ProtoSize() (n int) {
 	if m.SendBasicAuthPrompt {
 		n += 2
 	}
+	if m.BEPWebSocketEnabled {
+		n += 2
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.ProtoSize()
+			n += 2 + l + sovGuiconfiguration(uint64(l))
+		}
+	}
+	if len(m.APIKeys) > 0 {
+		for _, e := range m.APIKeys {
+			l = e.ProtoSize()
+			n += 2 + l + sovGuiconfiguration(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -733,6 +805,94 @@ func (m *GUIConfiguration) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SendBasicAuthPrompt = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BEPWebSocketEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BEPWebSocketEnabled = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, GUIAccountConfiguration{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuiconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuiconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKeys = append(m.APIKeys, APIKeyConfiguration{})
+			if err := m.APIKeys[len(m.APIKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGuiconfiguration(dAtA[iNdEx:])
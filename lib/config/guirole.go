@@ -0,0 +1,36 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+func (r GUIRole) String() string {
+	switch r {
+	case GUIRoleViewer:
+		return "viewer"
+	case GUIRoleOperator:
+		return "operator"
+	case GUIRoleAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+func (r GUIRole) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *GUIRole) UnmarshalText(bs []byte) error {
+	switch string(bs) {
+	case "admin":
+		*r = GUIRoleAdmin
+	case "operator":
+		*r = GUIRoleOperator
+	default:
+		*r = GUIRoleViewer
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/guirole.proto
+
+package config
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GUIRole int32
+
+const (
+	// Accounts without a role are the least privileged.
+	GUIRoleViewer   GUIRole = 0
+	GUIRoleOperator GUIRole = 1
+	GUIRoleAdmin    GUIRole = 2
+)
+
+var GUIRole_name = map[int32]string{
+	0: "GUI_ROLE_VIEWER",
+	1: "GUI_ROLE_OPERATOR",
+	2: "GUI_ROLE_ADMIN",
+}
+
+var GUIRole_value = map[string]int32{
+	"GUI_ROLE_VIEWER":   0,
+	"GUI_ROLE_OPERATOR": 1,
+	"GUI_ROLE_ADMIN":    2,
+}
+
+func (GUIRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d974ce25416e2423, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("config.GUIRole", GUIRole_name, GUIRole_value)
+}
+
+func init() { proto.RegisterFile("lib/config/guirole.proto", fileDescriptor_d974ce25416e2423) }
+
+var fileDescriptor_d974ce25416e2423 = []byte{
+	// 273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc8, 0xc9, 0x4c, 0xd2,
+	0x4f, 0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x4f, 0x2f, 0xcd, 0x2c, 0xca, 0xcf, 0x49, 0xd5, 0x2b,
+	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0x88, 0x4a, 0x29, 0x17, 0xa5, 0x16, 0xe4, 0x17, 0xeb,
+	0x83, 0x05, 0x93, 0x4a, 0xd3, 0xf4, 0xd3, 0xf3, 0xd3, 0xf3, 0xc1, 0x1c, 0x30, 0x0b, 0xa2, 0x58,
+	0x8a, 0x33, 0xb5, 0xa2, 0x04, 0xc2, 0xd4, 0xda, 0xcb, 0xc8, 0xc5, 0xee, 0x1e, 0xea, 0x19, 0x94,
+	0x9f, 0x93, 0x2a, 0x64, 0xce, 0xc5, 0xef, 0x1e, 0xea, 0x19, 0x1f, 0xe4, 0xef, 0xe3, 0x1a, 0x1f,
+	0xe6, 0xe9, 0x1a, 0xee, 0x1a, 0x24, 0xc0, 0x20, 0xa5, 0xd4, 0x35, 0x57, 0x81, 0x17, 0xaa, 0x22,
+	0x2c, 0x33, 0xb5, 0x3c, 0xb5, 0xe8, 0x52, 0x9f, 0x2a, 0xaa, 0x80, 0x90, 0x2d, 0x97, 0x20, 0x5c,
+	0xa3, 0x7f, 0x80, 0x6b, 0x90, 0x63, 0x88, 0x7f, 0x90, 0x00, 0xa3, 0x94, 0x5a, 0xd7, 0x5c, 0x05,
+	0x7e, 0xa8, 0x4a, 0xff, 0x82, 0xd4, 0xa2, 0xc4, 0x92, 0x7c, 0x90, 0x66, 0x74, 0x21, 0x21, 0x13,
+	0x2e, 0x3e, 0xb8, 0x76, 0x47, 0x17, 0x5f, 0x4f, 0x3f, 0x01, 0x26, 0x29, 0x85, 0xae, 0xb9, 0x0a,
+	0x3c, 0x50, 0x85, 0x8e, 0x29, 0xb9, 0x99, 0x79, 0x97, 0xfa, 0x54, 0x51, 0xf8, 0x52, 0x2c, 0x2b,
+	0x96, 0xc8, 0x31, 0x38, 0x79, 0x9f, 0x78, 0x28, 0xc7, 0x70, 0xe1, 0xa1, 0x1c, 0xc3, 0x89, 0x47,
+	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0xb0, 0xe0, 0xb1, 0x1c, 0xe3, 0x85,
+	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
+	0x25, 0xe7, 0xe7, 0xea, 0x17, 0x57, 0xe6, 0x25, 0x97, 0x64, 0x64, 0xe6, 0xa5, 0x23, 0xb1, 0x10,
+	0x41, 0x9a, 0xc4, 0x06, 0x0e, 0x13, 0x63, 0xc0, 0x00, 0x01, 0xcf, 0xb9, 0xd2, 0x67, 0x01, 0x00,
+	0x00,
+}
@@ -0,0 +1,197 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// The origins of revisions not made through the REST API, which records
+// "api" or "api:" and the user making the change.
+const (
+	// The configuration in effect when Syncthing started
+	OriginStartup = "startup"
+	// Changes made by Syncthing itself, like adding a folder shared by an
+	// introducer
+	OriginSyncthing = "syncthing"
+)
+
+const historyIndexFile = "index.json"
+
+// ErrNoSuchRevision is returned for revisions that aren't, or are no
+// longer, in the history.
+var ErrNoSuchRevision = errors.New("no such revision")
+
+// A Revision is a committed configuration kept in the history.
+type Revision struct {
+	Number int       `json:"number"`
+	Time   time.Time `json:"time"`
+	Origin string    `json:"origin"`
+}
+
+// History keeps the most recently committed configurations in a directory,
+// each in a file of its own in the same format as config.xml, along with an
+// index of the revisions.
+type History struct {
+	dir  string
+	myID protocol.DeviceID
+
+	mut       sync.Mutex
+	revisions []Revision // nil until the index is loaded
+}
+
+func NewHistory(dir string, myID protocol.DeviceID) *History {
+	return &History{
+		dir:  dir,
+		myID: myID,
+		mut:  sync.NewMutex(),
+	}
+}
+
+// Record adds the configuration to the history, unless it is the same as
+// the latest revision, and removes the oldest revisions beyond the given
+// number to keep. It returns the latest revision, which is the zero
+// Revision if none are kept.
+func (h *History) Record(cfg Configuration, origin string, keep int) (Revision, error) {
+	var buf bytes.Buffer
+	if err := cfg.WriteXML(osutil.LineEndingsWriter(&buf)); err != nil {
+		return Revision{}, err
+	}
+
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	if err := h.loadLocked(); err != nil {
+		return Revision{}, err
+	}
+
+	if keep <= 0 {
+		return Revision{}, h.pruneLocked(0)
+	}
+
+	number := 1
+	if len(h.revisions) > 0 {
+		latest := h.revisions[len(h.revisions)-1]
+		if bs, err := os.ReadFile(h.revisionPath(latest.Number)); err == nil && bytes.Equal(bs, buf.Bytes()) {
+			return latest, nil
+		}
+		number = latest.Number + 1
+	}
+
+	if err := os.MkdirAll(h.dir, 0o700); err != nil {
+		return Revision{}, err
+	}
+	if err := writeFileAtomic(h.revisionPath(number), buf.Bytes()); err != nil {
+		return Revision{}, err
+	}
+	rev := Revision{
+		Number: number,
+		Time:   time.Now().Truncate(time.Second),
+		Origin: origin,
+	}
+	h.revisions = append(h.revisions, rev)
+	return rev, h.pruneLocked(keep)
+}
+
+// Revisions returns the revisions in the history, oldest first.
+func (h *History) Revisions() ([]Revision, error) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	if err := h.loadLocked(); err != nil {
+		return nil, err
+	}
+	return append([]Revision{}, h.revisions...), nil
+}
+
+// Load returns the revision with the given number and its configuration,
+// or ErrNoSuchRevision.
+func (h *History) Load(number int) (Revision, Configuration, error) {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	if err := h.loadLocked(); err != nil {
+		return Revision{}, Configuration{}, err
+	}
+	for _, rev := range h.revisions {
+		if rev.Number != number {
+			continue
+		}
+		fd, err := os.Open(h.revisionPath(number))
+		if err != nil {
+			return Revision{}, Configuration{}, err
+		}
+		defer fd.Close()
+		cfg, _, err := ReadXML(fd, h.myID)
+		return rev, cfg, err
+	}
+	return Revision{}, Configuration{}, ErrNoSuchRevision
+}
+
+func (h *History) loadLocked() error {
+	if h.revisions != nil {
+		return nil
+	}
+	bs, err := os.ReadFile(filepath.Join(h.dir, historyIndexFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		h.revisions = []Revision{}
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var revisions []Revision
+	if err := json.Unmarshal(bs, &revisions); err != nil {
+		return fmt.Errorf("reading configuration history: %w", err)
+	}
+	h.revisions = append([]Revision{}, revisions...)
+	return nil
+}
+
+// pruneLocked removes the oldest revisions beyond the number to keep and
+// saves the index.
+func (h *History) pruneLocked(keep int) error {
+	if len(h.revisions) > keep {
+		for _, rev := range h.revisions[:len(h.revisions)-keep] {
+			if err := os.Remove(h.revisionPath(rev.Number)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+		h.revisions = append([]Revision{}, h.revisions[len(h.revisions)-keep:]...)
+	} else if keep == 0 {
+		// Nothing to save, and the directory need not even exist.
+		return nil
+	}
+	bs, err := json.MarshalIndent(h.revisions, "", "    ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(h.dir, historyIndexFile), bs)
+}
+
+func (h *History) revisionPath(number int) string {
+	return filepath.Join(h.dir, fmt.Sprintf("config-%06d.xml", number))
+}
+
+func writeFileAtomic(path string, bs []byte) error {
+	fd, err := osutil.CreateAtomic(path)
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(bs); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
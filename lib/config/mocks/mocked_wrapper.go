@@ -137,6 +137,16 @@ type Wrapper struct {
 	gUIReturnsOnCall map[int]struct {
 		result1 config.GUIConfiguration
 	}
+	HistoryStub        func() *config.History
+	historyMutex       sync.RWMutex
+	historyArgsForCall []struct {
+	}
+	historyReturns struct {
+		result1 *config.History
+	}
+	historyReturnsOnCall map[int]struct {
+		result1 *config.History
+	}
 	IgnoredDeviceStub        func(protocol.DeviceID) bool
 	ignoredDeviceMutex       sync.RWMutex
 	ignoredDeviceArgsForCall []struct {
@@ -193,6 +203,20 @@ type Wrapper struct {
 		result1 config.Waiter
 		result2 error
 	}
+	ModifyFromStub        func(string, config.ModifyFunction) (config.Waiter, error)
+	modifyFromMutex       sync.RWMutex
+	modifyFromArgsForCall []struct {
+		arg1 string
+		arg2 config.ModifyFunction
+	}
+	modifyFromReturns struct {
+		result1 config.Waiter
+		result2 error
+	}
+	modifyFromReturnsOnCall map[int]struct {
+		result1 config.Waiter
+		result2 error
+	}
 	MyIDStub        func() protocol.DeviceID
 	myIDMutex       sync.RWMutex
 	myIDArgsForCall []struct {
@@ -203,6 +227,16 @@ type Wrapper struct {
 	myIDReturnsOnCall map[int]struct {
 		result1 protocol.DeviceID
 	}
+	OIDCStub        func() config.OIDCConfiguration
+	oIDCMutex       sync.RWMutex
+	oIDCArgsForCall []struct {
+	}
+	oIDCReturns struct {
+		result1 config.OIDCConfiguration
+	}
+	oIDCReturnsOnCall map[int]struct {
+		result1 config.OIDCConfiguration
+	}
 	OptionsStub        func() config.OptionsConfiguration
 	optionsMutex       sync.RWMutex
 	optionsArgsForCall []struct {
@@ -213,6 +247,16 @@ type Wrapper struct {
 	optionsReturnsOnCall map[int]struct {
 		result1 config.OptionsConfiguration
 	}
+	OrganizationStub        func() config.OrganizationConfiguration
+	organizationMutex       sync.RWMutex
+	organizationArgsForCall []struct {
+	}
+	organizationReturns struct {
+		result1 config.OrganizationConfiguration
+	}
+	organizationReturnsOnCall map[int]struct {
+		result1 config.OrganizationConfiguration
+	}
 	RawCopyStub        func() config.Configuration
 	rawCopyMutex       sync.RWMutex
 	rawCopyArgsForCall []struct {
@@ -236,6 +280,20 @@ type Wrapper struct {
 		result1 config.Waiter
 		result2 error
 	}
+	RemoveDeviceFromStub        func(string, protocol.DeviceID) (config.Waiter, error)
+	removeDeviceFromMutex       sync.RWMutex
+	removeDeviceFromArgsForCall []struct {
+		arg1 string
+		arg2 protocol.DeviceID
+	}
+	removeDeviceFromReturns struct {
+		result1 config.Waiter
+		result2 error
+	}
+	removeDeviceFromReturnsOnCall map[int]struct {
+		result1 config.Waiter
+		result2 error
+	}
 	RemoveFolderStub        func(string) (config.Waiter, error)
 	removeFolderMutex       sync.RWMutex
 	removeFolderArgsForCall []struct {
@@ -249,6 +307,20 @@ type Wrapper struct {
 		result1 config.Waiter
 		result2 error
 	}
+	RemoveFolderFromStub        func(string, string) (config.Waiter, error)
+	removeFolderFromMutex       sync.RWMutex
+	removeFolderFromArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	removeFolderFromReturns struct {
+		result1 config.Waiter
+		result2 error
+	}
+	removeFolderFromReturnsOnCall map[int]struct {
+		result1 config.Waiter
+		result2 error
+	}
 	RequiresRestartStub        func() bool
 	requiresRestartMutex       sync.RWMutex
 	requiresRestartArgsForCall []struct {
@@ -280,6 +352,11 @@ type Wrapper struct {
 	serveReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetHistoryStub        func(*config.History)
+	setHistoryMutex       sync.RWMutex
+	setHistoryArgsForCall []struct {
+		arg1 *config.History
+	}
 	SubscribeStub        func(config.Committer) config.Configuration
 	subscribeMutex       sync.RWMutex
 	subscribeArgsForCall []struct {
@@ -296,6 +373,16 @@ type Wrapper struct {
 	unsubscribeArgsForCall []struct {
 		arg1 config.Committer
 	}
+	WebhooksStub        func() []config.WebhookConfiguration
+	webhooksMutex       sync.RWMutex
+	webhooksArgsForCall []struct {
+	}
+	webhooksReturns struct {
+		result1 []config.WebhookConfiguration
+	}
+	webhooksReturnsOnCall map[int]struct {
+		result1 []config.WebhookConfiguration
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -966,6 +1053,59 @@ func (fake *Wrapper) GUIReturnsOnCall(i int, result1 config.GUIConfiguration) {
 	}{result1}
 }
 
+func (fake *Wrapper) History() *config.History {
+	fake.historyMutex.Lock()
+	ret, specificReturn := fake.historyReturnsOnCall[len(fake.historyArgsForCall)]
+	fake.historyArgsForCall = append(fake.historyArgsForCall, struct {
+	}{})
+	stub := fake.HistoryStub
+	fakeReturns := fake.historyReturns
+	fake.recordInvocation("History", []interface{}{})
+	fake.historyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Wrapper) HistoryCallCount() int {
+	fake.historyMutex.RLock()
+	defer fake.historyMutex.RUnlock()
+	return len(fake.historyArgsForCall)
+}
+
+func (fake *Wrapper) HistoryCalls(stub func() *config.History) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = stub
+}
+
+func (fake *Wrapper) HistoryReturns(result1 *config.History) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = nil
+	fake.historyReturns = struct {
+		result1 *config.History
+	}{result1}
+}
+
+func (fake *Wrapper) HistoryReturnsOnCall(i int, result1 *config.History) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = nil
+	if fake.historyReturnsOnCall == nil {
+		fake.historyReturnsOnCall = make(map[int]struct {
+			result1 *config.History
+		})
+	}
+	fake.historyReturnsOnCall[i] = struct {
+		result1 *config.History
+	}{result1}
+}
+
 func (fake *Wrapper) IgnoredDevice(arg1 protocol.DeviceID) bool {
 	fake.ignoredDeviceMutex.Lock()
 	ret, specificReturn := fake.ignoredDeviceReturnsOnCall[len(fake.ignoredDeviceArgsForCall)]
@@ -1259,6 +1399,71 @@ func (fake *Wrapper) ModifyReturnsOnCall(i int, result1 config.Waiter, result2 e
 	}{result1, result2}
 }
 
+func (fake *Wrapper) ModifyFrom(arg1 string, arg2 config.ModifyFunction) (config.Waiter, error) {
+	fake.modifyFromMutex.Lock()
+	ret, specificReturn := fake.modifyFromReturnsOnCall[len(fake.modifyFromArgsForCall)]
+	fake.modifyFromArgsForCall = append(fake.modifyFromArgsForCall, struct {
+		arg1 string
+		arg2 config.ModifyFunction
+	}{arg1, arg2})
+	stub := fake.ModifyFromStub
+	fakeReturns := fake.modifyFromReturns
+	fake.recordInvocation("ModifyFrom", []interface{}{arg1, arg2})
+	fake.modifyFromMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Wrapper) ModifyFromCallCount() int {
+	fake.modifyFromMutex.RLock()
+	defer fake.modifyFromMutex.RUnlock()
+	return len(fake.modifyFromArgsForCall)
+}
+
+func (fake *Wrapper) ModifyFromCalls(stub func(string, config.ModifyFunction) (config.Waiter, error)) {
+	fake.modifyFromMutex.Lock()
+	defer fake.modifyFromMutex.Unlock()
+	fake.ModifyFromStub = stub
+}
+
+func (fake *Wrapper) ModifyFromArgsForCall(i int) (string, config.ModifyFunction) {
+	fake.modifyFromMutex.RLock()
+	defer fake.modifyFromMutex.RUnlock()
+	argsForCall := fake.modifyFromArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Wrapper) ModifyFromReturns(result1 config.Waiter, result2 error) {
+	fake.modifyFromMutex.Lock()
+	defer fake.modifyFromMutex.Unlock()
+	fake.ModifyFromStub = nil
+	fake.modifyFromReturns = struct {
+		result1 config.Waiter
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Wrapper) ModifyFromReturnsOnCall(i int, result1 config.Waiter, result2 error) {
+	fake.modifyFromMutex.Lock()
+	defer fake.modifyFromMutex.Unlock()
+	fake.ModifyFromStub = nil
+	if fake.modifyFromReturnsOnCall == nil {
+		fake.modifyFromReturnsOnCall = make(map[int]struct {
+			result1 config.Waiter
+			result2 error
+		})
+	}
+	fake.modifyFromReturnsOnCall[i] = struct {
+		result1 config.Waiter
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Wrapper) MyID() protocol.DeviceID {
 	fake.myIDMutex.Lock()
 	ret, specificReturn := fake.myIDReturnsOnCall[len(fake.myIDArgsForCall)]
@@ -1312,6 +1517,59 @@ func (fake *Wrapper) MyIDReturnsOnCall(i int, result1 protocol.DeviceID) {
 	}{result1}
 }
 
+func (fake *Wrapper) OIDC() config.OIDCConfiguration {
+	fake.oIDCMutex.Lock()
+	ret, specificReturn := fake.oIDCReturnsOnCall[len(fake.oIDCArgsForCall)]
+	fake.oIDCArgsForCall = append(fake.oIDCArgsForCall, struct {
+	}{})
+	stub := fake.OIDCStub
+	fakeReturns := fake.oIDCReturns
+	fake.recordInvocation("OIDC", []interface{}{})
+	fake.oIDCMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Wrapper) OIDCCallCount() int {
+	fake.oIDCMutex.RLock()
+	defer fake.oIDCMutex.RUnlock()
+	return len(fake.oIDCArgsForCall)
+}
+
+func (fake *Wrapper) OIDCCalls(stub func() config.OIDCConfiguration) {
+	fake.oIDCMutex.Lock()
+	defer fake.oIDCMutex.Unlock()
+	fake.OIDCStub = stub
+}
+
+func (fake *Wrapper) OIDCReturns(result1 config.OIDCConfiguration) {
+	fake.oIDCMutex.Lock()
+	defer fake.oIDCMutex.Unlock()
+	fake.OIDCStub = nil
+	fake.oIDCReturns = struct {
+		result1 config.OIDCConfiguration
+	}{result1}
+}
+
+func (fake *Wrapper) OIDCReturnsOnCall(i int, result1 config.OIDCConfiguration) {
+	fake.oIDCMutex.Lock()
+	defer fake.oIDCMutex.Unlock()
+	fake.OIDCStub = nil
+	if fake.oIDCReturnsOnCall == nil {
+		fake.oIDCReturnsOnCall = make(map[int]struct {
+			result1 config.OIDCConfiguration
+		})
+	}
+	fake.oIDCReturnsOnCall[i] = struct {
+		result1 config.OIDCConfiguration
+	}{result1}
+}
+
 func (fake *Wrapper) Options() config.OptionsConfiguration {
 	fake.optionsMutex.Lock()
 	ret, specificReturn := fake.optionsReturnsOnCall[len(fake.optionsArgsForCall)]
@@ -1365,6 +1623,59 @@ func (fake *Wrapper) OptionsReturnsOnCall(i int, result1 config.OptionsConfigura
 	}{result1}
 }
 
+func (fake *Wrapper) Organization() config.OrganizationConfiguration {
+	fake.organizationMutex.Lock()
+	ret, specificReturn := fake.organizationReturnsOnCall[len(fake.organizationArgsForCall)]
+	fake.organizationArgsForCall = append(fake.organizationArgsForCall, struct {
+	}{})
+	stub := fake.OrganizationStub
+	fakeReturns := fake.organizationReturns
+	fake.recordInvocation("Organization", []interface{}{})
+	fake.organizationMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Wrapper) OrganizationCallCount() int {
+	fake.organizationMutex.RLock()
+	defer fake.organizationMutex.RUnlock()
+	return len(fake.organizationArgsForCall)
+}
+
+func (fake *Wrapper) OrganizationCalls(stub func() config.OrganizationConfiguration) {
+	fake.organizationMutex.Lock()
+	defer fake.organizationMutex.Unlock()
+	fake.OrganizationStub = stub
+}
+
+func (fake *Wrapper) OrganizationReturns(result1 config.OrganizationConfiguration) {
+	fake.organizationMutex.Lock()
+	defer fake.organizationMutex.Unlock()
+	fake.OrganizationStub = nil
+	fake.organizationReturns = struct {
+		result1 config.OrganizationConfiguration
+	}{result1}
+}
+
+func (fake *Wrapper) OrganizationReturnsOnCall(i int, result1 config.OrganizationConfiguration) {
+	fake.organizationMutex.Lock()
+	defer fake.organizationMutex.Unlock()
+	fake.OrganizationStub = nil
+	if fake.organizationReturnsOnCall == nil {
+		fake.organizationReturnsOnCall = make(map[int]struct {
+			result1 config.OrganizationConfiguration
+		})
+	}
+	fake.organizationReturnsOnCall[i] = struct {
+		result1 config.OrganizationConfiguration
+	}{result1}
+}
+
 func (fake *Wrapper) RawCopy() config.Configuration {
 	fake.rawCopyMutex.Lock()
 	ret, specificReturn := fake.rawCopyReturnsOnCall[len(fake.rawCopyArgsForCall)]
@@ -1482,6 +1793,71 @@ func (fake *Wrapper) RemoveDeviceReturnsOnCall(i int, result1 config.Waiter, res
 	}{result1, result2}
 }
 
+func (fake *Wrapper) RemoveDeviceFrom(arg1 string, arg2 protocol.DeviceID) (config.Waiter, error) {
+	fake.removeDeviceFromMutex.Lock()
+	ret, specificReturn := fake.removeDeviceFromReturnsOnCall[len(fake.removeDeviceFromArgsForCall)]
+	fake.removeDeviceFromArgsForCall = append(fake.removeDeviceFromArgsForCall, struct {
+		arg1 string
+		arg2 protocol.DeviceID
+	}{arg1, arg2})
+	stub := fake.RemoveDeviceFromStub
+	fakeReturns := fake.removeDeviceFromReturns
+	fake.recordInvocation("RemoveDeviceFrom", []interface{}{arg1, arg2})
+	fake.removeDeviceFromMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Wrapper) RemoveDeviceFromCallCount() int {
+	fake.removeDeviceFromMutex.RLock()
+	defer fake.removeDeviceFromMutex.RUnlock()
+	return len(fake.removeDeviceFromArgsForCall)
+}
+
+func (fake *Wrapper) RemoveDeviceFromCalls(stub func(string, protocol.DeviceID) (config.Waiter, error)) {
+	fake.removeDeviceFromMutex.Lock()
+	defer fake.removeDeviceFromMutex.Unlock()
+	fake.RemoveDeviceFromStub = stub
+}
+
+func (fake *Wrapper) RemoveDeviceFromArgsForCall(i int) (string, protocol.DeviceID) {
+	fake.removeDeviceFromMutex.RLock()
+	defer fake.removeDeviceFromMutex.RUnlock()
+	argsForCall := fake.removeDeviceFromArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Wrapper) RemoveDeviceFromReturns(result1 config.Waiter, result2 error) {
+	fake.removeDeviceFromMutex.Lock()
+	defer fake.removeDeviceFromMutex.Unlock()
+	fake.RemoveDeviceFromStub = nil
+	fake.removeDeviceFromReturns = struct {
+		result1 config.Waiter
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Wrapper) RemoveDeviceFromReturnsOnCall(i int, result1 config.Waiter, result2 error) {
+	fake.removeDeviceFromMutex.Lock()
+	defer fake.removeDeviceFromMutex.Unlock()
+	fake.RemoveDeviceFromStub = nil
+	if fake.removeDeviceFromReturnsOnCall == nil {
+		fake.removeDeviceFromReturnsOnCall = make(map[int]struct {
+			result1 config.Waiter
+			result2 error
+		})
+	}
+	fake.removeDeviceFromReturnsOnCall[i] = struct {
+		result1 config.Waiter
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Wrapper) RemoveFolder(arg1 string) (config.Waiter, error) {
 	fake.removeFolderMutex.Lock()
 	ret, specificReturn := fake.removeFolderReturnsOnCall[len(fake.removeFolderArgsForCall)]
@@ -1546,6 +1922,71 @@ func (fake *Wrapper) RemoveFolderReturnsOnCall(i int, result1 config.Waiter, res
 	}{result1, result2}
 }
 
+func (fake *Wrapper) RemoveFolderFrom(arg1 string, arg2 string) (config.Waiter, error) {
+	fake.removeFolderFromMutex.Lock()
+	ret, specificReturn := fake.removeFolderFromReturnsOnCall[len(fake.removeFolderFromArgsForCall)]
+	fake.removeFolderFromArgsForCall = append(fake.removeFolderFromArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RemoveFolderFromStub
+	fakeReturns := fake.removeFolderFromReturns
+	fake.recordInvocation("RemoveFolderFrom", []interface{}{arg1, arg2})
+	fake.removeFolderFromMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *Wrapper) RemoveFolderFromCallCount() int {
+	fake.removeFolderFromMutex.RLock()
+	defer fake.removeFolderFromMutex.RUnlock()
+	return len(fake.removeFolderFromArgsForCall)
+}
+
+func (fake *Wrapper) RemoveFolderFromCalls(stub func(string, string) (config.Waiter, error)) {
+	fake.removeFolderFromMutex.Lock()
+	defer fake.removeFolderFromMutex.Unlock()
+	fake.RemoveFolderFromStub = stub
+}
+
+func (fake *Wrapper) RemoveFolderFromArgsForCall(i int) (string, string) {
+	fake.removeFolderFromMutex.RLock()
+	defer fake.removeFolderFromMutex.RUnlock()
+	argsForCall := fake.removeFolderFromArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *Wrapper) RemoveFolderFromReturns(result1 config.Waiter, result2 error) {
+	fake.removeFolderFromMutex.Lock()
+	defer fake.removeFolderFromMutex.Unlock()
+	fake.RemoveFolderFromStub = nil
+	fake.removeFolderFromReturns = struct {
+		result1 config.Waiter
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *Wrapper) RemoveFolderFromReturnsOnCall(i int, result1 config.Waiter, result2 error) {
+	fake.removeFolderFromMutex.Lock()
+	defer fake.removeFolderFromMutex.Unlock()
+	fake.RemoveFolderFromStub = nil
+	if fake.removeFolderFromReturnsOnCall == nil {
+		fake.removeFolderFromReturnsOnCall = make(map[int]struct {
+			result1 config.Waiter
+			result2 error
+		})
+	}
+	fake.removeFolderFromReturnsOnCall[i] = struct {
+		result1 config.Waiter
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *Wrapper) RequiresRestart() bool {
 	fake.requiresRestartMutex.Lock()
 	ret, specificReturn := fake.requiresRestartReturnsOnCall[len(fake.requiresRestartArgsForCall)]
@@ -1713,6 +2154,38 @@ func (fake *Wrapper) ServeReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Wrapper) SetHistory(arg1 *config.History) {
+	fake.setHistoryMutex.Lock()
+	fake.setHistoryArgsForCall = append(fake.setHistoryArgsForCall, struct {
+		arg1 *config.History
+	}{arg1})
+	stub := fake.SetHistoryStub
+	fake.recordInvocation("SetHistory", []interface{}{arg1})
+	fake.setHistoryMutex.Unlock()
+	if stub != nil {
+		fake.SetHistoryStub(arg1)
+	}
+}
+
+func (fake *Wrapper) SetHistoryCallCount() int {
+	fake.setHistoryMutex.RLock()
+	defer fake.setHistoryMutex.RUnlock()
+	return len(fake.setHistoryArgsForCall)
+}
+
+func (fake *Wrapper) SetHistoryCalls(stub func(*config.History)) {
+	fake.setHistoryMutex.Lock()
+	defer fake.setHistoryMutex.Unlock()
+	fake.SetHistoryStub = stub
+}
+
+func (fake *Wrapper) SetHistoryArgsForCall(i int) *config.History {
+	fake.setHistoryMutex.RLock()
+	defer fake.setHistoryMutex.RUnlock()
+	argsForCall := fake.setHistoryArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *Wrapper) Subscribe(arg1 config.Committer) config.Configuration {
 	fake.subscribeMutex.Lock()
 	ret, specificReturn := fake.subscribeReturnsOnCall[len(fake.subscribeArgsForCall)]
@@ -1806,6 +2279,59 @@ func (fake *Wrapper) UnsubscribeArgsForCall(i int) config.Committer {
 	return argsForCall.arg1
 }
 
+func (fake *Wrapper) Webhooks() []config.WebhookConfiguration {
+	fake.webhooksMutex.Lock()
+	ret, specificReturn := fake.webhooksReturnsOnCall[len(fake.webhooksArgsForCall)]
+	fake.webhooksArgsForCall = append(fake.webhooksArgsForCall, struct {
+	}{})
+	stub := fake.WebhooksStub
+	fakeReturns := fake.webhooksReturns
+	fake.recordInvocation("Webhooks", []interface{}{})
+	fake.webhooksMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Wrapper) WebhooksCallCount() int {
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	return len(fake.webhooksArgsForCall)
+}
+
+func (fake *Wrapper) WebhooksCalls(stub func() []config.WebhookConfiguration) {
+	fake.webhooksMutex.Lock()
+	defer fake.webhooksMutex.Unlock()
+	fake.WebhooksStub = stub
+}
+
+func (fake *Wrapper) WebhooksReturns(result1 []config.WebhookConfiguration) {
+	fake.webhooksMutex.Lock()
+	defer fake.webhooksMutex.Unlock()
+	fake.WebhooksStub = nil
+	fake.webhooksReturns = struct {
+		result1 []config.WebhookConfiguration
+	}{result1}
+}
+
+func (fake *Wrapper) WebhooksReturnsOnCall(i int, result1 []config.WebhookConfiguration) {
+	fake.webhooksMutex.Lock()
+	defer fake.webhooksMutex.Unlock()
+	fake.WebhooksStub = nil
+	if fake.webhooksReturnsOnCall == nil {
+		fake.webhooksReturnsOnCall = make(map[int]struct {
+			result1 []config.WebhookConfiguration
+		})
+	}
+	fake.webhooksReturnsOnCall[i] = struct {
+		result1 []config.WebhookConfiguration
+	}{result1}
+}
+
 func (fake *Wrapper) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1833,6 +2359,8 @@ func (fake *Wrapper) Invocations() map[string][][]interface{} {
 	defer fake.foldersMutex.RUnlock()
 	fake.gUIMutex.RLock()
 	defer fake.gUIMutex.RUnlock()
+	fake.historyMutex.RLock()
+	defer fake.historyMutex.RUnlock()
 	fake.ignoredDeviceMutex.RLock()
 	defer fake.ignoredDeviceMutex.RUnlock()
 	fake.ignoredDevicesMutex.RLock()
@@ -1843,26 +2371,40 @@ func (fake *Wrapper) Invocations() map[string][][]interface{} {
 	defer fake.lDAPMutex.RUnlock()
 	fake.modifyMutex.RLock()
 	defer fake.modifyMutex.RUnlock()
+	fake.modifyFromMutex.RLock()
+	defer fake.modifyFromMutex.RUnlock()
 	fake.myIDMutex.RLock()
 	defer fake.myIDMutex.RUnlock()
+	fake.oIDCMutex.RLock()
+	defer fake.oIDCMutex.RUnlock()
 	fake.optionsMutex.RLock()
 	defer fake.optionsMutex.RUnlock()
+	fake.organizationMutex.RLock()
+	defer fake.organizationMutex.RUnlock()
 	fake.rawCopyMutex.RLock()
 	defer fake.rawCopyMutex.RUnlock()
 	fake.removeDeviceMutex.RLock()
 	defer fake.removeDeviceMutex.RUnlock()
+	fake.removeDeviceFromMutex.RLock()
+	defer fake.removeDeviceFromMutex.RUnlock()
 	fake.removeFolderMutex.RLock()
 	defer fake.removeFolderMutex.RUnlock()
+	fake.removeFolderFromMutex.RLock()
+	defer fake.removeFolderFromMutex.RUnlock()
 	fake.requiresRestartMutex.RLock()
 	defer fake.requiresRestartMutex.RUnlock()
 	fake.saveMutex.RLock()
 	defer fake.saveMutex.RUnlock()
 	fake.serveMutex.RLock()
 	defer fake.serveMutex.RUnlock()
+	fake.setHistoryMutex.RLock()
+	defer fake.setHistoryMutex.RUnlock()
 	fake.subscribeMutex.RLock()
 	defer fake.subscribeMutex.RUnlock()
 	fake.unsubscribeMutex.RLock()
 	defer fake.unsubscribeMutex.RUnlock()
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,54 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"golang.org/x/exp/slices"
+)
+
+func (c OIDCConfiguration) Copy() OIDCConfiguration {
+	c.Scopes = slices.Clone(c.Scopes)
+	c.AdminGroups = slices.Clone(c.AdminGroups)
+	c.OperatorGroups = slices.Clone(c.OperatorGroups)
+	c.ViewerGroups = slices.Clone(c.ViewerGroups)
+	return c
+}
+
+// RequestScopes returns the scopes to request, always including "openid".
+func (c OIDCConfiguration) RequestScopes() []string {
+	scopes := []string{"openid"}
+	extra := c.Scopes
+	if len(extra) == 0 {
+		extra = []string{"profile", "email"}
+	}
+	for _, scope := range extra {
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// RoleForGroups returns the most privileged role of any of the groups, and
+// false if none of them has a role.
+func (c OIDCConfiguration) RoleForGroups(groups []string) (GUIRole, bool) {
+	for _, rg := range []struct {
+		role   GUIRole
+		groups []string
+	}{
+		{GUIRoleAdmin, c.AdminGroups},
+		{GUIRoleOperator, c.OperatorGroups},
+		{GUIRoleViewer, c.ViewerGroups},
+	} {
+		for _, group := range groups {
+			if slices.Contains(rg.groups, group) {
+				return rg.role, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,757 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/oidcconfiguration.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Login to the GUI through an OpenID Connect identity provider, when the
+// auth mode is "oidc". The role of a user is given by their groups, unless
+// a GUI account is bound to their subject.
+type OIDCConfiguration struct {
+	IssuerURL    string `protobuf:"bytes,1,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuerURL" xml:"issuerURL,omitempty"`
+	ClientID     string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"clientID" xml:"clientID,omitempty"`
+	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"clientSecret" xml:"clientSecret,omitempty"`
+	// The URL of the callback, .../rest/noauth/auth/oidc/callback, if it
+	// isn't the one the GUI is accessed at.
+	RedirectURL    string   `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirectURL" xml:"redirectURL,omitempty"`
+	Scopes         []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes" xml:"scope"`
+	UsernameClaim  string   `protobuf:"bytes,6,opt,name=username_claim,json=usernameClaim,proto3" json:"usernameClaim" xml:"usernameClaim,omitempty" default:"preferred_username"`
+	GroupsClaim    string   `protobuf:"bytes,7,opt,name=groups_claim,json=groupsClaim,proto3" json:"groupsClaim" xml:"groupsClaim,omitempty" default:"groups"`
+	AdminGroups    []string `protobuf:"bytes,8,rep,name=admin_groups,json=adminGroups,proto3" json:"adminGroups" xml:"adminGroup"`
+	OperatorGroups []string `protobuf:"bytes,9,rep,name=operator_groups,json=operatorGroups,proto3" json:"operatorGroups" xml:"operatorGroup"`
+	ViewerGroups   []string `protobuf:"bytes,10,rep,name=viewer_groups,json=viewerGroups,proto3" json:"viewerGroups" xml:"viewerGroup"`
+}
+
+func (m *OIDCConfiguration) Reset()         { *m = OIDCConfiguration{} }
+func (m *OIDCConfiguration) String() string { return proto.CompactTextString(m) }
+func (*OIDCConfiguration) ProtoMessage()    {}
+func (*OIDCConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee763e3bef38c648, []int{0}
+}
+func (m *OIDCConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OIDCConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OIDCConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OIDCConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OIDCConfiguration.Merge(m, src)
+}
+func (m *OIDCConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *OIDCConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_OIDCConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OIDCConfiguration proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*OIDCConfiguration)(nil), "config.OIDCConfiguration")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/oidcconfiguration.proto", fileDescriptor_ee763e3bef38c648)
+}
+
+var fileDescriptor_ee763e3bef38c648 = []byte{
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xbd, 0x6f, 0xd3, 0x4e,
+	0x1c, 0xc6, 0xe3, 0x5f, 0x7f, 0x0d, 0xf5, 0x25, 0x29, 0xf4, 0x2a, 0xc0, 0xbc, 0xc8, 0x57, 0x45,
+	0x1e, 0x8a, 0x54, 0xb5, 0x42, 0x30, 0x65, 0x6c, 0x2a, 0xa1, 0x02, 0x12, 0x92, 0x81, 0x01, 0x96,
+	0x28, 0xb1, 0xaf, 0xe9, 0x49, 0x7e, 0x89, 0xce, 0x67, 0x68, 0x27, 0x58, 0x10, 0x2b, 0xca, 0xc2,
+	0xca, 0xc8, 0xc4, 0xdf, 0xd1, 0x2d, 0x19, 0x99, 0x4e, 0x6a, 0xb2, 0x79, 0xf4, 0xd8, 0x09, 0xf9,
+	0xeb, 0x38, 0x3e, 0xd3, 0xb0, 0xdd, 0x7d, 0x9e, 0xfb, 0x3e, 0xcf, 0xe3, 0x4b, 0x6c, 0xd4, 0xf6,
+	0xd8, 0xe0, 0xc0, 0x09, 0x83, 0x13, 0x36, 0x3c, 0x08, 0x99, 0xeb, 0xe4, 0xcb, 0x98, 0xf7, 0x05,
+	0x0b, 0x83, 0xfd, 0x11, 0x0f, 0x45, 0x88, 0xeb, 0x39, 0xbc, 0xaf, 0xd3, 0x33, 0x91, 0xa3, 0xf6,
+	0x2f, 0x1d, 0x6d, 0xbd, 0x3a, 0x3e, 0xea, 0x76, 0xd5, 0xe3, 0xf8, 0xab, 0x86, 0x10, 0x8b, 0xa2,
+	0x98, 0xf2, 0x5e, 0xcc, 0x3d, 0x43, 0xdb, 0xd1, 0x76, 0xf5, 0xc3, 0xd3, 0x99, 0x24, 0xfa, 0x31,
+	0xd0, 0xb7, 0xf6, 0xcb, 0x44, 0x12, 0x9d, 0x15, 0x9b, 0x54, 0x92, 0x7b, 0x67, 0xbe, 0xd7, 0x69,
+	0x2f, 0xc9, 0x5e, 0xe8, 0x33, 0x41, 0xfd, 0x91, 0x38, 0x6f, 0x27, 0x13, 0x6b, 0x7b, 0x05, 0x4f,
+	0x27, 0x56, 0x69, 0x30, 0x9e, 0x5a, 0xa5, 0xb5, 0x5d, 0x70, 0xee, 0xe1, 0x4f, 0x48, 0x77, 0x3c,
+	0x46, 0x03, 0xd1, 0x63, 0xae, 0xf1, 0x1f, 0xf4, 0x18, 0xcc, 0x24, 0xd9, 0xe8, 0x02, 0x3c, 0x3e,
+	0x4a, 0x24, 0xd9, 0x70, 0x16, 0xeb, 0x54, 0x12, 0x03, 0x5a, 0x14, 0xa0, 0x5a, 0x02, 0x5f, 0xc7,
+	0xe9, 0xc4, 0x5a, 0x4e, 0x8f, 0xa7, 0xd6, 0xd2, 0xd5, 0x2e, 0xa8, 0x8b, 0x43, 0xd4, 0x5a, 0x14,
+	0x88, 0xa8, 0xc3, 0xa9, 0x30, 0xd6, 0xa0, 0xc4, 0xf3, 0x44, 0x92, 0x66, 0x2e, 0xbc, 0x06, 0x9e,
+	0x4a, 0xf2, 0x50, 0x09, 0xcf, 0x61, 0xb5, 0xc0, 0x9d, 0xd5, 0x92, 0x5d, 0xf1, 0xc1, 0xdf, 0x35,
+	0xd4, 0xe4, 0xd4, 0x65, 0x9c, 0x3a, 0x02, 0x6e, 0xff, 0x7f, 0x08, 0x14, 0x33, 0x49, 0x1a, 0xf6,
+	0x82, 0xe7, 0xf7, 0xdf, 0xe0, 0xe5, 0x36, 0x95, 0xe4, 0x01, 0xc4, 0x2b, 0xac, 0x9a, 0x7e, 0x7b,
+	0xa5, 0x92, 0x4e, 0x2c, 0xd5, 0x66, 0x3c, 0xb5, 0xd4, 0x10, 0xbb, 0xd4, 0xb8, 0x87, 0x3b, 0xa8,
+	0x1e, 0x39, 0xe1, 0x88, 0x46, 0xc6, 0xfa, 0xce, 0xda, 0xae, 0x7e, 0xd8, 0x4e, 0x24, 0x59, 0x90,
+	0x54, 0x92, 0x06, 0xc4, 0xc3, 0x36, 0x8b, 0x5b, 0x87, 0x95, 0xbd, 0xd0, 0xf1, 0x4f, 0x0d, 0x6d,
+	0xc6, 0x11, 0xe5, 0x41, 0xdf, 0xa7, 0x3d, 0xc7, 0xeb, 0x33, 0xdf, 0xa8, 0xc3, 0x73, 0x7d, 0xd6,
+	0x12, 0x49, 0x5a, 0x85, 0xd4, 0xcd, 0x94, 0x54, 0x92, 0x0e, 0x98, 0x55, 0xa8, 0xf2, 0x34, 0x3b,
+	0x2e, 0x3d, 0xe9, 0xc7, 0x9e, 0xe8, 0xb4, 0x47, 0x9c, 0x9e, 0x50, 0xce, 0xa9, 0xdb, 0x2b, 0xce,
+	0x66, 0xd9, 0x77, 0xff, 0x31, 0x78, 0x35, 0xb1, 0xf0, 0xf5, 0x09, 0xbb, 0x9a, 0x8e, 0xbf, 0x68,
+	0xa8, 0x39, 0xe4, 0x61, 0x3c, 0x8a, 0x16, 0x45, 0x6f, 0xe4, 0x7f, 0xbb, 0xec, 0xc6, 0x73, 0x5e,
+	0xb4, 0xdc, 0x83, 0x96, 0x0a, 0x5b, 0xd9, 0x31, 0xd7, 0xe1, 0x27, 0x58, 0x79, 0xf4, 0x6a, 0x62,
+	0xd5, 0x73, 0xc1, 0x56, 0xfd, 0xf1, 0x1b, 0xd4, 0xec, 0xbb, 0x3e, 0x0b, 0x7a, 0x39, 0x34, 0x36,
+	0xe0, 0xd2, 0x1f, 0x67, 0x35, 0x80, 0x3f, 0x03, 0x9c, 0x4a, 0x72, 0x0b, 0x6a, 0x94, 0x2c, 0x8b,
+	0x42, 0xe5, 0xd6, 0x56, 0x8f, 0x63, 0x07, 0xdd, 0x0c, 0x47, 0x94, 0xf7, 0x45, 0xc8, 0x0b, 0x63,
+	0x1d, 0x8c, 0x3b, 0x89, 0x24, 0x9b, 0x85, 0xb4, 0xf4, 0xde, 0x06, 0xef, 0x0a, 0xce, 0xec, 0x5b,
+	0x15, 0x62, 0xff, 0x35, 0x87, 0xdf, 0xa1, 0xd6, 0x07, 0x46, 0x3f, 0xd2, 0x65, 0x04, 0x82, 0x88,
+	0xa7, 0xd9, 0x4b, 0x93, 0x0b, 0xcb, 0x80, 0x2d, 0x08, 0x50, 0x60, 0x66, 0xdf, 0x50, 0xf6, 0x76,
+	0x65, 0xe2, 0xf0, 0xc5, 0xc5, 0xa5, 0x59, 0x9b, 0x5e, 0x9a, 0xb5, 0x8b, 0x99, 0xa9, 0x4d, 0x67,
+	0xa6, 0xf6, 0x6d, 0x6e, 0xd6, 0x7e, 0xcc, 0x4d, 0x6d, 0x3a, 0x37, 0x6b, 0xbf, 0xe7, 0x66, 0xed,
+	0xfd, 0xa3, 0x21, 0x13, 0xa7, 0xf1, 0x60, 0xdf, 0x09, 0xfd, 0x83, 0xe8, 0x3c, 0x70, 0xc4, 0x29,
+	0x0b, 0x86, 0xca, 0xaa, 0xfc, 0x50, 0x0e, 0xea, 0xf0, 0x11, 0x7c, 0xf2, 0x67, 0x00, 0x52, 0xe3,
+	0x6e, 0x76, 0x3d, 0x05, 0x00, 0x00,
+}
+
+func (m *OIDCConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OIDCConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OIDCConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ViewerGroups) > 0 {
+		for iNdEx := len(m.ViewerGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ViewerGroups[iNdEx])
+			copy(dAtA[i:], m.ViewerGroups[iNdEx])
+			i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.ViewerGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OperatorGroups) > 0 {
+		for iNdEx := len(m.OperatorGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OperatorGroups[iNdEx])
+			copy(dAtA[i:], m.OperatorGroups[iNdEx])
+			i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.OperatorGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AdminGroups) > 0 {
+		for iNdEx := len(m.AdminGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdminGroups[iNdEx])
+			copy(dAtA[i:], m.AdminGroups[iNdEx])
+			i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.AdminGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.GroupsClaim) > 0 {
+		i -= len(m.GroupsClaim)
+		copy(dAtA[i:], m.GroupsClaim)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.GroupsClaim)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UsernameClaim) > 0 {
+		i -= len(m.UsernameClaim)
+		copy(dAtA[i:], m.UsernameClaim)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.UsernameClaim)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RedirectURL) > 0 {
+		i -= len(m.RedirectURL)
+		copy(dAtA[i:], m.RedirectURL)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.RedirectURL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientSecret) > 0 {
+		i -= len(m.ClientSecret)
+		copy(dAtA[i:], m.ClientSecret)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.ClientSecret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IssuerURL) > 0 {
+		i -= len(m.IssuerURL)
+		copy(dAtA[i:], m.IssuerURL)
+		i = encodeVarintOidcconfiguration(dAtA, i, uint64(len(m.IssuerURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOidcconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOidcconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OIDCConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IssuerURL)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.ClientSecret)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.RedirectURL)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	l = len(m.UsernameClaim)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	l = len(m.GroupsClaim)
+	if l > 0 {
+		n += 1 + l + sovOidcconfiguration(uint64(l))
+	}
+	if len(m.AdminGroups) > 0 {
+		for _, s := range m.AdminGroups {
+			l = len(s)
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	if len(m.OperatorGroups) > 0 {
+		for _, s := range m.OperatorGroups {
+			l = len(s)
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	if len(m.ViewerGroups) > 0 {
+		for _, s := range m.ViewerGroups {
+			l = len(s)
+			n += 1 + l + sovOidcconfiguration(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOidcconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOidcconfiguration(x uint64) (n int) {
+	return sovOidcconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OIDCConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOidcconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OIDCConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OIDCConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedirectURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsernameClaim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupsClaim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminGroups = append(m.AdminGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorGroups = append(m.OperatorGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewerGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ViewerGroups = append(m.ViewerGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOidcconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOidcconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOidcconfiguration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOidcconfiguration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOidcconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOidcconfiguration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOidcconfiguration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOidcconfiguration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOidcconfiguration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOidcconfiguration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOidcconfiguration = fmt.Errorf("proto: unexpected end of group")
+)
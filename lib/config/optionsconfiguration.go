@@ -26,6 +26,10 @@ func (opts OptionsConfiguration) Copy() OptionsConfiguration {
 	copy(optsCopy.AlwaysLocalNets, opts.AlwaysLocalNets)
 	optsCopy.UnackedNotificationIDs = make([]string, len(opts.UnackedNotificationIDs))
 	copy(optsCopy.UnackedNotificationIDs, opts.UnackedNotificationIDs)
+	optsCopy.WebSocketTrustedProxies = make([]string, len(opts.WebSocketTrustedProxies))
+	copy(optsCopy.WebSocketTrustedProxies, opts.WebSocketTrustedProxies)
+	optsCopy.DNSDiscoveryDomains = make([]string, len(opts.DNSDiscoveryDomains))
+	copy(optsCopy.DNSDiscoveryDomains, opts.DNSDiscoveryDomains)
 	return optsCopy
 }
 
@@ -34,6 +38,7 @@ func (opts *OptionsConfiguration) prepare(guiPWIsSet bool) {
 
 	opts.RawListenAddresses = stringutil.UniqueTrimmedStrings(opts.RawListenAddresses)
 	opts.RawGlobalAnnServers = stringutil.UniqueTrimmedStrings(opts.RawGlobalAnnServers)
+	opts.DNSDiscoveryDomains = stringutil.UniqueTrimmedStrings(opts.DNSDiscoveryDomains)
 
 	// Very short reconnection intervals are annoying
 	if opts.ReconnectIntervalS < 5 {
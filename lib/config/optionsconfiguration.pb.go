@@ -89,6 +89,38 @@ type OptionsConfiguration struct {
 	ConnectionPriorityQUICWAN          int  `protobuf:"varint,57,opt,name=connection_priority_quic_wan,json=connectionPriorityQuicWan,proto3,casttype=int" json:"connectionPriorityQuicWan" xml:"connectionPriorityQuicWan" default:"40"`
 	ConnectionPriorityRelay            int  `protobuf:"varint,58,opt,name=connection_priority_relay,json=connectionPriorityRelay,proto3,casttype=int" json:"connectionPriorityRelay" xml:"connectionPriorityRelay" default:"50"`
 	ConnectionPriorityUpgradeThreshold int  `protobuf:"varint,59,opt,name=connection_priority_upgrade_threshold,json=connectionPriorityUpgradeThreshold,proto3,casttype=int" json:"connectionPriorityUpgradeThreshold" xml:"connectionPriorityUpgradeThreshold" default:"0"`
+	// Announce and discover devices using mDNS/DNS-SD, in addition to the
+	// local discovery beacons, when local discovery is enabled. Off by
+	// default, as it binds the mDNS port shared with the host's own
+	// responder.
+	LocalAnnMDNSEnabled bool `protobuf:"varint,60,opt,name=local_announce_mdns_enabled,json=localAnnounceMdnsEnabled,proto3" json:"localAnnounceMDNSEnabled" xml:"localAnnounceMDNSEnabled" default:"false"`
+	// Domains under which device addresses are looked up in the DNS, as
+	// _syncthing._tcp.<device ID>.<domain>.
+	DNSDiscoveryDomains []string `protobuf:"bytes,61,rep,name=dns_discovery_domains,json=dnsDiscoveryDomains,proto3" json:"dnsDiscoveryDomains" xml:"dnsDiscoveryDomain"`
+	// The number of relays from a dynamic relay pool to stay joined to at
+	// the same time.
+	RelayConnections int `protobuf:"varint,62,opt,name=relay_connections,json=relayConnections,proto3,casttype=int" json:"relayConnections" xml:"relayConnections" default:"1"`
+	// Try to replace relayed connections with direct QUIC connections, by
+	// hole punching through the NATs of both devices. Off by default, as it
+	// sends our external addresses over the relay and packets to the other
+	// device's; both devices need it enabled.
+	HolePunchingEnabled         bool `protobuf:"varint,63,opt,name=hole_punching_enabled,json=holePunchingEnabled,proto3" json:"holePunchingEnabled" xml:"holePunchingEnabled" default:"false"`
+	ConnectionPriorityWebSocket int  `protobuf:"varint,64,opt,name=connection_priority_websocket,json=connectionPriorityWebsocket,proto3,casttype=int" json:"connectionPriorityWebSocket" xml:"connectionPriorityWebSocket" default:"45"`
+	// Proxy for dialing other devices: empty for the proxy set in the
+	// environment, "direct", or a socks5:// URL.
+	ProxyURL string `protobuf:"bytes,65,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxyURL" xml:"proxyURL"`
+	// Keep a journal of events in the database, to be queried later on.
+	EventJournalEnabled bool `protobuf:"varint,66,opt,name=event_journal_enabled,json=eventJournalEnabled,proto3" json:"eventJournalEnabled" xml:"eventJournalEnabled"`
+	// Days after which journalled events are removed again; zero keeps
+	// them forever.
+	EventJournalRetentionDays int `protobuf:"varint,67,opt,name=event_journal_retention_days,json=eventJournalRetentionDays,proto3,casttype=int" json:"eventJournalRetentionDays" xml:"eventJournalRetentionDays" default:"30"`
+	// The number of committed configurations kept in the configuration
+	// history; zero keeps none.
+	ConfigHistoryRevisions int `protobuf:"varint,68,opt,name=config_history_revisions,json=configHistoryRevisions,proto3,casttype=int" json:"configHistoryRevisions" xml:"configHistoryRevisions" default:"50"`
+	// Networks of the reverse proxies in front of the WebSocket listeners
+	// and the GUI. Connections from them take the device address from the
+	// X-Forwarded-For header, for the allowed networks check.
+	WebSocketTrustedProxies []string `protobuf:"bytes,69,rep,name=websocket_trusted_proxies,json=websocketTrustedProxies,proto3" json:"webSocketTrustedProxies" xml:"webSocketTrustedProxy"`
 	// Legacy deprecated
 	DeprecatedUPnPEnabled        bool     `protobuf:"varint,9000,opt,name=upnp_enabled,json=upnpEnabled,proto3" json:"-" xml:"upnpEnabled,omitempty"`                                    // Deprecated: Do not use.
 	DeprecatedUPnPLeaseM         int      `protobuf:"varint,9001,opt,name=upnp_lease_m,json=upnpLeaseM,proto3,casttype=int" json:"-" xml:"upnpLeaseMinutes,omitempty"`                   // Deprecated: Do not use.
@@ -141,228 +173,257 @@ func init() {
 }
 
 var fileDescriptor_d09882599506ca03 = []byte{
-	// 3521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5a, 0x5d, 0x6c, 0x1d, 0x47,
-	0x15, 0xce, 0x26, 0x4d, 0xda, 0x6c, 0x1c, 0x27, 0x1e, 0x3b, 0xf6, 0x26, 0x4e, 0xbd, 0xee, 0xcd,
-	0x4d, 0xeb, 0xb6, 0x69, 0x62, 0x3b, 0x3f, 0x4d, 0x8d, 0x50, 0xf1, 0x4f, 0x4d, 0xdd, 0xd8, 0x8e,
-	0x3b, 0xb6, 0x6b, 0x54, 0x84, 0x56, 0xe3, 0xbd, 0x73, 0xed, 0xad, 0xf7, 0xee, 0xde, 0xec, 0xce,
-	0xfa, 0xa7, 0x45, 0x50, 0x15, 0x41, 0x79, 0xa3, 0x5c, 0x15, 0x90, 0x40, 0xaa, 0x8a, 0x00, 0x89,
-	0x52, 0x8a, 0x90, 0x90, 0x90, 0x40, 0x42, 0x54, 0x48, 0x48, 0x15, 0x3c, 0xf8, 0x3e, 0x21, 0x24,
-	0xca, 0xa2, 0x3a, 0x7d, 0xba, 0x0f, 0x3c, 0xdc, 0x47, 0xf3, 0x82, 0x66, 0xf6, 0x6f, 0x76, 0x77,
-	0xd6, 0xce, 0xdb, 0xdd, 0xf3, 0x9d, 0x39, 0x73, 0xbe, 0xf9, 0x39, 0x73, 0xce, 0xcc, 0x95, 0x2f,
-	0x9b, 0xc6, 0xea, 0x35, 0xdd, 0xb6, 0xaa, 0xc6, 0xda, 0x35, 0xbb, 0x4e, 0x0c, 0xdb, 0x72, 0x83,
-	0x2f, 0xcf, 0x41, 0xf4, 0xeb, 0x6a, 0xdd, 0xb1, 0x89, 0x0d, 0x4e, 0x04, 0xc2, 0x0b, 0x7d, 0x9c,
-	0x3a, 0xf1, 0x2c, 0xc3, 0x5a, 0x0b, 0x14, 0x2e, 0x9c, 0xe3, 0x00, 0xd7, 0x78, 0x1d, 0x87, 0xe2,
-	0x93, 0x78, 0x9b, 0x04, 0x3f, 0x4b, 0xef, 0x2d, 0xca, 0x3d, 0x77, 0x83, 0x1e, 0x26, 0xf9, 0x1e,
-	0xc0, 0x7b, 0x92, 0x7c, 0xd6, 0x34, 0x5c, 0x82, 0x2d, 0x0d, 0x55, 0x2a, 0x0e, 0x76, 0x5d, 0xec,
-	0x2a, 0xd2, 0xe0, 0xb1, 0xa1, 0x93, 0x13, 0xee, 0x9e, 0xaf, 0x02, 0x88, 0xb6, 0x66, 0x19, 0x3c,
-	0x1e, 0xa1, 0x2d, 0x5f, 0x3d, 0x63, 0xa6, 0x45, 0x6d, 0x5f, 0xbd, 0xbc, 0x5d, 0x33, 0xc7, 0x4a,
-	0x29, 0x79, 0x69, 0xb0, 0x82, 0xab, 0xc8, 0x33, 0xc9, 0x58, 0x29, 0xfc, 0x51, 0xda, 0xdf, 0x2d,
-	0x3f, 0x1c, 0xfe, 0x6e, 0x34, 0xcb, 0x02, 0xe3, 0x30, 0x6b, 0x1a, 0xfc, 0x57, 0x92, 0x95, 0x35,
-	0xd3, 0x5e, 0x45, 0xa6, 0x56, 0x31, 0x5c, 0xdd, 0xde, 0xc4, 0xce, 0x8e, 0xe6, 0x62, 0x67, 0x13,
-	0x3b, 0xae, 0x72, 0x94, 0x39, 0xfa, 0x3b, 0x69, 0xcf, 0x57, 0xbb, 0x21, 0xda, 0xfa, 0x32, 0xd3,
-	0x1b, 0xb7, 0xac, 0xc5, 0x00, 0x6f, 0xf9, 0xea, 0xb9, 0xb5, 0x48, 0x66, 0x7b, 0x96, 0x8e, 0x43,
-	0xa0, 0xed, 0xab, 0x57, 0x98, 0xc3, 0x22, 0x54, 0xe0, 0x77, 0x6b, 0xb7, 0xdc, 0x23, 0x52, 0x6d,
-	0xef, 0x96, 0xc5, 0x1d, 0xa4, 0x89, 0x8a, 0x7c, 0x83, 0xbd, 0x41, 0xc3, 0xa9, 0x88, 0x54, 0x28,
-	0x07, 0x9f, 0x8b, 0x08, 0x63, 0x0b, 0xad, 0x9a, 0xb8, 0xa2, 0x1c, 0x1b, 0x94, 0x86, 0x1e, 0x99,
-	0xf8, 0x80, 0x12, 0x3e, 0x1b, 0x5b, 0x7c, 0x21, 0x00, 0xf3, 0x6c, 0x43, 0xa0, 0xed, 0xab, 0x4f,
-	0x09, 0xd8, 0x86, 0x28, 0x47, 0x97, 0x38, 0x1e, 0xa6, 0x5c, 0x0b, 0xcc, 0x14, 0x01, 0xfb, 0xbb,
-	0xe5, 0x87, 0x68, 0xd3, 0x46, 0xb3, 0x9c, 0x73, 0x2a, 0x47, 0x33, 0x94, 0x83, 0x4f, 0x25, 0xb9,
-	0xcf, 0xb4, 0x75, 0x21, 0xcb, 0x87, 0x18, 0xcb, 0x9f, 0x51, 0x96, 0x67, 0x66, 0xa9, 0x4e, 0x8a,
-	0x64, 0x8f, 0x19, 0x8a, 0x32, 0x1c, 0x9f, 0x0c, 0x96, 0xa0, 0x00, 0x14, 0x50, 0x14, 0x1b, 0x29,
-	0x90, 0x73, 0x04, 0xb3, 0xfe, 0xc0, 0x73, 0xac, 0x41, 0x8e, 0xde, 0xdf, 0x25, 0xb9, 0x3b, 0xa0,
-	0x87, 0x42, 0x5b, 0x5a, 0xdd, 0x76, 0x88, 0x72, 0x7c, 0x50, 0x1a, 0x3a, 0x3e, 0xf1, 0x63, 0x4a,
-	0xad, 0x23, 0x32, 0xb5, 0x60, 0x3b, 0xa4, 0xe5, 0xab, 0x5d, 0xa9, 0xae, 0xa9, 0xb0, 0xed, 0xab,
-	0x4f, 0xe4, 0x49, 0x51, 0x84, 0x63, 0x34, 0x3a, 0x32, 0x3c, 0xfa, 0x6c, 0x69, 0xdf, 0x57, 0x8f,
-	0x19, 0x16, 0x69, 0xed, 0x96, 0x05, 0x66, 0x44, 0xc2, 0xfd, 0xdd, 0xf2, 0x71, 0xd6, 0xb4, 0xd1,
-	0x2c, 0xa7, 0x3c, 0x81, 0x79, 0x5d, 0xf0, 0xad, 0xa3, 0xf2, 0x60, 0x86, 0x4d, 0xcd, 0x33, 0x89,
-	0xa1, 0x23, 0x97, 0x44, 0x71, 0x43, 0x39, 0x31, 0x28, 0x0d, 0x9d, 0x9c, 0xf8, 0x03, 0xa5, 0xd6,
-	0x19, 0x19, 0x9c, 0x9b, 0xa4, 0x3b, 0xb9, 0xe5, 0xab, 0xdd, 0x29, 0xa3, 0x81, 0xb8, 0xed, 0xab,
-	0xb7, 0xf2, 0xf4, 0x02, 0x8c, 0x23, 0xf8, 0xd5, 0x6a, 0x75, 0x64, 0x74, 0x6c, 0xec, 0xf6, 0xf5,
-	0xdb, 0x37, 0xbe, 0x36, 0x16, 0xb0, 0x6d, 0xed, 0x96, 0x85, 0x06, 0xc5, 0xe2, 0xfd, 0xdd, 0x32,
-	0xc8, 0x1b, 0x69, 0x34, 0xcb, 0x19, 0x37, 0xe1, 0xa3, 0xe9, 0xc6, 0x11, 0xc3, 0x30, 0x18, 0x81,
-	0xbb, 0xf2, 0xe9, 0x1a, 0xda, 0xd6, 0x5c, 0x6c, 0x55, 0xb4, 0x8d, 0xd5, 0xba, 0xab, 0x3c, 0xcc,
-	0x26, 0xf3, 0xe9, 0x96, 0xaf, 0x9e, 0xaa, 0xa1, 0xed, 0x45, 0x6c, 0x55, 0xee, 0xac, 0xd6, 0x69,
-	0x70, 0xe9, 0x62, 0xb4, 0x38, 0x59, 0x34, 0x3f, 0x90, 0x57, 0x8c, 0x0c, 0x3a, 0x58, 0xdf, 0x0c,
-	0x0c, 0x3e, 0x92, 0x32, 0x08, 0xb1, 0xbe, 0x99, 0x35, 0x18, 0xc9, 0x52, 0x06, 0x23, 0x21, 0xf8,
-	0xbd, 0x24, 0xf7, 0x39, 0x58, 0xb7, 0x2d, 0x0b, 0xeb, 0x34, 0xbc, 0x6b, 0x86, 0x45, 0xb0, 0xb3,
-	0x89, 0x4c, 0xcd, 0x55, 0x4e, 0x32, 0xdb, 0xdf, 0x60, 0x41, 0x3d, 0x52, 0x99, 0x09, 0xe1, 0x45,
-	0x1a, 0x3b, 0xf8, 0x86, 0x31, 0xd0, 0xf6, 0xd5, 0x21, 0xd6, 0xb7, 0x10, 0xe5, 0x66, 0xe9, 0xd6,
-	0x70, 0xe4, 0xd2, 0xfe, 0x6e, 0xf9, 0xe8, 0xad, 0x61, 0x16, 0xdf, 0x73, 0xfd, 0x40, 0x71, 0x2f,
-	0xa0, 0x2a, 0x77, 0x3a, 0xd8, 0x44, 0x3b, 0x6e, 0x1c, 0x03, 0x64, 0x16, 0x03, 0x9e, 0x6f, 0xf9,
-	0xea, 0xe9, 0x00, 0x49, 0x36, 0x7a, 0x29, 0x74, 0x88, 0x93, 0x66, 0x77, 0x78, 0xb4, 0x63, 0x61,
-	0xba, 0x31, 0x78, 0xeb, 0xa8, 0xdc, 0x1f, 0x76, 0x14, 0x3b, 0x92, 0x0c, 0x52, 0x4d, 0x39, 0xc5,
-	0x06, 0xe9, 0x2f, 0x74, 0x0d, 0xf7, 0x41, 0xaa, 0x97, 0xa3, 0x30, 0xd7, 0xf2, 0xd5, 0x3e, 0x47,
-	0x0c, 0xc5, 0x81, 0xb6, 0x00, 0xe7, 0xbc, 0x1c, 0x19, 0xe6, 0xb6, 0x6c, 0xa1, 0xbd, 0x62, 0x88,
-	0x0e, 0xf2, 0x08, 0x1d, 0xe4, 0x22, 0x37, 0xa1, 0x12, 0xf0, 0xcc, 0x23, 0x60, 0x55, 0x3e, 0xed,
-	0x12, 0xe4, 0x10, 0x6d, 0xd5, 0xb1, 0xb7, 0x5c, 0xec, 0x28, 0x1d, 0x6c, 0xac, 0xbf, 0xd8, 0xf2,
-	0xd5, 0x0e, 0x06, 0x4c, 0x04, 0xf2, 0xb6, 0xaf, 0x3e, 0xc6, 0xe8, 0xf0, 0xc2, 0xc2, 0x91, 0x4e,
-	0x35, 0x05, 0xbf, 0x90, 0xe4, 0x73, 0x16, 0x22, 0x1a, 0x71, 0x10, 0x3d, 0xd5, 0x90, 0x19, 0x4f,
-	0x6c, 0x27, 0xeb, 0xec, 0xde, 0x9e, 0xaf, 0xca, 0xf3, 0xe3, 0x4b, 0x49, 0x58, 0x97, 0x2d, 0x44,
-	0x92, 0x39, 0x56, 0x59, 0xc7, 0x89, 0x48, 0x10, 0xc2, 0xf9, 0x06, 0xa9, 0x2f, 0x2e, 0x5c, 0x73,
-	0x5d, 0xc0, 0x6e, 0x0b, 0x91, 0xa5, 0xc8, 0x9d, 0x68, 0x41, 0xfc, 0x31, 0xe7, 0xa7, 0x89, 0x91,
-	0x8b, 0xb5, 0x9a, 0x72, 0x86, 0x2d, 0x85, 0xef, 0xd0, 0xa5, 0x70, 0x72, 0x7e, 0x7c, 0x69, 0x96,
-	0x8a, 0xe9, 0xe4, 0x9f, 0xb1, 0x10, 0x09, 0x3e, 0x0c, 0xcb, 0x23, 0x2c, 0xf9, 0x29, 0x45, 0xce,
-	0xf2, 0x72, 0xe1, 0xde, 0x68, 0xed, 0x96, 0x73, 0xed, 0xf3, 0xa2, 0x78, 0x07, 0x25, 0x1d, 0x43,
-	0xc0, 0x7b, 0x1f, 0xc8, 0xc0, 0xdf, 0x24, 0xb9, 0x2f, 0xed, 0xbc, 0x83, 0x2d, 0xbc, 0xc5, 0x56,
-	0xf2, 0x59, 0xe6, 0x7e, 0x83, 0xba, 0x7f, 0x6a, 0x7e, 0x7c, 0x09, 0x06, 0x00, 0x25, 0xd0, 0x65,
-	0x21, 0x12, 0x7d, 0xc6, 0x14, 0xca, 0x11, 0x85, 0x34, 0xc2, 0x91, 0xb8, 0xce, 0x93, 0x10, 0xd8,
-	0x10, 0x09, 0x29, 0x91, 0xeb, 0x94, 0x08, 0xef, 0x02, 0xec, 0xe1, 0xa9, 0x44, 0x52, 0x01, 0x19,
-	0x62, 0xd4, 0xb0, 0xed, 0x11, 0xcd, 0x55, 0xba, 0xd2, 0x64, 0x96, 0x02, 0x60, 0x31, 0x24, 0x13,
-	0x7d, 0xd2, 0x95, 0x5e, 0x49, 0x91, 0x49, 0x23, 0x45, 0xdb, 0x4f, 0x60, 0x43, 0x24, 0x8c, 0xb7,
-	0x1c, 0xef, 0x42, 0x9a, 0x4c, 0x24, 0x05, 0x3f, 0x91, 0x64, 0xc5, 0x73, 0xd1, 0x1a, 0xd6, 0x1c,
-	0x4c, 0xcf, 0x7d, 0xc3, 0x5a, 0xd3, 0x90, 0xae, 0xe3, 0x3a, 0xc1, 0x15, 0x05, 0x30, 0x36, 0x88,
-	0xee, 0x80, 0x65, 0x38, 0x1e, 0x4a, 0xe9, 0x0e, 0xf0, 0x9c, 0xe8, 0xab, 0xed, 0xab, 0x67, 0x19,
-	0x89, 0x44, 0xc4, 0x39, 0xcc, 0x2b, 0xa6, 0xbe, 0xe8, 0x8a, 0x4f, 0x4c, 0xc2, 0x5e, 0xe6, 0x02,
-	0x8c, 0x3c, 0x88, 0xe4, 0xe0, 0x0d, 0xb9, 0x27, 0xeb, 0x9c, 0x8b, 0xb1, 0xa5, 0x74, 0x33, 0xc7,
-	0x66, 0xf6, 0x7c, 0xf5, 0xc4, 0x32, 0x5c, 0xc4, 0xd8, 0x6a, 0xf9, 0xea, 0x09, 0xcf, 0xa1, 0xbf,
-	0xda, 0xbe, 0xda, 0x11, 0x3a, 0x44, 0x3f, 0x39, 0x67, 0x22, 0x85, 0xf8, 0x57, 0xa3, 0x59, 0x0e,
-	0x9b, 0x43, 0x90, 0x76, 0x80, 0xca, 0xc0, 0x0f, 0x24, 0xf9, 0x7c, 0xb6, 0x77, 0xcf, 0x32, 0xee,
-	0x79, 0x58, 0x33, 0x2a, 0x4a, 0x0f, 0x4b, 0x22, 0x5e, 0x0d, 0xc6, 0x66, 0x99, 0x89, 0x67, 0xa6,
-	0x82, 0xb1, 0x09, 0xbf, 0xf8, 0xb1, 0x89, 0x14, 0x4a, 0xc1, 0xa0, 0x44, 0x9f, 0x6d, 0xfe, 0x2b,
-	0x1c, 0x94, 0x08, 0xcb, 0x0e, 0x4a, 0xa4, 0x05, 0x3e, 0x96, 0xe4, 0xee, 0x9c, 0x5f, 0x8e, 0xa9,
-	0x9c, 0x63, 0x1e, 0x7d, 0x8f, 0xae, 0xbd, 0xe3, 0xcb, 0x70, 0x19, 0xce, 0xb6, 0x7c, 0xf5, 0xb8,
-	0xe7, 0x2c, 0xc3, 0xd9, 0xb6, 0xaf, 0xde, 0x8e, 0x1c, 0x81, 0xb3, 0xdc, 0xea, 0x5a, 0x27, 0xa4,
-	0xee, 0x8e, 0x5d, 0xbb, 0x56, 0x41, 0x04, 0x5d, 0x75, 0x77, 0x2c, 0x9d, 0xac, 0xd3, 0x62, 0xcd,
-	0xc2, 0xe4, 0x9a, 0x85, 0xb7, 0xa8, 0x94, 0x3a, 0x1c, 0x1a, 0x89, 0x7e, 0xec, 0xef, 0x96, 0x1f,
-	0xa0, 0x61, 0xa3, 0x59, 0x0e, 0xbc, 0x80, 0x5d, 0x19, 0x1e, 0x8e, 0x09, 0xfe, 0x23, 0xc9, 0x6a,
-	0x96, 0x42, 0xdd, 0x76, 0xe9, 0x09, 0xe7, 0x62, 0xdd, 0x73, 0xb0, 0xb9, 0xa3, 0xf4, 0xb2, 0xf0,
-	0xfb, 0x23, 0x56, 0x41, 0x2c, 0xc3, 0x05, 0xdb, 0x25, 0x33, 0x31, 0xd8, 0xf2, 0xd5, 0xb3, 0x9e,
-	0x93, 0x96, 0xb5, 0x7d, 0xf5, 0xf1, 0x90, 0x64, 0x1a, 0xe0, 0xf8, 0x56, 0x91, 0xe9, 0xb2, 0x90,
-	0x9c, 0x6f, 0x2d, 0x90, 0xd1, 0xcc, 0x93, 0xb5, 0xa0, 0xf5, 0x42, 0xd6, 0x05, 0x78, 0x31, 0x4d,
-	0x2b, 0x8d, 0x82, 0x7f, 0x0b, 0x18, 0x1a, 0x96, 0x41, 0x0c, 0x5a, 0x47, 0xd0, 0xf3, 0x4e, 0x73,
-	0x95, 0x3e, 0xb6, 0x8a, 0x7f, 0xc8, 0xaa, 0x87, 0x65, 0x38, 0x13, 0xa0, 0x53, 0x14, 0xa4, 0x01,
-	0xe3, 0x8c, 0xe7, 0xa4, 0x44, 0x71, 0xb8, 0xc8, 0xc8, 0xf9, 0x60, 0x71, 0x7b, 0x38, 0x15, 0xc0,
-	0xb3, 0x16, 0xf2, 0x22, 0x7a, 0x02, 0xd1, 0x56, 0xb4, 0x60, 0xc8, 0xb8, 0x00, 0xfb, 0xd3, 0x04,
-	0x53, 0x20, 0x78, 0x5b, 0x92, 0xfb, 0x90, 0x47, 0x6c, 0xcd, 0xab, 0xaf, 0x39, 0xa8, 0x82, 0x93,
-	0xdc, 0x64, 0x5d, 0x39, 0xcf, 0x78, 0x2d, 0xd0, 0x0a, 0x88, 0xaa, 0x2c, 0x07, 0x1a, 0xd1, 0xb1,
-	0xfe, 0x62, 0x5c, 0x2c, 0x88, 0x40, 0x9e, 0xcd, 0x28, 0x9f, 0xa8, 0x8d, 0x8c, 0x42, 0xa1, 0x35,
-	0x50, 0x93, 0xfb, 0x22, 0x1f, 0x88, 0xad, 0xd5, 0x1d, 0x3a, 0xe2, 0xec, 0x68, 0x74, 0x95, 0x0b,
-	0x6c, 0x09, 0xdd, 0xa2, 0x8e, 0x84, 0x2a, 0x4b, 0xf6, 0x82, 0x83, 0x61, 0x88, 0xb7, 0x7d, 0xf5,
-	0x42, 0x30, 0xa2, 0x02, 0xb0, 0x04, 0x85, 0x6d, 0xc0, 0xa6, 0x0c, 0x36, 0x30, 0xae, 0x6b, 0x04,
-	0xd7, 0xea, 0xb6, 0x83, 0x1c, 0x03, 0xbb, 0xda, 0xba, 0xd2, 0xcf, 0x28, 0xbf, 0x48, 0xd7, 0x25,
-	0x45, 0x97, 0x12, 0x90, 0xd2, 0xbd, 0xc4, 0x7a, 0xc9, 0x02, 0x7c, 0x69, 0x74, 0x83, 0xa7, 0x3a,
-	0x7a, 0x03, 0xe6, 0xac, 0x80, 0x1d, 0xb9, 0x5b, 0x47, 0xfa, 0x3a, 0xd6, 0x8c, 0x35, 0xcb, 0x76,
-	0x70, 0x45, 0xab, 0x1a, 0x26, 0x76, 0x95, 0x8b, 0x8c, 0xe2, 0x0c, 0x3d, 0x60, 0x18, 0x3c, 0x13,
-	0xa0, 0xd3, 0x14, 0x8c, 0x07, 0x3a, 0x87, 0xe4, 0xb6, 0x44, 0xbc, 0xd4, 0x61, 0xde, 0x0c, 0xf8,
-	0xbe, 0x24, 0x5f, 0xa8, 0x3b, 0xf6, 0x1a, 0xad, 0x2d, 0x34, 0xaf, 0x5e, 0x41, 0x04, 0xf3, 0xf9,
-	0xfa, 0xa3, 0x8c, 0xfb, 0x12, 0x4d, 0x37, 0x23, 0xad, 0x65, 0xa6, 0xc4, 0xe7, 0xe6, 0x41, 0xcd,
-	0x5b, 0x80, 0x73, 0xee, 0xdc, 0xe4, 0x06, 0x42, 0xba, 0x09, 0x8b, 0x2c, 0x82, 0xb7, 0x24, 0xb9,
-	0xd7, 0x34, 0x6a, 0x06, 0xd1, 0x56, 0x91, 0x55, 0xd9, 0x32, 0x2a, 0x64, 0x5d, 0x33, 0x2c, 0xcd,
-	0x44, 0x96, 0x32, 0xc0, 0x86, 0x64, 0x8e, 0xd5, 0x72, 0x54, 0x63, 0x22, 0x52, 0x98, 0xb1, 0x66,
-	0x91, 0x95, 0xd4, 0xdf, 0x79, 0xec, 0x80, 0x61, 0x11, 0x99, 0x02, 0x6f, 0x4a, 0x32, 0xa8, 0x19,
-	0x96, 0xb6, 0x6e, 0xd7, 0xb0, 0x56, 0x31, 0xdc, 0x0d, 0xad, 0xea, 0x60, 0xac, 0xa8, 0x83, 0xd2,
-	0xd0, 0xa9, 0xd1, 0x8e, 0xab, 0xc1, 0x45, 0xd7, 0xd5, 0x45, 0xe3, 0x75, 0x3c, 0xf1, 0xc2, 0x27,
-	0xbe, 0x7a, 0x84, 0xee, 0xea, 0x9a, 0x61, 0xbd, 0x68, 0xd7, 0xf0, 0x94, 0xe1, 0x6e, 0x4c, 0x3b,
-	0x18, 0xc7, 0xab, 0x23, 0x23, 0xe7, 0xf7, 0xc1, 0xe0, 0x65, 0xea, 0xc8, 0xb1, 0x91, 0xc1, 0xcb,
-	0x30, 0xdb, 0x1c, 0xdc, 0x97, 0xe4, 0x8e, 0x68, 0xbd, 0xb3, 0x53, 0x60, 0x90, 0x9d, 0x02, 0x7f,
-	0x66, 0x19, 0x48, 0xb4, 0x68, 0x83, 0xb3, 0xe0, 0x94, 0x93, 0x7c, 0xb6, 0x7d, 0x75, 0x2a, 0x2a,
-	0x00, 0x22, 0x99, 0xe0, 0x5c, 0x08, 0x77, 0x80, 0x9b, 0x09, 0xf1, 0x35, 0x4c, 0xd0, 0xd5, 0xd7,
-	0x5c, 0xdb, 0xa2, 0xa1, 0x34, 0x65, 0x36, 0xfd, 0xb9, 0xbf, 0x5b, 0x1e, 0x7a, 0x50, 0x53, 0x34,
-	0x5d, 0xe1, 0xfc, 0x85, 0x89, 0x1d, 0xc7, 0x04, 0x2b, 0x72, 0x17, 0x32, 0xb7, 0x68, 0x31, 0x14,
-	0x14, 0xf7, 0x16, 0x26, 0xae, 0xf2, 0x18, 0xbb, 0x53, 0xa3, 0x35, 0xe8, 0x99, 0x00, 0x64, 0x45,
-	0xf2, 0x3c, 0x26, 0x74, 0xe1, 0xf7, 0x04, 0x11, 0x26, 0x25, 0x2f, 0xc1, 0xac, 0x22, 0xf8, 0x9f,
-	0x24, 0x0f, 0xd9, 0x9b, 0xd8, 0xd9, 0x72, 0x0c, 0x42, 0x03, 0x47, 0xcd, 0x26, 0x58, 0xab, 0xe0,
-	0x4d, 0x43, 0xc7, 0x9a, 0x85, 0x6a, 0xd8, 0xd5, 0x6c, 0x4b, 0x0b, 0xeb, 0x12, 0xa5, 0x94, 0xdc,
-	0xf6, 0xf4, 0xdd, 0x8d, 0x1a, 0x41, 0xd6, 0x66, 0x0a, 0x6f, 0xce, 0x53, 0xf5, 0x96, 0xaf, 0x5e,
-	0xb2, 0x73, 0x90, 0xa1, 0x63, 0x86, 0xde, 0xb5, 0x26, 0x03, 0x53, 0x6d, 0x5f, 0x7d, 0x8e, 0x39,
-	0xf8, 0x00, 0xba, 0xc5, 0x8b, 0x92, 0x16, 0x55, 0x05, 0x7e, 0xc0, 0x07, 0xf1, 0x02, 0x7c, 0x53,
-	0x3e, 0x47, 0xc3, 0x98, 0x66, 0x58, 0x15, 0xbc, 0xad, 0xd1, 0x95, 0xbc, 0x6a, 0xda, 0xfa, 0x86,
-	0xab, 0x5c, 0x62, 0x5b, 0x9a, 0x2e, 0x1a, 0x40, 0x15, 0x66, 0x28, 0x3e, 0x67, 0x58, 0x13, 0x0c,
-	0x8d, 0x2f, 0x51, 0xf3, 0x90, 0x30, 0x71, 0x0d, 0xd2, 0x51, 0x28, 0xb0, 0x04, 0xfe, 0x45, 0xb3,
-	0x4f, 0x0b, 0xe9, 0x1b, 0xb8, 0xa2, 0x59, 0x36, 0x31, 0xaa, 0x86, 0x8e, 0x82, 0xeb, 0x80, 0x8a,
-	0xab, 0x94, 0xd9, 0xfc, 0xbe, 0x4f, 0x87, 0xbb, 0x77, 0x39, 0x50, 0x9a, 0xe7, 0x74, 0x66, 0xa6,
-	0xe8, 0x68, 0xf7, 0x7a, 0x42, 0xa4, 0xed, 0xab, 0xfd, 0x41, 0x68, 0x17, 0xc1, 0xec, 0xea, 0x50,
-	0x88, 0xb4, 0x77, 0xcb, 0x05, 0x16, 0x1b, 0xcd, 0x72, 0x81, 0x17, 0x50, 0xd8, 0xa2, 0xe2, 0x02,
-	0x28, 0x9f, 0x26, 0x0e, 0xaa, 0x56, 0x0d, 0x5d, 0xd3, 0x4d, 0xe4, 0xba, 0xca, 0x65, 0x36, 0xac,
-	0xcf, 0xd0, 0xf2, 0x35, 0x04, 0x26, 0xa9, 0xbc, 0xed, 0xab, 0x20, 0x18, 0x50, 0x4e, 0x18, 0xdf,
-	0x9b, 0xa4, 0x54, 0xc1, 0x1b, 0x72, 0x77, 0x38, 0xc4, 0x5a, 0xd5, 0x36, 0x2b, 0xd8, 0xd1, 0xea,
-	0x88, 0xac, 0x2b, 0x8f, 0xb3, 0x5d, 0x7f, 0x67, 0xcf, 0x57, 0xfb, 0xa7, 0x70, 0xdd, 0xc1, 0x3a,
-	0x22, 0xb8, 0x32, 0x15, 0x28, 0x4e, 0x33, 0xbd, 0x05, 0x44, 0xd6, 0x5b, 0xbe, 0x2a, 0x3d, 0x13,
-	0x17, 0xcb, 0x95, 0x2c, 0x7c, 0xc5, 0xae, 0x19, 0x74, 0x92, 0xc8, 0x4e, 0x49, 0x91, 0x60, 0x57,
-	0x0e, 0x07, 0x1b, 0xf2, 0x59, 0x17, 0x13, 0xcd, 0xb4, 0xb7, 0xb4, 0xba, 0x63, 0xd8, 0x8e, 0x41,
-	0x76, 0x94, 0x27, 0xd8, 0xa6, 0x18, 0x6f, 0xf9, 0x6a, 0xa7, 0x8b, 0xc9, 0xac, 0xbd, 0xb5, 0x10,
-	0x22, 0x71, 0x64, 0x4b, 0x8b, 0x0b, 0xcb, 0xf2, 0x4c, 0x73, 0xf0, 0x81, 0x24, 0xf7, 0xd6, 0xd0,
-	0x76, 0x44, 0x53, 0xb7, 0x2d, 0xdd, 0x73, 0x1c, 0x6c, 0xe9, 0x3b, 0xca, 0x10, 0x1b, 0x47, 0x97,
-	0xdd, 0x7d, 0xa0, 0xad, 0x39, 0xb4, 0x1d, 0xf8, 0x38, 0x99, 0xa8, 0xd0, 0x23, 0xbf, 0x26, 0x90,
-	0xc7, 0x47, 0xbe, 0x08, 0x8c, 0x86, 0x9c, 0x5d, 0x56, 0x88, 0xed, 0x42, 0xa1, 0x55, 0xf0, 0xa9,
-	0x24, 0x77, 0xeb, 0x0e, 0x72, 0xd7, 0x33, 0x29, 0xf9, 0x93, 0x6c, 0x5a, 0x3e, 0x64, 0x29, 0xf9,
-	0x64, 0x94, 0x92, 0xeb, 0x61, 0x4a, 0x3e, 0x1d, 0x9c, 0xcd, 0xb4, 0x59, 0x92, 0x1c, 0x0b, 0xc3,
-	0x30, 0xd3, 0xc9, 0xa7, 0xd9, 0x4c, 0x4c, 0xd7, 0x72, 0x57, 0xce, 0x08, 0x4d, 0xd6, 0xf5, 0x30,
-	0x59, 0x2f, 0x3f, 0x88, 0x19, 0x9a, 0xae, 0x4f, 0x06, 0xe9, 0x7a, 0xc6, 0x98, 0x63, 0x82, 0x9f,
-	0x4a, 0x72, 0x5f, 0x96, 0x5e, 0x74, 0x4b, 0xf2, 0x14, 0x9b, 0x7f, 0x63, 0xcf, 0x57, 0x4f, 0x4e,
-	0x42, 0xee, 0x82, 0x3f, 0x6d, 0x25, 0x7b, 0xc1, 0x2f, 0x44, 0x8b, 0x96, 0x46, 0xa3, 0x59, 0x4e,
-	0x6c, 0x43, 0xb1, 0x65, 0xf0, 0x6d, 0x49, 0xee, 0x75, 0x89, 0x67, 0x69, 0x34, 0x73, 0x42, 0xa6,
-	0xb1, 0x89, 0xb5, 0xe0, 0xee, 0xc8, 0x55, 0x9e, 0x8e, 0xf3, 0xd1, 0x6e, 0xaa, 0x71, 0x27, 0x52,
-	0x58, 0xa4, 0xf8, 0x62, 0x9c, 0x25, 0x09, 0xb0, 0x74, 0x6e, 0xcd, 0x05, 0xb4, 0x63, 0x23, 0xb7,
-	0x87, 0xa1, 0xc8, 0x1a, 0x2d, 0x59, 0x33, 0x6e, 0xd0, 0xb8, 0xea, 0x2a, 0x57, 0x98, 0x13, 0x2f,
-	0xd1, 0x44, 0x2d, 0xd5, 0x6c, 0xce, 0xb0, 0x92, 0xd4, 0x3e, 0x87, 0xf0, 0x39, 0x62, 0x2a, 0xa0,
-	0x8e, 0x0e, 0xc3, 0xbc, 0x1d, 0x9a, 0x95, 0x77, 0xb0, 0xde, 0xa3, 0x77, 0xa7, 0x67, 0x58, 0x0c,
-	0xad, 0xec, 0xf9, 0x6a, 0x27, 0x44, 0x5b, 0x8b, 0xc4, 0xe3, 0x5e, 0x9c, 0x4e, 0xb9, 0xc9, 0x67,
-	0x7c, 0x37, 0x94, 0xc8, 0x0e, 0x7d, 0x15, 0xcb, 0x58, 0x84, 0xbc, 0x3d, 0xb0, 0x29, 0x9f, 0xa1,
-	0x55, 0xe0, 0x2a, 0x72, 0xb1, 0x16, 0x3c, 0x01, 0x2a, 0x57, 0x07, 0xa5, 0xa1, 0xce, 0xd1, 0xce,
-	0x28, 0x2d, 0x5a, 0x62, 0x52, 0x76, 0x99, 0xd7, 0x19, 0xa9, 0x06, 0xb2, 0x38, 0x72, 0xa4, 0xc5,
-	0xa5, 0x41, 0x07, 0xb3, 0x29, 0x0d, 0x97, 0xc7, 0x9b, 0xcd, 0xb2, 0x04, 0x33, 0x4d, 0xc1, 0xbb,
-	0x47, 0xe5, 0x4b, 0x34, 0x6a, 0xc4, 0xe1, 0x82, 0xd6, 0x94, 0xba, 0x5d, 0xa3, 0x4b, 0xd6, 0xc1,
-	0xf7, 0x3c, 0xec, 0x12, 0x6d, 0xc3, 0x58, 0x55, 0xae, 0xb1, 0xe9, 0xf8, 0xab, 0x14, 0x3e, 0x1d,
-	0xce, 0xa1, 0xed, 0xc9, 0x19, 0x18, 0xe0, 0x77, 0x8c, 0x89, 0x96, 0xaf, 0xaa, 0x35, 0xb4, 0x1d,
-	0x6f, 0x71, 0x32, 0x13, 0xda, 0x48, 0x54, 0xe2, 0x53, 0xf0, 0x10, 0x3d, 0xae, 0x1e, 0x3b, 0xd4,
-	0xe4, 0xe1, 0x2a, 0xe1, 0x63, 0x64, 0xc6, 0x5d, 0x78, 0x48, 0xb3, 0x55, 0xf0, 0xb9, 0x24, 0xf7,
-	0xc6, 0x2f, 0x22, 0x26, 0xe2, 0xdf, 0x50, 0x87, 0xd9, 0x06, 0xfe, 0x88, 0x8e, 0x44, 0x4f, 0xf4,
-	0xa2, 0x30, 0x3b, 0x3e, 0xcf, 0x3f, 0xa3, 0xf6, 0x20, 0x81, 0x3c, 0x4e, 0xa4, 0x45, 0xa0, 0xe8,
-	0x21, 0x4b, 0x68, 0xa4, 0x40, 0xce, 0x6d, 0x7d, 0xa1, 0x53, 0x30, 0x69, 0x85, 0xb8, 0x37, 0xd8,
-	0x4d, 0xf9, 0x02, 0x7b, 0xf4, 0xa8, 0x7a, 0xa6, 0x19, 0x66, 0x35, 0xb6, 0x15, 0x95, 0xa8, 0xca,
-	0x08, 0x63, 0x3a, 0x46, 0xb3, 0x06, 0xaa, 0x35, 0xed, 0x99, 0x26, 0xcb, 0x47, 0xee, 0x5a, 0x61,
-	0x51, 0xd9, 0xf6, 0xd5, 0x8b, 0xe1, 0x91, 0x25, 0x82, 0x4b, 0xb0, 0xa0, 0x1d, 0x78, 0x49, 0x3e,
-	0x5d, 0xc5, 0x88, 0x78, 0x0e, 0xd6, 0xaa, 0x26, 0x5a, 0x73, 0x95, 0x51, 0xb6, 0xef, 0x2e, 0xd3,
-	0x93, 0x3e, 0x04, 0xa6, 0xa9, 0x3c, 0x7e, 0x20, 0xe1, 0x84, 0x25, 0x98, 0x52, 0x01, 0x5b, 0x72,
-	0x1f, 0xf7, 0x2e, 0x12, 0xd4, 0x38, 0xd8, 0xb2, 0xbd, 0xb5, 0x75, 0xe5, 0x3a, 0x5b, 0xb4, 0xcf,
-	0xb3, 0xf0, 0x1a, 0xab, 0xcc, 0x52, 0x8d, 0x17, 0x98, 0x42, 0x9c, 0xf5, 0x08, 0xd1, 0x38, 0xa3,
-	0x10, 0x37, 0x06, 0x1b, 0x72, 0x4f, 0xae, 0xe3, 0x1a, 0xda, 0x56, 0x6e, 0xb0, 0x5e, 0x9f, 0xa3,
-	0xc9, 0x60, 0xa6, 0xe1, 0x1c, 0xda, 0x6e, 0xfb, 0xaa, 0x22, 0xea, 0x72, 0x0e, 0x6d, 0xc7, 0xfd,
-	0x09, 0x9a, 0x81, 0xb7, 0x8f, 0xca, 0x6a, 0x74, 0xd9, 0xa3, 0x21, 0x93, 0xa6, 0x14, 0xb6, 0x59,
-	0xd1, 0x88, 0xe9, 0x6a, 0x34, 0x7e, 0x18, 0xb6, 0xe5, 0x2a, 0x37, 0xd9, 0x7c, 0x7d, 0x4c, 0x57,
-	0x66, 0x7f, 0x74, 0xb5, 0x32, 0x4e, 0x55, 0xef, 0x9a, 0x95, 0xa5, 0xd9, 0xc5, 0x57, 0x42, 0xbd,
-	0x96, 0xaf, 0xf6, 0x1b, 0xc5, 0x70, 0x9c, 0xef, 0x1c, 0xa0, 0x43, 0xd7, 0xe7, 0x81, 0x36, 0x0e,
-	0x86, 0x1b, 0xcd, 0xf2, 0x41, 0x0e, 0xc2, 0x7c, 0x5b, 0xd3, 0x8d, 0x40, 0xd0, 0x94, 0xe4, 0x7e,
-	0x6e, 0xdc, 0xa3, 0xc4, 0x4a, 0x23, 0x7a, 0x9d, 0x95, 0xb3, 0xb7, 0xd8, 0xf0, 0xbf, 0x43, 0x47,
-	0x41, 0x99, 0x8c, 0xf5, 0xa2, 0x34, 0x69, 0x69, 0x72, 0x61, 0x76, 0x7c, 0xbe, 0xe5, 0xab, 0x8a,
-	0x9e, 0xc7, 0xf4, 0x7a, 0x50, 0xf0, 0x3e, 0x9d, 0x99, 0xa1, 0xb4, 0xc2, 0x01, 0x49, 0x7b, 0xa3,
-	0x59, 0x2e, 0xec, 0x13, 0x16, 0xf6, 0x08, 0xfe, 0x21, 0xc9, 0x17, 0x45, 0x94, 0xee, 0x79, 0x86,
-	0xce, 0x38, 0x3d, 0xcb, 0x38, 0xbd, 0x4b, 0x39, 0x9d, 0xcf, 0xdb, 0x7f, 0x79, 0x79, 0x66, 0x32,
-	0x20, 0x75, 0x3e, 0xdf, 0xc5, 0xcb, 0x9e, 0xa1, 0x07, 0xac, 0xae, 0x14, 0xb0, 0x0a, 0x35, 0x0e,
-	0x38, 0x3a, 0x1b, 0xcd, 0x72, 0x71, 0xb7, 0xb0, 0xb8, 0xd3, 0x03, 0xe7, 0x6a, 0x0b, 0x59, 0xca,
-	0xed, 0xc3, 0xe6, 0x6a, 0xe5, 0x80, 0xb9, 0x5a, 0x39, 0x6c, 0xae, 0x56, 0x52, 0xa4, 0xae, 0xa7,
-	0x48, 0x5d, 0x2f, 0x9e, 0xab, 0x95, 0xc2, 0xb9, 0x5a, 0x39, 0x6c, 0xae, 0x28, 0xa7, 0xe7, 0x0e,
-	0x9d, 0xab, 0x95, 0x83, 0xe6, 0x6a, 0xe5, 0xd0, 0xb9, 0x4a, 0xd3, 0xba, 0x91, 0xa2, 0x75, 0xe3,
-	0x80, 0xb9, 0x5a, 0x29, 0x9e, 0x2b, 0x4a, 0xac, 0x21, 0xc9, 0xe7, 0x45, 0xc4, 0xd8, 0x6b, 0xa3,
-	0x32, 0xc6, 0x58, 0xbd, 0xd2, 0xf2, 0xd5, 0xbe, 0xbc, 0x09, 0xf6, 0x52, 0x99, 0xe4, 0xaa, 0x62,
-	0x9c, 0xbf, 0xb4, 0x4a, 0xf9, 0x7c, 0x73, 0x18, 0x16, 0xd9, 0x04, 0x7f, 0x92, 0xe4, 0xcb, 0x22,
-	0xa7, 0xe2, 0x1b, 0xcc, 0x75, 0x07, 0xbb, 0xeb, 0xb6, 0x59, 0x51, 0xbe, 0xc0, 0x1c, 0x7c, 0xad,
-	0xe5, 0xab, 0x02, 0x07, 0xc2, 0x73, 0x67, 0x29, 0xd2, 0x6e, 0xfb, 0xea, 0x8d, 0x02, 0x5f, 0xb3,
-	0xaa, 0x9c, 0xdb, 0xbc, 0xd7, 0xd2, 0x30, 0x7c, 0x80, 0xc6, 0xe0, 0xeb, 0x72, 0x87, 0x57, 0xb7,
-	0xea, 0x71, 0xf6, 0xff, 0xcb, 0x69, 0x16, 0xa3, 0xbf, 0xb2, 0xe7, 0xab, 0xe7, 0x92, 0xc2, 0x73,
-	0x79, 0xc1, 0x5a, 0x48, 0x4a, 0x01, 0x56, 0x72, 0x86, 0xd5, 0x78, 0xdd, 0xaa, 0x87, 0x00, 0x57,
-	0x6c, 0x36, 0x9a, 0x65, 0x71, 0x63, 0x45, 0x82, 0xa7, 0xb8, 0x26, 0xe0, 0xe7, 0x52, 0xd8, 0x7d,
-	0xf4, 0xf4, 0xf9, 0xc1, 0x34, 0x1b, 0xa5, 0x37, 0x59, 0xf2, 0x92, 0x36, 0x11, 0x3f, 0x83, 0xb2,
-	0xee, 0x07, 0xe3, 0xee, 0xf9, 0xe7, 0x4b, 0xce, 0x87, 0x24, 0x4b, 0xbb, 0x50, 0xac, 0x45, 0xb3,
-	0x11, 0x51, 0x2f, 0x8a, 0x04, 0xe5, 0xa4, 0x15, 0xf8, 0xad, 0x24, 0x77, 0x32, 0x37, 0x93, 0x47,
-	0xce, 0x5f, 0x05, 0x8e, 0x7e, 0x97, 0x5d, 0x66, 0xa4, 0x4d, 0x70, 0x0f, 0x9e, 0xcc, 0xd5, 0x52,
-	0xec, 0x6a, 0xfa, 0x89, 0x52, 0xe8, 0xec, 0xc5, 0x83, 0xf4, 0x1a, 0xcd, 0x72, 0x41, 0x5f, 0x8a,
-	0x04, 0x3b, 0xf8, 0x96, 0x89, 0xcb, 0xc9, 0x53, 0xe6, 0x87, 0xc5, 0x2e, 0x73, 0xcf, 0x9a, 0x19,
-	0x97, 0xd3, 0x0f, 0x91, 0xc5, 0x2e, 0x17, 0xe9, 0xe5, 0x5d, 0x8e, 0x34, 0x23, 0x97, 0xe3, 0x97,
-	0xcb, 0xaa, 0x1c, 0xfc, 0x65, 0x22, 0xae, 0x75, 0x7e, 0x3d, 0xcd, 0x92, 0xae, 0x2f, 0xa5, 0xfd,
-	0x65, 0xfb, 0x2e, 0x29, 0x7a, 0xb8, 0xc5, 0xe8, 0x24, 0x48, 0xfa, 0xe6, 0xa3, 0x83, 0x43, 0x5c,
-	0x76, 0xd3, 0x9c, 0xbf, 0xe4, 0xd5, 0xea, 0x3a, 0x51, 0x3e, 0xa2, 0x43, 0x24, 0x4d, 0xcc, 0xed,
-	0xf9, 0xea, 0xc5, 0xa4, 0xc7, 0xb9, 0xf4, 0x15, 0xed, 0x82, 0x4e, 0xd2, 0xe3, 0x54, 0xcb, 0xe1,
-	0xe9, 0xee, 0x41, 0x5e, 0x81, 0x16, 0x76, 0x3d, 0x99, 0xb2, 0xc6, 0xd5, 0x91, 0xe5, 0x2a, 0xbf,
-	0x09, 0x66, 0x69, 0x29, 0xe3, 0x02, 0x5f, 0x0e, 0x2c, 0x52, 0xc5, 0x8c, 0x0b, 0x39, 0x3c, 0x3f,
-	0x55, 0xcc, 0x93, 0x9c, 0xde, 0xc4, 0x9d, 0x4f, 0x3e, 0x1b, 0x38, 0xd2, 0xfc, 0x6c, 0xe0, 0xc8,
-	0x27, 0x7b, 0x03, 0x52, 0x73, 0x6f, 0x40, 0x7a, 0xe7, 0xfe, 0xc0, 0x91, 0xf7, 0xef, 0x0f, 0x48,
-	0xcd, 0xfb, 0x03, 0x47, 0xfe, 0x79, 0x7f, 0xe0, 0xc8, 0xab, 0x4f, 0xae, 0x19, 0x64, 0xdd, 0x5b,
-	0xbd, 0xaa, 0xdb, 0xb5, 0x6b, 0xf1, 0x65, 0x03, 0xf7, 0x2b, 0xf9, 0x0f, 0xe8, 0xea, 0x09, 0xf6,
-	0xa7, 0xcf, 0xeb, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x24, 0x93, 0x46, 0x60, 0x2a, 0x00,
-	0x00,
+	// 4000 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5b, 0x5d, 0x6c, 0x25, 0xc9,
+	0x55, 0x9e, 0x9e, 0xc9, 0x4c, 0x76, 0x7a, 0x3c, 0x7f, 0xe5, 0xbf, 0x9e, 0x9f, 0x75, 0x3b, 0xde,
+	0x3b, 0x89, 0x37, 0xbb, 0x33, 0x63, 0x7b, 0x7e, 0x32, 0x3b, 0x10, 0x36, 0xfe, 0x59, 0xb3, 0xde,
+	0xb1, 0x3d, 0x4e, 0xd9, 0xce, 0xa0, 0x45, 0xa8, 0x29, 0x77, 0x97, 0x7d, 0x7b, 0xdd, 0xb7, 0xfb,
+	0x6e, 0x57, 0xb7, 0x7f, 0x12, 0x04, 0x4b, 0x10, 0x84, 0xa7, 0x10, 0xac, 0x00, 0x12, 0x48, 0x10,
+	0x44, 0x90, 0x58, 0x42, 0x10, 0x12, 0x12, 0x12, 0x48, 0x11, 0x11, 0x08, 0x69, 0x05, 0x0f, 0xf6,
+	0x13, 0x42, 0xfc, 0x34, 0x5a, 0x0f, 0x2f, 0xdc, 0x07, 0x1e, 0xee, 0xe3, 0x20, 0x24, 0x74, 0xaa,
+	0xff, 0xaa, 0xbb, 0xab, 0xaf, 0xe7, 0xed, 0xf6, 0xf9, 0x4e, 0x9d, 0x3a, 0xa7, 0xea, 0xd4, 0xa9,
+	0x53, 0xa7, 0xea, 0xaa, 0xb7, 0x1c, 0x7b, 0xe3, 0xae, 0xe9, 0xb9, 0x9b, 0xf6, 0xd6, 0x5d, 0xaf,
+	0x1d, 0xd8, 0x9e, 0xcb, 0xe2, 0xaf, 0xd0, 0x27, 0xf0, 0x75, 0xa7, 0xed, 0x7b, 0x81, 0x87, 0xce,
+	0xc5, 0xc4, 0xeb, 0xc3, 0x02, 0x7b, 0x10, 0xba, 0xb6, 0xbb, 0x15, 0x33, 0x5c, 0x1f, 0x14, 0x00,
+	0x66, 0x7f, 0x9d, 0x26, 0xe4, 0xf3, 0x74, 0x2f, 0x88, 0x7f, 0x8e, 0xfd, 0xf7, 0xcf, 0xab, 0x03,
+	0x4f, 0xe3, 0x1e, 0x66, 0xc5, 0x1e, 0xd0, 0xef, 0x2b, 0xea, 0x15, 0xc7, 0x66, 0x01, 0x75, 0x0d,
+	0x62, 0x59, 0x3e, 0x65, 0x8c, 0x32, 0x4d, 0x19, 0x3d, 0x33, 0x7e, 0x7e, 0x86, 0x1d, 0x47, 0x3a,
+	0xc2, 0x64, 0x77, 0x91, 0xc3, 0xd3, 0x29, 0xda, 0x89, 0xf4, 0xcb, 0x4e, 0x91, 0xd4, 0x8d, 0xf4,
+	0x5b, 0x7b, 0x2d, 0xe7, 0xf1, 0x58, 0x81, 0x3e, 0x36, 0x6a, 0xd1, 0x4d, 0x12, 0x3a, 0xc1, 0xe3,
+	0xb1, 0xe4, 0xc7, 0xd8, 0x8b, 0xc3, 0xc6, 0x67, 0x93, 0xdf, 0x07, 0x47, 0x0d, 0x89, 0x70, 0x5c,
+	0x16, 0x8d, 0xfe, 0x47, 0x51, 0xb5, 0x2d, 0xc7, 0xdb, 0x20, 0x8e, 0x61, 0xd9, 0xcc, 0xf4, 0x76,
+	0xa8, 0xbf, 0x6f, 0x30, 0xea, 0xef, 0x50, 0x9f, 0x69, 0xa7, 0xb9, 0xa2, 0x7f, 0xa9, 0x1c, 0x47,
+	0x7a, 0x3f, 0x26, 0xbb, 0x3f, 0xcd, 0xf9, 0xa6, 0x5d, 0x77, 0x35, 0xc6, 0x3b, 0x91, 0x3e, 0xb8,
+	0x95, 0xd2, 0xbc, 0xd0, 0x35, 0x69, 0x02, 0x74, 0x23, 0xfd, 0x4d, 0xae, 0xb0, 0x0c, 0x95, 0xe8,
+	0xdd, 0x39, 0x6c, 0x0c, 0xc8, 0x58, 0xbb, 0x87, 0x0d, 0x79, 0x07, 0x45, 0x43, 0x65, 0xba, 0xe1,
+	0xa1, 0xb8, 0xe1, 0x5c, 0x6a, 0x54, 0x42, 0x47, 0xff, 0x25, 0x33, 0x98, 0xba, 0x64, 0xc3, 0xa1,
+	0x96, 0x76, 0x66, 0x54, 0x19, 0x7f, 0x65, 0xe6, 0x63, 0x30, 0xf8, 0x4a, 0x26, 0xf1, 0x9d, 0x18,
+	0xac, 0x5a, 0x9b, 0x00, 0xdd, 0x48, 0xff, 0xa2, 0xc4, 0xda, 0x04, 0x15, 0xcc, 0x0d, 0xfc, 0x90,
+	0x82, 0xad, 0x35, 0x62, 0xea, 0x80, 0x17, 0x87, 0x8d, 0xcf, 0x40, 0xd3, 0x83, 0xa3, 0x46, 0x45,
+	0xa9, 0x8a, 0x99, 0x09, 0x1d, 0xfd, 0xbb, 0xa2, 0x0e, 0x3b, 0x9e, 0x29, 0xb5, 0xf2, 0x33, 0xdc,
+	0xca, 0x3f, 0x02, 0x2b, 0x2f, 0x2f, 0x7a, 0xa6, 0x28, 0xaf, 0x13, 0xe9, 0x03, 0x8e, 0x67, 0x56,
+	0x74, 0xe8, 0x46, 0xfa, 0xeb, 0xb1, 0x0b, 0x7a, 0xe6, 0xcb, 0x98, 0x28, 0x17, 0x52, 0x43, 0x17,
+	0x0c, 0x2c, 0xeb, 0x83, 0x07, 0x79, 0x83, 0x8a, 0x79, 0xff, 0xa4, 0xa8, 0xfd, 0xb1, 0x79, 0x24,
+	0x91, 0x65, 0xb4, 0x3d, 0x3f, 0xd0, 0xce, 0x8e, 0x2a, 0xe3, 0x67, 0x67, 0x7e, 0x17, 0x4c, 0xeb,
+	0x4b, 0x45, 0xad, 0x78, 0x7e, 0xd0, 0x89, 0xf4, 0xab, 0x85, 0xae, 0x81, 0xd8, 0x8d, 0xf4, 0x2f,
+	0x54, 0x8d, 0x02, 0x44, 0xb0, 0x68, 0x6a, 0x72, 0x62, 0xea, 0x4b, 0x63, 0x2f, 0x22, 0xfd, 0x8c,
+	0xed, 0x06, 0x9d, 0xc3, 0x86, 0x44, 0x8c, 0x8c, 0xf8, 0xe2, 0xb0, 0x71, 0x96, 0x37, 0x3d, 0x38,
+	0x6a, 0x14, 0x34, 0xc1, 0x55, 0x5e, 0xf4, 0x2b, 0xa7, 0xd5, 0xd1, 0x92, 0x35, 0xad, 0xd0, 0x09,
+	0x6c, 0x93, 0xb0, 0x20, 0x8d, 0x1b, 0xda, 0xb9, 0x51, 0x65, 0xfc, 0xfc, 0xcc, 0x5f, 0x83, 0x69,
+	0x97, 0x52, 0x81, 0x4b, 0xb3, 0xb0, 0x92, 0x3b, 0x91, 0xde, 0x5f, 0x10, 0x1a, 0x93, 0xbb, 0x91,
+	0xfe, 0xb0, 0x6a, 0x5e, 0x8c, 0x09, 0x06, 0xfe, 0xec, 0xe6, 0xe6, 0xe4, 0xd4, 0xe3, 0xc7, 0x8f,
+	0xee, 0x3d, 0xba, 0xff, 0x73, 0x8f, 0x63, 0x6b, 0x3b, 0x87, 0x0d, 0xa9, 0x40, 0x39, 0xf9, 0xc5,
+	0x61, 0x03, 0x55, 0x85, 0x1c, 0x1c, 0x35, 0x4a, 0x6a, 0xe2, 0x57, 0x8b, 0x8d, 0x53, 0x0b, 0x93,
+	0x60, 0x84, 0x9e, 0xaa, 0x17, 0x5b, 0x64, 0xcf, 0x60, 0xd4, 0xb5, 0x8c, 0xed, 0x8d, 0x36, 0xd3,
+	0x3e, 0xcb, 0x27, 0xf3, 0x8d, 0x4e, 0xa4, 0x5f, 0x68, 0x91, 0xbd, 0x55, 0xea, 0x5a, 0x4f, 0x36,
+	0xda, 0x10, 0x5c, 0xae, 0x72, 0xb3, 0x04, 0x5a, 0x3a, 0x3f, 0x58, 0x64, 0x4c, 0x05, 0xfa, 0xd4,
+	0xdc, 0x89, 0x05, 0xbe, 0x52, 0x10, 0x88, 0xa9, 0xb9, 0x53, 0x16, 0x98, 0xd2, 0x0a, 0x02, 0x53,
+	0x22, 0xfa, 0x2b, 0x45, 0x1d, 0xf6, 0xa9, 0xe9, 0xb9, 0x2e, 0x35, 0x21, 0xbc, 0x1b, 0xb6, 0x1b,
+	0x50, 0x7f, 0x87, 0x38, 0x06, 0xd3, 0xce, 0x73, 0xd9, 0xbf, 0xc8, 0x83, 0x7a, 0xca, 0xb2, 0x90,
+	0xc0, 0xab, 0x10, 0x3b, 0xc4, 0x86, 0x19, 0xd0, 0x8d, 0xf4, 0x71, 0xde, 0xb7, 0x14, 0x15, 0x66,
+	0xe9, 0xe1, 0x44, 0xaa, 0xd2, 0x8b, 0xc3, 0xc6, 0xe9, 0x87, 0x13, 0x3c, 0xbe, 0x57, 0xfa, 0xc1,
+	0xf2, 0x5e, 0xd0, 0xa6, 0x7a, 0xc9, 0xa7, 0x0e, 0xd9, 0x67, 0x59, 0x0c, 0x50, 0x79, 0x0c, 0x78,
+	0xbb, 0x13, 0xe9, 0x17, 0x63, 0x24, 0x5f, 0xe8, 0x63, 0x89, 0x42, 0x02, 0xb5, 0xbc, 0xc2, 0xd3,
+	0x15, 0x8b, 0x8b, 0x8d, 0xd1, 0x37, 0x4f, 0xab, 0x37, 0x92, 0x8e, 0x32, 0x45, 0xf2, 0x41, 0x6a,
+	0x69, 0x17, 0xf8, 0x20, 0xfd, 0x1d, 0xf8, 0xf0, 0x30, 0x06, 0xbe, 0x8a, 0x09, 0x4b, 0x9d, 0x48,
+	0x1f, 0xf6, 0xe5, 0x50, 0x16, 0x68, 0x6b, 0x70, 0x41, 0xcb, 0xc9, 0x09, 0x61, 0xc9, 0xd6, 0xca,
+	0xab, 0x87, 0x60, 0x90, 0x27, 0x61, 0x90, 0xeb, 0xd4, 0xc4, 0x5a, 0x6c, 0x67, 0x15, 0x41, 0x1b,
+	0xea, 0x45, 0x16, 0x10, 0x3f, 0x30, 0x36, 0x7c, 0x6f, 0x97, 0x51, 0x5f, 0xeb, 0xe3, 0x63, 0xfd,
+	0xe5, 0x4e, 0xa4, 0xf7, 0x71, 0x60, 0x26, 0xa6, 0x77, 0x23, 0xfd, 0x73, 0xdc, 0x1c, 0x91, 0x58,
+	0x3b, 0xd2, 0x85, 0xa6, 0xe8, 0x8f, 0x15, 0x75, 0xd0, 0x25, 0x81, 0x11, 0xf8, 0x04, 0x76, 0x35,
+	0xe2, 0x64, 0x13, 0x7b, 0x89, 0x77, 0xf6, 0xe1, 0x71, 0xa4, 0xab, 0xcb, 0xd3, 0x6b, 0x79, 0x58,
+	0x57, 0x5d, 0x12, 0xe4, 0x73, 0xac, 0xf3, 0x8e, 0x73, 0x92, 0x24, 0x84, 0x8b, 0x0d, 0x0a, 0x5f,
+	0x42, 0xb8, 0x16, 0xba, 0xc0, 0xfd, 0x2e, 0x09, 0xd6, 0x52, 0x75, 0x52, 0x87, 0xf8, 0x9b, 0x8a,
+	0x9e, 0x0e, 0x25, 0x8c, 0x1a, 0x2d, 0xed, 0x32, 0x77, 0x85, 0x5f, 0x03, 0x57, 0x38, 0xbf, 0x3c,
+	0xbd, 0xb6, 0x08, 0x64, 0x98, 0xfc, 0xcb, 0x2e, 0x09, 0xe2, 0x0f, 0xdb, 0x0d, 0x03, 0xca, 0x32,
+	0x87, 0x2c, 0xd1, 0xa5, 0x6b, 0xa3, 0x73, 0xd8, 0xa8, 0xb4, 0xaf, 0x92, 0xb2, 0x15, 0x94, 0x77,
+	0x8c, 0x91, 0xa8, 0x7d, 0x4c, 0x43, 0xff, 0xa8, 0xa8, 0xc3, 0x45, 0xe5, 0x7d, 0xea, 0xd2, 0x5d,
+	0xee, 0xc9, 0x57, 0xb8, 0xfa, 0x07, 0xa0, 0xfe, 0x85, 0xe5, 0xe9, 0x35, 0x1c, 0x03, 0x60, 0xc0,
+	0x55, 0x97, 0x04, 0xe9, 0x67, 0x66, 0x42, 0x23, 0x35, 0xa1, 0x88, 0x08, 0x46, 0xdc, 0x13, 0x8d,
+	0x90, 0xc8, 0x90, 0x11, 0xc1, 0x90, 0x7b, 0x60, 0x88, 0xa8, 0x02, 0x1e, 0x10, 0x4d, 0x49, 0xa9,
+	0x12, 0x63, 0x02, 0xbb, 0x45, 0xbd, 0x30, 0x30, 0x98, 0x76, 0xb5, 0x68, 0xcc, 0x5a, 0x0c, 0xac,
+	0x26, 0xc6, 0xa4, 0x9f, 0xe0, 0xe9, 0x56, 0xc1, 0x98, 0x22, 0x52, 0xb7, 0xfc, 0x24, 0x32, 0x64,
+	0xc4, 0x6c, 0xc9, 0x89, 0x2a, 0x14, 0x8d, 0x49, 0xa9, 0xe8, 0xf7, 0x14, 0x55, 0x0b, 0x19, 0xd9,
+	0xa2, 0x86, 0x4f, 0x61, 0xdf, 0xb7, 0xdd, 0x2d, 0x83, 0x98, 0x26, 0x6d, 0x07, 0xd4, 0xd2, 0x10,
+	0xb7, 0x86, 0xc0, 0x0a, 0x58, 0xc7, 0xd3, 0x09, 0x15, 0x56, 0x40, 0xe8, 0xa7, 0x5f, 0xdd, 0x48,
+	0xbf, 0xc2, 0x8d, 0xc8, 0x49, 0x82, 0xc2, 0x22, 0x63, 0xe1, 0x0b, 0x3c, 0x3e, 0x17, 0x89, 0x87,
+	0xb8, 0x0a, 0x38, 0xd5, 0x20, 0xa5, 0xa3, 0x6f, 0xa8, 0x03, 0x65, 0xe5, 0x18, 0xa5, 0xae, 0xd6,
+	0xcf, 0x15, 0x5b, 0x38, 0x8e, 0xf4, 0x73, 0xeb, 0x78, 0x95, 0x52, 0xb7, 0x13, 0xe9, 0xe7, 0x42,
+	0x1f, 0x7e, 0x75, 0x23, 0xbd, 0x2f, 0x51, 0x08, 0x3e, 0x05, 0x65, 0x52, 0x86, 0xec, 0xd7, 0xc1,
+	0x51, 0x23, 0x69, 0x8e, 0x51, 0x51, 0x01, 0xa0, 0xa1, 0xdf, 0x52, 0xd4, 0x6b, 0xe5, 0xde, 0x43,
+	0xd7, 0xfe, 0x30, 0xa4, 0x86, 0x6d, 0x69, 0x03, 0x3c, 0x89, 0x78, 0x3f, 0x1e, 0x9b, 0x75, 0x4e,
+	0x5e, 0x98, 0x8b, 0xc7, 0x26, 0xf9, 0x12, 0xc7, 0x26, 0x65, 0x18, 0x8b, 0x07, 0x25, 0xfd, 0xec,
+	0x8a, 0x5f, 0xc9, 0xa0, 0xa4, 0x58, 0x79, 0x50, 0x52, 0x2e, 0xf4, 0x63, 0x45, 0xed, 0xaf, 0xe8,
+	0xe5, 0x3b, 0xda, 0x20, 0xd7, 0xe8, 0x37, 0xc0, 0xf7, 0xce, 0xae, 0xe3, 0x75, 0xbc, 0xd8, 0x89,
+	0xf4, 0xb3, 0xa1, 0xbf, 0x8e, 0x17, 0xbb, 0x91, 0xfe, 0x28, 0x55, 0x04, 0x2f, 0x0a, 0xde, 0xd5,
+	0x0c, 0x82, 0x36, 0x7b, 0x7c, 0xf7, 0xae, 0x45, 0x02, 0x72, 0x87, 0xed, 0xbb, 0x66, 0xd0, 0x84,
+	0xc3, 0x9a, 0x4b, 0x83, 0xbb, 0x2e, 0xdd, 0x05, 0x2a, 0x28, 0x9c, 0x08, 0x49, 0x7f, 0xbc, 0x38,
+	0x6c, 0xbc, 0x44, 0xc3, 0x83, 0xa3, 0x46, 0xac, 0x05, 0xbe, 0x5a, 0xb2, 0xc3, 0x77, 0xd0, 0x7f,
+	0x2a, 0xaa, 0x5e, 0x36, 0xa1, 0xed, 0x31, 0xd8, 0xe1, 0x18, 0x35, 0x43, 0x9f, 0x3a, 0xfb, 0xda,
+	0x10, 0x0f, 0xbf, 0xbf, 0xc3, 0x4f, 0x10, 0xeb, 0x78, 0xc5, 0x63, 0xc1, 0x42, 0x06, 0x76, 0x22,
+	0xfd, 0x4a, 0xe8, 0x17, 0x69, 0xdd, 0x48, 0xff, 0x7c, 0x62, 0x64, 0x11, 0x10, 0xec, 0xdd, 0x24,
+	0x0e, 0xe3, 0x21, 0xb9, 0xda, 0x5a, 0x42, 0x83, 0xcc, 0x93, 0xb7, 0x80, 0xf3, 0x42, 0x59, 0x05,
+	0x7c, 0xb3, 0x68, 0x56, 0x11, 0x45, 0xff, 0x21, 0xb1, 0xd0, 0x76, 0xed, 0xc0, 0x86, 0x73, 0x04,
+	0xec, 0x77, 0x06, 0xd3, 0x86, 0xb9, 0x17, 0xff, 0x36, 0x3f, 0x3d, 0xac, 0xe3, 0x85, 0x18, 0x9d,
+	0x03, 0x10, 0x02, 0xc6, 0xe5, 0xd0, 0x2f, 0x90, 0xb2, 0x70, 0x51, 0xa2, 0x8b, 0xc1, 0xe2, 0xd1,
+	0x44, 0x21, 0x80, 0x97, 0x25, 0x54, 0x49, 0xb0, 0x03, 0x41, 0x2b, 0x38, 0x30, 0x94, 0x54, 0xc0,
+	0x37, 0x8a, 0x06, 0x16, 0x40, 0xf4, 0x2d, 0x45, 0x1d, 0x26, 0x61, 0xe0, 0x19, 0x61, 0x7b, 0xcb,
+	0x27, 0x16, 0xcd, 0x73, 0x93, 0xa6, 0x76, 0x8d, 0xdb, 0xb5, 0x02, 0x27, 0x20, 0x60, 0x59, 0x8f,
+	0x39, 0xd2, 0x6d, 0xfd, 0xdd, 0xec, 0xb0, 0x20, 0x03, 0x45, 0x6b, 0xa6, 0xc4, 0x44, 0x6d, 0x72,
+	0x0a, 0x4b, 0xa5, 0xa1, 0x96, 0x3a, 0x9c, 0xea, 0x10, 0x78, 0x46, 0xdb, 0x87, 0x11, 0xe7, 0x5b,
+	0x23, 0xd3, 0xae, 0x73, 0x17, 0x7a, 0x08, 0x8a, 0x24, 0x2c, 0x6b, 0xde, 0x8a, 0x4f, 0x71, 0x82,
+	0x77, 0x23, 0xfd, 0x7a, 0x3c, 0xa2, 0x12, 0x70, 0x0c, 0x4b, 0xdb, 0xa0, 0x1d, 0x15, 0x6d, 0x53,
+	0xda, 0x36, 0x02, 0xda, 0x6a, 0x7b, 0x3e, 0xf1, 0x6d, 0xca, 0x8c, 0xa6, 0x76, 0x83, 0x9b, 0xfc,
+	0x2e, 0xf8, 0x25, 0xa0, 0x6b, 0x39, 0x08, 0xe6, 0xbe, 0xc6, 0x7b, 0x29, 0x03, 0xe2, 0xd1, 0xe8,
+	0xbe, 0x68, 0xea, 0xd4, 0x7d, 0x5c, 0x91, 0x82, 0xf6, 0xd5, 0x7e, 0x93, 0x98, 0x4d, 0x6a, 0xd8,
+	0x5b, 0xae, 0xe7, 0x53, 0xcb, 0xd8, 0xb4, 0x1d, 0xca, 0xb4, 0x9b, 0xdc, 0xc4, 0x05, 0xd8, 0x60,
+	0x38, 0xbc, 0x10, 0xa3, 0xf3, 0x00, 0x66, 0x03, 0x5d, 0x41, 0x2a, 0x4b, 0x22, 0x73, 0x75, 0x5c,
+	0x15, 0x83, 0x7e, 0x53, 0x51, 0xaf, 0xb7, 0x7d, 0x6f, 0x0b, 0xce, 0x16, 0x46, 0xd8, 0xb6, 0x48,
+	0x40, 0xc5, 0x7c, 0xfd, 0x55, 0x6e, 0xfb, 0x1a, 0xa4, 0x9b, 0x29, 0xd7, 0x3a, 0x67, 0x12, 0x73,
+	0xf3, 0xf8, 0xcc, 0x5b, 0x83, 0x0b, 0xea, 0x3c, 0x10, 0x06, 0x42, 0x79, 0x80, 0xeb, 0x24, 0xa2,
+	0x6f, 0x2a, 0xea, 0x90, 0x63, 0xb7, 0xec, 0xc0, 0xd8, 0x20, 0xae, 0xb5, 0x6b, 0x5b, 0x41, 0xd3,
+	0xb0, 0x5d, 0xc3, 0x21, 0xae, 0x36, 0xc2, 0x87, 0x64, 0x89, 0x9f, 0xe5, 0x80, 0x63, 0x26, 0x65,
+	0x58, 0x70, 0x17, 0x89, 0x9b, 0x9f, 0xbf, 0xab, 0x58, 0x8f, 0x61, 0x91, 0x89, 0x42, 0x1f, 0x29,
+	0x2a, 0x6a, 0xd9, 0xae, 0xd1, 0xf4, 0x5a, 0x14, 0xaa, 0x03, 0xdb, 0xc6, 0xa6, 0x4f, 0xa9, 0xa6,
+	0x8f, 0x2a, 0xe3, 0x17, 0xa6, 0xfa, 0xee, 0xc4, 0x85, 0xae, 0x3b, 0xab, 0xf6, 0xd7, 0xe9, 0xcc,
+	0x3b, 0x9f, 0x44, 0xfa, 0x29, 0x58, 0xd5, 0x2d, 0xdb, 0x7d, 0xd7, 0x6b, 0xd1, 0x39, 0x9b, 0x6d,
+	0xcf, 0xfb, 0x94, 0x66, 0xde, 0x51, 0xa2, 0x8b, 0xeb, 0x60, 0xf4, 0x16, 0x28, 0x72, 0x66, 0x72,
+	0xf4, 0x16, 0x2e, 0x37, 0x47, 0xcf, 0x15, 0xb5, 0x2f, 0xf5, 0x77, 0xbe, 0x0b, 0x8c, 0xf2, 0x5d,
+	0xe0, 0x6f, 0x79, 0x06, 0x92, 0x3a, 0x6d, 0xbc, 0x17, 0x5c, 0xf0, 0xf3, 0xcf, 0x6e, 0xa4, 0xcf,
+	0xa5, 0x07, 0x80, 0x94, 0x26, 0xd9, 0x17, 0x92, 0x15, 0xc0, 0x4a, 0x21, 0xbe, 0x45, 0x03, 0x72,
+	0xe7, 0x03, 0xe6, 0xb9, 0x10, 0x4a, 0x0b, 0x62, 0x8b, 0x9f, 0x2f, 0x0e, 0x1b, 0xe3, 0x2f, 0x2b,
+	0x0a, 0xd2, 0x15, 0x41, 0x5f, 0x9c, 0xcb, 0xf1, 0x1d, 0xf4, 0x4c, 0xbd, 0x4a, 0x9c, 0x5d, 0x38,
+	0x0c, 0xc5, 0x87, 0x7b, 0x97, 0x06, 0x4c, 0xfb, 0x1c, 0xaf, 0xa9, 0xc1, 0x19, 0xf4, 0x72, 0x0c,
+	0xf2, 0x43, 0xf2, 0x32, 0x0d, 0xc0, 0xf1, 0x07, 0xe2, 0x08, 0x53, 0xa0, 0x8f, 0xe1, 0x32, 0x23,
+	0xfa, 0x5f, 0x45, 0x1d, 0x87, 0x72, 0xc8, 0xae, 0x6f, 0x07, 0x10, 0x38, 0x5a, 0x5e, 0x40, 0x0d,
+	0x8b, 0xee, 0xd8, 0x26, 0x35, 0x5c, 0xd2, 0xa2, 0xcc, 0xf0, 0x5c, 0x23, 0x39, 0x97, 0x68, 0x63,
+	0x79, 0xb5, 0x67, 0xf8, 0x69, 0xda, 0x08, 0xf3, 0x36, 0x73, 0x74, 0x67, 0x19, 0xd8, 0x3b, 0x91,
+	0xfe, 0x9a, 0x57, 0x81, 0x6c, 0x93, 0x72, 0xf4, 0xa9, 0x3b, 0x1b, 0x8b, 0xea, 0x46, 0xfa, 0x5b,
+	0x5c, 0xc1, 0x97, 0xe0, 0xad, 0x77, 0x4a, 0x38, 0x54, 0xd5, 0xe8, 0x81, 0x5f, 0x46, 0x0b, 0xf4,
+	0x4b, 0xea, 0x20, 0x84, 0x31, 0xc3, 0x76, 0x2d, 0xba, 0x67, 0x80, 0x27, 0x6f, 0x38, 0x9e, 0xb9,
+	0xcd, 0xb4, 0xd7, 0xf8, 0x92, 0x06, 0xa7, 0x41, 0xc0, 0xb0, 0x00, 0xf8, 0x92, 0xed, 0xce, 0x70,
+	0x34, 0x2b, 0xa2, 0x56, 0x21, 0x69, 0xe2, 0x1a, 0xa7, 0xa3, 0x58, 0x22, 0x09, 0xfd, 0x1b, 0x64,
+	0x9f, 0x2e, 0x31, 0xb7, 0xa9, 0x65, 0xb8, 0x5e, 0x60, 0x6f, 0xda, 0x26, 0x89, 0xcb, 0x01, 0x16,
+	0xd3, 0x1a, 0x7c, 0x7e, 0xbf, 0x07, 0xc3, 0x3d, 0xb4, 0x1e, 0x33, 0x2d, 0x0b, 0x3c, 0x0b, 0x73,
+	0x30, 0xda, 0x43, 0xa1, 0x14, 0xe9, 0x46, 0xfa, 0x8d, 0x38, 0xb4, 0xcb, 0x60, 0x5e, 0x3a, 0x94,
+	0x22, 0xdd, 0xc3, 0x46, 0x8d, 0xc4, 0x83, 0xa3, 0x46, 0x8d, 0x16, 0x58, 0xda, 0xc2, 0x62, 0x08,
+	0xab, 0x17, 0x03, 0x9f, 0x6c, 0x6e, 0xda, 0xa6, 0x61, 0x3a, 0x84, 0x31, 0xed, 0x16, 0x1f, 0xd6,
+	0xdb, 0x70, 0x7c, 0x4d, 0x80, 0x59, 0xa0, 0x77, 0x23, 0x1d, 0xc5, 0x03, 0x2a, 0x10, 0xb3, 0xba,
+	0x49, 0x81, 0x15, 0x7d, 0x43, 0xed, 0x4f, 0x86, 0xd8, 0xd8, 0xf4, 0x1c, 0x8b, 0xfa, 0x46, 0x9b,
+	0x04, 0x4d, 0xed, 0xf3, 0x7c, 0xd5, 0x3f, 0x39, 0x8e, 0xf4, 0x1b, 0x73, 0xb4, 0xed, 0x53, 0x93,
+	0x04, 0xd4, 0x9a, 0x8b, 0x19, 0xe7, 0x39, 0xdf, 0x0a, 0x09, 0x9a, 0x9d, 0x48, 0x57, 0x6e, 0x67,
+	0x87, 0x65, 0xab, 0x0c, 0xbf, 0xe9, 0xb5, 0x6c, 0x98, 0xa4, 0x60, 0x7f, 0x4c, 0x53, 0xf0, 0xd5,
+	0x0a, 0x8e, 0xb6, 0xd5, 0x2b, 0x8c, 0x06, 0x86, 0xe3, 0xed, 0x1a, 0x6d, 0xdf, 0xf6, 0x7c, 0x3b,
+	0xd8, 0xd7, 0xbe, 0xc0, 0x17, 0xc5, 0x74, 0x27, 0xd2, 0x2f, 0x31, 0x1a, 0x2c, 0x7a, 0xbb, 0x2b,
+	0x09, 0x92, 0x45, 0xb6, 0x22, 0xb9, 0xf6, 0x58, 0x5e, 0x6a, 0x8e, 0x3e, 0x56, 0xd4, 0x21, 0x28,
+	0x3a, 0x25, 0x66, 0x9a, 0x9e, 0x6b, 0x86, 0xbe, 0x4f, 0x5d, 0x73, 0x5f, 0x1b, 0xe7, 0xe3, 0xc8,
+	0x78, 0xed, 0x83, 0xec, 0x2e, 0x91, 0xbd, 0x58, 0xc7, 0xd9, 0x9c, 0x05, 0xb6, 0xfc, 0x96, 0x84,
+	0x9e, 0x6d, 0xf9, 0x32, 0x30, 0x1d, 0x72, 0x5e, 0xac, 0x90, 0xcb, 0xc5, 0x52, 0xa9, 0x50, 0x23,
+	0xee, 0x37, 0x7d, 0xc2, 0x9a, 0xa5, 0x94, 0xfc, 0x75, 0x3e, 0x2d, 0x3f, 0xe0, 0x29, 0xf9, 0x6c,
+	0x9a, 0x92, 0x9b, 0x49, 0x4a, 0x3e, 0x1f, 0xef, 0xcd, 0xd0, 0x2c, 0x4f, 0x8e, 0xa5, 0x61, 0x98,
+	0xf3, 0x54, 0xd3, 0x6c, 0x4e, 0x06, 0x5f, 0xbe, 0x5a, 0x11, 0x02, 0xc9, 0xba, 0x99, 0x24, 0xeb,
+	0x8d, 0x97, 0x11, 0x03, 0xe9, 0xfa, 0x6c, 0x9c, 0xae, 0x97, 0x84, 0xf9, 0x0e, 0xfa, 0x43, 0x45,
+	0x1d, 0x2e, 0x9b, 0x97, 0x56, 0x49, 0xbe, 0xc8, 0xe7, 0xdf, 0x86, 0xe2, 0xc3, 0x2c, 0x16, 0x0a,
+	0xfc, 0x45, 0x29, 0xe5, 0x02, 0xbf, 0x14, 0xad, 0x73, 0x0d, 0xa8, 0x2f, 0x64, 0xb2, 0xb1, 0x5c,
+	0x32, 0xfa, 0x55, 0x45, 0x1d, 0x62, 0x41, 0xe8, 0x1a, 0x90, 0x39, 0x11, 0xc7, 0xde, 0xa1, 0x46,
+	0x5c, 0x3b, 0x62, 0xda, 0x1b, 0x59, 0x3e, 0xda, 0x0f, 0x1c, 0x4f, 0x52, 0x86, 0x55, 0xc0, 0x57,
+	0xb3, 0x2c, 0x49, 0x82, 0x15, 0x73, 0x6b, 0x21, 0xa0, 0x9d, 0x99, 0x7c, 0x34, 0x81, 0x65, 0xd2,
+	0xe0, 0xc8, 0x5a, 0x52, 0x03, 0xe2, 0x2a, 0xd3, 0xde, 0xe4, 0x4a, 0xbc, 0x07, 0x89, 0x5a, 0xa1,
+	0xd9, 0x92, 0xed, 0xe6, 0xa9, 0x7d, 0x05, 0x11, 0x73, 0xc4, 0x42, 0x40, 0x9d, 0x9a, 0xc0, 0x55,
+	0x39, 0x90, 0x95, 0xf7, 0xf1, 0xde, 0xd3, 0x7b, 0xa7, 0xdb, 0x3c, 0x86, 0x5a, 0x50, 0xe9, 0xc6,
+	0x64, 0x77, 0x35, 0x08, 0x85, 0x1b, 0xa7, 0x0b, 0x2c, 0xff, 0xcc, 0x6a, 0x43, 0x39, 0xed, 0xc4,
+	0x5b, 0xb1, 0x92, 0x44, 0x2c, 0xca, 0x43, 0x3b, 0xea, 0x65, 0x8b, 0x04, 0x64, 0x03, 0x4a, 0x54,
+	0xf1, 0x15, 0xa0, 0x76, 0x67, 0x54, 0x19, 0xbf, 0x34, 0x75, 0x29, 0x4d, 0x8b, 0xd6, 0x38, 0x95,
+	0x17, 0xf3, 0x2e, 0xa5, 0xac, 0x31, 0x2d, 0x8b, 0x1c, 0x45, 0xf2, 0xd8, 0xa8, 0x4f, 0xf9, 0x94,
+	0x26, 0xee, 0xf1, 0xd1, 0x51, 0x43, 0xc1, 0xa5, 0xa6, 0xe8, 0xbb, 0xa7, 0xd5, 0xd7, 0x20, 0x6a,
+	0x64, 0xe1, 0x02, 0xce, 0x94, 0xa6, 0xd7, 0x02, 0x97, 0xf5, 0xe9, 0x87, 0x21, 0x65, 0x81, 0xb1,
+	0x6d, 0x6f, 0x68, 0x77, 0xf9, 0x74, 0xfc, 0x83, 0x92, 0x5c, 0x1d, 0x2e, 0x91, 0xbd, 0xd9, 0x05,
+	0x1c, 0xe3, 0x4f, 0xec, 0x99, 0x4e, 0xa4, 0xeb, 0x2d, 0xb2, 0x97, 0x2d, 0xf1, 0x60, 0x21, 0x91,
+	0x91, 0xb3, 0x64, 0xbb, 0xe0, 0x09, 0x7c, 0xc2, 0x79, 0xec, 0x44, 0x91, 0x27, 0xb3, 0x24, 0x97,
+	0x91, 0x25, 0x75, 0xf1, 0x09, 0xcd, 0x36, 0xe0, 0xae, 0x6e, 0x28, 0xbb, 0x11, 0x71, 0x88, 0x78,
+	0x87, 0x3a, 0xc1, 0x17, 0xf0, 0x0f, 0x61, 0x24, 0x06, 0xd2, 0x1b, 0x85, 0xc5, 0xe9, 0x65, 0xf1,
+	0x1a, 0x75, 0x80, 0x48, 0xe8, 0x59, 0x22, 0x2d, 0x03, 0x65, 0x17, 0x59, 0x52, 0x21, 0x35, 0x74,
+	0x61, 0xe9, 0x4b, 0x95, 0xc2, 0x79, 0x2b, 0x22, 0xdc, 0xc1, 0xee, 0xa8, 0xd7, 0xf9, 0xa5, 0xc7,
+	0x66, 0xe8, 0x38, 0x49, 0x56, 0xe3, 0xb9, 0xe9, 0x11, 0x55, 0x9b, 0xe4, 0x96, 0x3e, 0x86, 0xac,
+	0x01, 0xb8, 0xe6, 0x43, 0xc7, 0xe1, 0xf9, 0xc8, 0x53, 0x37, 0x39, 0x54, 0x76, 0x23, 0xfd, 0x66,
+	0xb2, 0x65, 0xc9, 0xe0, 0x31, 0x5c, 0xd3, 0x0e, 0xbd, 0xa7, 0x5e, 0xdc, 0xa4, 0x24, 0x08, 0x7d,
+	0x6a, 0x6c, 0x3a, 0x64, 0x8b, 0x69, 0x53, 0x7c, 0xdd, 0xdd, 0x82, 0x9d, 0x3e, 0x01, 0xe6, 0x81,
+	0x9e, 0x5d, 0x90, 0x08, 0xc4, 0x31, 0x5c, 0x60, 0x41, 0xbb, 0xea, 0xb0, 0x70, 0x2f, 0x12, 0x9f,
+	0x71, 0xa8, 0xeb, 0x85, 0x5b, 0x4d, 0xed, 0x1e, 0x77, 0xda, 0xb7, 0x79, 0x78, 0xcd, 0x58, 0x16,
+	0x81, 0xe3, 0x1d, 0xce, 0x90, 0x65, 0x3d, 0x52, 0x34, 0xcb, 0x28, 0xe4, 0x8d, 0xd1, 0xb6, 0x3a,
+	0x50, 0xe9, 0xb8, 0x45, 0xf6, 0xb4, 0xfb, 0xbc, 0xd7, 0xb7, 0x20, 0x19, 0x2c, 0x35, 0x5c, 0x22,
+	0x7b, 0xdd, 0x48, 0xd7, 0x64, 0x5d, 0x2e, 0x91, 0xbd, 0xac, 0x3f, 0x49, 0x33, 0xf4, 0xad, 0xd3,
+	0xaa, 0x9e, 0x16, 0x7b, 0x0c, 0xe2, 0x40, 0x4a, 0xe1, 0x39, 0x96, 0x11, 0x38, 0xcc, 0x80, 0xf8,
+	0x61, 0x7b, 0x2e, 0xd3, 0x1e, 0xf0, 0xf9, 0xfa, 0x31, 0x78, 0xe6, 0x8d, 0xb4, 0xb4, 0x32, 0x0d,
+	0xac, 0x4f, 0x1d, 0x6b, 0x6d, 0x71, 0xf5, 0x6b, 0x09, 0x5f, 0x27, 0xd2, 0x6f, 0xd8, 0xf5, 0x70,
+	0x96, 0xef, 0xf4, 0xe0, 0x01, 0xff, 0xec, 0x29, 0xa3, 0x37, 0x7c, 0x70, 0xd4, 0xe8, 0xa5, 0x20,
+	0xae, 0xb6, 0x75, 0x58, 0x0a, 0xa2, 0x23, 0x45, 0xbd, 0x21, 0x8c, 0x7b, 0x9a, 0x58, 0x19, 0x81,
+	0xd9, 0xe6, 0xc7, 0xd9, 0x87, 0x7c, 0xf8, 0xbf, 0x03, 0xa3, 0xa0, 0xcd, 0x66, 0x7c, 0x69, 0x9a,
+	0xb4, 0x36, 0xbb, 0xb2, 0x38, 0xbd, 0xdc, 0x89, 0x74, 0xcd, 0xac, 0x62, 0x66, 0x3b, 0x3e, 0xf0,
+	0xbe, 0x51, 0x9a, 0xa1, 0x22, 0x43, 0x8f, 0xa4, 0xfd, 0xe0, 0xa8, 0x51, 0xdb, 0x27, 0xae, 0xed,
+	0x11, 0xfd, 0xb3, 0xa2, 0xde, 0x94, 0x99, 0xf4, 0x61, 0x68, 0x9b, 0xdc, 0xa6, 0x2f, 0x71, 0x9b,
+	0xbe, 0x0b, 0x36, 0x5d, 0xab, 0xca, 0xff, 0xea, 0xfa, 0xc2, 0x6c, 0x6c, 0xd4, 0xb5, 0x6a, 0x17,
+	0x5f, 0x0d, 0x6d, 0x33, 0xb6, 0xea, 0xcd, 0x1a, 0xab, 0x12, 0x8e, 0x1e, 0x5b, 0xe7, 0xc1, 0x51,
+	0xa3, 0xbe, 0x5b, 0x5c, 0xdf, 0x69, 0xcf, 0xb9, 0xda, 0x25, 0xae, 0xf6, 0xe8, 0xa4, 0xb9, 0x7a,
+	0xd6, 0x63, 0xae, 0x9e, 0x9d, 0x34, 0x57, 0xcf, 0x88, 0x2b, 0xbd, 0xe6, 0xc8, 0x2e, 0x2f, 0x6a,
+	0xfb, 0xc4, 0xb5, 0x3d, 0xf6, 0x9e, 0x2b, 0xb0, 0xe9, 0xad, 0x13, 0xe7, 0xea, 0x59, 0xaf, 0xb9,
+	0x7a, 0x76, 0xe2, 0x5c, 0x15, 0xcd, 0xba, 0x5f, 0x30, 0xeb, 0x7e, 0x8f, 0xb9, 0x7a, 0x56, 0x3f,
+	0x57, 0x60, 0xd8, 0x81, 0xa2, 0x5e, 0x93, 0x19, 0xc6, 0x6f, 0x1b, 0xb5, 0xc7, 0xdc, 0xaa, 0xaf,
+	0x41, 0xd1, 0xaa, 0x2a, 0x82, 0xdf, 0x54, 0xe6, 0xb9, 0xaa, 0x1c, 0x17, 0x8b, 0x56, 0x05, 0x9d,
+	0x1f, 0x4c, 0xe0, 0x3a, 0x99, 0xe8, 0x47, 0x8a, 0x7a, 0x4b, 0xa6, 0x54, 0x56, 0xc1, 0x6c, 0xfa,
+	0x94, 0x35, 0x3d, 0xc7, 0xd2, 0x7e, 0x82, 0x2b, 0xf8, 0x41, 0x27, 0xd2, 0x25, 0x0a, 0x24, 0xfb,
+	0xce, 0x5a, 0xca, 0xdd, 0x8d, 0xf4, 0xfb, 0x35, 0xba, 0x96, 0x59, 0x05, 0xb5, 0x45, 0xad, 0x95,
+	0x09, 0xfc, 0x12, 0x8d, 0xd1, 0xff, 0x29, 0xea, 0x8d, 0xf2, 0xfb, 0x0a, 0xcb, 0xcd, 0x2f, 0xc3,
+	0x7f, 0x92, 0x87, 0xec, 0x1f, 0xf1, 0x77, 0x4e, 0xd9, 0x9b, 0x85, 0xb9, 0xe5, 0xd5, 0xfc, 0x60,
+	0xa0, 0x15, 0x9f, 0x2e, 0xe4, 0x58, 0x37, 0xd2, 0xef, 0x48, 0x1e, 0x59, 0xe4, 0x0c, 0xb2, 0x3a,
+	0x7e, 0xbd, 0xb4, 0x1e, 0x98, 0x58, 0x40, 0x91, 0x69, 0x89, 0x4b, 0x2d, 0x2d, 0x37, 0xbb, 0x96,
+	0xff, 0x7b, 0x45, 0x1d, 0x04, 0x7b, 0xf3, 0xa7, 0x40, 0x96, 0xd7, 0x22, 0xb6, 0xcb, 0xb4, 0x2f,
+	0xf3, 0x1d, 0xff, 0xdb, 0xdc, 0xf2, 0xb9, 0xe5, 0xd5, 0xec, 0x9d, 0xcd, 0x5c, 0x8c, 0xc3, 0xe1,
+	0xc3, 0x72, 0x59, 0x99, 0x9c, 0x6d, 0x9f, 0x55, 0x0c, 0xcc, 0x43, 0x55, 0x32, 0x3c, 0x1d, 0x91,
+	0x08, 0x02, 0x53, 0x24, 0xdd, 0x62, 0x19, 0x2f, 0x62, 0xea, 0x55, 0xbe, 0x0a, 0x8c, 0x7c, 0xc6,
+	0x99, 0xf6, 0x53, 0xdc, 0xe1, 0xe6, 0xa1, 0x84, 0xcd, 0xc1, 0x7c, 0xd1, 0xb1, 0xe2, 0x53, 0x06,
+	0x01, 0x10, 0xb7, 0x0e, 0xd1, 0x99, 0x26, 0x71, 0x45, 0x06, 0xfa, 0x65, 0x45, 0x1d, 0x6c, 0x7a,
+	0x0e, 0x35, 0xda, 0xa1, 0x6b, 0x36, 0xc5, 0x23, 0xe4, 0xdb, 0x79, 0xc1, 0x16, 0x18, 0x56, 0x12,
+	0xbc, 0xfc, 0x60, 0x4a, 0x82, 0xf5, 0x2a, 0xd8, 0x4a, 0xd8, 0xd1, 0x1f, 0x9c, 0x56, 0x5f, 0x95,
+	0x2d, 0xbf, 0x5d, 0xba, 0xc1, 0x3c, 0x73, 0x9b, 0x06, 0xda, 0x57, 0xf8, 0x28, 0xfc, 0x2b, 0xcf,
+	0x39, 0xaa, 0x61, 0xe7, 0x19, 0xdd, 0x58, 0xe5, 0x7c, 0x90, 0x73, 0x98, 0xf5, 0x70, 0xe6, 0xcb,
+	0x3d, 0x78, 0xc4, 0x98, 0xf7, 0x40, 0x38, 0x25, 0xf4, 0x94, 0xdb, 0x1b, 0xe6, 0x11, 0xf3, 0x01,
+	0x64, 0x23, 0x3d, 0x54, 0xc7, 0x72, 0x09, 0xb1, 0xfd, 0x68, 0x5b, 0x3d, 0xdf, 0xf6, 0xbd, 0xbd,
+	0x7d, 0x5e, 0xbe, 0x98, 0xe6, 0xe5, 0x8b, 0xe5, 0xe3, 0x48, 0x7f, 0x65, 0x05, 0x88, 0x71, 0x01,
+	0xe3, 0x95, 0x76, 0xf2, 0xbb, 0x1b, 0xe9, 0x97, 0xd2, 0xb2, 0x3e, 0x27, 0x80, 0xcb, 0xe6, 0xa8,
+	0xf0, 0xfb, 0xe0, 0xa8, 0x91, 0x49, 0xc0, 0x09, 0xd5, 0x77, 0x50, 0x53, 0x1d, 0xa4, 0x3b, 0x70,
+	0x44, 0xfb, 0xc0, 0x0b, 0x7d, 0x57, 0x78, 0x7a, 0x31, 0xc3, 0x3d, 0xe2, 0x3e, 0x78, 0x04, 0x67,
+	0x78, 0x2f, 0xc6, 0x73, 0x8f, 0xb8, 0xc6, 0xfb, 0x95, 0x60, 0x63, 0x58, 0xd6, 0x02, 0xae, 0xb9,
+	0x6f, 0x16, 0xbb, 0xf2, 0x69, 0x40, 0x5d, 0xee, 0x05, 0x16, 0xd9, 0x67, 0xda, 0x2c, 0x9f, 0xf7,
+	0xf7, 0x61, 0x1f, 0x13, 0xdb, 0xe3, 0x94, 0x6b, 0x8e, 0xec, 0xe7, 0x8f, 0x31, 0x6b, 0x39, 0x7a,
+	0x6c, 0xcf, 0xb8, 0x5e, 0x2e, 0xfa, 0xb6, 0xa2, 0x6a, 0xf1, 0xa9, 0xd8, 0x68, 0xda, 0x2c, 0xf0,
+	0x7c, 0xd8, 0xa5, 0x76, 0xec, 0x38, 0x0b, 0x9e, 0xcb, 0xae, 0x57, 0x86, 0x62, 0x9e, 0x77, 0x63,
+	0x16, 0x9c, 0x72, 0x64, 0x0b, 0x44, 0x0e, 0xf7, 0xda, 0xa7, 0x6a, 0x24, 0xa2, 0x4f, 0x15, 0xf5,
+	0x5a, 0xb6, 0x26, 0x8c, 0xc0, 0x0f, 0x59, 0x40, 0x2d, 0x03, 0xe6, 0xcd, 0xa6, 0x4c, 0x7b, 0x87,
+	0x87, 0xba, 0xef, 0xf3, 0x3a, 0x78, 0xe6, 0x56, 0x6b, 0x31, 0xd3, 0x4a, 0xcc, 0x03, 0xfb, 0xea,
+	0xae, 0x1c, 0xca, 0x0e, 0x29, 0x32, 0x7c, 0x9f, 0x97, 0x66, 0xa5, 0x08, 0xbc, 0x31, 0xaa, 0x11,
+	0x09, 0x05, 0xbb, 0x1a, 0x45, 0xf0, 0x70, 0x66, 0x47, 0x11, 0x40, 0xbf, 0xa0, 0xf6, 0x85, 0x6d,
+	0xb7, 0x9d, 0xf9, 0xdc, 0x9f, 0xcc, 0x73, 0xa7, 0xfb, 0x99, 0xe3, 0x48, 0x1f, 0xcc, 0x6b, 0xa8,
+	0xeb, 0x2b, 0xee, 0x4a, 0xbe, 0x79, 0x29, 0xb7, 0x33, 0xed, 0xa1, 0x6d, 0x02, 0x08, 0x75, 0xd3,
+	0x83, 0xa3, 0x86, 0xbc, 0xb1, 0xa6, 0xe0, 0x0b, 0x42, 0x13, 0xf4, 0x7d, 0x25, 0xe9, 0x3e, 0x7d,
+	0xc5, 0xf3, 0xf1, 0x3c, 0x9f, 0xe7, 0x8f, 0xf8, 0x39, 0xbc, 0x28, 0x22, 0x7b, 0xd1, 0xc3, 0xbb,
+	0x1f, 0xcd, 0xba, 0x17, 0x5f, 0xe2, 0x08, 0x3a, 0xe4, 0xa1, 0xe4, 0x7a, 0x3d, 0x17, 0x1c, 0xac,
+	0x65, 0xbd, 0x68, 0x0a, 0x56, 0xf3, 0x56, 0xe8, 0x2f, 0x14, 0xf5, 0x12, 0x57, 0x33, 0x7f, 0xaf,
+	0xf3, 0xa7, 0xb1, 0xa2, 0xbf, 0xce, 0xeb, 0xf2, 0x45, 0x11, 0xc2, 0xdb, 0x1d, 0xe5, 0x76, 0xb6,
+	0x69, 0x40, 0xfb, 0xe2, 0x6b, 0x1b, 0xa9, 0xb2, 0x37, 0x7b, 0xf1, 0x41, 0xf5, 0x5d, 0xde, 0x97,
+	0xa6, 0xe0, 0x3e, 0xb1, 0x65, 0xae, 0x72, 0xfe, 0x2a, 0xe7, 0x07, 0xf5, 0x2a, 0x0b, 0x2f, 0x74,
+	0x4a, 0x2a, 0x17, 0xdf, 0xd4, 0xd4, 0xab, 0x5c, 0xc7, 0x57, 0x55, 0x39, 0xe5, 0x4c, 0x55, 0x4e,
+	0xbf, 0xd1, 0xa6, 0x1a, 0xbf, 0xfe, 0xcb, 0xca, 0x76, 0x7f, 0x36, 0xcf, 0x97, 0xd8, 0x57, 0x8a,
+	0xfa, 0xf2, 0x14, 0x32, 0xaf, 0xdf, 0x09, 0xce, 0xe8, 0xe7, 0x48, 0xb1, 0x88, 0xdf, 0x27, 0x20,
+	0x8c, 0x5f, 0x9a, 0x56, 0xef, 0x2b, 0x8d, 0xb6, 0x19, 0x68, 0x3f, 0x84, 0x21, 0x52, 0x66, 0x96,
+	0x8e, 0x23, 0xfd, 0x66, 0xde, 0xe3, 0x52, 0xf1, 0xb6, 0x71, 0xc5, 0x0c, 0x8a, 0xe3, 0xd4, 0xaa,
+	0xe0, 0xc5, 0xee, 0x51, 0x95, 0x01, 0x6a, 0x94, 0x03, 0xa5, 0x0a, 0x1d, 0x33, 0x89, 0xcb, 0xb4,
+	0x3f, 0x8f, 0x67, 0x69, 0xad, 0xa4, 0x82, 0x58, 0xd9, 0x5a, 0x05, 0xc6, 0x92, 0x0a, 0x15, 0xbc,
+	0x3a, 0x55, 0x5c, 0x93, 0x0a, 0xdf, 0xcc, 0x93, 0x4f, 0x3e, 0x1d, 0x39, 0x75, 0xf4, 0xe9, 0xc8,
+	0xa9, 0x4f, 0x8e, 0x47, 0x94, 0xa3, 0xe3, 0x11, 0xe5, 0x3b, 0xcf, 0x47, 0x4e, 0x7d, 0xef, 0xf9,
+	0x88, 0x72, 0xf4, 0x7c, 0xe4, 0xd4, 0xbf, 0x3c, 0x1f, 0x39, 0xf5, 0xfe, 0xeb, 0x5b, 0x76, 0xd0,
+	0x0c, 0x37, 0xee, 0x98, 0x5e, 0xeb, 0x6e, 0x56, 0x37, 0x17, 0x7e, 0xe5, 0x7f, 0x67, 0xd8, 0x38,
+	0xc7, 0xff, 0xbf, 0x70, 0xef, 0xff, 0x07, 0x00, 0x0c, 0x87, 0xf8, 0x84, 0x2b, 0x31, 0x00, 0x00,
 }
 
 func (m *OptionsConfiguration) Marshal() (dAtA []byte, err error) {
@@ -458,6 +519,101 @@ func (m *OptionsConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xc0
 	}
+	if len(m.WebSocketTrustedProxies) > 0 {
+		for iNdEx := len(m.WebSocketTrustedProxies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WebSocketTrustedProxies[iNdEx])
+			copy(dAtA[i:], m.WebSocketTrustedProxies[iNdEx])
+			i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.WebSocketTrustedProxies[iNdEx])))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.ConfigHistoryRevisions != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.ConfigHistoryRevisions))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.EventJournalRetentionDays != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.EventJournalRetentionDays))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.EventJournalEnabled {
+		i--
+		if m.EventJournalEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ProxyURL) > 0 {
+		i -= len(m.ProxyURL)
+		copy(dAtA[i:], m.ProxyURL)
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.ProxyURL)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ConnectionPriorityWebSocket != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.ConnectionPriorityWebSocket))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.HolePunchingEnabled {
+		i--
+		if m.HolePunchingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.RelayConnections != 0 {
+		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.RelayConnections))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.DNSDiscoveryDomains) > 0 {
+		for iNdEx := len(m.DNSDiscoveryDomains) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DNSDiscoveryDomains[iNdEx])
+			copy(dAtA[i:], m.DNSDiscoveryDomains[iNdEx])
+			i = encodeVarintOptionsconfiguration(dAtA, i, uint64(len(m.DNSDiscoveryDomains[iNdEx])))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.LocalAnnMDNSEnabled {
+		i--
+		if m.LocalAnnMDNSEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe0
+	}
 	if m.ConnectionPriorityUpgradeThreshold != 0 {
 		i = encodeVarintOptionsconfiguration(dAtA, i, uint64(m.ConnectionPriorityUpgradeThreshold))
 		i--
@@ -1159,6 +1315,43 @@ func (m *OptionsConfiguration) ProtoSize() (n int) {
 	if m.ConnectionPriorityUpgradeThreshold != 0 {
 		n += 2 + sovOptionsconfiguration(uint64(m.ConnectionPriorityUpgradeThreshold))
 	}
+	if m.LocalAnnMDNSEnabled {
+		n += 3
+	}
+	if len(m.DNSDiscoveryDomains) > 0 {
+		for _, s := range m.DNSDiscoveryDomains {
+			l = len(s)
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
+	if m.RelayConnections != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.RelayConnections))
+	}
+	if m.HolePunchingEnabled {
+		n += 3
+	}
+	if m.ConnectionPriorityWebSocket != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.ConnectionPriorityWebSocket))
+	}
+	l = len(m.ProxyURL)
+	if l > 0 {
+		n += 2 + l + sovOptionsconfiguration(uint64(l))
+	}
+	if m.EventJournalEnabled {
+		n += 3
+	}
+	if m.EventJournalRetentionDays != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.EventJournalRetentionDays))
+	}
+	if m.ConfigHistoryRevisions != 0 {
+		n += 2 + sovOptionsconfiguration(uint64(m.ConfigHistoryRevisions))
+	}
+	if len(m.WebSocketTrustedProxies) > 0 {
+		for _, s := range m.WebSocketTrustedProxies {
+			l = len(s)
+			n += 2 + l + sovOptionsconfiguration(uint64(l))
+		}
+	}
 	if m.DeprecatedUPnPEnabled {
 		n += 4
 	}
@@ -2489,6 +2682,238 @@ func (m *OptionsConfiguration) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 60:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalAnnMDNSEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LocalAnnMDNSEnabled = bool(v != 0)
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSDiscoveryDomains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DNSDiscoveryDomains = append(m.DNSDiscoveryDomains, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 62:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayConnections", wireType)
+			}
+			m.RelayConnections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayConnections |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 63:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolePunchingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HolePunchingEnabled = bool(v != 0)
+		case 64:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionPriorityWebSocket", wireType)
+			}
+			m.ConnectionPriorityWebSocket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionPriorityWebSocket |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 65:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 66:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventJournalEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EventJournalEnabled = bool(v != 0)
+		case 67:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventJournalRetentionDays", wireType)
+			}
+			m.EventJournalRetentionDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventJournalRetentionDays |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 68:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigHistoryRevisions", wireType)
+			}
+			m.ConfigHistoryRevisions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigHistoryRevisions |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebSocketTrustedProxies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptionsconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptionsconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebSocketTrustedProxies = append(m.WebSocketTrustedProxies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 9000:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedUPnPEnabled", wireType)
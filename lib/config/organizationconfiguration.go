@@ -0,0 +1,98 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+var (
+	errNoOrganizationCA = errors.New("no organization CA certificates")
+	errNameNotAllowed   = errors.New("certificate name does not match the organization name patterns")
+)
+
+func (cfg OrganizationConfiguration) Copy() OrganizationConfiguration {
+	c := cfg
+	c.NamePatterns = make([]string, len(c.NamePatterns))
+	copy(c.NamePatterns, cfg.NamePatterns)
+	return c
+}
+
+// CertPool returns the organization CA certificates as a pool.
+func (c OrganizationConfiguration) CertPool() (*x509.CertPool, error) {
+	if strings.TrimSpace(c.CACertificates) == "" {
+		return nil, errNoOrganizationCA
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(c.CACertificates)) {
+		return nil, errors.New("failed to parse organization CA certificates")
+	}
+	return pool, nil
+}
+
+// Verify returns nil if the device certificate is signed by one of the
+// organization CAs, possibly by way of the given intermediate certificates
+// the device sent along, is valid at the given time and matches the name
+// and validity constraints.
+func (c OrganizationConfiguration) Verify(cert *x509.Certificate, intermediates []*x509.Certificate, now time.Time) error {
+	pool, err := c.CertPool()
+	if err != nil {
+		return err
+	}
+
+	var intermediatePool *x509.CertPool
+	if len(intermediates) > 0 {
+		intermediatePool = x509.NewCertPool()
+		for _, ic := range intermediates {
+			intermediatePool.AddCert(ic)
+		}
+	}
+
+	// The same certificate is used on both sides of the connection, so
+	// don't insist on a particular usage.
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: intermediatePool,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return err
+	}
+
+	if c.MaxValidityDays > 0 {
+		if validity := cert.NotAfter.Sub(cert.NotBefore); validity > time.Duration(c.MaxValidityDays)*24*time.Hour {
+			return fmt.Errorf("certificate is valid for %d days, more than the allowed %d", int(validity.Hours()/24), c.MaxValidityDays)
+		}
+	}
+
+	if len(c.NamePatterns) > 0 && !c.nameAllowed(cert) {
+		return errNameNotAllowed
+	}
+
+	return nil
+}
+
+func (c OrganizationConfiguration) nameAllowed(cert *x509.Certificate) bool {
+	names := append([]string{cert.Subject.CommonName}, cert.DNSNames...)
+	for _, pattern := range c.NamePatterns {
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+			if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,437 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/organizationconfiguration.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Devices presenting a certificate signed by one of the organization CAs,
+// and matching the constraints, are trusted without being added by hand.
+type OrganizationConfiguration struct {
+	Enabled         bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled" xml:"enabled,attr"`
+	CACertificates  string   `protobuf:"bytes,2,opt,name=ca_certificates,json=caCertificates,proto3" json:"caCertificates" xml:"caCertificates,omitempty"`
+	NamePatterns    []string `protobuf:"bytes,3,rep,name=name_patterns,json=namePatterns,proto3" json:"namePatterns" xml:"namePattern,omitempty"`
+	MaxValidityDays int      `protobuf:"varint,4,opt,name=max_validity_days,json=maxValidityDays,proto3,casttype=int" json:"maxValidityDays" xml:"maxValidityDays,omitempty"`
+}
+
+func (m *OrganizationConfiguration) Reset()         { *m = OrganizationConfiguration{} }
+func (m *OrganizationConfiguration) String() string { return proto.CompactTextString(m) }
+func (*OrganizationConfiguration) ProtoMessage()    {}
+func (*OrganizationConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a9d399c224bb5db, []int{0}
+}
+func (m *OrganizationConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrganizationConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrganizationConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrganizationConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationConfiguration.Merge(m, src)
+}
+func (m *OrganizationConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *OrganizationConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationConfiguration proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*OrganizationConfiguration)(nil), "config.OrganizationConfiguration")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/organizationconfiguration.proto", fileDescriptor_5a9d399c224bb5db)
+}
+
+var fileDescriptor_5a9d399c224bb5db = []byte{
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0x63, 0x7a, 0x1c, 0x34, 0x3a, 0xee, 0x44, 0x24, 0xa4, 0x1c, 0x48, 0x76, 0x54, 0x65,
+	0x08, 0xe8, 0x74, 0x15, 0x62, 0x63, 0x23, 0x65, 0x40, 0xba, 0x01, 0x94, 0x81, 0xe1, 0x96, 0xc8,
+	0x49, 0xdd, 0xd4, 0x52, 0xec, 0x54, 0x89, 0x8b, 0x12, 0x3e, 0x40, 0x67, 0x04, 0x5f, 0x80, 0x8f,
+	0xc0, 0xc7, 0xe8, 0xd6, 0x8c, 0x4c, 0x96, 0xda, 0x6c, 0x19, 0x33, 0x32, 0xa1, 0xa6, 0xad, 0x70,
+	0x23, 0xba, 0xd9, 0xbf, 0xff, 0x7b, 0xef, 0xf7, 0x86, 0xa7, 0xbf, 0x8a, 0x69, 0x30, 0x0c, 0x13,
+	0x3e, 0xa1, 0xd1, 0x30, 0x49, 0x23, 0xcc, 0xe9, 0x57, 0x2c, 0x68, 0xc2, 0x77, 0x68, 0x9e, 0xb6,
+	0x9f, 0xdb, 0x59, 0x9a, 0x88, 0xc4, 0x38, 0xdf, 0xc1, 0xe7, 0x7d, 0x92, 0x8b, 0x1d, 0x1a, 0xfc,
+	0x38, 0xd3, 0xaf, 0x3f, 0x2a, 0x6d, 0x23, 0xb5, 0xcd, 0xb8, 0xd3, 0x1f, 0x11, 0x8e, 0x83, 0x98,
+	0x8c, 0x4d, 0x60, 0x01, 0xe7, 0xb1, 0xfb, 0xba, 0x96, 0xe8, 0x80, 0x1a, 0x89, 0x8c, 0x9c, 0xc5,
+	0x6f, 0x07, 0xfb, 0xff, 0x0d, 0x16, 0x22, 0x1d, 0xd4, 0x2b, 0xfb, 0x42, 0x05, 0xde, 0xa1, 0xdc,
+	0xf8, 0x05, 0xf4, 0xab, 0x10, 0xfb, 0x21, 0x49, 0x05, 0x9d, 0xd0, 0x10, 0x0b, 0x92, 0x99, 0x0f,
+	0x2c, 0xe0, 0xf4, 0xdd, 0x05, 0xd8, 0x48, 0x74, 0x39, 0x7a, 0x37, 0x52, 0xa2, 0x5a, 0xa2, 0xcb,
+	0x10, 0xab, 0xa4, 0x91, 0x08, 0xb6, 0xbe, 0x63, 0x7c, 0x93, 0x30, 0x2a, 0x08, 0x9b, 0x89, 0x62,
+	0xeb, 0x36, 0x4f, 0x85, 0xcd, 0xca, 0xee, 0xcc, 0xfb, 0x5e, 0xda, 0x1d, 0xa7, 0xd7, 0xa9, 0x30,
+	0x98, 0xfe, 0x84, 0x63, 0x46, 0xfc, 0x19, 0x16, 0x82, 0xa4, 0x3c, 0x33, 0x7b, 0x56, 0xcf, 0xe9,
+	0xbb, 0x1f, 0x6a, 0x89, 0x2e, 0xb6, 0xc1, 0xa7, 0x3d, 0x6f, 0x24, 0x7a, 0xd1, 0xae, 0xa6, 0xc0,
+	0xe3, 0xbd, 0x9e, 0xfd, 0x37, 0xf1, 0x8e, 0xa6, 0x18, 0x0b, 0xa0, 0x3f, 0x65, 0x38, 0xf7, 0xbf,
+	0xe0, 0x98, 0x8e, 0xa9, 0x28, 0xfc, 0x31, 0x2e, 0x32, 0xf3, 0xcc, 0x02, 0xce, 0x43, 0xf7, 0xbe,
+	0x96, 0xe8, 0x8a, 0xe1, 0xfc, 0xf3, 0x3e, 0x7b, 0x8f, 0x8b, 0xad, 0x16, 0xb5, 0xda, 0x0e, 0x57,
+	0xd4, 0x7f, 0x24, 0xea, 0x51, 0x2e, 0xea, 0x95, 0x7d, 0x7d, 0xb2, 0xc8, 0xeb, 0xce, 0x75, 0xef,
+	0x96, 0x6b, 0xa8, 0x95, 0x6b, 0xa8, 0x2d, 0x37, 0x10, 0x94, 0x1b, 0x08, 0xbe, 0x55, 0x50, 0xfb,
+	0x59, 0x41, 0x50, 0x56, 0x50, 0xfb, 0x5d, 0x41, 0xed, 0xfe, 0x65, 0x44, 0xc5, 0x74, 0x1e, 0xdc,
+	0x86, 0x09, 0x1b, 0x66, 0x05, 0x0f, 0xc5, 0x94, 0xf2, 0x48, 0x79, 0xfd, 0xbb, 0xca, 0xe0, 0xbc,
+	0xbd, 0xb4, 0x37, 0x7f, 0x07, 0x00, 0x39, 0xed, 0x26, 0xa5, 0xaa, 0x02, 0x00, 0x00,
+}
+
+func (m *OrganizationConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrganizationConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrganizationConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxValidityDays != 0 {
+		i = encodeVarintOrganizationconfiguration(dAtA, i, uint64(m.MaxValidityDays))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NamePatterns) > 0 {
+		for iNdEx := len(m.NamePatterns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NamePatterns[iNdEx])
+			copy(dAtA[i:], m.NamePatterns[iNdEx])
+			i = encodeVarintOrganizationconfiguration(dAtA, i, uint64(len(m.NamePatterns[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CACertificates) > 0 {
+		i -= len(m.CACertificates)
+		copy(dAtA[i:], m.CACertificates)
+		i = encodeVarintOrganizationconfiguration(dAtA, i, uint64(len(m.CACertificates)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrganizationconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrganizationconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OrganizationConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.CACertificates)
+	if l > 0 {
+		n += 1 + l + sovOrganizationconfiguration(uint64(l))
+	}
+	if len(m.NamePatterns) > 0 {
+		for _, s := range m.NamePatterns {
+			l = len(s)
+			n += 1 + l + sovOrganizationconfiguration(uint64(l))
+		}
+	}
+	if m.MaxValidityDays != 0 {
+		n += 1 + sovOrganizationconfiguration(uint64(m.MaxValidityDays))
+	}
+	return n
+}
+
+func sovOrganizationconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrganizationconfiguration(x uint64) (n int) {
+	return sovOrganizationconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OrganizationConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrganizationconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrganizationConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrganizationConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganizationconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertificates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganizationconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrganizationconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganizationconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CACertificates = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePatterns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganizationconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrganizationconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganizationconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePatterns = append(m.NamePatterns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidityDays", wireType)
+			}
+			m.MaxValidityDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganizationconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidityDays |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrganizationconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrganizationconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrganizationconfiguration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrganizationconfiguration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrganizationconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrganizationconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrganizationconfiguration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrganizationconfiguration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrganizationconfiguration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrganizationconfiguration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrganizationconfiguration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrganizationconfiguration = fmt.Errorf("proto: unexpected end of group")
+)
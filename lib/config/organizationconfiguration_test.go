@@ -0,0 +1,168 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestOrganizationVerify(t *testing.T) {
+	now := time.Now()
+	caCert, caKey, caPEM := newTestCA(t, now)
+	otherCACert, otherCAKey, _ := newTestCA(t, now)
+
+	org := OrganizationConfiguration{
+		Enabled:        true,
+		CACertificates: caPEM,
+		NamePatterns:   []string{"*.devices.example.com"},
+	}
+
+	cases := []struct {
+		name     string
+		cn       string
+		validFor time.Duration
+		signer   *x509.Certificate
+		key      *ecdsa.PrivateKey
+		ok       bool
+	}{
+		{"valid", "laptop.devices.example.com", 30 * 24 * time.Hour, caCert, caKey, true},
+		{"other CA", "laptop.devices.example.com", 30 * 24 * time.Hour, otherCACert, otherCAKey, false},
+		{"wrong name", "laptop.example.com", 30 * 24 * time.Hour, caCert, caKey, false},
+		{"expired", "laptop.devices.example.com", -time.Hour, caCert, caKey, false},
+	}
+	for _, tc := range cases {
+		cert := newTestDeviceCert(t, tc.cn, now, tc.validFor, tc.signer, tc.key)
+		if err := org.Verify(cert, nil, now); (err == nil) != tc.ok {
+			t.Errorf("%s: unexpected result %v", tc.name, err)
+		}
+	}
+
+	// Long lived certificates are rejected when there is a limit.
+
+	cert := newTestDeviceCert(t, "laptop.devices.example.com", now, 400*24*time.Hour, caCert, caKey)
+	if err := org.Verify(cert, nil, now); err != nil {
+		t.Error("unexpected error without validity limit:", err)
+	}
+	org.MaxValidityDays = 365
+	if err := org.Verify(cert, nil, now); err == nil {
+		t.Error("expected error for certificate exceeding the validity limit")
+	}
+
+	// Certificates issued by an intermediate CA verify when the device
+	// sends the intermediate along.
+
+	org.MaxValidityDays = 0
+	interCert, interKey := newTestIntermediateCA(t, now, caCert, caKey)
+	cert = newTestDeviceCert(t, "laptop.devices.example.com", now, 30*24*time.Hour, interCert, interKey)
+	if err := org.Verify(cert, nil, now); err == nil {
+		t.Error("expected error without the intermediate certificate")
+	}
+	if err := org.Verify(cert, []*x509.Certificate{interCert}, now); err != nil {
+		t.Error("unexpected error with the intermediate certificate:", err)
+	}
+	otherInterCert, _ := newTestIntermediateCA(t, now, otherCACert, otherCAKey)
+	if err := org.Verify(cert, []*x509.Certificate{otherInterCert}, now); err == nil {
+		t.Error("expected error with an unrelated intermediate certificate")
+	}
+
+	// Without CAs nothing is trusted.
+
+	org.CACertificates = ""
+	if err := org.Verify(cert, nil, now); err == nil {
+		t.Error("expected error without CA certificates")
+	}
+}
+
+func newTestCA(t *testing.T, now time.Time) (*x509.Certificate, *ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Example CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(10 * 365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func newTestIntermediateCA(t *testing.T, now time.Time, ca *x509.Certificate, caKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(3),
+		Subject:               pkix.Name{CommonName: "Example Intermediate CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(5 * 365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func newTestDeviceCert(t *testing.T, cn string, now time.Time, validFor time.Duration, ca *x509.Certificate, caKey *ecdsa.PrivateKey) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	notBefore := now.Add(-time.Hour)
+	if validFor < 0 {
+		notBefore = now.Add(2 * validFor)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     []string{cn},
+		NotBefore:    notBefore,
+		NotAfter:     now.Add(validFor),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/syncthing/syncthing/lib/dialer"
+)
+
+var errProxyURLInvalid = errors.New("not the URL of a SOCKS proxy")
+
+// validateProxyURL returns an error unless the proxy setting is empty,
+// dialer.ProxyDirect or the URL of a SOCKS proxy. The error doesn't include
+// the setting, which may hold credentials.
+func validateProxyURL(proxyURL string) error {
+	if proxyURL == "" || proxyURL == dialer.ProxyDirect {
+		return nil
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Host == "" {
+		return errProxyURLInvalid
+	}
+	switch u.Scheme {
+	case "socks", "socks5", "socks5h":
+		return nil
+	default:
+		return errProxyURLInvalid
+	}
+}
+
+// RedactProxyURL returns the proxy setting with the credentials in it, if
+// any, replaced.
+func RedactProxyURL(proxyURL string) string {
+	if proxyURL == "" || proxyURL == dialer.ProxyDirect {
+		return proxyURL
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return Redacted
+	}
+	if u.User != nil {
+		u.User = url.User(Redacted)
+	}
+	return u.String()
+}
+
+func (cfg *Configuration) prepareProxyURLs() error {
+	if err := validateProxyURL(cfg.Options.ProxyURL); err != nil {
+		return fmt.Errorf("proxy URL: %w", err)
+	}
+	for _, device := range append([]DeviceConfiguration{cfg.Defaults.Device}, cfg.Devices...) {
+		if err := validateProxyURL(device.ProxyURL); err != nil {
+			return fmt.Errorf("proxy URL of device %s: %w", device.DeviceID.Short(), err)
+		}
+	}
+	return nil
+}
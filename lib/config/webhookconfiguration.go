@@ -0,0 +1,106 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/structutil"
+)
+
+var (
+	errWebhookIDEmpty     = errors.New("webhook has empty ID")
+	errWebhookIDDuplicate = errors.New("webhook has duplicate ID")
+)
+
+// defaultWebhookEvents are the events sent to webhooks that don't specify
+// any: all of them except the chatty disk events, as for the REST API.
+const defaultWebhookEvents = events.AllEvents &^ (events.LocalChangeDetected | events.RemoteChangeDetected)
+
+func (c WebhookConfiguration) Copy() WebhookConfiguration {
+	n := c
+	n.Events = make([]string, len(c.Events))
+	copy(n.Events, c.Events)
+	n.Folders = make([]string, len(c.Folders))
+	copy(n.Folders, c.Folders)
+	n.Devices = make([]string, len(c.Devices))
+	copy(n.Devices, c.Devices)
+	return n
+}
+
+func (c *WebhookConfiguration) UnmarshalJSON(data []byte) error {
+	structutil.SetDefaults(c)
+	type noCustomUnmarshal WebhookConfiguration
+	ptr := (*noCustomUnmarshal)(c)
+	return json.Unmarshal(data, ptr)
+}
+
+func (c *WebhookConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	structutil.SetDefaults(c)
+	type noCustomUnmarshal WebhookConfiguration
+	ptr := (*noCustomUnmarshal)(c)
+	return d.DecodeElement(ptr, &start)
+}
+
+// Filter returns the filter for the events to send to the webhook.
+func (c WebhookConfiguration) Filter() (events.Filter, error) {
+	mask := defaultWebhookEvents
+	if len(c.Events) > 0 {
+		mask = 0
+		for _, name := range c.Events {
+			t := events.UnmarshalEventType(name)
+			if t == 0 {
+				return events.Filter{}, fmt.Errorf("unknown event type %q", name)
+			}
+			mask |= t
+		}
+	}
+	return events.NewFilter(mask, c.Folders, c.Devices)
+}
+
+// RetryInterval returns how long to wait before the given attempt to
+// deliver an event, after failed ones.
+func (c WebhookConfiguration) RetryInterval(attempt int) time.Duration {
+	const maxInterval = 24 * time.Hour
+	interval := time.Duration(c.RetryIntervalS) * time.Second
+	for i := 1; i < attempt && interval < maxInterval; i++ {
+		interval *= 2
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+	return interval
+}
+
+func (c *WebhookConfiguration) prepare() error {
+	if c.ID == "" {
+		return errWebhookIDEmpty
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("webhook %q: %w", c.ID, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("webhook %q: URL must be http or https", c.ID)
+	}
+	if _, err := c.Filter(); err != nil {
+		return fmt.Errorf("webhook %q: %w", c.ID, err)
+	}
+	if c.MaxAttempts < 1 {
+		c.MaxAttempts = 1
+	}
+	if c.RetryIntervalS < 1 {
+		c.RetryIntervalS = 1
+	}
+	return nil
+}
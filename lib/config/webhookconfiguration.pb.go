@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lib/config/webhookconfiguration.proto
+
+package config
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/syncthing/syncthing/proto/ext"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A webhook is notified of events by an HTTP POST of the event, as in the
+// REST API, to its URL.
+type WebhookConfiguration struct {
+	ID             string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id" xml:"id,attr"`
+	URL            string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url" xml:"url"`
+	Paused         bool     `protobuf:"varint,3,opt,name=paused,proto3" json:"paused" xml:"paused,attr"`
+	Events         []string `protobuf:"bytes,4,rep,name=events,proto3" json:"events" xml:"event,omitempty"`
+	Folders        []string `protobuf:"bytes,5,rep,name=folders,proto3" json:"folders" xml:"folder,omitempty"`
+	Devices        []string `protobuf:"bytes,6,rep,name=devices,proto3" json:"devices" xml:"device,omitempty"`
+	Secret         string   `protobuf:"bytes,7,opt,name=secret,proto3" json:"secret" xml:"secret,omitempty"`
+	MaxAttempts    int      `protobuf:"varint,8,opt,name=max_attempts,json=maxAttempts,proto3,casttype=int" json:"maxAttempts" xml:"maxAttempts" default:"10"`
+	RetryIntervalS int      `protobuf:"varint,9,opt,name=retry_interval_s,json=retryIntervalS,proto3,casttype=int" json:"retryIntervalS" xml:"retryIntervalS" default:"60"`
+}
+
+func (m *WebhookConfiguration) Reset()         { *m = WebhookConfiguration{} }
+func (m *WebhookConfiguration) String() string { return proto.CompactTextString(m) }
+func (*WebhookConfiguration) ProtoMessage()    {}
+func (*WebhookConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4505edde0bb42548, []int{0}
+}
+func (m *WebhookConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebhookConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WebhookConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookConfiguration.Merge(m, src)
+}
+func (m *WebhookConfiguration) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WebhookConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookConfiguration proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*WebhookConfiguration)(nil), "config.WebhookConfiguration")
+}
+
+func init() {
+	proto.RegisterFile("lib/config/webhookconfiguration.proto", fileDescriptor_4505edde0bb42548)
+}
+
+var fileDescriptor_4505edde0bb42548 = []byte{
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xcf, 0x8a, 0xd3, 0x40,
+	0x1c, 0xc7, 0x9b, 0xd4, 0x4d, 0xb7, 0x59, 0x5d, 0xd7, 0xa0, 0x12, 0x3c, 0x64, 0x6a, 0x88, 0x50,
+	0x61, 0x69, 0xbb, 0x28, 0x45, 0xf7, 0x20, 0x18, 0x8b, 0x50, 0x14, 0x91, 0x2c, 0x22, 0x78, 0x29,
+	0x69, 0x33, 0x6d, 0x07, 0xf3, 0xa7, 0x4c, 0x26, 0xb5, 0x7d, 0x0b, 0xe9, 0x13, 0xf8, 0x00, 0x3e,
+	0xc8, 0xde, 0x9a, 0xa3, 0xa7, 0x81, 0x6d, 0x6f, 0x39, 0xe6, 0xb8, 0x27, 0xc9, 0x4c, 0xda, 0x4d,
+	0x73, 0xca, 0x7c, 0x3f, 0x33, 0xdf, 0xcf, 0x2f, 0x21, 0x8c, 0xfc, 0xc2, 0x45, 0xc3, 0xf6, 0x28,
+	0xf0, 0xc7, 0x68, 0xd2, 0xfe, 0x05, 0x87, 0xd3, 0x20, 0xf8, 0xc9, 0x53, 0x84, 0x6d, 0x82, 0x02,
+	0xbf, 0x35, 0xc3, 0x01, 0x09, 0x14, 0x89, 0xc3, 0x67, 0x75, 0xb8, 0x20, 0x1c, 0xe9, 0x7f, 0x25,
+	0xf9, 0xf1, 0x77, 0xde, 0xf8, 0x50, 0x6c, 0x28, 0x3d, 0x59, 0x44, 0x8e, 0x2a, 0x34, 0x84, 0x66,
+	0xdd, 0x7c, 0xbd, 0xa1, 0x40, 0xec, 0xf7, 0x12, 0x0a, 0x44, 0xe4, 0xa4, 0x14, 0x3c, 0x58, 0x78,
+	0xee, 0xa5, 0x8e, 0x9c, 0x73, 0x9b, 0x10, 0xac, 0x27, 0x6b, 0xa3, 0x96, 0xaf, 0xd3, 0xb5, 0x21,
+	0x22, 0x67, 0x15, 0x1b, 0x62, 0xbf, 0x67, 0x89, 0xc8, 0x51, 0x4c, 0xb9, 0x1a, 0x61, 0x57, 0x15,
+	0x99, 0xa6, 0xb3, 0xa1, 0xa0, 0xfa, 0xcd, 0xfa, 0x9c, 0x50, 0x90, 0xd1, 0x94, 0x82, 0x3a, 0x13,
+	0x45, 0xd8, 0xcd, 0x24, 0x8c, 0xf1, 0xc7, 0x2a, 0x36, 0xb2, 0x83, 0x56, 0xb6, 0x56, 0x3e, 0xca,
+	0xd2, 0xcc, 0x8e, 0x42, 0xe8, 0xa8, 0xd5, 0x86, 0xd0, 0x3c, 0x36, 0x5b, 0x09, 0x05, 0x39, 0x49,
+	0x29, 0x78, 0xc4, 0x14, 0x3c, 0xee, 0xdf, 0xe7, 0xa4, 0x90, 0xad, 0xfc, 0xac, 0xf2, 0x45, 0x96,
+	0xe0, 0x1c, 0xfa, 0x24, 0x54, 0xef, 0x35, 0xaa, 0xcd, 0xba, 0xd9, 0xcd, 0x3c, 0x9c, 0xa4, 0x14,
+	0x3c, 0x61, 0x1e, 0x16, 0xcf, 0x03, 0x0f, 0x11, 0xe8, 0xcd, 0xc8, 0x32, 0x73, 0x3d, 0x2c, 0x31,
+	0x2b, 0xef, 0x28, 0x57, 0x72, 0x6d, 0x1c, 0xb8, 0x0e, 0xc4, 0xa1, 0x7a, 0xc4, 0x84, 0x6f, 0x13,
+	0x0a, 0x76, 0x28, 0xa5, 0xe0, 0x29, 0x33, 0xf2, 0x7c, 0xa8, 0x3c, 0x2b, 0x43, 0x6b, 0x57, 0xcb,
+	0xa4, 0x0e, 0x9c, 0xa3, 0x11, 0x0c, 0x55, 0xe9, 0x4e, 0x9a, 0xa3, 0xbd, 0x94, 0xe7, 0x92, 0xb4,
+	0x0c, 0xad, 0x5d, 0x4d, 0xf9, 0x2a, 0x4b, 0x21, 0x1c, 0x61, 0x48, 0xd4, 0x1a, 0xfb, 0x11, 0x6f,
+	0xb2, 0x2f, 0xe7, 0x64, 0xaf, 0xe4, 0xb1, 0xa4, 0x2c, 0x43, 0x2b, 0x6f, 0x29, 0xb6, 0x7c, 0xdf,
+	0xb3, 0x17, 0x03, 0x9b, 0x30, 0x1e, 0xaa, 0xc7, 0x0d, 0xa1, 0x79, 0x64, 0xbe, 0x4b, 0x28, 0x38,
+	0xf1, 0xec, 0xc5, 0xfb, 0x1c, 0xa7, 0x14, 0x68, 0x4c, 0x5e, 0x60, 0x7a, 0xc3, 0x81, 0x63, 0x3b,
+	0x72, 0xc9, 0xa5, 0x7e, 0xd1, 0xd1, 0x6f, 0x29, 0xa8, 0x22, 0x9f, 0xdc, 0xae, 0x0d, 0xf1, 0xa2,
+	0x63, 0x15, 0xbb, 0xca, 0x4a, 0x90, 0xcf, 0x30, 0x24, 0x78, 0x39, 0x40, 0x3e, 0x81, 0x78, 0x6e,
+	0xbb, 0x83, 0x50, 0xad, 0xb3, 0x39, 0xd3, 0x0d, 0x05, 0xa7, 0x56, 0xb6, 0xd7, 0xcf, 0xb7, 0xae,
+	0x12, 0x0a, 0x4e, 0xf1, 0x01, 0x49, 0x29, 0x78, 0xce, 0x86, 0x1f, 0xe2, 0xc2, 0xfc, 0xee, 0xc1,
+	0xfc, 0x6e, 0x67, 0x15, 0x1b, 0x25, 0xab, 0x55, 0x72, 0x9a, 0x9f, 0xae, 0x6f, 0xb4, 0x4a, 0x7c,
+	0xa3, 0x55, 0xae, 0x37, 0x9a, 0x10, 0x6f, 0x34, 0xe1, 0xf7, 0x56, 0xab, 0xfc, 0xd9, 0x6a, 0x42,
+	0xbc, 0xd5, 0x2a, 0xff, 0xb6, 0x5a, 0xe5, 0xc7, 0xcb, 0x09, 0x22, 0xd3, 0x68, 0xd8, 0x1a, 0x05,
+	0x5e, 0x3b, 0x5c, 0xfa, 0x23, 0x32, 0x45, 0xfe, 0xa4, 0xb0, 0xba, 0xbb, 0xa9, 0x43, 0x89, 0x5d,
+	0xc1, 0x57, 0xff, 0x07, 0x00, 0xff, 0xa7, 0x6d, 0xe3, 0xbe, 0x03, 0x00, 0x00,
+}
+
+func (m *WebhookConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryIntervalS != 0 {
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(m.RetryIntervalS))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Devices) > 0 {
+		for iNdEx := len(m.Devices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Devices[iNdEx])
+			copy(dAtA[i:], m.Devices[iNdEx])
+			i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.Devices[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Folders) > 0 {
+		for iNdEx := len(m.Folders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Folders[iNdEx])
+			copy(dAtA[i:], m.Folders[iNdEx])
+			i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.Folders[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Events[iNdEx])
+			copy(dAtA[i:], m.Events[iNdEx])
+			i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.Events[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintWebhookconfiguration(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWebhookconfiguration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWebhookconfiguration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WebhookConfiguration) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovWebhookconfiguration(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovWebhookconfiguration(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovWebhookconfiguration(uint64(l))
+		}
+	}
+	if len(m.Folders) > 0 {
+		for _, s := range m.Folders {
+			l = len(s)
+			n += 1 + l + sovWebhookconfiguration(uint64(l))
+		}
+	}
+	if len(m.Devices) > 0 {
+		for _, s := range m.Devices {
+			l = len(s)
+			n += 1 + l + sovWebhookconfiguration(uint64(l))
+		}
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovWebhookconfiguration(uint64(l))
+	}
+	if m.MaxAttempts != 0 {
+		n += 1 + sovWebhookconfiguration(uint64(m.MaxAttempts))
+	}
+	if m.RetryIntervalS != 0 {
+		n += 1 + sovWebhookconfiguration(uint64(m.RetryIntervalS))
+	}
+	return n
+}
+
+func sovWebhookconfiguration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWebhookconfiguration(x uint64) (n int) {
+	return sovWebhookconfiguration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WebhookConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebhookconfiguration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Folders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Folders = append(m.Folders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Devices = append(m.Devices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryIntervalS", wireType)
+			}
+			m.RetryIntervalS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryIntervalS |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebhookconfiguration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebhookconfiguration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWebhookconfiguration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWebhookconfiguration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWebhookconfiguration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWebhookconfiguration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWebhookconfiguration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWebhookconfiguration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWebhookconfiguration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWebhookconfiguration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWebhookconfiguration = fmt.Errorf("proto: unexpected end of group")
+)
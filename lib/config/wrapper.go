@@ -75,6 +75,23 @@ type noopWaiter struct{}
 
 func (noopWaiter) Wait() {}
 
+// A committedWaiter is returned for modifications that changed the
+// configuration, to tell what they changed. The configurations are never
+// modified in place, so keeping them is safe.
+type committedWaiter struct {
+	Waiter
+	from, to Configuration
+}
+
+// ChangesOf returns the changes made by the modification that returned the
+// waiter, with secrets redacted; none if it didn't change anything.
+func ChangesOf(w Waiter) []Change {
+	if cw, ok := w.(committedWaiter); ok {
+		return Diff(cw.from, cw.to)
+	}
+	return nil
+}
+
 // ModifyFunction gets a pointer to a copy of the currently active configuration
 // for modification.
 type ModifyFunction func(*Configuration)
@@ -85,7 +102,8 @@ type ModifyFunction func(*Configuration)
 //
 // Modify allows changing the currently active configuration through the given
 // ModifyFunction. It can be called concurrently: All calls will be queued and
-// called in order.
+// called in order. ModifyFrom does the same, recording the given origin for
+// the change in the history, if any.
 type Wrapper interface {
 	ConfigPath() string
 	MyID() protocol.DeviceID
@@ -95,11 +113,17 @@ type Wrapper interface {
 	Save() error
 
 	Modify(ModifyFunction) (Waiter, error)
+	ModifyFrom(origin string, fn ModifyFunction) (Waiter, error)
 	RemoveFolder(id string) (Waiter, error)
+	RemoveFolderFrom(origin string, id string) (Waiter, error)
 	RemoveDevice(id protocol.DeviceID) (Waiter, error)
+	RemoveDeviceFrom(origin string, id protocol.DeviceID) (Waiter, error)
 
 	GUI() GUIConfiguration
 	LDAP() LDAPConfiguration
+	Organization() OrganizationConfiguration
+	OIDC() OIDCConfiguration
+	Webhooks() []WebhookConfiguration
 	Options() OptionsConfiguration
 	DefaultIgnores() Ignores
 
@@ -121,6 +145,9 @@ type Wrapper interface {
 	Subscribe(c Committer) Configuration
 	Unsubscribe(c Committer)
 
+	SetHistory(h *History)
+	History() *History
+
 	suture.Service
 }
 
@@ -131,9 +158,10 @@ type wrapper struct {
 	myID     protocol.DeviceID
 	queue    chan modifyEntry
 
-	waiter Waiter // Latest ongoing config change
-	subs   []Committer
-	mut    sync.Mutex
+	waiter  Waiter // Latest ongoing config change
+	subs    []Committer
+	history *History // nil unless set
+	mut     sync.Mutex
 
 	requiresRestart atomic.Bool
 }
@@ -218,12 +246,17 @@ func (w *wrapper) RawCopy() Configuration {
 }
 
 func (w *wrapper) Modify(fn ModifyFunction) (Waiter, error) {
-	return w.modifyQueued(fn)
+	return w.modifyQueued(OriginSyncthing, fn)
 }
 
-func (w *wrapper) modifyQueued(modifyFunc ModifyFunction) (Waiter, error) {
+func (w *wrapper) ModifyFrom(origin string, fn ModifyFunction) (Waiter, error) {
+	return w.modifyQueued(origin, fn)
+}
+
+func (w *wrapper) modifyQueued(origin string, modifyFunc ModifyFunction) (Waiter, error) {
 	e := modifyEntry{
 		modifyFunc: modifyFunc,
+		origin:     origin,
 		res:        make(chan modifyResult),
 	}
 	select {
@@ -261,16 +294,30 @@ func (w *wrapper) Serve(ctx context.Context) error {
 		e.modifyFunc(&to)
 
 		// Check if the config was actually changed at all.
+		var committed *Configuration
 		w.mut.Lock()
 		if !reflect.DeepEqual(w.cfg, to) {
+			from := w.cfg
 			waiter, err = w.replaceLocked(to)
 			if !saveTimerRunning {
 				saveTimer.Reset(minSaveInterval)
 				saveTimerRunning = true
 			}
+			if err == nil {
+				waiter = committedWaiter{Waiter: waiter, from: from, to: w.cfg}
+				if w.history != nil {
+					cfg := w.cfg.Copy()
+					committed = &cfg
+				}
+			}
 		}
+		history := w.history
 		w.mut.Unlock()
 
+		if committed != nil {
+			recordHistory(history, *committed, e.origin)
+		}
+
 		e.res <- modifyResult{
 			w:   waiter,
 			err: err,
@@ -366,7 +413,13 @@ func (w *wrapper) DeviceList() []DeviceConfiguration {
 
 // RemoveDevice removes the device from the configuration
 func (w *wrapper) RemoveDevice(id protocol.DeviceID) (Waiter, error) {
-	return w.modifyQueued(func(cfg *Configuration) {
+	return w.RemoveDeviceFrom(OriginSyncthing, id)
+}
+
+// RemoveDeviceFrom is like RemoveDevice, recording the given origin in the
+// configuration history.
+func (w *wrapper) RemoveDeviceFrom(origin string, id protocol.DeviceID) (Waiter, error) {
+	return w.modifyQueued(origin, func(cfg *Configuration) {
 		if _, i, ok := cfg.Device(id); ok {
 			cfg.Devices = append(cfg.Devices[:i], cfg.Devices[i+1:]...)
 		}
@@ -399,7 +452,13 @@ func (w *wrapper) FolderList() []FolderConfiguration {
 
 // RemoveFolder removes the folder from the configuration
 func (w *wrapper) RemoveFolder(id string) (Waiter, error) {
-	return w.modifyQueued(func(cfg *Configuration) {
+	return w.RemoveFolderFrom(OriginSyncthing, id)
+}
+
+// RemoveFolderFrom is like RemoveFolder, recording the given origin in the
+// configuration history.
+func (w *wrapper) RemoveFolderFrom(origin string, id string) (Waiter, error) {
+	return w.modifyQueued(origin, func(cfg *Configuration) {
 		if _, i, ok := cfg.Folder(id); ok {
 			cfg.Folders = append(cfg.Folders[:i], cfg.Folders[i+1:]...)
 		}
@@ -433,6 +492,29 @@ func (w *wrapper) LDAP() LDAPConfiguration {
 	return w.cfg.LDAP.Copy()
 }
 
+func (w *wrapper) OIDC() OIDCConfiguration {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.cfg.OIDC.Copy()
+}
+
+func (w *wrapper) Organization() OrganizationConfiguration {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.cfg.Organization.Copy()
+}
+
+// Webhooks returns the current webhook configurations.
+func (w *wrapper) Webhooks() []WebhookConfiguration {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	hooks := make([]WebhookConfiguration, len(w.cfg.Webhooks))
+	for i := range w.cfg.Webhooks {
+		hooks[i] = w.cfg.Webhooks[i].Copy()
+	}
+	return hooks
+}
+
 // GUI returns the current GUI configuration object.
 func (w *wrapper) GUI() GUIConfiguration {
 	w.mut.Lock()
@@ -529,8 +611,33 @@ func (w *wrapper) Save() error {
 
 func (w *wrapper) RequiresRestart() bool { return w.requiresRestart.Load() }
 
+// SetHistory makes the wrapper keep a history of the committed
+// configurations, starting with the current one.
+func (w *wrapper) SetHistory(h *History) {
+	w.mut.Lock()
+	w.history = h
+	cfg := w.cfg.Copy()
+	w.mut.Unlock()
+	recordHistory(h, cfg, OriginStartup)
+}
+
+// History returns the history of committed configurations, or nil if none
+// is kept.
+func (w *wrapper) History() *History {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.history
+}
+
+func recordHistory(h *History, cfg Configuration, origin string) {
+	if _, err := h.Record(cfg, origin, cfg.Options.ConfigHistoryRevisions); err != nil {
+		l.Warnln("Failed to record config history:", err)
+	}
+}
+
 type modifyEntry struct {
 	modifyFunc ModifyFunction
+	origin     string
 	res        chan modifyResult
 }
 
@@ -374,6 +374,8 @@ func TestConnectionEstablishment(t *testing.T) {
 	addrs := []string{
 		"tcp://127.0.0.1:0",
 		"quic://127.0.0.1:0",
+		"ws://127.0.0.1:0",
+		"wss://127.0.0.1:0",
 	}
 
 	send := make([]byte, 128<<10)
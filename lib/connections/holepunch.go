@@ -0,0 +1,141 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/semaphore"
+	"github.com/syncthing/syncthing/lib/stringutil"
+)
+
+// Hole punching lets two devices behind NATs with endpoint independent
+// mapping connect directly over QUIC, instead of through a relay. It works
+// like this:
+//
+//   - Each device learns the external address of its QUIC listener through
+//     STUN. The STUN service only reports it when the NAT type is punchable.
+//   - On a relayed connection both devices include those addresses in their
+//     Hello. The Hello exchange is our rendezvous; both sides act as soon as
+//     it completes, which is within a round trip of each other.
+//   - The device with the lower device ID dials the other's addresses over
+//     QUIC. At the same time the other device sends packets to the dialer's
+//     addresses from its listening socket, which opens its NAT for the
+//     incoming dial. The QUIC handshake retransmits cover the remaining
+//     difference in timing.
+//
+// Only one side dials, so that we don't end up with two direct connections
+// that each side rejects in favour of the other. The direct connection
+// replaces the relayed one under the usual upgrade threshold rules.
+
+const (
+	// How long the passive side keeps its NAT open for the dial
+	holePunchDuration = 15 * time.Second
+	// How often it sends a packet to each of the dialer's addresses
+	holePunchPacketInterval = 250 * time.Millisecond
+)
+
+// A punchableListener is a listener that can be reached by hole punching
+type punchableListener interface {
+	// PunchAddress returns the external address of the listener, as seen
+	// by STUN, or nil if there is none.
+	PunchAddress() *url.URL
+}
+
+// punchAddresses returns the addresses to include in our Hello on the given
+// connection.
+func (s *service) punchAddresses(c internalConn) []string {
+	if c.connType.Transport() != "relay" || !s.cfg.Options().HolePunchingEnabled {
+		return nil
+	}
+
+	var addrs []string
+	s.listenersMut.RLock()
+	for _, listener := range s.listeners {
+		if pl, ok := listener.(punchableListener); ok {
+			if uri := pl.PunchAddress(); uri != nil {
+				addrs = append(addrs, uri.String())
+			}
+		}
+	}
+	s.listenersMut.RUnlock()
+	return stringutil.UniqueTrimmedStrings(addrs)
+}
+
+// holePunch tries to establish a direct connection to the device at the
+// given addresses, as received in the Hello on a relayed connection.
+func (s *service) holePunch(ctx context.Context, remoteID protocol.DeviceID, addrs []string) {
+	deviceCfg, ok := s.cfg.Device(remoteID)
+	if !ok {
+		return
+	}
+
+	var uris []*url.URL
+	for _, addr := range addrs {
+		uri, err := url.Parse(addr)
+		if err != nil {
+			l.Debugf("Hole punching to %s: %v", remoteID.Short(), err)
+			continue
+		}
+		if !strings.HasPrefix(uri.Scheme, "quic") {
+			continue
+		}
+		if len(deviceCfg.AllowedNetworks) > 0 && !IsAllowedNetwork(uri.Host, deviceCfg.AllowedNetworks) {
+			continue
+		}
+		uris = append(uris, uri)
+	}
+	if len(uris) == 0 {
+		return
+	}
+
+	punchCtx, cancel := context.WithTimeout(ctx, holePunchDuration)
+	defer cancel()
+
+	if s.myID.Compare(remoteID) > 0 {
+		l.Debugf("Opening NAT for hole punching from %s at %v", remoteID.Short(), uris)
+		s.sendPunchPackets(punchCtx, uris)
+		return
+	}
+
+	l.Debugf("Hole punching to %s at %v", remoteID.Short(), uris)
+
+	cfg := s.cfg.RawCopy()
+	var targets []dialTarget
+	for _, uri := range uris {
+		dialerFactory, err := getDialerFactory(cfg, uri)
+		if err != nil {
+			l.Debugf("Hole punching to %s: %v", remoteID.Short(), err)
+			continue
+		}
+		dialer := dialerFactory.New(cfg.Options, s.tlsCfg, s.registry, s.lanChecker)
+		targets = append(targets, dialTarget{
+			addr:     uri.String(),
+			dialer:   dialer,
+			priority: dialer.Priority(uri.Host),
+			deviceID: remoteID,
+			uri:      uri,
+		})
+	}
+
+	conn, ok := s.dialParallel(punchCtx, remoteID, targets, semaphore.New(dialMaxParallel))
+	if !ok {
+		l.Debugf("Hole punching to %s failed", remoteID.Short())
+		return
+	}
+	l.Debugf("Hole punching to %s succeeded: %s", remoteID.Short(), conn)
+
+	select {
+	case s.conns <- conn:
+	case <-ctx.Done():
+		conn.Close()
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/connections/registry"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+type fakePunchableListener struct {
+	genericListener
+	uri *url.URL
+}
+
+func (l *fakePunchableListener) PunchAddress() *url.URL {
+	return l.uri
+}
+
+func newPunchTestService(myID protocol.DeviceID, cfg config.Configuration) *service {
+	return &service{
+		cfg:          config.Wrap("", cfg, myID, events.NoopLogger),
+		myID:         myID,
+		registry:     registry.New(),
+		listenersMut: sync.NewRWMutex(),
+		listeners:    make(map[string]genericListener),
+	}
+}
+
+func TestPunchAddresses(t *testing.T) {
+	cfg := config.Configuration{Options: config.OptionsConfiguration{HolePunchingEnabled: true}}
+	s := newPunchTestService(protocol.LocalDeviceID, cfg)
+	uri, _ := url.Parse("quic://192.0.2.42:22000")
+	s.listeners["quic://0.0.0.0:22000"] = &fakePunchableListener{uri: uri}
+	s.listeners["quic4://0.0.0.0:22000"] = &fakePunchableListener{uri: uri}
+	s.listeners["quic6://[::]:22000"] = &fakePunchableListener{}
+
+	relayed := internalConn{connType: connTypeRelayClient}
+	if addrs := s.punchAddresses(relayed); len(addrs) != 1 || addrs[0] != uri.String() {
+		t.Errorf("got %v, expected only %v", addrs, uri)
+	}
+	if addrs := s.punchAddresses(internalConn{connType: connTypeTCPClient}); addrs != nil {
+		t.Errorf("got %v on a direct connection", addrs)
+	}
+
+	cfg.Options.HolePunchingEnabled = false
+	s.cfg = config.Wrap("", cfg, s.myID, events.NoopLogger)
+	if addrs := s.punchAddresses(relayed); addrs != nil {
+		t.Errorf("got %v with hole punching disabled", addrs)
+	}
+}
+
+func TestHolePunchNoAddresses(t *testing.T) {
+	// The local device has the higher ID, so it would only open its NAT
+	// and not dial.
+	remoteID := protocol.DeviceID{1}
+	cfg := config.Configuration{
+		Devices: []config.DeviceConfiguration{{
+			DeviceID:        remoteID,
+			AllowedNetworks: []string{"10.0.0.0/8"},
+		}},
+	}
+	s := newPunchTestService(protocol.DeviceID{2}, cfg)
+
+	cases := map[string]struct {
+		device protocol.DeviceID
+		addrs  []string
+	}{
+		"unknown device":       {protocol.DeviceID{3}, []string{"quic://10.0.0.1:22000"}},
+		"no quic addresses":    {remoteID, []string{"tcp://10.0.0.1:22000", "relay://10.0.0.2:22067"}},
+		"invalid address":      {remoteID, []string{"quic://10.0.0.1:port%"}},
+		"disallowed addresses": {remoteID, []string{"quic://192.0.2.1:22000"}},
+	}
+	for name, tc := range cases {
+		tc := tc
+		done := make(chan struct{})
+		go func() {
+			s.holePunch(context.Background(), tc.device, tc.addrs)
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Errorf("%s: hole punching didn't return", name)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package mocks
 
 import (
 	"context"
+	"net/http"
 	"sync"
 
 	"github.com/syncthing/syncthing/lib/connections"
@@ -70,6 +71,16 @@ type Service struct {
 	serveReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WebSocketHandlerStub        func() http.Handler
+	webSocketHandlerMutex       sync.RWMutex
+	webSocketHandlerArgsForCall []struct {
+	}
+	webSocketHandlerReturns struct {
+		result1 http.Handler
+	}
+	webSocketHandlerReturnsOnCall map[int]struct {
+		result1 http.Handler
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -400,6 +411,59 @@ func (fake *Service) ServeReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *Service) WebSocketHandler() http.Handler {
+	fake.webSocketHandlerMutex.Lock()
+	ret, specificReturn := fake.webSocketHandlerReturnsOnCall[len(fake.webSocketHandlerArgsForCall)]
+	fake.webSocketHandlerArgsForCall = append(fake.webSocketHandlerArgsForCall, struct {
+	}{})
+	stub := fake.WebSocketHandlerStub
+	fakeReturns := fake.webSocketHandlerReturns
+	fake.recordInvocation("WebSocketHandler", []interface{}{})
+	fake.webSocketHandlerMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Service) WebSocketHandlerCallCount() int {
+	fake.webSocketHandlerMutex.RLock()
+	defer fake.webSocketHandlerMutex.RUnlock()
+	return len(fake.webSocketHandlerArgsForCall)
+}
+
+func (fake *Service) WebSocketHandlerCalls(stub func() http.Handler) {
+	fake.webSocketHandlerMutex.Lock()
+	defer fake.webSocketHandlerMutex.Unlock()
+	fake.WebSocketHandlerStub = stub
+}
+
+func (fake *Service) WebSocketHandlerReturns(result1 http.Handler) {
+	fake.webSocketHandlerMutex.Lock()
+	defer fake.webSocketHandlerMutex.Unlock()
+	fake.WebSocketHandlerStub = nil
+	fake.webSocketHandlerReturns = struct {
+		result1 http.Handler
+	}{result1}
+}
+
+func (fake *Service) WebSocketHandlerReturnsOnCall(i int, result1 http.Handler) {
+	fake.webSocketHandlerMutex.Lock()
+	defer fake.webSocketHandlerMutex.Unlock()
+	fake.WebSocketHandlerStub = nil
+	if fake.webSocketHandlerReturnsOnCall == nil {
+		fake.webSocketHandlerReturnsOnCall = make(map[int]struct {
+			result1 http.Handler
+		})
+	}
+	fake.webSocketHandlerReturnsOnCall[i] = struct {
+		result1 http.Handler
+	}{result1}
+}
+
 func (fake *Service) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -415,6 +479,8 @@ func (fake *Service) Invocations() map[string][][]interface{} {
 	defer fake.nATTypeMutex.RUnlock()
 	fake.serveMutex.RLock()
 	defer fake.serveMutex.RUnlock()
+	fake.webSocketHandlerMutex.RLock()
+	defer fake.webSocketHandlerMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
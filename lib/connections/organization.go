@@ -0,0 +1,83 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// With organization trust enabled, an unknown device presenting a
+// certificate signed by an organization CA is added to the configuration,
+// based on the default device configuration, instead of becoming a pending
+// device. Devices added this way are marked as such, and their certificate
+// is verified against the organization CAs on every connection, so that
+// expired certificates or removed CAs take effect.
+
+// checkOrganizationTrust returns an error if the device was added as
+// trusted by the organization and its certificate no longer verifies.
+func (s *service) checkOrganizationTrust(remoteID protocol.DeviceID, cert *x509.Certificate, intermediates []*x509.Certificate) error {
+	deviceCfg, ok := s.cfg.Device(remoteID)
+	if !ok || !deviceCfg.OrganizationTrusted {
+		return nil
+	}
+	if err := s.cfg.Organization().Verify(cert, intermediates, time.Now()); err != nil {
+		return fmt.Errorf("organization trust: %w", err)
+	}
+	return nil
+}
+
+// trustedByOrganization returns whether the unknown device is to be added
+// as its certificate is trusted by the organization.
+func (s *service) trustedByOrganization(remoteID protocol.DeviceID, cert *x509.Certificate, intermediates []*x509.Certificate) bool {
+	org := s.cfg.Organization()
+	if !org.Enabled {
+		return false
+	}
+	if err := org.Verify(cert, intermediates, time.Now()); err != nil {
+		l.Debugf("Device %s is not trusted by the organization: %v", remoteID, err)
+		return false
+	}
+	return true
+}
+
+// addOrganizationDevice adds the unknown device, trusted by the
+// organization, to the configuration and returns whether it did. It waits
+// for the configuration to be committed.
+func (s *service) addOrganizationDevice(remoteID protocol.DeviceID, cert *x509.Certificate, hello protocol.Hello) bool {
+	waiter, err := s.cfg.Modify(func(cfg *config.Configuration) {
+		if _, _, ok := cfg.Device(remoteID); ok {
+			return
+		}
+		device := cfg.Defaults.Device.Copy()
+		device.DeviceID = remoteID
+		device.Name = hello.DeviceName
+		if device.Name == "" {
+			device.Name = cert.Subject.CommonName
+		}
+		// The certificate is not the default self signed one, so expect
+		// the name it was issued with.
+		device.CertName = cert.Subject.CommonName
+		if device.CertName == "" && len(cert.DNSNames) > 0 {
+			device.CertName = cert.DNSNames[0]
+		}
+		device.OrganizationTrusted = true
+		cfg.SetDevice(device)
+	})
+	if err != nil {
+		l.Warnf("Adding device %s trusted by the organization: %v", remoteID, err)
+		return false
+	}
+	waiter.Wait()
+
+	l.Infof("Added device %s (%q), trusted by the organization", remoteID, cert.Subject.CommonName)
+	return true
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func newTestCert(t *testing.T, cn string, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	if isCA {
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+		tmpl.DNSNames = []string{cn}
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+// helloRecordingModel rejects every device, recording which ones it was
+// asked about.
+type helloRecordingModel struct {
+	Model
+	hellos chan protocol.DeviceID
+}
+
+func (m *helloRecordingModel) OnHello(id protocol.DeviceID, _ net.Addr, _ protocol.Hello) error {
+	m.hellos <- id
+	return errors.New("rejected")
+}
+
+func newHelloTestConn(t *testing.T) internalConn {
+	t.Helper()
+	c1, c2 := net.Pipe()
+	t.Cleanup(func() {
+		c1.Close()
+		c2.Close()
+	})
+	return internalConn{tlsConn: tls.Client(c1, &tls.Config{}), connType: connTypeTCPServer}
+}
+
+func TestOrganizationDeviceAddedOutsideHelloLoop(t *testing.T) {
+	caCert, caKey := newTestCert(t, "Example CA", true, nil, nil)
+	interCert, interKey := newTestCert(t, "Example Intermediate CA", true, caCert, caKey)
+	deviceCert, _ := newTestCert(t, "laptop.devices.example.com", false, interCert, interKey)
+	deviceID := protocol.NewDeviceID(deviceCert.Raw)
+
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.Organization = config.OrganizationConfiguration{
+		Enabled:        true,
+		CACertificates: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw})),
+	}
+	wcfg := config.Wrap(filepath.Join(t.TempDir(), "config.xml"), cfg, protocol.LocalDeviceID, events.NoopLogger)
+	model := &helloRecordingModel{hellos: make(chan protocol.DeviceID, 2)}
+	s := &service{
+		cfg:    wcfg,
+		myID:   protocol.LocalDeviceID,
+		model:  model,
+		hellos: make(chan *connWithHello),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.handleHellos(ctx)
+
+	// The configuration isn't being served yet, so adding the device
+	// blocks.
+	s.hellos <- &connWithHello{
+		c:             newHelloTestConn(t),
+		hello:         protocol.Hello{DeviceName: "laptop"},
+		remoteID:      deviceID,
+		remoteCert:    deviceCert,
+		intermediates: []*x509.Certificate{interCert},
+	}
+
+	// Other connections are handled in the meantime.
+	otherCert, _ := newTestCert(t, "other", false, nil, nil)
+	otherID := protocol.NewDeviceID(otherCert.Raw)
+	s.hellos <- &connWithHello{c: newHelloTestConn(t), remoteID: otherID, remoteCert: otherCert}
+	select {
+	case id := <-model.hellos:
+		if id != otherID {
+			t.Fatalf("got hello from %s, expected the other device", id)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("the hello loop is blocked")
+	}
+
+	// Once the device is added the connection is handled again, as a
+	// known device.
+	go wcfg.Serve(ctx)
+	select {
+	case id := <-model.hellos:
+		if id != deviceID {
+			t.Fatalf("got hello from %s, expected the added device", id)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("the connection wasn't handled after adding the device")
+	}
+	deviceCfg, ok := wcfg.Device(deviceID)
+	if !ok || !deviceCfg.OrganizationTrusted || deviceCfg.Name != "laptop" || deviceCfg.CertName != "laptop.devices.example.com" {
+		t.Errorf("unexpected device configuration %+v", deviceCfg)
+	}
+}
+
+func TestOrganizationTrustIntermediates(t *testing.T) {
+	caCert, caKey := newTestCert(t, "Example CA", true, nil, nil)
+	interCert, interKey := newTestCert(t, "Example Intermediate CA", true, caCert, caKey)
+	deviceCert, _ := newTestCert(t, "laptop.devices.example.com", false, interCert, interKey)
+	deviceID := protocol.NewDeviceID(deviceCert.Raw)
+
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.Organization = config.OrganizationConfiguration{
+		Enabled:        true,
+		CACertificates: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw})),
+	}
+	device := cfg.Defaults.Device.Copy()
+	device.DeviceID = deviceID
+	device.OrganizationTrusted = true
+	cfg.SetDevice(device)
+	s := &service{cfg: config.Wrap("", cfg, protocol.LocalDeviceID, events.NoopLogger)}
+
+	if s.trustedByOrganization(deviceID, deviceCert, nil) {
+		t.Error("trusted without the intermediate")
+	}
+	if !s.trustedByOrganization(deviceID, deviceCert, []*x509.Certificate{interCert}) {
+		t.Error("not trusted with the intermediate")
+	}
+	if err := s.checkOrganizationTrust(deviceID, deviceCert, nil); err == nil {
+		t.Error("expected an error without the intermediate")
+	}
+	if err := s.checkOrganizationTrust(deviceID, deviceCert, []*x509.Certificate{interCert}); err != nil {
+		t.Error(err)
+	}
+}
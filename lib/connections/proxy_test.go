@@ -0,0 +1,178 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"crypto/tls"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/connections/registry"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/relay/client"
+	relayprotocol "github.com/syncthing/syncthing/lib/relay/protocol"
+)
+
+var (
+	proxyDevice  = protocol.DeviceID{1}
+	directDevice = protocol.DeviceID{2}
+	globalDevice = protocol.DeviceID{3}
+)
+
+func proxyTestConfig(globalProxy, deviceProxy string) config.Configuration {
+	return config.Configuration{
+		Options: config.OptionsConfiguration{
+			RelaysEnabled: true,
+			ProxyURL:      globalProxy,
+		},
+		Devices: []config.DeviceConfiguration{
+			{DeviceID: proxyDevice, ProxyURL: deviceProxy, Addresses: []string{"tcp://192.0.2.1:22000", "relay://192.0.2.2:22067"}},
+			{DeviceID: directDevice, ProxyURL: "direct", Addresses: []string{"tcp://192.0.2.1:22000", "relay://192.0.2.2:22067"}},
+			{DeviceID: globalDevice, Addresses: []string{"tcp://192.0.2.1:22000", "relay://192.0.2.2:22067"}},
+		},
+	}
+}
+
+func TestDeviceProxyURL(t *testing.T) {
+	opts := config.OptionsConfiguration{ProxyURL: "socks5://global:1080"}
+	cases := []struct {
+		deviceProxy string
+		expected    string
+	}{
+		{"", "socks5://global:1080"},
+		{"direct", "direct"},
+		{"socks5://device:1080", "socks5://device:1080"},
+	}
+	for _, tc := range cases {
+		if got := deviceProxyURL(opts, config.DeviceConfiguration{ProxyURL: tc.deviceProxy}); got != tc.expected {
+			t.Errorf("device proxy %q: got %q, expected %q", tc.deviceProxy, got, tc.expected)
+		}
+	}
+	if got := deviceProxyURL(config.OptionsConfiguration{}, config.DeviceConfiguration{}); got != "" {
+		t.Errorf("got %q without any proxy, expected the environment's", got)
+	}
+}
+
+func TestResolveDialTargetsProxy(t *testing.T) {
+	cfg := proxyTestConfig("socks5://global:1080", "socks5://device:1080")
+	s := &service{
+		connectionStatusHandler: newConnectionStatusHandler(),
+		cfg:                     config.Wrap("", cfg, protocol.LocalDeviceID, events.NoopLogger),
+		registry:                registry.New(),
+		lanChecker:              &lanChecker{},
+	}
+	s.lanChecker.cfg = s.cfg
+
+	expected := map[protocol.DeviceID]string{
+		proxyDevice:  "socks5://device:1080",
+		directDevice: "direct",
+		globalDevice: "socks5://global:1080",
+	}
+	for _, deviceCfg := range cfg.Devices {
+		targets := s.resolveDialTargets(context.Background(), time.Now(), cfg, deviceCfg, make(nextDialRegistry), true, math.MaxInt32)
+		if len(targets) != 2 {
+			t.Fatalf("%s: got %d dial targets, expected two", deviceCfg.DeviceID.Short(), len(targets))
+		}
+		for _, tgt := range targets {
+			var proxyURL string
+			switch d := tgt.dialer.(type) {
+			case *tcpDialer:
+				proxyURL = d.proxyURL
+			case *relayDialer:
+				proxyURL = d.proxyURL
+			default:
+				t.Fatalf("unexpected dialer %T", d)
+			}
+			if proxyURL != expected[deviceCfg.DeviceID] {
+				t.Errorf("%s via %s: got proxy %q, expected %q", deviceCfg.DeviceID.Short(), tgt.addr, proxyURL, expected[deviceCfg.DeviceID])
+			}
+		}
+	}
+}
+
+type fakeInvitingRelayClient struct {
+	client.RelayClient
+	invitations chan relayprotocol.SessionInvitation
+}
+
+func (c *fakeInvitingRelayClient) Invitations() <-chan relayprotocol.SessionInvitation {
+	return c.invitations
+}
+
+func (*fakeInvitingRelayClient) Changed() <-chan struct{} {
+	return nil
+}
+
+// acceptOne returns a listener, and a channel receiving when it's been
+// connected to.
+func acceptOne(t *testing.T) (*net.TCPAddr, <-chan struct{}) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	accepted := make(chan struct{}, 1)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+			select {
+			case accepted <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr), accepted
+}
+
+func TestRelayInvitationProxy(t *testing.T) {
+	// The "proxies" merely accept connections, which is enough to see
+	// where the session is joined through.
+	globalAddr, globalAccepted := acceptOne(t)
+	deviceAddr, deviceAccepted := acceptOne(t)
+	sessionAddr, sessionAccepted := acceptOne(t)
+
+	cfg := proxyTestConfig("socks5://"+globalAddr.String(), "socks5://"+deviceAddr.String())
+	wcfg := config.Wrap("", cfg, protocol.LocalDeviceID, events.NoopLogger)
+	listener := &relayListener{cfg: wcfg, tlsCfg: &tls.Config{}, conns: make(chan internalConn)}
+	fake := &fakeInvitingRelayClient{invitations: make(chan relayprotocol.SessionInvitation)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go listener.handleInvitations(ctx, fake)
+
+	cases := []struct {
+		from     []byte
+		accepted <-chan struct{}
+	}{
+		{proxyDevice[:], deviceAccepted},
+		{directDevice[:], sessionAccepted},
+		{globalDevice[:], globalAccepted},
+		{[]byte("unknown"), globalAccepted},
+	}
+	for i, tc := range cases {
+		fake.invitations <- relayprotocol.SessionInvitation{
+			From:    tc.from,
+			Address: sessionAddr.IP,
+			Port:    uint16(sessionAddr.Port),
+		}
+		select {
+		case <-tc.accepted:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("case %d: the session wasn't joined through the expected proxy", i)
+		}
+	}
+}
@@ -209,6 +209,12 @@ func (t *quicListener) LANAddresses() []*url.URL {
 	return addrs
 }
 
+func (t *quicListener) PunchAddress() *url.URL {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+	return t.address
+}
+
 func (t *quicListener) String() string {
 	return t.uri.String()
 }
@@ -0,0 +1,63 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.15 && !noquic
+// +build go1.15,!noquic
+
+package connections
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/quic-go/quic-go"
+)
+
+// The packet sent to open the NAT. The first byte has the QUIC fixed bit
+// cleared, so the receiving transport doesn't take it for a QUIC packet.
+var punchPacket = []byte{0}
+
+// sendPunchPackets sends packets to the given addresses from the socket of
+// our QUIC listener, until the context is done.
+func (s *service) sendPunchPackets(ctx context.Context, uris []*url.URL) {
+	type punchTarget struct {
+		transport *quic.Transport
+		addr      *net.UDPAddr
+	}
+	var targets []punchTarget
+	for _, uri := range uris {
+		transport, _ := s.registry.Get(uri.Scheme, transportConnUnspecified).(*quic.Transport)
+		if transport == nil {
+			continue
+		}
+		addr, err := net.ResolveUDPAddr(quicNetwork(uri), uri.Host)
+		if err != nil {
+			l.Debugln("Hole punching:", err)
+			continue
+		}
+		targets = append(targets, punchTarget{transport, addr})
+	}
+	if len(targets) == 0 {
+		return
+	}
+
+	t := time.NewTicker(holePunchPacketInterval)
+	defer t.Stop()
+	for {
+		for _, tgt := range targets {
+			if _, err := tgt.transport.WriteTo(punchPacket, tgt.addr); err != nil {
+				l.Debugln("Hole punching:", err)
+			}
+		}
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+//go:build go1.15 && !noquic
+// +build go1.15,!noquic
+
+package connections
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestHolePunchOpensNAT(t *testing.T) {
+	// The socket of our QUIC listener, which the punch packets are sent
+	// from.
+	udpConn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4zero})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer udpConn.Close()
+	transport := &quic.Transport{Conn: udpConn}
+	defer transport.Close()
+
+	// The dialing device.
+	remote, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer remote.Close()
+
+	remoteID := protocol.DeviceID{1}
+	cfg := config.Configuration{
+		Devices: []config.DeviceConfiguration{{
+			DeviceID:        remoteID,
+			AllowedNetworks: []string{"127.0.0.0/8"},
+		}},
+	}
+	// The local device has the higher ID, so it opens its NAT rather than
+	// dialing.
+	s := newPunchTestService(protocol.DeviceID{2}, cfg)
+	s.registry.Register("quic", transport)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.holePunch(ctx, remoteID, []string{"quic://" + remote.LocalAddr().String(), "tcp://127.0.0.1:22000"})
+		close(done)
+	}()
+
+	// Several packets arrive from the listening socket.
+	buf := make([]byte, 1500)
+	for i := 0; i < 2; i++ {
+		if err := remote.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, from, err := remote.ReadFromUDP(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf[:n], punchPacket) {
+			t.Errorf("got packet %x", buf[:n])
+		}
+		if from.Port != udpConn.LocalAddr().(*net.UDPAddr).Port {
+			t.Errorf("packet came from %v, not the listening socket", from)
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("hole punching didn't stop")
+	}
+}
+
+func TestSendPunchPacketsWithoutListener(t *testing.T) {
+	s := newPunchTestService(protocol.LocalDeviceID, config.Configuration{})
+	uri, err := url.Parse("quic://127.0.0.1:22000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	go func() {
+		// Without a registered QUIC transport there is nothing to send
+		// from, and this returns at once.
+		s.sendPunchPackets(context.Background(), []*url.URL{uri})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("sending punch packets didn't return")
+	}
+}
@@ -28,12 +28,12 @@ type relayDialer struct {
 }
 
 func (d *relayDialer) Dial(ctx context.Context, id protocol.DeviceID, uri *url.URL) (internalConn, error) {
-	inv, err := client.GetInvitationFromRelay(ctx, uri, id, d.tlsCfg.Certificates, 10*time.Second)
+	inv, err := client.GetInvitationFromRelay(ctx, uri, id, d.tlsCfg.Certificates, 10*time.Second, d.proxyURL)
 	if err != nil {
 		return internalConn{}, err
 	}
 
-	conn, err := client.JoinSession(ctx, inv)
+	conn, err := client.JoinSession(ctx, inv, d.proxyURL)
 	if err != nil {
 		return internalConn{}, err
 	}
@@ -62,7 +62,9 @@ func (d *relayDialer) Dial(ctx context.Context, id protocol.DeviceID, uri *url.U
 		return internalConn{}, err
 	}
 
-	return newInternalConn(tc, connTypeRelayClient, false, d.wanPriority), nil
+	c := newInternalConn(tc, connTypeRelayClient, false, d.wanPriority)
+	c.proxy = dialer.Proxy(conn)
+	return c, nil
 }
 
 func (d *relayDialer) Priority(_ string) int {
@@ -78,6 +80,7 @@ func (relayDialerFactory) New(opts config.OptionsConfiguration, tlsCfg *tls.Conf
 		tlsCfg:            tlsCfg,
 		wanPriority:       opts.ConnectionPriorityRelay,
 		lanPriority:       opts.ConnectionPriorityRelay,
+		proxyURL:          opts.ProxyURL,
 	}}
 }
 
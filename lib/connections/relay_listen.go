@@ -18,6 +18,7 @@ import (
 	"github.com/syncthing/syncthing/lib/connections/registry"
 	"github.com/syncthing/syncthing/lib/dialer"
 	"github.com/syncthing/syncthing/lib/nat"
+	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/relay/client"
 	"github.com/syncthing/syncthing/lib/svcutil"
 )
@@ -44,7 +45,7 @@ type relayListener struct {
 }
 
 func (t *relayListener) serve(ctx context.Context) error {
-	clnt, err := client.NewClient(t.uri, t.tlsCfg.Certificates, 10*time.Second)
+	clnt, err := client.NewClient(t.uri, t.tlsCfg.Certificates, 10*time.Second, t.cfg.Options().RelayConnections)
 	if err != nil {
 		l.Infoln("Listen (BEP/relay):", err)
 		return err
@@ -68,13 +69,16 @@ func (t *relayListener) serve(ctx context.Context) error {
 func (t *relayListener) handleInvitations(ctx context.Context, clnt client.RelayClient) {
 	invitations := clnt.Invitations()
 
-	// Start with nil, so that we send a addresses changed notification as soon as we connect somewhere.
-	var oldURI *url.URL
-
 	for {
 		select {
 		case inv := <-invitations:
-			conn, err := client.JoinSession(ctx, inv)
+			// The session is joined on the relay the invitation points
+			// to, through the proxy we'd use to dial the inviting device.
+			var deviceCfg config.DeviceConfiguration
+			if from, err := protocol.DeviceIDFromBytes(inv.From); err == nil {
+				deviceCfg, _ = t.cfg.Device(from)
+			}
+			conn, err := client.JoinSession(ctx, inv, deviceProxyURL(t.cfg.Options(), deviceCfg))
 			if err != nil {
 				if !errors.Is(err, context.Canceled) {
 					l.Infoln("Listen (BEP/relay): joining session:", err)
@@ -108,16 +112,11 @@ func (t *relayListener) handleInvitations(ctx context.Context, clnt client.Relay
 
 			t.conns <- newInternalConn(tc, connTypeRelayServer, false, t.cfg.Options().ConnectionPriorityRelay)
 
-		// Poor mans notifier that informs the connection service that the
-		// relay URI has changed. This can only happen when we connect to a
-		// relay via dynamic+http(s) pool, which upon a relay failing/dropping
-		// us, would pick a different one.
-		case <-time.After(10 * time.Second):
-			currentURI := clnt.URI()
-			if currentURI != oldURI {
-				oldURI = currentURI
-				t.notifyAddressesChanged(t)
-			}
+		// The client has joined or lost a relay. With a dynamic+http(s)
+		// pool it moves on to another one right away, and we want that to
+		// be announced as soon as possible.
+		case <-clnt.Changed():
+			t.notifyAddressesChanged(t)
 
 		case <-ctx.Done():
 			return
@@ -138,18 +137,42 @@ func (t *relayListener) WANAddresses() []*url.URL {
 		return nil
 	}
 
-	curi := client.URI()
-	if curi == nil {
-		return nil
+	var addrs []*url.URL
+	for _, status := range client.Relays() {
+		if status.Connected {
+			addrs = append(addrs, status.URI)
+		}
 	}
-
-	return []*url.URL{curi}
+	return addrs
 }
 
 func (t *relayListener) LANAddresses() []*url.URL {
 	return t.WANAddresses()
 }
 
+// RelayStatus returns the state of each relay the listener is joined, or
+// trying to join, to.
+func (t *relayListener) RelayStatus() []RelayStatusEntry {
+	t.mut.RLock()
+	client := t.client
+	t.mut.RUnlock()
+
+	if client == nil {
+		return nil
+	}
+
+	relays := client.Relays()
+	res := make([]RelayStatusEntry, len(relays))
+	for i, status := range relays {
+		res[i] = RelayStatusEntry{
+			URI:       status.URI.String(),
+			Connected: status.Connected,
+			Since:     status.Since,
+		}
+	}
+	return res
+}
+
 func (t *relayListener) Error() error {
 	err := t.ServiceWithError.Error()
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"io"
 	"math"
 	"net"
+	"net/http"
 	"net/url"
 	"sort"
 	"strings"
@@ -45,6 +46,7 @@ import (
 	"github.com/syncthing/syncthing/lib/sync"
 
 	// Registers NAT service providers
+	_ "github.com/syncthing/syncthing/lib/pcp"
 	_ "github.com/syncthing/syncthing/lib/pmp"
 	_ "github.com/syncthing/syncthing/lib/upnp"
 
@@ -136,12 +138,24 @@ type Service interface {
 	ListenerStatus() map[string]ListenerStatusEntry
 	ConnectionStatus() map[string]ConnectionStatusEntry
 	NATType() string
+	// WebSocketHandler returns a handler accepting BEP connections over
+	// WebSocket, for serving from the GUI.
+	WebSocketHandler() http.Handler
 }
 
 type ListenerStatusEntry struct {
-	Error        *string  `json:"error"`
-	LANAddresses []string `json:"lanAddresses"`
-	WANAddresses []string `json:"wanAddresses"`
+	Error        *string            `json:"error"`
+	LANAddresses []string           `json:"lanAddresses"`
+	WANAddresses []string           `json:"wanAddresses"`
+	Relays       []RelayStatusEntry `json:"relays,omitempty"`
+}
+
+// RelayStatusEntry is the state of one relay a relay listener is joined, or
+// trying to join, to.
+type RelayStatusEntry struct {
+	URI       string    `json:"uri"`
+	Connected bool      `json:"connected"`
+	Since     time.Time `json:"since"`
 }
 
 type ConnectionStatusEntry struct {
@@ -150,11 +164,13 @@ type ConnectionStatusEntry struct {
 }
 
 type connWithHello struct {
-	c          internalConn
-	hello      protocol.Hello
-	err        error
-	remoteID   protocol.DeviceID
-	remoteCert *x509.Certificate
+	c             internalConn
+	hello         protocol.Hello
+	punch         bool // we sent punch addresses in our hello
+	err           error
+	remoteID      protocol.DeviceID
+	remoteCert    *x509.Certificate
+	intermediates []*x509.Certificate // sent along with remoteCert
 }
 
 type service struct {
@@ -176,6 +192,7 @@ type service struct {
 	evLogger             events.Logger
 	registry             *registry.Registry
 	keyGen               *protocol.KeyGenerator
+	successions          []protocol.Succession
 	lanChecker           *lanChecker
 
 	dialNow           chan struct{}
@@ -187,7 +204,7 @@ type service struct {
 	listenerTokens map[string]suture.ServiceToken
 }
 
-func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *tls.Config, discoverer discover.Finder, bepProtocolName string, tlsDefaultCommonName string, evLogger events.Logger, registry *registry.Registry, keyGen *protocol.KeyGenerator) Service {
+func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *tls.Config, discoverer discover.Finder, bepProtocolName string, tlsDefaultCommonName string, evLogger events.Logger, registry *registry.Registry, keyGen *protocol.KeyGenerator, successions []protocol.Succession) Service {
 	spec := svcutil.SpecWithInfoLogger(l)
 	service := &service{
 		Supervisor:              suture.New("connections.Service", spec),
@@ -207,6 +224,7 @@ func NewService(cfg config.Wrapper, myID protocol.DeviceID, mdl Model, tlsCfg *t
 		evLogger:             evLogger,
 		registry:             registry,
 		keyGen:               keyGen,
+		successions:          successions,
 		lanChecker:           &lanChecker{cfg},
 
 		dialNowDevicesMut: sync.NewMutex(),
@@ -264,10 +282,11 @@ func (s *service) handleConns(ctx context.Context) error {
 		}
 
 		// We should have received exactly one certificate from the other
-		// side. If we didn't, they don't have a device ID and we drop the
-		// connection.
+		// side, followed by the intermediates it was issued by when
+		// organization trust is in use. If we didn't, they don't have a
+		// device ID and we drop the connection.
 		certs := cs.PeerCertificates
-		if cl := len(certs); cl != 1 {
+		if cl := len(certs); cl == 0 || cl > 1 && !s.cfg.Organization().Enabled {
 			l.Infof("Got peer certificate list of length %d != 1 from peer at %s; protocol error", cl, c)
 			c.Close()
 			continue
@@ -284,7 +303,7 @@ func (s *service) handleConns(ctx context.Context) error {
 			continue
 		}
 
-		if err := s.connectionCheckEarly(remoteID, c); err != nil {
+		if err := s.connectionCheckEarly(remoteID, remoteCert, certs[1:], c); err != nil {
 			l.Infof("Connection from %s at %s (%s) rejected: %v", remoteID, c.RemoteAddr(), c.Type(), err)
 			c.Close()
 			continue
@@ -294,23 +313,41 @@ func (s *service) handleConns(ctx context.Context) error {
 		go func() {
 			// Exchange Hello messages with the peer.
 			outgoing := s.helloForDevice(remoteID)
+			outgoing.PunchAddresses = s.punchAddresses(c)
 			incoming, err := protocol.ExchangeHello(c, outgoing)
 			// The timestamps are used to create the connection ID.
 			c.connectionID = newConnectionID(outgoing.Timestamp, incoming.Timestamp)
 
 			select {
-			case s.hellos <- &connWithHello{c, incoming, err, remoteID, remoteCert}:
+			case s.hellos <- &connWithHello{c, incoming, len(outgoing.PunchAddresses) > 0, err, remoteID, remoteCert, certs[1:]}:
 			case <-ctx.Done():
 			}
 		}()
 	}
 }
 
+// retryHelloAfter handles the connection again once the given change to
+// the configuration is done, or closes it if the change failed.
+func (s *service) retryHelloAfter(ctx context.Context, withHello *connWithHello, change func() bool) {
+	if !change() {
+		withHello.c.Close()
+		return
+	}
+	select {
+	case s.hellos <- withHello:
+	case <-ctx.Done():
+		withHello.c.Close()
+	}
+}
+
 func (s *service) helloForDevice(remoteID protocol.DeviceID) protocol.Hello {
 	hello := protocol.Hello{
 		ClientName:    "syncthing",
 		ClientVersion: build.Version,
 		Timestamp:     time.Now().UnixNano(),
+		// Devices that know us by a device ID from before a key rotation
+		// need these to recognize us.
+		Successions: s.successions,
 	}
 	if cfg, ok := s.cfg.Device(remoteID); ok {
 		hello.NumConnections = cfg.NumConnections()
@@ -323,7 +360,7 @@ func (s *service) helloForDevice(remoteID protocol.DeviceID) protocol.Hello {
 	return hello
 }
 
-func (s *service) connectionCheckEarly(remoteID protocol.DeviceID, c internalConn) error {
+func (s *service) connectionCheckEarly(remoteID protocol.DeviceID, remoteCert *x509.Certificate, intermediates []*x509.Certificate, c internalConn) error {
 	if s.cfg.IgnoredDevice(remoteID) {
 		return errDeviceIgnored
 	}
@@ -348,6 +385,10 @@ func (s *service) connectionCheckEarly(remoteID protocol.DeviceID, c internalCon
 		return errNetworkNotAllowed
 	}
 
+	if err := s.checkOrganizationTrust(remoteID, remoteCert, intermediates); err != nil {
+		return err
+	}
+
 	currentConns := s.numConnectionsForDevice(cfg.DeviceID)
 	desiredConns := s.desiredConnectionsToDevice(cfg.DeviceID)
 	worstPrio := s.worstConnectionPriority(remoteID)
@@ -364,16 +405,19 @@ func (s *service) handleHellos(ctx context.Context) error {
 	for {
 		var c internalConn
 		var hello protocol.Hello
+		var punch bool
 		var err error
 		var remoteID protocol.DeviceID
 		var remoteCert *x509.Certificate
+		var withHello *connWithHello
 
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case withHello := <-s.hellos:
+		case withHello = <-s.hellos:
 			c = withHello.c
 			hello = withHello.hello
+			punch = withHello.punch
 			err = withHello.err
 			remoteID = withHello.remoteID
 			remoteCert = withHello.remoteCert
@@ -403,6 +447,29 @@ func (s *service) handleHellos(ctx context.Context) error {
 		}
 		_ = c.SetDeadline(time.Time{})
 
+		// Unknown devices that succeed a known device after a key
+		// rotation take over its configuration, and those trusted by the
+		// organization are added, instead of ending up as pending devices.
+		// Adding a device waits for the configuration to be saved, which
+		// must not hold up the other connections here, so the connection
+		// comes back once the device is known.
+		if _, ok := s.cfg.Device(remoteID); !ok {
+			var change func() bool
+			if oldCfg, ok := s.predecessorOf(remoteID, remoteCert, withHello.intermediates, hello); ok {
+				change = func() bool {
+					return s.replacePredecessor(oldCfg, remoteID)
+				}
+			} else if s.trustedByOrganization(remoteID, remoteCert, withHello.intermediates) {
+				change = func() bool {
+					return s.addOrganizationDevice(remoteID, remoteCert, hello)
+				}
+			}
+			if change != nil {
+				go s.retryHelloAfter(ctx, withHello, change)
+				continue
+			}
+		}
+
 		// The Model will return an error for devices that we don't want to
 		// have a connection with for whatever reason, for example unknown devices.
 		if err := s.model.OnHello(remoteID, c.RemoteAddr(), hello); err != nil {
@@ -457,6 +524,12 @@ func (s *service) handleHellos(ctx context.Context) error {
 		l.Infof("Established secure connection to %s at %s", remoteID.Short(), c)
 
 		s.model.AddConnection(protoConn, hello)
+
+		// Both sides sent their punch addresses on this relayed
+		// connection, so both are now attempting to connect directly.
+		if punch && len(hello.PunchAddresses) > 0 {
+			go s.holePunch(ctx, remoteID, hello.PunchAddresses)
+		}
 		continue
 	}
 }
@@ -698,7 +771,9 @@ func (s *service) resolveDialTargets(ctx context.Context, now time.Time, cfg con
 			continue
 		}
 
-		dialer := dialerFactory.New(s.cfg.Options(), s.tlsCfg, s.registry, s.lanChecker)
+		opts := s.cfg.Options()
+		opts.ProxyURL = deviceProxyURL(opts, deviceCfg)
+		dialer := dialerFactory.New(opts, s.tlsCfg, s.registry, s.lanChecker)
 		priority := dialer.Priority(uri.Host)
 		currentConns := s.numConnectionsForDevice(deviceCfg.DeviceID)
 		if priority > priorityCutoff {
@@ -728,6 +803,15 @@ func (s *service) resolveDialTargets(ctx context.Context, now time.Time, cfg con
 	return dialTargets
 }
 
+// deviceProxyURL returns the proxy to use for connections to the given
+// device. A proxy set for the device takes precedence over the global one.
+func deviceProxyURL(opts config.OptionsConfiguration, deviceCfg config.DeviceConfiguration) string {
+	if deviceCfg.ProxyURL != "" {
+		return deviceCfg.ProxyURL
+	}
+	return opts.ProxyURL
+}
+
 func (s *service) resolveDeviceAddrs(ctx context.Context, cfg config.DeviceConfiguration) []string {
 	var addrs []string
 	for _, addr := range cfg.Addresses {
@@ -990,6 +1074,9 @@ func (s *service) ListenerStatus() map[string]ListenerStatusEntry {
 
 		status.LANAddresses = urlsToStrings(listener.LANAddresses())
 		status.WANAddresses = urlsToStrings(listener.WANAddresses())
+		if rl, ok := listener.(*relayListener); ok {
+			status.Relays = rl.RelayStatus()
+		}
 
 		result[addr] = status
 	}
@@ -1035,6 +1122,10 @@ func (s *connectionStatusHandler) setConnectionStatus(address string, err error)
 	s.connectionStatusMut.Unlock()
 }
 
+func (s *service) WebSocketHandler() http.Handler {
+	return newWebSocketHandler(s.cfg, s.tlsCfg, s.conns)
+}
+
 func (s *service) NATType() string {
 	s.listenersMut.RLock()
 	defer s.listenersMut.RUnlock()
@@ -1208,10 +1299,11 @@ func (s *service) validateIdentity(c internalConn, expectedID protocol.DeviceID)
 	cs := c.ConnectionState()
 
 	// We should have received exactly one certificate from the other
-	// side. If we didn't, they don't have a device ID and we drop the
-	// connection.
+	// side, followed by the intermediates it was issued by when
+	// organization trust is in use. If we didn't, they don't have a
+	// device ID and we drop the connection.
 	certs := cs.PeerCertificates
-	if cl := len(certs); cl != 1 {
+	if cl := len(certs); cl == 0 || cl > 1 && !s.cfg.Organization().Enabled {
 		l.Infof("Got peer certificate list of length %d != 1 from peer at %s; protocol error", cl, c)
 		c.Close()
 		return fmt.Errorf("expected 1 certificate, got %d", cl)
@@ -1234,6 +1326,11 @@ func (s *service) validateIdentity(c internalConn, expectedID protocol.DeviceID)
 		return fmt.Errorf("unexpected device id, expected %s got %s", expectedID, remoteID)
 	}
 
+	if err := s.checkOrganizationTrust(remoteID, remoteCert, certs[1:]); err != nil {
+		c.Close()
+		return err
+	}
+
 	return nil
 }
 
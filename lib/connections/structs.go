@@ -43,6 +43,7 @@ type internalConn struct {
 	priority      int
 	establishedAt time.Time
 	connectionID  string // set after Hello exchange
+	proxy         string // the proxy an outgoing connection was dialed through
 }
 
 type connType int
@@ -54,6 +55,8 @@ const (
 	connTypeTCPServer
 	connTypeQUICClient
 	connTypeQUICServer
+	connTypeWebSocketClient
+	connTypeWebSocketServer
 )
 
 func (t connType) String() string {
@@ -70,6 +73,10 @@ func (t connType) String() string {
 		return "quic-client"
 	case connTypeQUICServer:
 		return "quic-server"
+	case connTypeWebSocketClient:
+		return "websocket-client"
+	case connTypeWebSocketServer:
+		return "websocket-server"
 	default:
 		return "unknown-type"
 	}
@@ -83,6 +90,8 @@ func (t connType) Transport() string {
 		return "tcp"
 	case connTypeQUICClient, connTypeQUICServer:
 		return "quic"
+	case connTypeWebSocketClient, connTypeWebSocketServer:
+		return "websocket"
 	default:
 		return "unknown"
 	}
@@ -124,6 +133,10 @@ func (c internalConn) Crypto() string {
 	return fmt.Sprintf("%s-%s", tlsVersionNames[cs.Version], tlsCipherSuiteNames[cs.CipherSuite])
 }
 
+func (c internalConn) Proxy() string {
+	return c.proxy
+}
+
 func (c internalConn) Transport() string {
 	transport := c.connType.Transport()
 	ip, err := osutil.IPFromAddr(c.RemoteAddr())
@@ -167,6 +180,7 @@ type commonDialer struct {
 	lanPriority       int
 	wanPriority       int
 	allowsMultiConns  bool
+	proxyURL          string // as for dialer.DialContextProxy
 }
 
 func (d *commonDialer) RedialFrequency() time.Duration {
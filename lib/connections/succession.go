@@ -0,0 +1,81 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"crypto/x509"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// A device that rotated its key sends the successions signed by its earlier
+// keys in the Hello. When an unknown device proves this way that it
+// succeeds a known one, the configuration of the known device, folder
+// shares and all, is moved over to the new device ID and the old one is
+// ignored from then on. As we don't yet know the new device ID, we can't
+// connect to it; the migration happens when it connects to us.
+
+// predecessorOf returns the configuration of the known device the unknown
+// remote device succeeds, if any.
+func (s *service) predecessorOf(remoteID protocol.DeviceID, cert *x509.Certificate, intermediates []*x509.Certificate, hello protocol.Hello) (config.DeviceConfiguration, bool) {
+	if len(hello.Successions) == 0 {
+		return config.DeviceConfiguration{}, false
+	}
+	predecessors, err := protocol.VerifySuccessions(remoteID, hello.Successions)
+	if err != nil {
+		l.Infof("Device %s sent invalid successions: %v", remoteID, err)
+		return config.DeviceConfiguration{}, false
+	}
+
+	// The most recent predecessor we know of is the one to replace.
+	var oldCfg config.DeviceConfiguration
+	found := false
+	for i := len(predecessors) - 1; i >= 0; i-- {
+		if oldCfg, found = s.cfg.Device(predecessors[i]); found {
+			break
+		}
+	}
+	if !found {
+		return config.DeviceConfiguration{}, false
+	}
+	if oldCfg.OrganizationTrusted {
+		// The new certificate must be as trusted as the old one.
+		if err := s.cfg.Organization().Verify(cert, intermediates, time.Now()); err != nil {
+			l.Infof("Not replacing device %s by %s: organization trust: %v", oldCfg.DeviceID, remoteID, err)
+			return config.DeviceConfiguration{}, false
+		}
+	}
+	return oldCfg, true
+}
+
+// replacePredecessor replaces the known device by the remote device
+// succeeding it, and returns whether it did. It waits for the
+// configuration to be committed.
+func (s *service) replacePredecessor(oldCfg config.DeviceConfiguration, remoteID protocol.DeviceID) bool {
+	waiter, err := s.cfg.Modify(func(cfg *config.Configuration) {
+		if !cfg.ReplaceDevice(oldCfg.DeviceID, remoteID) {
+			return
+		}
+		// The old key is retired, and whoever still has it is not to be
+		// trusted.
+		cfg.IgnoredDevices = append(cfg.IgnoredDevices, config.ObservedDevice{
+			Time: time.Now().Truncate(time.Second),
+			ID:   oldCfg.DeviceID,
+			Name: oldCfg.Name,
+		})
+	})
+	if err != nil {
+		l.Warnf("Replacing device %s by %s: %v", oldCfg.DeviceID, remoteID, err)
+		return false
+	}
+	waiter.Wait()
+
+	l.Infof("Device %s (%q) rotated its key and is now %s", oldCfg.DeviceID, oldCfg.Name, remoteID)
+	return true
+}
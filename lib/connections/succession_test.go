@@ -0,0 +1,102 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"crypto/x509"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+func TestSuccessorReplacedOutsideHelloLoop(t *testing.T) {
+	oldCert, err := tlsutil.NewCertificateInMemory("syncthing", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldID := protocol.NewDeviceID(oldCert.Certificate[0])
+	newCert, err := tlsutil.NewCertificateInMemory("syncthing", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newID := protocol.NewDeviceID(newCert.Certificate[0])
+	newX509, err := x509.ParseCertificate(newCert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	succession, err := protocol.NewSuccession(oldCert, newID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.New(protocol.LocalDeviceID)
+	device := cfg.Defaults.Device.Copy()
+	device.DeviceID = oldID
+	device.Name = "laptop"
+	cfg.SetDevice(device)
+	wcfg := config.Wrap(filepath.Join(t.TempDir(), "config.xml"), cfg, protocol.LocalDeviceID, events.NoopLogger)
+	model := &helloRecordingModel{hellos: make(chan protocol.DeviceID, 2)}
+	s := &service{
+		cfg:    wcfg,
+		myID:   protocol.LocalDeviceID,
+		model:  model,
+		hellos: make(chan *connWithHello),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.handleHellos(ctx)
+
+	// The configuration isn't being served yet, so replacing the device
+	// blocks.
+	s.hellos <- &connWithHello{
+		c:          newHelloTestConn(t),
+		hello:      protocol.Hello{Successions: []protocol.Succession{succession}},
+		remoteID:   newID,
+		remoteCert: newX509,
+	}
+
+	// Other connections are handled in the meantime.
+	otherCert, _ := newTestCert(t, "other", false, nil, nil)
+	otherID := protocol.NewDeviceID(otherCert.Raw)
+	s.hellos <- &connWithHello{c: newHelloTestConn(t), remoteID: otherID, remoteCert: otherCert}
+	select {
+	case id := <-model.hellos:
+		if id != otherID {
+			t.Fatalf("got hello from %s, expected the other device", id)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("the hello loop is blocked")
+	}
+
+	// Once the device is replaced the connection is handled again, as a
+	// known device.
+	go wcfg.Serve(ctx)
+	select {
+	case id := <-model.hellos:
+		if id != newID {
+			t.Fatalf("got hello from %s, expected the successor", id)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("the connection wasn't handled after replacing the device")
+	}
+	if deviceCfg, ok := wcfg.Device(newID); !ok || deviceCfg.Name != "laptop" {
+		t.Errorf("unexpected device configuration %+v", deviceCfg)
+	}
+	if _, ok := wcfg.Device(oldID); ok {
+		t.Error("the predecessor is still configured")
+	}
+	if !wcfg.IgnoredDevice(oldID) {
+		t.Error("the predecessor isn't ignored")
+	}
+}
@@ -35,7 +35,7 @@ func (d *tcpDialer) Dial(ctx context.Context, _ protocol.DeviceID, uri *url.URL)
 
 	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
-	conn, err := dialer.DialContextReusePortFunc(d.registry)(timeoutCtx, uri.Scheme, uri.Host)
+	conn, err := dialer.DialContextReusePortFunc(d.registry, d.proxyURL)(timeoutCtx, uri.Scheme, uri.Host)
 	if err != nil {
 		return internalConn{}, err
 	}
@@ -63,7 +63,9 @@ func (d *tcpDialer) Dial(ctx context.Context, _ protocol.DeviceID, uri *url.URL)
 		priority = d.lanPriority
 	}
 
-	return newInternalConn(tc, connTypeTCPClient, isLocal, priority), nil
+	c := newInternalConn(tc, connTypeTCPClient, isLocal, priority)
+	c.proxy = dialer.Proxy(conn)
+	return c, nil
 }
 
 type tcpDialerFactory struct{}
@@ -78,6 +80,7 @@ func (tcpDialerFactory) New(opts config.OptionsConfiguration, tlsCfg *tls.Config
 			lanPriority:       opts.ConnectionPriorityTCPLAN,
 			wanPriority:       opts.ConnectionPriorityTCPWAN,
 			allowsMultiConns:  true,
+			proxyURL:          opts.ProxyURL,
 		},
 		registry: registry,
 	}
@@ -0,0 +1,250 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/connections/registry"
+	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func init() {
+	factory := &webSocketDialerFactory{}
+	for _, scheme := range []string{"ws", "wss"} {
+		dialers[scheme] = factory
+	}
+}
+
+type webSocketDialer struct {
+	commonDialer
+}
+
+func (d *webSocketDialer) Dial(ctx context.Context, _ protocol.DeviceID, uri *url.URL) (internalConn, error) {
+	uri = fixupWebSocketURI(uri)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	conn, proxy, err := dialWebSocketTransport(timeoutCtx, uri, d.proxyURL)
+	if err != nil {
+		return internalConn{}, err
+	}
+
+	// The websocket package has no context support for the handshake.
+	if deadline, ok := timeoutCtx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	origin := "https://" + uri.Host
+	if uri.Scheme == "ws" {
+		origin = "http://" + uri.Host
+	}
+	wsCfg, err := websocket.NewConfig(uri.String(), origin)
+	if err != nil {
+		conn.Close()
+		return internalConn{}, err
+	}
+	ws, err := websocket.NewClient(wsCfg, conn)
+	if err != nil {
+		conn.Close()
+		return internalConn{}, fmt.Errorf("websocket handshake: %w", err)
+	}
+	_ = conn.SetDeadline(time.Time{})
+
+	wc := newWebSocketConn(ws, conn.LocalAddr(), conn.RemoteAddr())
+	tc := tls.Client(wc, d.tlsCfg)
+	err = tlsTimedHandshake(tc)
+	if err != nil {
+		tc.Close()
+		return internalConn{}, err
+	}
+
+	// Through a proxy, the remote address is that of the proxy.
+	priority := d.wanPriority
+	isLocal := proxy == "" && d.lanChecker.isLAN(conn.RemoteAddr())
+	if isLocal {
+		priority = d.lanPriority
+	}
+
+	c := newInternalConn(tc, connTypeWebSocketClient, isLocal, priority)
+	c.proxy = proxy
+	return c, nil
+}
+
+// dialWebSocketTransport returns a connection to the host of the URI, over
+// which the WebSocket handshake can take place, and the proxy it goes
+// through, if any. Without a configured proxy, an HTTP proxy from the
+// environment is used as a CONNECT tunnel; otherwise the connection is made
+// as for TCP, through the configured proxy or ALL_PROXY.
+func dialWebSocketTransport(ctx context.Context, uri *url.URL, proxyURL string) (net.Conn, string, error) {
+	var httpProxy *url.URL
+	if proxyURL == "" {
+		target := &url.URL{Scheme: "https", Host: uri.Host}
+		if uri.Scheme == "ws" {
+			target.Scheme = "http"
+		}
+		var err error
+		httpProxy, err = http.ProxyFromEnvironment(&http.Request{URL: target})
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	var conn net.Conn
+	var proxy string
+	var err error
+	if httpProxy != nil {
+		conn, err = dialHTTPConnect(ctx, httpProxy, uri.Host)
+		proxy = (&url.URL{Scheme: httpProxy.Scheme, Host: httpProxy.Host}).String()
+	} else {
+		conn, err = dialer.DialContextProxy(ctx, proxyURL, "tcp", uri.Host)
+		if err == nil {
+			proxy = dialer.Proxy(conn)
+			if err := dialer.SetTCPOptions(conn); err != nil {
+				l.Debugln("Dial (BEP/websocket): setting tcp options:", err)
+			}
+		}
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	if uri.Scheme == "wss" {
+		// The certificate of whoever terminates the outer TLS, be it the
+		// other device or a reverse proxy, isn't verified. The inner TLS
+		// connection authenticates the device.
+		tc := tls.Client(conn, &tls.Config{
+			ServerName:         uri.Hostname(),
+			InsecureSkipVerify: true,
+			NextProtos:         []string{"http/1.1"},
+			MinVersion:         tls.VersionTLS12,
+		})
+		if err := tc.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, "", err
+		}
+		conn = tc
+	}
+
+	return conn, proxy, nil
+}
+
+// dialHTTPConnect returns a connection to addr, tunneled through the given
+// HTTP or HTTPS proxy.
+func dialHTTPConnect(ctx context.Context, proxyURL *url.URL, addr string) (net.Conn, error) {
+	port := 80
+	if proxyURL.Scheme == "https" {
+		port = 443
+	}
+	proxyURL = fixupPort(proxyURL, port)
+
+	conn, err := dialer.DialContext(ctx, "tcp", proxyURL.Host)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL.Scheme == "https" {
+		tc := tls.Client(conn, &tls.Config{
+			ServerName: proxyURL.Hostname(),
+			MinVersion: tls.VersionTLS12,
+		})
+		if err := tc.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tc
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		auth := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+auth)
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s: %s", proxyURL.Host, resp.Status)
+	}
+
+	_ = conn.SetDeadline(time.Time{})
+	if br.Buffered() > 0 {
+		// The tunnel has already delivered data past the response, which
+		// is still in the reader.
+		return &bufferedConn{Conn: conn, br: br}, nil
+	}
+	return conn, nil
+}
+
+type bufferedConn struct {
+	net.Conn
+	br *bufio.Reader
+}
+
+func (c *bufferedConn) Read(bs []byte) (int, error) {
+	return c.br.Read(bs)
+}
+
+type webSocketDialerFactory struct{}
+
+func (webSocketDialerFactory) New(opts config.OptionsConfiguration, tlsCfg *tls.Config, _ *registry.Registry, lanChecker *lanChecker) genericDialer {
+	return &webSocketDialer{
+		commonDialer: commonDialer{
+			trafficClass:      opts.TrafficClass,
+			reconnectInterval: time.Duration(opts.ReconnectIntervalS) * time.Second,
+			tlsCfg:            tlsCfg,
+			lanChecker:        lanChecker,
+			lanPriority:       opts.ConnectionPriorityWebSocket,
+			wanPriority:       opts.ConnectionPriorityWebSocket,
+			allowsMultiConns:  true,
+			proxyURL:          opts.ProxyURL,
+		},
+	}
+}
+
+func (webSocketDialerFactory) AlwaysWAN() bool {
+	return false
+}
+
+func (webSocketDialerFactory) Valid(_ config.Configuration) error {
+	// Always valid
+	return nil
+}
+
+func (webSocketDialerFactory) String() string {
+	return "WebSocket Dialer"
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/connections/registry"
+	"github.com/syncthing/syncthing/lib/nat"
+	"github.com/syncthing/syncthing/lib/svcutil"
+)
+
+func init() {
+	factory := &webSocketListenerFactory{}
+	for _, scheme := range []string{"ws", "wss"} {
+		listeners[scheme] = factory
+	}
+}
+
+type webSocketListener struct {
+	svcutil.ServiceWithError
+	onAddressesChangedNotifier
+
+	uri     *url.URL
+	cfg     config.Wrapper
+	tlsCfg  *tls.Config
+	conns   chan internalConn
+	factory listenerFactory
+
+	laddr net.Addr
+	mut   sync.RWMutex
+}
+
+func (t *webSocketListener) serve(ctx context.Context) error {
+	listener, err := net.Listen("tcp", t.uri.Host)
+	if err != nil {
+		l.Infoln("Listen (BEP/websocket):", err)
+		return err
+	}
+	defer listener.Close()
+
+	laddr := listener.Addr()
+
+	if t.uri.Scheme == "wss" {
+		// The outer TLS uses the device certificate for lack of a better
+		// one; it's not what authenticates us.
+		listener = tls.NewListener(listener, &tls.Config{
+			Certificates: t.tlsCfg.Certificates,
+			NextProtos:   []string{"http/1.1"},
+			MinVersion:   tls.VersionTLS12,
+		})
+	}
+
+	t.mut.Lock()
+	t.laddr = laddr
+	t.mut.Unlock()
+	defer func() {
+		t.mut.Lock()
+		t.laddr = nil
+		t.mut.Unlock()
+	}()
+
+	t.notifyAddressesChanged(t)
+	defer t.clearAddresses(t)
+
+	uri := maybeReplacePort(t.uri, laddr)
+	l.Infof("WebSocket listener (%v) starting", uri)
+	defer l.Infof("WebSocket listener (%v) shutting down", uri)
+
+	mux := http.NewServeMux()
+	mux.Handle(t.uri.Path, newWebSocketHandler(t.cfg, t.tlsCfg, t.conns))
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		ErrorLog:          log.New(io.Discard, "", 0),
+	}
+
+	serveError := make(chan error, 1)
+	go func() {
+		serveError <- srv.Serve(listener)
+	}()
+
+	select {
+	case <-ctx.Done():
+		srv.Close()
+		return nil
+	case err := <-serveError:
+		l.Infoln("Listen (BEP/websocket):", err)
+		return err
+	}
+}
+
+func (t *webSocketListener) URI() *url.URL {
+	return t.uri
+}
+
+func (t *webSocketListener) WANAddresses() []*url.URL {
+	t.mut.RLock()
+	defer t.mut.RUnlock()
+	return []*url.URL{maybeReplacePort(t.uri, t.laddr)}
+}
+
+func (t *webSocketListener) LANAddresses() []*url.URL {
+	t.mut.RLock()
+	uri := maybeReplacePort(t.uri, t.laddr)
+	t.mut.RUnlock()
+	addrs := []*url.URL{uri}
+	addrs = append(addrs, getURLsForAllAdaptersIfUnspecified("tcp", uri)...)
+	return addrs
+}
+
+func (t *webSocketListener) String() string {
+	return t.uri.String()
+}
+
+func (t *webSocketListener) Factory() listenerFactory {
+	return t.factory
+}
+
+func (*webSocketListener) NATType() string {
+	return "unknown"
+}
+
+type webSocketListenerFactory struct{}
+
+func (f *webSocketListenerFactory) New(uri *url.URL, cfg config.Wrapper, tlsCfg *tls.Config, conns chan internalConn, _ *nat.Service, _ *registry.Registry, _ *lanChecker) genericListener {
+	l := &webSocketListener{
+		uri:     fixupWebSocketURI(uri),
+		cfg:     cfg,
+		tlsCfg:  tlsCfg,
+		conns:   conns,
+		factory: f,
+	}
+	l.ServiceWithError = svcutil.AsService(l.serve, l.String())
+	return l
+}
+
+func (webSocketListenerFactory) Valid(_ config.Configuration) error {
+	// Always valid
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/syncthing/syncthing/lib/config"
+)
+
+// The WebSocket transport carries BEP, TLS and all, as binary WebSocket
+// messages. It is meant for networks that only let HTTP through, possibly
+// by way of a proxy. The outer transport, HTTP or HTTPS, is not trusted for
+// anything; the inner TLS connection authenticates the devices just as it
+// does over TCP.
+
+const webSocketDefaultPath = "/bep"
+
+// fixupWebSocketURI returns the URI with the default port for the scheme,
+// and the default path if there is none.
+func fixupWebSocketURI(uri *url.URL) *url.URL {
+	port := 443
+	if uri.Scheme == "ws" {
+		port = 80
+	}
+	uri = fixupPort(uri, port)
+	if uri.Path == "" {
+		uri.Path = webSocketDefaultPath
+	}
+	return uri
+}
+
+// webSocketConn is a WebSocket connection carrying BEP. The websocket
+// package reports the WebSocket URLs as the connection addresses, while we
+// need the device addresses for the allowed networks and LAN checks, so
+// those are kept separately. The closed channel lets the server side handler
+// wait for the connection to be closed, as the websocket package closes it
+// when the handler returns.
+type webSocketConn struct {
+	*websocket.Conn
+	localAddr  net.Addr
+	remoteAddr net.Addr
+	closeOnce  sync.Once
+	closed     chan struct{}
+}
+
+func newWebSocketConn(ws *websocket.Conn, localAddr, remoteAddr net.Addr) *webSocketConn {
+	ws.PayloadType = websocket.BinaryFrame
+	return &webSocketConn{
+		Conn:       ws,
+		localAddr:  localAddr,
+		remoteAddr: remoteAddr,
+		closed:     make(chan struct{}),
+	}
+}
+
+func (c *webSocketConn) LocalAddr() net.Addr {
+	return c.localAddr
+}
+
+func (c *webSocketConn) RemoteAddr() net.Addr {
+	return c.remoteAddr
+}
+
+func (c *webSocketConn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+	return c.Conn.Close()
+}
+
+// newWebSocketHandler returns an HTTP handler accepting BEP connections over
+// WebSocket. It serves both the WebSocket listeners and the GUI, when that
+// is set to accept BEP connections.
+func newWebSocketHandler(cfg config.Wrapper, tlsCfg *tls.Config, conns chan internalConn) http.Handler {
+	return websocket.Server{
+		// Accept any origin. Browsers have no business here, and the devices
+		// are authenticated by the inner TLS connection.
+		Handshake: func(*websocket.Config, *http.Request) error {
+			return nil
+		},
+		Handler: func(ws *websocket.Conn) {
+			req := ws.Request()
+			l.Debugln("Listen (BEP/websocket): connect from", req.RemoteAddr)
+
+			// The HTTP server may have set deadlines for reading the
+			// request, which would otherwise stay in effect.
+			_ = ws.SetDeadline(time.Time{})
+
+			remoteAddr, err := webSocketRemoteAddr(req, cfg.Options().WebSocketTrustedProxies)
+			if err != nil {
+				l.Debugln("Listen (BEP/websocket):", err)
+				return
+			}
+			localAddr, _ := req.Context().Value(http.LocalAddrContextKey).(net.Addr)
+
+			wc := newWebSocketConn(ws, localAddr, remoteAddr)
+			tc := tls.Server(wc, tlsCfg)
+			if err := tlsTimedHandshake(tc); err != nil {
+				l.Infoln("Listen (BEP/websocket): TLS handshake:", err)
+				tc.Close()
+				return
+			}
+
+			// Behind a reverse proxy every device would look like it's on
+			// the proxy's network, so these connections are never taken
+			// to be local.
+			priority := cfg.Options().ConnectionPriorityWebSocket
+			conns <- newInternalConn(tc, connTypeWebSocketServer, false, priority)
+
+			<-wc.closed
+		},
+	}
+}
+
+// webSocketRemoteAddr returns the address of the device connecting with the
+// given request. That's the address the request came from, unless it came
+// from one of the trusted proxies, in which case it's the last address in
+// X-Forwarded-For that isn't a trusted proxy itself.
+func webSocketRemoteAddr(req *http.Request, trustedProxies []string) (*net.TCPAddr, error) {
+	addr, err := net.ResolveTCPAddr("tcp", req.RemoteAddr)
+	if err != nil {
+		return nil, err
+	}
+	if len(trustedProxies) == 0 {
+		return addr, nil
+	}
+
+	var hops []string
+	for _, val := range req.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(val, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0 && IsAllowedNetwork(addr.IP.String(), trustedProxies); i-- {
+		ip := net.ParseIP(strings.TrimSpace(hops[i]))
+		if ip == nil {
+			return nil, fmt.Errorf("invalid X-Forwarded-For address %q from %v", hops[i], addr)
+		}
+		addr = &net.TCPAddr{IP: ip}
+	}
+	return addr, nil
+}
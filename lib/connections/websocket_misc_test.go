@@ -0,0 +1,67 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package connections
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestWebSocketRemoteAddr(t *testing.T) {
+	trusted := []string{"192.0.2.0/24", "2001:db8::/32"}
+	cases := []struct {
+		remoteAddr string
+		forwarded  []string
+		trusted    []string
+		expected   string // empty for an error
+	}{
+		// Without trusted proxies the header is ignored.
+		{"192.0.2.1:1234", []string{"198.51.100.7"}, nil, "192.0.2.1:1234"},
+		// The header is ignored from anyone but the trusted proxies.
+		{"203.0.113.1:1234", []string{"198.51.100.7"}, trusted, "203.0.113.1:1234"},
+		// The device is the last hop that isn't a trusted proxy.
+		{"192.0.2.1:1234", []string{"198.51.100.7"}, trusted, "198.51.100.7:0"},
+		{"192.0.2.1:1234", []string{"10.0.0.1, 198.51.100.7, 192.0.2.2"}, trusted, "198.51.100.7:0"},
+		{"192.0.2.1:1234", []string{"10.0.0.1", "198.51.100.7", "192.0.2.2"}, trusted, "198.51.100.7:0"},
+		{"[2001:db8::1]:1234", []string{"2001:db9::7"}, trusted, "[2001:db9::7]:0"},
+		// A trusted proxy without the header is the device itself.
+		{"192.0.2.1:1234", nil, trusted, "192.0.2.1:1234"},
+		// A proxy can't make it look like the connection came from
+		// itself or anywhere else with garbage.
+		{"192.0.2.1:1234", []string{"10.0.0.1, garbage"}, trusted, ""},
+		{"192.0.2.1:1234", []string{""}, trusted, ""},
+	}
+
+	for _, tc := range cases {
+		req := &http.Request{RemoteAddr: tc.remoteAddr, Header: http.Header{}}
+		for _, val := range tc.forwarded {
+			req.Header.Add("X-Forwarded-For", val)
+		}
+		addr, err := webSocketRemoteAddr(req, tc.trusted)
+		if tc.expected == "" {
+			if err == nil {
+				t.Errorf("%s %v: expected an error, got %v", tc.remoteAddr, tc.forwarded, addr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s %v: %v", tc.remoteAddr, tc.forwarded, err)
+		} else if addr.String() != tc.expected {
+			t.Errorf("%s %v: got %v, expected %v", tc.remoteAddr, tc.forwarded, addr, tc.expected)
+		}
+	}
+}
+
+func TestWebSocketConnectionsAreNotLocal(t *testing.T) {
+	// Even from the loopback address, as that's where a reverse proxy
+	// would connect from.
+	withConnectionPair(t, "ws://127.0.0.1:0", func(_, server internalConn) {
+		if server.isLocal {
+			t.Error("accepted WebSocket connection is taken to be local")
+		}
+	})
+}
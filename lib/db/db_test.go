@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/syncthing/syncthing/lib/db/backend"
 	"github.com/syncthing/syncthing/lib/events"
@@ -994,6 +995,74 @@ func TestNeedAfterDropGlobal(t *testing.T) {
 	snap.Release()
 }
 
+func TestEventJournal(t *testing.T) {
+	db := newLowlevelMemory(t)
+	defer db.Close()
+
+	base := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	evs := []events.Event{
+		{GlobalID: 1, Time: base, Type: events.StateChanged, Data: map[string]string{"folder": "a"}},
+		{GlobalID: 2, Time: base.Add(time.Hour), Type: events.RemoteChangeDetected, Data: map[string]string{"folder": "a", "modifiedBy": "foo"}},
+		{GlobalID: 3, Time: base.Add(2 * time.Hour), Type: events.StateChanged, Data: map[string]string{"folder": "b"}},
+		{GlobalID: 1, Time: base.Add(3 * time.Hour), Type: events.StateChanged, Data: map[string]string{"folder": "a"}},
+	}
+	if err := db.AppendEvents(evs); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := func(q EventJournalQuery) []int {
+		t.Helper()
+		res, err := db.JournalledEvents(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []int
+		for _, ev := range res {
+			ids = append(ids, ev.GlobalID)
+		}
+		return ids
+	}
+	all := events.Filter{Mask: events.AllEvents}
+	folderA, err := events.NewFilter(events.StateChanged, []string{"a"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		q   EventJournalQuery
+		ids []int
+	}{
+		{EventJournalQuery{Filter: all}, []int{1, 2, 3, 1}},
+		{EventJournalQuery{Filter: all, Since: base.Add(time.Hour)}, []int{2, 3, 1}},
+		{EventJournalQuery{Filter: all, Until: base.Add(2 * time.Hour)}, []int{1, 2}},
+		{EventJournalQuery{Filter: all, Limit: 2}, []int{3, 1}},
+		{EventJournalQuery{Filter: folderA}, []int{1, 1}},
+		{EventJournalQuery{Filter: events.Filter{Mask: events.RemoteChangeDetected}}, []int{2}},
+	}
+	for i, tc := range cases {
+		if got := ids(tc.q); fmt.Sprint(got) != fmt.Sprint(tc.ids) {
+			t.Errorf("case %d: got %v, expected %v", i, got, tc.ids)
+		}
+	}
+
+	res, err := db.JournalledEvents(EventJournalQuery{Filter: events.Filter{Mask: events.RemoteChangeDetected}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || !res[0].Time.Equal(evs[1].Time) || res[0].Type != events.RemoteChangeDetected {
+		t.Errorf("unexpected event %+v", res)
+	}
+
+	if n, err := db.PruneEventJournal(base.Add(2 * time.Hour)); err != nil {
+		t.Fatal(err)
+	} else if n != 2 {
+		t.Errorf("pruned %d events, expected 2", n)
+	}
+	if got := ids(EventJournalQuery{Filter: all}); fmt.Sprint(got) != "[3 1]" {
+		t.Errorf("got %v after pruning", got)
+	}
+}
+
 func numBlockLists(db *Lowlevel) (int, error) {
 	it, err := db.Backend.NewPrefixIterator([]byte{KeyTypeBlockList})
 	if err != nil {
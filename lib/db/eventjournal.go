@@ -0,0 +1,136 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package db
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+// The event journal keeps events in the database, keyed by time, so that
+// they can be looked at after a restart. Journalled events are returned as
+// they were serialized, i.e. their data is no longer the original type.
+
+const eventJournalKeyLen = 1 + 8 + 8
+
+// EventJournalQuery selects events from the journal.
+type EventJournalQuery struct {
+	// Events at or after Since and before Until; zero times are unbounded.
+	Since time.Time
+	Until time.Time
+	// Filter selects events by type, folder and device.
+	Filter events.Filter
+	// Limit is the maximum number of events returned; the most recent
+	// ones are kept. Zero means no limit.
+	Limit int
+}
+
+func eventJournalKey(t time.Time, globalID int) []byte {
+	key := make([]byte, eventJournalKeyLen)
+	key[0] = KeyTypeEventJournal
+	binary.BigEndian.PutUint64(key[1:], uint64(t.UnixNano()))
+	binary.BigEndian.PutUint64(key[9:], uint64(globalID))
+	return key
+}
+
+// AppendEvents adds the given events to the journal.
+func (db *Lowlevel) AppendEvents(evs []events.Event) error {
+	if len(evs) == 0 {
+		return nil
+	}
+	t, err := db.newReadWriteTransaction()
+	if err != nil {
+		return err
+	}
+	defer t.close()
+
+	for _, ev := range evs {
+		bs, err := json.Marshal(ev)
+		if err != nil {
+			return fmt.Errorf("marshalling event %d: %w", ev.GlobalID, err)
+		}
+		if err := t.Put(eventJournalKey(ev.Time, ev.GlobalID), bs); err != nil {
+			return err
+		}
+	}
+	return t.Commit()
+}
+
+// JournalledEvents returns the events from the journal that match the
+// query, oldest first.
+func (db *Lowlevel) JournalledEvents(q EventJournalQuery) ([]events.Event, error) {
+	first := eventJournalKey(time.Unix(0, 0), 0)
+	if !q.Since.IsZero() && q.Since.After(time.Unix(0, 0)) {
+		first = eventJournalKey(q.Since, 0)
+	}
+	last := []byte{KeyTypeEventJournal + 1}
+	if !q.Until.IsZero() {
+		last = eventJournalKey(q.Until, 0)
+	}
+	iter, err := db.NewRangeIterator(first, last)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+
+	var res []events.Event
+	for iter.Next() {
+		var ev events.Event
+		if err := json.Unmarshal(iter.Value(), &ev); err != nil {
+			l.Debugf("Skipping invalid event journal entry %x: %v", iter.Key(), err)
+			continue
+		}
+		if !q.Filter.Matches(ev) {
+			continue
+		}
+		res = append(res, ev)
+		if q.Limit > 0 && len(res) > 2*q.Limit {
+			// Keep memory in check while looking for the most recent.
+			res = append(res[:0], res[len(res)-q.Limit:]...)
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	if q.Limit > 0 && len(res) > q.Limit {
+		res = res[len(res)-q.Limit:]
+	}
+	return res, nil
+}
+
+// PruneEventJournal removes the events from before the given time from the
+// journal and returns how many were removed.
+func (db *Lowlevel) PruneEventJournal(before time.Time) (int, error) {
+	t, err := db.newReadWriteTransaction()
+	if err != nil {
+		return 0, err
+	}
+	defer t.close()
+
+	iter, err := t.NewRangeIterator([]byte{KeyTypeEventJournal}, eventJournalKey(before, 0))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Release()
+
+	removed := 0
+	for iter.Next() {
+		if err := t.Delete(iter.Key()); err != nil {
+			return 0, err
+		}
+		removed++
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+	iter.Release()
+	return removed, t.Commit()
+}
@@ -74,6 +74,9 @@ const (
 
 	// KeyTypePendingDevice <device ID in wire format> = ObservedDevice
 	KeyTypePendingDevice byte = 17
+
+	// KeyTypeEventJournal <int64 unix nanoseconds> <int64 global event ID> = JSON event
+	KeyTypeEventJournal byte = 18
 )
 
 type keyer interface {
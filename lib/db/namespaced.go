@@ -124,6 +124,23 @@ func (n NamespacedKV) Delete(key string) error {
 	return n.db.Delete(n.prefixedKey(key))
 }
 
+// IterateBytes calls fn, in key order, for each stored key that starts with
+// the given prefix, until fn returns false. The value is only valid for the
+// duration of the call.
+func (n NamespacedKV) IterateBytes(prefix string, fn func(key string, val []byte) bool) error {
+	it, err := n.db.NewPrefixIterator(n.prefixedKey(prefix))
+	if err != nil {
+		return err
+	}
+	defer it.Release()
+	for it.Next() {
+		if !fn(string(it.Key()[len(n.prefix):]), it.Value()) {
+			break
+		}
+	}
+	return it.Error()
+}
+
 func (n NamespacedKV) prefixedKey(key string) []byte {
 	return []byte(n.prefix + key)
 }
@@ -157,6 +157,52 @@ func TestNamespacedReset(t *testing.T) {
 	}
 }
 
+func TestNamespacedIterateBytes(t *testing.T) {
+	ldb := newLowlevelMemory(t)
+	defer ldb.Close()
+
+	n1 := NewNamespacedKV(ldb, "foo")
+	n2 := NewNamespacedKV(ldb, "bar")
+
+	for _, key := range []string{"a/2", "a/1", "b/1"} {
+		if err := n1.PutBytes(key, []byte(key)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := n2.PutBytes("a/3", []byte("a/3")); err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	err := n1.IterateBytes("a/", func(key string, val []byte) bool {
+		if string(val) != key {
+			t.Errorf("Incorrect value %q for key %q", val, key)
+		}
+		keys = append(keys, key)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0] != "a/1" || keys[1] != "a/2" {
+		t.Errorf("Incorrect keys %v != [a/1 a/2]", keys)
+	}
+
+	// Iteration stops when the function returns false
+
+	keys = nil
+	err = n1.IterateBytes("", func(key string, _ []byte) bool {
+		keys = append(keys, key)
+		return false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0] != "a/1" {
+		t.Errorf("Incorrect keys %v != [a/1]", keys)
+	}
+}
+
 // reset removes all entries in this namespace.
 func reset(n *NamespacedKV) {
 	tr, err := n.db.NewWriteTransaction()
@@ -65,13 +65,33 @@ func socksDialerFunction(u *url.URL, forward proxy.Dialer) (proxy.Dialer, error)
 // existing connection" shenanigans.
 type dialerConn struct {
 	net.Conn
-	addr net.Addr
+	addr  net.Addr
+	proxy string // the proxy the connection goes through, without credentials
 }
 
 func (c dialerConn) RemoteAddr() net.Addr {
 	return c.addr
 }
 
+// proxyName returns the proxy URL without credentials, for display
+func proxyName(u *url.URL) string {
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+}
+
+// environmentProxyName returns the name of the proxy set in the
+// environment, or the empty string
+func environmentProxyName() string {
+	for _, key := range []string{"ALL_PROXY", "all_proxy"} {
+		if v := os.Getenv(key); v != "" {
+			if u, err := url.Parse(v); err == nil {
+				return proxyName(u)
+			}
+			return ""
+		}
+	}
+	return ""
+}
+
 func newDialerAddr(network, addr string) net.Addr {
 	netAddr, err := net.ResolveIPAddr(network, addr)
 	if err == nil {
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/connections/registry"
@@ -21,6 +22,10 @@ import (
 
 var errUnexpectedInterfaceType = errors.New("unexpected interface type")
 
+// ProxyDirect is the proxy setting for dialing directly, ignoring any proxy
+// set in the environment.
+const ProxyDirect = "direct"
+
 // SetTCPOptions sets our default TCP options on a TCP connection, possibly
 // digging through dialerConn to extract the *net.TCPConn
 func SetTCPOptions(conn net.Conn) error {
@@ -80,7 +85,7 @@ func dialContextWithFallback(ctx context.Context, fallback proxy.ContextDialer,
 		if err != nil {
 			return nil, err
 		}
-		return dialerConn{conn, newDialerAddr(network, addr)}, nil
+		return dialerConn{conn, newDialerAddr(network, addr), environmentProxyName()}, nil
 	}
 
 	proxyDialFudgeAddress := func(ctx context.Context, network, address string) (net.Conn, error) {
@@ -88,7 +93,7 @@ func dialContextWithFallback(ctx context.Context, fallback proxy.ContextDialer,
 		if err != nil {
 			return nil, err
 		}
-		return dialerConn{conn, newDialerAddr(network, addr)}, err
+		return dialerConn{conn, newDialerAddr(network, addr), environmentProxyName()}, err
 	}
 
 	return dialTwicePreferFirst(ctx, proxyDialFudgeAddress, fallback.DialContext, "proxy", "fallback", network, addr)
@@ -101,14 +106,56 @@ func DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
 	return dialContextWithFallback(ctx, proxy.Direct, network, addr)
 }
 
+// DialContextProxy dials through the given proxy. An empty proxy URL means
+// the proxy from the environment, as for DialContext, and ProxyDirect means
+// dialing directly. Otherwise the URL is that of a SOCKS5 proxy, possibly
+// with credentials, and there is no fallback to dialing directly.
+func DialContextProxy(ctx context.Context, proxyURL, network, addr string) (net.Conn, error) {
+	switch proxyURL {
+	case "":
+		return DialContext(ctx, network, addr)
+	case ProxyDirect:
+		return (&net.Dialer{}).DialContext(ctx, network, addr)
+	}
+
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: %w", err)
+	}
+	pd, err := proxy.FromURL(u, proxy.Direct)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: %w", err)
+	}
+	dialer, ok := pd.(proxy.ContextDialer)
+	if !ok {
+		return nil, errUnexpectedInterfaceType
+	}
+	conn, err := dialer.DialContext(ctx, network, addr)
+	l.Debugf("Dialing via %s result %s %s: %v %v", proxyName(u), network, addr, conn, err)
+	if err != nil {
+		return nil, err
+	}
+	return dialerConn{conn, newDialerAddr(network, addr), proxyName(u)}, nil
+}
+
+// Proxy returns the proxy the connection was dialed through, without
+// credentials, or the empty string for a direct connection.
+func Proxy(conn net.Conn) string {
+	if conn, ok := conn.(dialerConn); ok {
+		return conn.proxy
+	}
+	return ""
+}
+
 // DialContextReusePort tries dialing via proxy if a proxy is configured, and falls back to
 // a direct connection reusing the port from the connections registry, if no proxy is defined, or connecting via proxy
 // fails. It also in parallel dials without reusing the port, just in case reusing the port affects routing decisions badly.
-func DialContextReusePortFunc(registry *registry.Registry) func(ctx context.Context, network, addr string) (net.Conn, error) {
+// The proxy URL is as for DialContextProxy.
+func DialContextReusePortFunc(registry *registry.Registry, proxyURL string) func(ctx context.Context, network, addr string) (net.Conn, error) {
 	return func(ctx context.Context, network, addr string) (net.Conn, error) {
 		// If proxy is configured, there is no point trying to reuse listen addresses.
-		if proxy.FromEnvironment() != proxy.Direct {
-			return DialContext(ctx, network, addr)
+		if proxyURL != ProxyDirect && (proxyURL != "" || proxy.FromEnvironment() != proxy.Direct) {
+			return DialContextProxy(ctx, proxyURL, network, addr)
 		}
 
 		localAddrInterface := registry.Get(network, func(addr interface{}) bool {
@@ -116,7 +163,7 @@ func DialContextReusePortFunc(registry *registry.Registry) func(ctx context.Cont
 		})
 		if localAddrInterface == nil {
 			// Nothing listening, nothing to reuse.
-			return DialContext(ctx, network, addr)
+			return DialContextProxy(ctx, proxyURL, network, addr)
 		}
 
 		laddr, ok := localAddrInterface.(*net.TCPAddr)
@@ -0,0 +1,268 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package dialer
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// socksServer is a minimal SOCKS5 proxy supporting CONNECT, with optional
+// username/password authentication.
+type socksServer struct {
+	listener net.Listener
+	user     string
+	password string
+
+	mut     sync.Mutex
+	targets []string
+}
+
+func newSOCKSServer(t *testing.T, user, password string) *socksServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &socksServer{listener: listener, user: user, password: password}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.handle(conn)
+		}
+	}()
+	return s
+}
+
+func (s *socksServer) addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *socksServer) connected() []string {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return append([]string(nil), s.targets...)
+}
+
+func (s *socksServer) handle(conn net.Conn) {
+	defer conn.Close()
+	target, err := s.negotiate(conn)
+	if err != nil {
+		return
+	}
+	upstream, err := net.Dial("tcp", target)
+	if err != nil {
+		_, _ = conn.Write([]byte{5, 5, 0, 1, 0, 0, 0, 0, 0, 0})
+		return
+	}
+	defer upstream.Close()
+	s.mut.Lock()
+	s.targets = append(s.targets, target)
+	s.mut.Unlock()
+	if _, err := conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0}); err != nil {
+		return
+	}
+	go func() {
+		_, _ = io.Copy(upstream, conn)
+		upstream.Close()
+	}()
+	_, _ = io.Copy(conn, upstream)
+}
+
+func (s *socksServer) negotiate(conn net.Conn) (string, error) {
+	buf := make([]byte, 256)
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		return "", err
+	}
+	if _, err := io.ReadFull(conn, buf[:buf[1]]); err != nil {
+		return "", err
+	}
+	method := byte(0)
+	if s.user != "" {
+		method = 2
+	}
+	if _, err := conn.Write([]byte{5, method}); err != nil {
+		return "", err
+	}
+
+	if method == 2 {
+		var user, password string
+		for _, field := range []*string{nil, &user, &password} {
+			if _, err := io.ReadFull(conn, buf[:1]); err != nil {
+				return "", err
+			}
+			if field == nil {
+				// The version of the subnegotiation
+				continue
+			}
+			n := buf[0]
+			if _, err := io.ReadFull(conn, buf[:n]); err != nil {
+				return "", err
+			}
+			*field = string(buf[:n])
+		}
+		if user != s.user || password != s.password {
+			_, _ = conn.Write([]byte{1, 1})
+			return "", errors.New("authentication failed")
+		}
+		if _, err := conn.Write([]byte{1, 0}); err != nil {
+			return "", err
+		}
+	}
+
+	if _, err := io.ReadFull(conn, buf[:4]); err != nil {
+		return "", err
+	}
+	var host string
+	switch buf[3] {
+	case 1:
+		if _, err := io.ReadFull(conn, buf[:4]); err != nil {
+			return "", err
+		}
+		host = net.IP(buf[:4]).String()
+	case 3:
+		if _, err := io.ReadFull(conn, buf[:1]); err != nil {
+			return "", err
+		}
+		n := buf[0]
+		if _, err := io.ReadFull(conn, buf[:n]); err != nil {
+			return "", err
+		}
+		host = string(buf[:n])
+	default:
+		return "", errors.New("unsupported address type")
+	}
+	if _, err := io.ReadFull(conn, buf[:2]); err != nil {
+		return "", err
+	}
+	port := binary.BigEndian.Uint16(buf[:2])
+	return net.JoinHostPort(host, strconv.Itoa(int(port))), nil
+}
+
+// newEchoServer returns the address of a server echoing what it receives.
+func newEchoServer(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func testEcho(t *testing.T, conn net.Conn) {
+	t.Helper()
+	if _, err := conn.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 5)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "hello" {
+		t.Errorf("got %q back", buf)
+	}
+}
+
+func TestDialContextProxyDirect(t *testing.T) {
+	target := newEchoServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := DialContextProxy(ctx, ProxyDirect, "tcp", target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	testEcho(t, conn)
+	if p := Proxy(conn); p != "" {
+		t.Errorf("got proxy %q for a direct connection", p)
+	}
+}
+
+func TestDialContextProxySOCKS(t *testing.T) {
+	target := newEchoServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, scheme := range []string{"socks5", "socks"} {
+		proxy := newSOCKSServer(t, "user", "pass")
+		conn, err := DialContextProxy(ctx, scheme+"://user:pass@"+proxy.addr(), "tcp", target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testEcho(t, conn)
+		conn.Close()
+
+		if targets := proxy.connected(); len(targets) != 1 || targets[0] != target {
+			t.Errorf("%s: proxy connected to %v, expected %s", scheme, targets, target)
+		}
+		// The connection is reported as being to the target, through the
+		// proxy, without the credentials.
+		if addr := conn.RemoteAddr().String(); addr != target {
+			t.Errorf("%s: remote address %s, expected %s", scheme, addr, target)
+		}
+		if p, exp := Proxy(conn), scheme+"://"+proxy.addr(); p != exp {
+			t.Errorf("%s: got proxy %q, expected %q", scheme, p, exp)
+		}
+	}
+}
+
+func TestDialContextProxyErrors(t *testing.T) {
+	target := newEchoServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	proxy := newSOCKSServer(t, "user", "pass")
+	if _, err := DialContextProxy(ctx, "socks5://user:wrong@"+proxy.addr(), "tcp", target); err == nil {
+		t.Error("expected an error with the wrong credentials")
+	}
+
+	// There is no falling back to dialing directly when the proxy isn't
+	// there.
+	unused, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := unused.Addr().String()
+	unused.Close()
+	if _, err := DialContextProxy(ctx, "socks5://"+addr, "tcp", target); err == nil {
+		t.Error("expected an error with the proxy unreachable")
+	}
+
+	for _, proxyURL := range []string{"http://" + proxy.addr(), "socks5://%zz"} {
+		if _, err := DialContextProxy(ctx, proxyURL, "tcp", target); err == nil {
+			t.Errorf("expected an error for %s", proxyURL)
+		}
+	}
+	if targets := proxy.connected(); len(targets) != 0 {
+		t.Errorf("proxy connected to %v", targets)
+	}
+}
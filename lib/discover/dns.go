@@ -0,0 +1,155 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/stringutil"
+)
+
+const (
+	dnsService = "syncthing"
+	dnsProto   = "tcp"
+)
+
+// dnsClient looks up device addresses in the DNS. For a device with a
+// discovery domain set in its configuration the records are looked up at
+// _syncthing._tcp.<domain>; for each of the configured DNS discovery
+// domains they are looked up at _syncthing._tcp.<device ID>.<domain>.
+//
+// SRV records give TCP addresses; TXT records of the form "addr=<address>"
+// give addresses of any type, one per record.
+type dnsClient struct {
+	cfg      config.Wrapper
+	resolver *net.Resolver
+}
+
+func NewDNS(cfg config.Wrapper) Finder {
+	return newDNSClient(cfg, net.DefaultResolver)
+}
+
+func newDNSClient(cfg config.Wrapper, resolver *net.Resolver) *dnsClient {
+	return &dnsClient{
+		cfg:      cfg,
+		resolver: resolver,
+	}
+}
+
+// Lookup returns the addresses published for the device in the DNS. A
+// device without any records isn't an error; the result is then cached as
+// negative by the manager like any other empty result.
+func (c *dnsClient) Lookup(ctx context.Context, device protocol.DeviceID) (addresses []string, err error) {
+	var errs []string
+	for _, name := range c.names(device) {
+		addrs, err := c.lookupName(ctx, name)
+		if err != nil {
+			l.Debugln("dnsClient.Lookup", name, err)
+			errs = append(errs, err.Error())
+			continue
+		}
+		addresses = append(addresses, addrs...)
+	}
+
+	if len(addresses) == 0 && len(errs) > 0 {
+		// Only temporary failures end up here, which should be retried
+		// after the normal negative cache time.
+		return nil, errors.New(strings.Join(errs, "; "))
+	}
+	return stringutil.UniqueTrimmedStrings(addresses), nil
+}
+
+// names returns the DNS names to look up for the given device
+func (c *dnsClient) names(device protocol.DeviceID) []string {
+	var names []string
+	prefix := fmt.Sprintf("_%s._%s.", dnsService, dnsProto)
+	if devCfg, ok := c.cfg.Device(device); ok && devCfg.DiscoveryDomain != "" {
+		names = append(names, prefix+dnsFQDN(devCfg.DiscoveryDomain))
+	}
+	label := strings.ToLower(device.String())
+	for _, domain := range c.cfg.Options().DNSDiscoveryDomains {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			names = append(names, prefix+label+"."+dnsFQDN(domain))
+		}
+	}
+	return names
+}
+
+// lookupName returns the addresses from the SRV and TXT records at the
+// given name. Nonexistent names and records are not an error.
+func (c *dnsClient) lookupName(ctx context.Context, name string) ([]string, error) {
+	var addresses []string
+
+	_, srvs, err := c.resolver.LookupSRV(ctx, "", "", name)
+	if err != nil && !isDNSNotFound(err) {
+		return nil, err
+	}
+	for _, srv := range srvs {
+		if srv.Target == "." {
+			// "Service decidedly not available at this domain", RFC 2782
+			continue
+		}
+		host := strings.TrimSuffix(srv.Target, ".")
+		addresses = append(addresses, "tcp://"+net.JoinHostPort(host, strconv.Itoa(int(srv.Port))))
+	}
+
+	txts, err := c.resolver.LookupTXT(ctx, name)
+	if err != nil && !isDNSNotFound(err) {
+		return nil, err
+	}
+	for _, txt := range txts {
+		if addr, ok := strings.CutPrefix(txt, "addr="); ok && addr != "" {
+			addresses = append(addresses, addr)
+		}
+	}
+
+	return addresses, nil
+}
+
+func (*dnsClient) Error() error {
+	return nil
+}
+
+func (*dnsClient) String() string {
+	return "DNS"
+}
+
+func (*dnsClient) Cache() map[protocol.DeviceID]CacheEntry {
+	return nil
+}
+
+func dnsFQDN(domain string) string {
+	return strings.TrimSuffix(strings.TrimSpace(domain), ".") + "."
+}
+
+func isDNSNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// dnsDiscoveryEnabled returns whether there are any domains configured for
+// DNS discovery, in which case it makes sense to run the DNS finder.
+func dnsDiscoveryEnabled(cfg config.Configuration) bool {
+	for _, domain := range cfg.Options.DNSDiscoveryDomains {
+		if strings.TrimSpace(domain) != "" {
+			return true
+		}
+	}
+	for _, dev := range cfg.Devices {
+		if dev.DiscoveryDomain != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+func TestDNSLookup(t *testing.T) {
+	device1 := protocol.DeviceID{1, 2, 3, 4}
+	device2 := protocol.DeviceID{5, 6, 7, 8}
+	device3 := protocol.DeviceID{9, 10, 11, 12}
+
+	// device1 is published under the global DNS discovery domain, device2
+	// under its own domain with a TXT address only, device3 not at all.
+	zone := map[string][]string{
+		"_syncthing._tcp." + strings.ToLower(device1.String()) + ".example.org.": {
+			"SRV 0 0 22000 host1.example.org.",
+			"SRV 10 0 22001 .",
+			`TXT "addr=quic://192.0.2.1:22000"`,
+			`TXT "unrelated"`,
+		},
+		"_syncthing._tcp.device2.example.net.": {
+			`TXT "addr=tcp://[2001:db8::2]:22000"`,
+		},
+	}
+	srv, queries := newTestDNSServer(t, zone)
+
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.Options.DNSDiscoveryDomains = []string{"example.org"}
+	dev2 := cfg.Defaults.Device.Copy()
+	dev2.DeviceID = device2
+	dev2.DiscoveryDomain = "device2.example.net"
+	cfg.Devices = append(cfg.Devices, dev2)
+	w := config.Wrap("", cfg, protocol.LocalDeviceID, events.NoopLogger)
+
+	if !dnsDiscoveryEnabled(cfg) {
+		t.Fatal("DNS discovery should be enabled")
+	}
+
+	c := newDNSClient(w, &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, srv)
+		},
+	})
+
+	cases := []struct {
+		device protocol.DeviceID
+		addrs  []string
+	}{
+		{device1, []string{"tcp://host1.example.org:22000", "quic://192.0.2.1:22000"}},
+		{device2, []string{"tcp://[2001:db8::2]:22000"}},
+		{device3, nil},
+	}
+	for _, tc := range cases {
+		addrs, err := c.Lookup(context.Background(), tc.device)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.device.Short(), err)
+			continue
+		}
+		if fmt.Sprint(addrs) != fmt.Sprint(tc.addrs) {
+			t.Errorf("%s: addresses %v != expected %v", tc.device.Short(), addrs, tc.addrs)
+		}
+	}
+
+	// Both positive and negative results are cached by the manager.
+
+	m := &manager{
+		finders: make(map[string]cachedFinder),
+		mut:     sync.NewRWMutex(),
+	}
+	m.finders[dnsIdentity()] = cachedFinder{Finder: c, cacheTime: 5 * time.Minute, negCacheTime: time.Minute, cache: newCache()}
+	for i := 0; i < 3; i++ {
+		m.Lookup(context.Background(), device1)
+		m.Lookup(context.Background(), device3)
+	}
+	before := queries.Load()
+	m.Lookup(context.Background(), device1)
+	m.Lookup(context.Background(), device3)
+	if after := queries.Load(); after != before {
+		t.Errorf("cached lookups should not cause queries, got %d more", after-before)
+	}
+}
+
+// newTestDNSServer runs a DNS server for the given zone, which is a map
+// from name to records in text form (without name, class and TTL). It
+// returns the server address and a counter of queries answered.
+func newTestDNSServer(t *testing.T, zone map[string][]string) (string, *atomic.Int64) {
+	t.Helper()
+
+	records := make(map[string][]dns.RR)
+	for name, rrs := range zone {
+		for _, s := range rrs {
+			rr, err := dns.NewRR(name + " 60 IN " + s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			records[name] = append(records[name], rr)
+		}
+	}
+
+	queries := new(atomic.Int64)
+	handler := dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+		queries.Add(1)
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		resp.Authoritative = true
+		for _, q := range req.Question {
+			rrs, ok := records[strings.ToLower(q.Name)]
+			if !ok {
+				resp.Rcode = dns.RcodeNameError
+				continue
+			}
+			for _, rr := range rrs {
+				if rr.Header().Rrtype == q.Qtype {
+					resp.Answer = append(resp.Answer, rr)
+				}
+			}
+		}
+		_ = w.WriteMsg(resp)
+	})
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{})
+	srv := &dns.Server{PacketConn: pc, Handler: handler, NotifyStartedFunc: func() { close(started) }}
+	go func() { _ = srv.ActivateAndServe() }()
+	t.Cleanup(func() { _ = srv.Shutdown() })
+	<-started
+
+	return pc.LocalAddr().String(), queries
+}
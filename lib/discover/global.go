@@ -68,6 +68,7 @@ type serverOptions struct {
 	insecure   bool   // don't check certificate
 	noAnnounce bool   // don't announce
 	noLookup   bool   // don't use for lookups
+	lookupCert bool   // present our certificate on lookups
 	id         string // expected server device ID
 }
 
@@ -102,7 +103,7 @@ func NewGlobal(server string, cert tls.Certificate, addrList AddressLister, evLo
 	// certificate depending on the insecure setting.
 	var dialContext func(ctx context.Context, network, addr string) (net.Conn, error)
 	if registry != nil {
-		dialContext = dialer.DialContextReusePortFunc(registry)
+		dialContext = dialer.DialContextReusePortFunc(registry, "")
 	} else {
 		dialContext = dialer.DialContext
 	}
@@ -124,17 +125,22 @@ func NewGlobal(server string, cert tls.Certificate, addrList AddressLister, evLo
 	}
 
 	// The http.Client used for queries. We don't need to present our
-	// certificate here, so lets not include it. May be insecure if requested.
+	// certificate here, so lets not include it, unless the server requires
+	// it to decide what we may look up. May be insecure if requested.
+	queryTLSCfg := &tls.Config{
+		InsecureSkipVerify: opts.insecure,
+		MinVersion:         tls.VersionTLS12,
+	}
+	if opts.lookupCert {
+		queryTLSCfg.Certificates = []tls.Certificate{cert}
+	}
 	var queryClient httpClient = &contextClient{&http.Client{
 		Timeout: requestTimeout,
 		Transport: http2EnabledTransport(&http.Transport{
 			DialContext:     dialer.DialContext,
 			Proxy:           http.ProxyFromEnvironment,
 			IdleConnTimeout: time.Second,
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: opts.insecure,
-				MinVersion:         tls.VersionTLS12,
-			},
+			TLSClientConfig: queryTLSCfg,
 		}),
 	}}
 	if opts.id != "" {
@@ -335,6 +341,7 @@ func parseOptions(dsn string) (server string, opts serverOptions, err error) {
 	opts.insecure = opts.id != "" || queryBool(q, "insecure")
 	opts.noAnnounce = queryBool(q, "noannounce")
 	opts.noLookup = queryBool(q, "nolookup")
+	opts.lookupCert = queryBool(q, "lookupcert")
 
 	// Check for disallowed combinations
 	if p.Scheme == "http" {
@@ -462,7 +469,12 @@ func globalDiscoveryIdentity(addr string) string {
 	return "global discovery server " + addr
 }
 
-func ipv4Identity(port int) string {
+// ipv4Identity includes whether mDNS runs alongside the IPv4 beacons, so
+// that toggling it restarts the client.
+func ipv4Identity(port int, mdns bool) string {
+	if mdns {
+		return fmt.Sprintf("IPv4 local broadcast discovery on port %d and mDNS", port)
+	}
 	return fmt.Sprintf("IPv4 local broadcast discovery on port %d", port)
 }
 
@@ -470,6 +482,10 @@ func ipv6Identity(addr string) string {
 	return fmt.Sprintf("IPv6 local multicast discovery on address %s", addr)
 }
 
+func dnsIdentity() string {
+	return "DNS discovery"
+}
+
 func http2EnabledTransport(t *http.Transport) *http.Transport {
 	_ = http2.ConfigureTransport(t)
 	return t
@@ -48,6 +48,10 @@ const (
 )
 
 func NewLocal(id protocol.DeviceID, addr string, addrList AddressLister, evLogger events.Logger) (FinderService, error) {
+	return newLocal(id, addr, addrList, evLogger)
+}
+
+func newLocal(id protocol.DeviceID, addr string, addrList AddressLister, evLogger events.Logger) (*localClient, error) {
 	c := &localClient{
 		Supervisor:      suture.New("local", svcutil.SpecWithDebugLogger(l)),
 		myID:            id,
@@ -219,6 +223,13 @@ func (c *localClient) recvAnnouncements(ctx context.Context) error {
 }
 
 func (c *localClient) registerDevice(src net.Addr, device Announce) bool {
+	return registerLocalDevice(c.cache, c.evLogger, src, device)
+}
+
+// registerLocalDevice records the addresses from a local announcement
+// received from src in the cache, and returns true if the device is new to
+// us.
+func registerLocalDevice(c *cache, evLogger events.Logger, src net.Addr, device Announce) bool {
 	// Remember whether we already had a valid cache entry for this device.
 	// If the instance ID has changed the remote device has restarted since
 	// we last heard from it, so we should treat it as a new device.
@@ -280,7 +291,7 @@ func (c *localClient) registerDevice(src net.Addr, device Announce) bool {
 	})
 
 	if isNewDevice {
-		c.evLogger.Log(events.DeviceDiscovered, map[string]interface{}{
+		evLogger.Log(events.DeviceDiscovered, map[string]interface{}{
 			"device": device.ID.String(),
 			"addrs":  validAddresses,
 		})
@@ -241,10 +241,14 @@ func (m *manager) CommitConfiguration(_, to config.Configuration) (handled bool)
 	}
 
 	if to.Options.LocalAnnEnabled {
-		toIdentities[ipv4Identity(to.Options.LocalAnnPort)] = struct{}{}
+		toIdentities[ipv4Identity(to.Options.LocalAnnPort, to.Options.LocalAnnMDNSEnabled)] = struct{}{}
 		toIdentities[ipv6Identity(to.Options.LocalAnnMCAddr)] = struct{}{}
 	}
 
+	if dnsDiscoveryEnabled(to) {
+		toIdentities[dnsIdentity()] = struct{}{}
+	}
+
 	// Remove things that we're not expected to have.
 	for identity := range m.finders {
 		if _, ok := toIdentities[identity]; !ok {
@@ -274,13 +278,17 @@ func (m *manager) CommitConfiguration(_, to config.Configuration) (handled bool)
 	}
 
 	if to.Options.LocalAnnEnabled {
-		// v4 broadcasts
-		v4Identity := ipv4Identity(to.Options.LocalAnnPort)
+		// v4 broadcasts, and mDNS over both IPv4 and IPv6 into the same
+		// cache
+		v4Identity := ipv4Identity(to.Options.LocalAnnPort, to.Options.LocalAnnMDNSEnabled)
 		if _, ok := m.finders[v4Identity]; !ok {
-			bcd, err := NewLocal(m.myID, fmt.Sprintf(":%d", to.Options.LocalAnnPort), m.addressLister, m.evLogger)
+			bcd, err := newLocal(m.myID, fmt.Sprintf(":%d", to.Options.LocalAnnPort), m.addressLister, m.evLogger)
 			if err != nil {
 				l.Warnln("IPv4 local discovery:", err)
 			} else {
+				if to.Options.LocalAnnMDNSEnabled {
+					bcd.Add(newMDNS(m.myID, m.addressLister, bcd.cache, m.evLogger))
+				}
 				m.addLocked(v4Identity, bcd, 0, 0)
 			}
 		}
@@ -297,5 +305,14 @@ func (m *manager) CommitConfiguration(_, to config.Configuration) (handled bool)
 		}
 	}
 
+	if dnsDiscoveryEnabled(to) {
+		if _, ok := m.finders[dnsIdentity()]; !ok {
+			// DNS lookups are cached the same as global discovery ones. The
+			// finder looks at the current configuration for each lookup, so
+			// it needn't be restarted when domains change.
+			m.addLocked(dnsIdentity(), NewDNS(m.cfg), 5*time.Minute, time.Minute)
+		}
+	}
+
 	return true
 }
@@ -0,0 +1,455 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/thejerf/suture/v4"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/svcutil"
+)
+
+// The mDNS client announces us as a DNS-SD service of type
+// _syncthing._tcp in the .local domain, with the device ID and addresses
+// in the TXT record, and listens for the announcements of others. It
+// complements the local discovery beacons on networks where only mDNS is
+// let through, and records what it hears in the cache of the IPv4 beacon
+// client so that both are looked up as one.
+
+const (
+	mdnsService      = "_syncthing._tcp.local."
+	mdnsPort         = 5353
+	mdnsTTL          = uint32(CacheLifeTime / time.Second)
+	mdnsCacheFlush   = 1 << 15 // top bit of the class, RFC 6762 section 10.2
+	mdnsMaxPacket    = 9000
+	mdnsMinRespDelay = time.Second
+)
+
+var (
+	mdnsIPv4Group = &net.UDPAddr{IP: net.IPv4(224, 0, 0, 251), Port: mdnsPort}
+	mdnsIPv6Group = &net.UDPAddr{IP: net.ParseIP("ff02::fb"), Port: mdnsPort}
+)
+
+type mdnsClient struct {
+	*suture.Supervisor
+	myID       protocol.DeviceID
+	addrList   AddressLister
+	evLogger   events.Logger
+	instanceID int64
+	cache      *cache
+}
+
+func newMDNS(id protocol.DeviceID, addrList AddressLister, cache *cache, evLogger events.Logger) *mdnsClient {
+	c := &mdnsClient{
+		Supervisor: suture.New("mdns", svcutil.SpecWithDebugLogger(l)),
+		myID:       id,
+		addrList:   addrList,
+		evLogger:   evLogger,
+		instanceID: rand.Int63(),
+		cache:      cache,
+	}
+
+	for _, group := range []*net.UDPAddr{mdnsIPv4Group, mdnsIPv6Group} {
+		group := group
+		c.Add(svcutil.AsService(func(ctx context.Context) error {
+			return c.serveGroup(ctx, group)
+		}, fmt.Sprintf("%s/%s", c, group)))
+	}
+
+	return c
+}
+
+func (*mdnsClient) String() string {
+	return "mDNS local"
+}
+
+// serveGroup runs mDNS on the given multicast group, until the context is
+// cancelled.
+func (c *mdnsClient) serveGroup(ctx context.Context, group *net.UDPAddr) error {
+	conn, err := listenMDNS(group)
+	if err != nil {
+		l.Debugln("mDNS listen:", err)
+		return err
+	}
+	defer conn.Close()
+
+	intfs := mdnsInterfaces()
+	joined := 0
+	for i := range intfs {
+		if err := conn.joinGroup(&intfs[i]); err != nil {
+			l.Debugln("mDNS join", group, intfs[i].Name, "failed:", err)
+			continue
+		}
+		joined++
+	}
+	if joined == 0 {
+		return errors.New("no multicast interfaces available")
+	}
+
+	type packet struct {
+		data []byte
+		src  net.Addr
+	}
+	recv := make(chan packet, 16)
+	readErr := make(chan error, 1)
+	go func() {
+		buf := make([]byte, 65536)
+		for {
+			n, src, err := conn.readFrom(buf)
+			if err != nil {
+				readErr <- err
+				return
+			}
+			data := make([]byte, n)
+			copy(data, buf)
+			select {
+			case recv <- packet{data, src}:
+			default:
+				l.Debugln("mDNS: dropping packet from", src)
+			}
+		}
+	}()
+
+	// Ask who's out there, then announce ourselves. Peers hearing the
+	// query or our announcement answer with their own.
+	c.send(conn, intfs, c.queryMsg())
+	lastAnnounce := time.Now()
+	c.announce(conn, intfs, mdnsTTL)
+
+	ticker := time.NewTicker(BroadcastInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// Interfaces come and go; keep the set current.
+			intfs = mdnsInterfaces()
+			for i := range intfs {
+				_ = conn.joinGroup(&intfs[i])
+			}
+			lastAnnounce = time.Now()
+			c.announce(conn, intfs, mdnsTTL)
+
+		case pkt := <-recv:
+			newDevice, queried := c.handlePacket(pkt.data, pkt.src)
+			if (newDevice || queried) && time.Since(lastAnnounce) > mdnsMinRespDelay {
+				lastAnnounce = time.Now()
+				c.announce(conn, intfs, mdnsTTL)
+			}
+
+		case err := <-readErr:
+			l.Debugln("mDNS read:", err)
+			return err
+
+		case <-ctx.Done():
+			// Say goodbye, so that others forget about us right away.
+			c.announce(conn, intfs, 0)
+			return ctx.Err()
+		}
+	}
+}
+
+// announce sends our service records with the given TTL on all interfaces
+func (c *mdnsClient) announce(conn mdnsConn, intfs []net.Interface, ttl uint32) {
+	addrs := c.addrList.AllAddresses()
+
+	// remove all addresses which are not dialable
+	addrs = filterUndialableLocal(addrs)
+
+	// do not leak relay tokens to discovery
+	addrs = sanitizeRelayAddresses(addrs)
+
+	if len(addrs) == 0 {
+		// Nothing to announce
+		return
+	}
+
+	for i := range intfs {
+		msg := c.announcementMsg(addrs, interfaceIPs(&intfs[i]), ttl)
+		c.send(conn, intfs[i:i+1], msg)
+	}
+}
+
+func (c *mdnsClient) send(conn mdnsConn, intfs []net.Interface, msg *dns.Msg) {
+	bs, err := msg.Pack()
+	if err != nil {
+		l.Debugln("mDNS pack:", err)
+		return
+	}
+	if len(bs) > mdnsMaxPacket {
+		l.Debugf("mDNS: packet too large (%d bytes)", len(bs))
+		return
+	}
+	for i := range intfs {
+		if err := conn.writeTo(bs, &intfs[i]); err != nil {
+			l.Debugln("mDNS write on", intfs[i].Name, "failed:", err)
+		}
+	}
+}
+
+func (*mdnsClient) queryMsg() *dns.Msg {
+	msg := new(dns.Msg)
+	msg.Question = []dns.Question{{
+		Name:   mdnsService,
+		Qtype:  dns.TypePTR,
+		Qclass: dns.ClassINET,
+	}}
+	return msg
+}
+
+// announcementMsg returns the unsolicited response announcing our service
+// instance. The instance name is the device ID, which is exactly the
+// maximum label length of 63 characters.
+func (c *mdnsClient) announcementMsg(addrs []string, ips []net.IP, ttl uint32) *dns.Msg {
+	instance := c.myID.String() + "." + mdnsService
+	host := c.myID.Short().String() + ".local."
+
+	txt := []string{
+		"id=" + c.myID.String(),
+		"instance=" + strconv.FormatInt(c.instanceID, 16),
+	}
+	var port uint16
+	for _, addr := range addrs {
+		txt = append(txt, "addr="+addr)
+		if port == 0 {
+			if u, err := url.Parse(addr); err == nil && strings.HasPrefix(u.Scheme, "tcp") {
+				p, _ := strconv.ParseUint(u.Port(), 10, 16)
+				port = uint16(p)
+			}
+		}
+	}
+
+	msg := new(dns.Msg)
+	msg.Response = true
+	msg.Authoritative = true
+	msg.Answer = []dns.RR{
+		&dns.PTR{
+			Hdr: dns.RR_Header{Name: mdnsService, Rrtype: dns.TypePTR, Class: dns.ClassINET, Ttl: ttl},
+			Ptr: instance,
+		},
+		&dns.SRV{
+			Hdr:    dns.RR_Header{Name: instance, Rrtype: dns.TypeSRV, Class: dns.ClassINET | mdnsCacheFlush, Ttl: ttl},
+			Port:   port,
+			Target: host,
+		},
+		&dns.TXT{
+			Hdr: dns.RR_Header{Name: instance, Rrtype: dns.TypeTXT, Class: dns.ClassINET | mdnsCacheFlush, Ttl: ttl},
+			Txt: txt,
+		},
+	}
+	for _, ip := range ips {
+		if ip4 := ip.To4(); ip4 != nil {
+			msg.Extra = append(msg.Extra, &dns.A{
+				Hdr: dns.RR_Header{Name: host, Rrtype: dns.TypeA, Class: dns.ClassINET | mdnsCacheFlush, Ttl: ttl},
+				A:   ip4,
+			})
+		} else {
+			msg.Extra = append(msg.Extra, &dns.AAAA{
+				Hdr:  dns.RR_Header{Name: host, Rrtype: dns.TypeAAAA, Class: dns.ClassINET | mdnsCacheFlush, Ttl: ttl},
+				AAAA: ip,
+			})
+		}
+	}
+	return msg
+}
+
+// handlePacket processes an mDNS packet from src. It returns whether a
+// new device was discovered, and whether the packet was a query for our
+// service type, as either calls for announcing ourselves.
+func (c *mdnsClient) handlePacket(data []byte, src net.Addr) (newDevice, queried bool) {
+	var msg dns.Msg
+	if err := msg.Unpack(data); err != nil {
+		l.Debugf("discover: Failed to unpack mDNS packet from %s: %v", src, err)
+		return false, false
+	}
+
+	if !msg.Response {
+		for _, q := range msg.Question {
+			if strings.EqualFold(q.Name, mdnsService) && (q.Qtype == dns.TypePTR || q.Qtype == dns.TypeANY) {
+				return false, true
+			}
+		}
+		return false, false
+	}
+
+	for _, rr := range append(msg.Answer, msg.Extra...) {
+		txt, ok := rr.(*dns.TXT)
+		if !ok || !strings.HasSuffix(strings.ToLower(txt.Hdr.Name), "."+mdnsService) {
+			continue
+		}
+		ann, ok := parseMDNSTXT(txt.Txt)
+		if !ok || ann.ID == c.myID {
+			continue
+		}
+
+		if txt.Hdr.Ttl == 0 {
+			// A goodbye; the device is going away.
+			l.Debugf("discover: Received mDNS goodbye from %s for %s", src, ann.ID)
+			c.cache.Set(ann.ID, CacheEntry{})
+			continue
+		}
+
+		l.Debugf("discover: Received mDNS announcement from %s for %s", src, ann.ID)
+		if registerLocalDevice(c.cache, c.evLogger, src, ann) {
+			newDevice = true
+		}
+	}
+	return newDevice, false
+}
+
+// parseMDNSTXT returns the announcement contained in the strings of a TXT
+// record, or false if it isn't one of ours.
+func parseMDNSTXT(txt []string) (Announce, bool) {
+	var ann Announce
+	var haveID bool
+	for _, kv := range txt {
+		key, val, ok := strings.Cut(kv, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "id":
+			id, err := protocol.DeviceIDFromString(val)
+			if err != nil {
+				return Announce{}, false
+			}
+			ann.ID = id
+			haveID = true
+		case "instance":
+			ann.InstanceID, _ = strconv.ParseInt(val, 16, 64)
+		case "addr":
+			ann.Addresses = append(ann.Addresses, val)
+		}
+	}
+	return ann, haveID
+}
+
+// mdnsInterfaces returns the interfaces that are up and multicast capable
+func mdnsInterfaces() []net.Interface {
+	intfs, err := net.Interfaces()
+	if err != nil {
+		l.Debugln("mDNS interfaces:", err)
+		return nil
+	}
+	res := intfs[:0]
+	for _, intf := range intfs {
+		if intf.Flags&net.FlagRunning == 0 || intf.Flags&net.FlagMulticast == 0 {
+			continue
+		}
+		res = append(res, intf)
+	}
+	return res
+}
+
+// interfaceIPs returns the unicast addresses of the interface
+func interfaceIPs(intf *net.Interface) []net.IP {
+	addrs, err := intf.Addrs()
+	if err != nil {
+		return nil
+	}
+	var ips []net.IP
+	for _, addr := range addrs {
+		ipn, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		if ipn.IP.IsGlobalUnicast() || ipn.IP.IsLinkLocalUnicast() {
+			ips = append(ips, ipn.IP)
+		}
+	}
+	return ips
+}
+
+// mdnsConn is the IPv4 or IPv6 multicast socket bound to the mDNS port
+type mdnsConn interface {
+	joinGroup(intf *net.Interface) error
+	readFrom(buf []byte) (int, net.Addr, error)
+	writeTo(buf []byte, intf *net.Interface) error
+	Close() error
+}
+
+func listenMDNS(group *net.UDPAddr) (mdnsConn, error) {
+	if group.IP.To4() != nil {
+		// Listening on the group address makes Go set the address reuse
+		// socket options. Whether that lets us share the port with other
+		// mDNS responders on the host depends on the platform and on how
+		// they bound it; when it doesn't, listening fails and the
+		// supervisor retries later.
+		conn, err := net.ListenPacket("udp4", group.String())
+		if err != nil {
+			return nil, err
+		}
+		pconn := ipv4.NewPacketConn(conn)
+		_ = pconn.SetMulticastTTL(255)
+		_ = pconn.SetMulticastLoopback(true)
+		return &mdnsConnV4{pconn, group}, nil
+	}
+
+	conn, err := net.ListenPacket("udp6", group.String())
+	if err != nil {
+		return nil, err
+	}
+	pconn := ipv6.NewPacketConn(conn)
+	_ = pconn.SetMulticastHopLimit(255)
+	_ = pconn.SetMulticastLoopback(true)
+	return &mdnsConnV6{pconn, group}, nil
+}
+
+type mdnsConnV4 struct {
+	*ipv4.PacketConn
+	group *net.UDPAddr
+}
+
+func (c *mdnsConnV4) joinGroup(intf *net.Interface) error {
+	return c.JoinGroup(intf, &net.UDPAddr{IP: c.group.IP})
+}
+
+func (c *mdnsConnV4) readFrom(buf []byte) (int, net.Addr, error) {
+	n, _, src, err := c.ReadFrom(buf)
+	return n, src, err
+}
+
+func (c *mdnsConnV4) writeTo(buf []byte, intf *net.Interface) error {
+	if err := c.SetMulticastInterface(intf); err != nil {
+		return err
+	}
+	_, err := c.WriteTo(buf, nil, c.group)
+	return err
+}
+
+type mdnsConnV6 struct {
+	*ipv6.PacketConn
+	group *net.UDPAddr
+}
+
+func (c *mdnsConnV6) joinGroup(intf *net.Interface) error {
+	return c.JoinGroup(intf, &net.UDPAddr{IP: c.group.IP})
+}
+
+func (c *mdnsConnV6) readFrom(buf []byte) (int, net.Addr, error) {
+	n, _, src, err := c.ReadFrom(buf)
+	return n, src, err
+}
+
+func (c *mdnsConnV6) writeTo(buf []byte, intf *net.Interface) error {
+	_, err := c.WriteTo(buf, &ipv6.ControlMessage{IfIndex: intf.Index}, c.group)
+	return err
+}
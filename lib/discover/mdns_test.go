@@ -0,0 +1,97 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package discover
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestMDNSAnnouncement(t *testing.T) {
+	sender := newMDNS(protocol.DeviceID{10, 20, 30, 40, 50, 60, 70, 80, 90}, &fakeAddressLister{}, newCache(), events.NoopLogger)
+	// The receiver records what it hears in the cache of the beacon client.
+	local, err := newLocal(protocol.LocalDeviceID, ":21027", &fakeAddressLister{}, events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receiver := newMDNS(protocol.LocalDeviceID, &fakeAddressLister{}, local.cache, events.NoopLogger)
+	src := &net.UDPAddr{IP: []byte{10, 20, 30, 40}, Port: mdnsPort}
+
+	pack := func(ttl uint32) []byte {
+		t.Helper()
+		bs, err := sender.announcementMsg(sender.addrList.AllAddresses(), []net.IP{src.IP}, ttl).Pack()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bs
+	}
+
+	// The first announcement is a new device, the second one isn't.
+
+	newDevice, queried := receiver.handlePacket(pack(120), src)
+	if !newDevice || queried {
+		t.Fatalf("first announcement: newDevice=%v, queried=%v", newDevice, queried)
+	}
+	if newDevice, _ = receiver.handlePacket(pack(120), src); newDevice {
+		t.Fatal("second announcement should not be new")
+	}
+
+	// Unspecified addresses are replaced by the source address.
+
+	addrs, _ := local.Lookup(context.Background(), sender.myID)
+	exp := []string{"tcp://10.20.30.40:22000", "tcp://192.168.0.1:22000"}
+	if fmt.Sprint(addrs) != fmt.Sprint(exp) {
+		t.Errorf("addresses %v != expected %v", addrs, exp)
+	}
+
+	// Our own announcements are ignored.
+
+	if newDevice, _ = sender.handlePacket(pack(120), src); newDevice {
+		t.Error("own announcement should be ignored")
+	}
+
+	// A goodbye removes the device.
+
+	receiver.handlePacket(pack(0), src)
+	if addrs, _ := local.Lookup(context.Background(), sender.myID); len(addrs) != 0 {
+		t.Errorf("addresses after goodbye: %v", addrs)
+	}
+
+	// A query for our service type should be answered.
+
+	bs, err := receiver.queryMsg().Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, queried = sender.handlePacket(bs, src); !queried {
+		t.Error("query should be recognized")
+	}
+}
+
+func TestParseMDNSTXT(t *testing.T) {
+	id := protocol.DeviceID{10, 20, 30, 40, 50, 60, 70, 80, 90}
+
+	ann, ok := parseMDNSTXT([]string{"id=" + id.String(), "instance=-1f", "addr=tcp://0.0.0.0:22000", "garbage", "addr=quic://0.0.0.0:22000"})
+	if !ok {
+		t.Fatal("unexpectedly not ok")
+	}
+	if ann.ID != id || ann.InstanceID != -31 || len(ann.Addresses) != 2 {
+		t.Errorf("unexpected announcement %+v", ann)
+	}
+
+	if _, ok := parseMDNSTXT([]string{"addr=tcp://0.0.0.0:22000"}); ok {
+		t.Error("TXT without ID should not be ok")
+	}
+	if _, ok := parseMDNSTXT([]string{"id=invalid"}); ok {
+		t.Error("TXT with invalid ID should not be ok")
+	}
+}
@@ -450,3 +450,38 @@ func BenchmarkLogEvent(b *testing.B) {
 		l.Log(StateChanged, nil)
 	}
 }
+
+func TestFilter(t *testing.T) {
+	dev := "AIR6LPZ-7K4PTTV-UXQSMUU-CPQ5YWH-OEDFIIQ-JUG777G-2YQXXR5-YD6AWQR"
+	filter, err := NewFilter(StateChanged|DeviceConnected, []string{"a"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	devFilter, err := NewFilter(AllEvents, nil, []string{dev})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFilter(AllEvents, nil, []string{"nope"}); err == nil {
+		t.Error("expected error for invalid device ID")
+	}
+
+	cases := []struct {
+		filter  Filter
+		ev      Event
+		matches bool
+	}{
+		{filter, Event{Type: StateChanged, Data: map[string]string{"folder": "a"}}, true},
+		{filter, Event{Type: StateChanged, Data: map[string]string{"folder": "b"}}, false},
+		{filter, Event{Type: LocalIndexUpdated, Data: map[string]string{"folder": "a"}}, false},
+		{filter, Event{Type: StateChanged, Data: "a"}, false},
+		{devFilter, Event{Type: DeviceConnected, Data: map[string]string{"id": dev}}, true},
+		{devFilter, Event{Type: DevicePaused, Data: map[string]string{"device": dev}}, true},
+		{devFilter, Event{Type: DevicePaused, Data: map[string]string{"folder": "a"}}, false},
+		{devFilter, Event{Type: Failure, Data: "oops"}, false},
+	}
+	for i, tc := range cases {
+		if got := tc.filter.Matches(tc.ev); got != tc.matches {
+			t.Errorf("%d: got %v, expected %v", i, got, tc.matches)
+		}
+	}
+}
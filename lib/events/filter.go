@@ -0,0 +1,89 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+// A Filter selects events by type and, if set, by the folders and devices
+// they concern.
+type Filter struct {
+	Mask    EventType
+	Folders map[string]struct{}
+	Devices map[protocol.DeviceID]struct{}
+}
+
+// NewFilter returns a filter for the given event types, folder IDs and
+// device IDs. Empty lists of folders or devices don't filter anything.
+func NewFilter(mask EventType, folders, devices []string) (Filter, error) {
+	filter := Filter{Mask: mask}
+	if len(folders) > 0 {
+		filter.Folders = make(map[string]struct{}, len(folders))
+		for _, folder := range folders {
+			filter.Folders[strings.TrimSpace(folder)] = struct{}{}
+		}
+	}
+	if len(devices) > 0 {
+		filter.Devices = make(map[protocol.DeviceID]struct{}, len(devices))
+		for _, device := range devices {
+			id, err := protocol.DeviceIDFromString(strings.TrimSpace(device))
+			if err != nil {
+				return Filter{}, fmt.Errorf("device: %w", err)
+			}
+			filter.Devices[id] = struct{}{}
+		}
+	}
+	return filter, nil
+}
+
+// Matches returns whether the event passes the filter. The folder and device
+// are taken from the "folder" and "device" (or "id") attributes of the event
+// data; events without them don't pass folder or device filters.
+func (f Filter) Matches(ev Event) bool {
+	if ev.Type&f.Mask == 0 {
+		return false
+	}
+	if f.Folders == nil && f.Devices == nil {
+		return true
+	}
+
+	bs, err := json.Marshal(ev.Data)
+	if err != nil {
+		return false
+	}
+	var attrs map[string]interface{}
+	if err := json.Unmarshal(bs, &attrs); err != nil {
+		// Not an object, so neither folder nor device
+		return false
+	}
+
+	if f.Folders != nil {
+		folder, _ := attrs["folder"].(string)
+		if _, ok := f.Folders[folder]; !ok {
+			return false
+		}
+	}
+	if f.Devices != nil {
+		device, ok := attrs["device"].(string)
+		if !ok {
+			device, _ = attrs["id"].(string)
+		}
+		id, err := protocol.DeviceIDFromString(device)
+		if err != nil {
+			return false
+		}
+		if _, ok := f.Devices[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -23,15 +23,18 @@ type LocationEnum string
 // more meaningful.
 const (
 	ConfigFile    LocationEnum = "config"
+	ConfigHistory LocationEnum = "configHistory"
 	CertFile      LocationEnum = "certFile"
 	KeyFile       LocationEnum = "keyFile"
 	HTTPSCertFile LocationEnum = "httpsCertFile"
 	HTTPSKeyFile  LocationEnum = "httpsKeyFile"
+	Successions   LocationEnum = "successions"
 	Database      LocationEnum = "database"
 	LogFile       LocationEnum = "logFile"
 	CsrfTokens    LocationEnum = "csrfTokens"
 	PanicLog      LocationEnum = "panicLog"
 	AuditLog      LocationEnum = "auditLog"
+	AuditTrail    LocationEnum = "auditTrail"
 	GUIAssets     LocationEnum = "guiAssets"
 	DefFolder     LocationEnum = "defFolder"
 )
@@ -115,15 +118,18 @@ func GetBaseDir(baseDir BaseDirEnum) string {
 // Use the variables from baseDirs here
 var locationTemplates = map[LocationEnum]string{
 	ConfigFile:    "${config}/config.xml",
+	ConfigHistory: "${config}/config-history",
 	CertFile:      "${config}/cert.pem",
 	KeyFile:       "${config}/key.pem",
 	HTTPSCertFile: "${config}/https-cert.pem",
 	HTTPSKeyFile:  "${config}/https-key.pem",
+	Successions:   "${config}/successions.json",
 	Database:      "${data}/" + LevelDBDir,
 	LogFile:       "${data}/syncthing.log", // --logfile on Windows
 	CsrfTokens:    "${data}/csrftokens.txt",
 	PanicLog:      "${data}/panic-%{timestamp}.log",
 	AuditLog:      "${data}/audit-%{timestamp}.log",
+	AuditTrail:    "${data}/audit-trail.jsonl", // kept, unlike the audit logs
 	GUIAssets:     "${config}/gui",
 	DefFolder:     "${userHome}/Sync",
 }
@@ -165,7 +171,9 @@ func ListExpandedPaths() map[string]string {
 func PrettyPaths() string {
 	var b strings.Builder
 	fmt.Fprintf(&b, "Configuration file:\n\t%s\n\n", Get(ConfigFile))
+	fmt.Fprintf(&b, "Configuration history directory:\n\t%s\n\n", Get(ConfigHistory))
 	fmt.Fprintf(&b, "Device private key & certificate files:\n\t%s\n\t%s\n\n", Get(KeyFile), Get(CertFile))
+	fmt.Fprintf(&b, "Device key successions file:\n\t%s\n\n", Get(Successions))
 	fmt.Fprintf(&b, "GUI / API HTTPS private key & certificate files:\n\t%s\n\t%s\n\n", Get(HTTPSKeyFile), Get(HTTPSCertFile))
 	fmt.Fprintf(&b, "Database location:\n\t%s\n\n", Get(Database))
 	fmt.Fprintf(&b, "Log file:\n\t%s\n\n", Get(LogFile))
@@ -731,6 +731,7 @@ type ConnectionInfo struct {
 	Type    string `json:"type"`
 	IsLocal bool   `json:"isLocal"`
 	Crypto  string `json:"crypto"`
+	Proxy   string `json:"proxy,omitempty"`
 }
 
 // ConnectionStats returns a map with connection statistics for each device.
@@ -762,6 +763,7 @@ func (m *model) ConnectionStats() map[string]interface{} {
 			cs.Primary.Type = conn.Type()
 			cs.Primary.IsLocal = conn.IsLocal()
 			cs.Primary.Crypto = conn.Crypto()
+			cs.Primary.Proxy = conn.Proxy()
 			cs.Primary.Statistics = conn.Statistics()
 			cs.Primary.Address = conn.RemoteAddr().String()
 
@@ -779,6 +781,7 @@ func (m *model) ConnectionStats() map[string]interface{} {
 					Type:       conn.Type(),
 					IsLocal:    conn.IsLocal(),
 					Crypto:     conn.Crypto(),
+					Proxy:      conn.Proxy(),
 				}
 				if sec.At.After(cs.At) {
 					cs.At = sec.At
@@ -0,0 +1,383 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package pcp implements port mapping through the Port Control Protocol,
+// RFC 6887.
+package pcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/jackpal/gateway"
+
+	"github.com/syncthing/syncthing/lib/nat"
+	"github.com/syncthing/syncthing/lib/osutil"
+	"github.com/syncthing/syncthing/lib/rand"
+	"github.com/syncthing/syncthing/lib/svcutil"
+)
+
+func init() {
+	nat.Register(Discover)
+}
+
+// Clients are kept across discoveries, as they hold the mappings they
+// renew and the server epoch they compare against.
+var (
+	clientsMut sync.Mutex
+	clients    = make(map[string]*client)
+)
+
+func Discover(ctx context.Context, renewal, timeout time.Duration) []nat.Device {
+	var ip net.IP
+	err := svcutil.CallWithContext(ctx, func() error {
+		var err error
+		ip, err = gateway.DiscoverGateway()
+		return err
+	})
+	if err != nil {
+		l.Debugln("Failed to discover gateway", err)
+		return nil
+	}
+	if ip == nil || ip.IsUnspecified() {
+		return nil
+	}
+
+	l.Debugln("Discovered gateway at", ip)
+
+	addr := &net.UDPAddr{IP: ip, Port: serverPort}
+	clientsMut.Lock()
+	c, ok := clients[addr.String()]
+	if !ok {
+		c = newClient(addr)
+		clients[addr.String()] = c
+	}
+	clientsMut.Unlock()
+
+	// Try contacting the gateway, if it does not respond or responds with
+	// an error, assume it does not speak PCP.
+	if err := c.announce(ctx, renewal, timeout); err != nil {
+		l.Debugln("PCP announce failed, assuming no PCP available:", err)
+		return nil
+	}
+
+	return []nat.Device{c}
+}
+
+type mappingKey struct {
+	protocol     nat.Protocol
+	internalPort int
+}
+
+type mapping struct {
+	nonce        [nonceSize]byte
+	externalPort int
+	expires      time.Time // when the mapping was requested to last until
+	granted      time.Time // when the lifetime granted by the server ends
+	timer        *time.Timer
+}
+
+// client talks PCP to one server. It implements nat.Device.
+//
+// The server may grant a shorter lifetime than requested, in which case the
+// client renews the mapping by itself until the requested lifetime has
+// passed. It also tracks the server epoch; when that shows the server has
+// lost its state, for example by rebooting, all mappings are recreated.
+type client struct {
+	server *net.UDPAddr
+
+	mut        sync.Mutex
+	renewal    time.Duration
+	timeout    time.Duration
+	localIP    net.IP
+	externalIP net.IP
+	mappings   map[mappingKey]*mapping
+
+	haveEpoch   bool
+	serverEpoch uint32
+	clientEpoch time.Time
+}
+
+func newClient(server *net.UDPAddr) *client {
+	return &client{
+		server:   server,
+		timeout:  10 * time.Second,
+		mappings: make(map[mappingKey]*mapping),
+	}
+}
+
+func (c *client) ID() string {
+	return fmt.Sprintf("PCP@%s", c.server.IP)
+}
+
+func (c *client) GetLocalIPAddress() net.IP {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.localIP
+}
+
+func (c *client) AddPortMapping(ctx context.Context, protocol nat.Protocol, internalPort, externalPort int, _ string, duration time.Duration) (int, error) {
+	// As with NAT-PMP, a zero lifetime deletes the mapping. Swap it with
+	// the renewal interval, which should make the lease last until the
+	// next call.
+	if duration == 0 {
+		c.mut.Lock()
+		duration = c.renewal
+		c.mut.Unlock()
+	}
+	return c.mapPort(ctx, mappingKey{protocol, internalPort}, externalPort, duration)
+}
+
+// GetExternalIPAddress returns the external address assigned in the latest
+// mapping, as PCP has no separate request for it.
+func (c *client) GetExternalIPAddress(_ context.Context) (net.IP, error) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if c.externalIP == nil {
+		return nil, errors.New("no external address known")
+	}
+	return c.externalIP, nil
+}
+
+// announce checks that the server speaks PCP, and updates the settings
+// that come from the NAT service.
+func (c *client) announce(ctx context.Context, renewal, timeout time.Duration) error {
+	c.mut.Lock()
+	c.renewal = renewal
+	if timeout > 0 {
+		c.timeout = timeout
+	}
+	c.mut.Unlock()
+
+	_, err := c.request(ctx, opAnnounce, 0, nil)
+	return err
+}
+
+// mapPort requests a mapping of the internal port, suggesting the given
+// external port, and returns the external port that was assigned.
+func (c *client) mapPort(ctx context.Context, key mappingKey, externalPort int, lifetime time.Duration) (int, error) {
+	c.mut.Lock()
+	m, ok := c.mappings[key]
+	if !ok {
+		// The nonce identifies the mapping to the server. Reusing it makes
+		// later requests renew the existing mapping.
+		m = &mapping{}
+		rand.Read(m.nonce[:])
+		c.mappings[key] = m
+	}
+	nonce := m.nonce
+	c.mut.Unlock()
+
+	req := mapPayload{
+		nonce:        nonce,
+		protocol:     protocolNumber(key.protocol),
+		internalPort: uint16(key.internalPort),
+		externalPort: uint16(externalPort),
+	}
+	resp, err := c.request(ctx, opMap, uint32(lifetime/time.Second), req.marshal())
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := parseMapPayload(resp.payload)
+	if err != nil {
+		return 0, err
+	}
+	if res.nonce != nonce || res.protocol != req.protocol || res.internalPort != req.internalPort {
+		return 0, errMalformedResponse
+	}
+
+	now := time.Now()
+	c.mut.Lock()
+	port := int(res.externalPort)
+	m.externalPort = port
+	m.expires = now.Add(lifetime)
+	m.granted = now.Add(time.Duration(resp.lifetime) * time.Second)
+	c.externalIP = res.externalIP
+	c.scheduleRenewalLocked(key, m)
+	c.mut.Unlock()
+
+	l.Debugf("Mapped %s %d -> %s:%d on %s for %ds", key.protocol, key.internalPort, res.externalIP, res.externalPort, c.ID(), resp.lifetime)
+
+	return port, nil
+}
+
+// scheduleRenewalLocked makes sure the mapping is renewed at half the
+// granted lifetime, if that ends before the requested lifetime does.
+func (c *client) scheduleRenewalLocked(key mappingKey, m *mapping) {
+	if m.timer != nil {
+		m.timer.Stop()
+		m.timer = nil
+	}
+	if !m.granted.Before(m.expires) {
+		return
+	}
+	m.timer = time.AfterFunc(time.Until(m.granted)/2, func() {
+		c.renew(key, m)
+	})
+}
+
+func (c *client) renew(key mappingKey, m *mapping) {
+	c.mut.Lock()
+	if c.mappings[key] != m {
+		c.mut.Unlock()
+		return
+	}
+	lifetime := time.Until(m.expires)
+	externalPort := m.externalPort
+	timeout := c.timeout
+	c.mut.Unlock()
+
+	if lifetime < time.Second {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if _, err := c.mapPort(ctx, key, externalPort, lifetime); err != nil {
+		l.Debugf("Failed to renew %s %d on %s: %v", key.protocol, key.internalPort, c.ID(), err)
+
+		// Try again while the granted lifetime lasts.
+		c.mut.Lock()
+		if c.mappings[key] == m && time.Until(m.granted) > 2*time.Second {
+			m.timer = time.AfterFunc(time.Until(m.granted)/2, func() {
+				c.renew(key, m)
+			})
+		}
+		c.mut.Unlock()
+	}
+}
+
+// remapAll recreates all mappings that should still exist, after the
+// server has lost its state.
+func (c *client) remapAll() {
+	c.mut.Lock()
+	keys := make([]mappingKey, 0, len(c.mappings))
+	for key, m := range c.mappings {
+		if time.Until(m.expires) >= time.Second {
+			keys = append(keys, key)
+		}
+	}
+	c.mut.Unlock()
+
+	for _, key := range keys {
+		c.mut.Lock()
+		m := c.mappings[key]
+		c.mut.Unlock()
+		c.renew(key, m)
+	}
+}
+
+// request sends a request to the server, retransmitting until there is a
+// response or the timeout passes, and returns the successful response.
+func (c *client) request(ctx context.Context, opcode uint8, lifetime uint32, payload []byte) (response, error) {
+	c.mut.Lock()
+	timeout := c.timeout
+	c.mut.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "udp", c.server.String())
+	if err != nil {
+		return response{}, err
+	}
+	defer conn.Close()
+
+	localIP, err := osutil.IPFromAddr(conn.LocalAddr())
+	if err != nil {
+		return response{}, err
+	}
+	c.mut.Lock()
+	c.localIP = localIP
+	c.mut.Unlock()
+
+	bs := request{
+		opcode:   opcode,
+		lifetime: lifetime,
+		clientIP: localIP,
+		payload:  payload,
+	}.marshal()
+
+	go func() {
+		<-ctx.Done()
+		conn.SetDeadline(time.Now())
+	}()
+
+	// Retransmit with increasing intervals, as in RFC 6887 section 8.1.1
+	// but starting lower; the server is on the local network.
+	interval := 250 * time.Millisecond
+	buf := make([]byte, 1100) // the maximum PCP message size
+	for {
+		if _, err := conn.Write(bs); err != nil {
+			return response{}, err
+		}
+		conn.SetReadDeadline(time.Now().Add(interval))
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				if ctx.Err() != nil {
+					return response{}, ctx.Err()
+				}
+				var netErr net.Error
+				if errors.As(err, &netErr) && netErr.Timeout() {
+					break
+				}
+				return response{}, err
+			}
+
+			resp, err := parseResponse(buf[:n])
+			if err != nil {
+				return response{}, err
+			}
+			if resp.opcode != opcode {
+				// A response to something else; keep waiting.
+				continue
+			}
+			c.checkEpoch(resp.epoch)
+			if resp.result != 0 {
+				return response{}, resultCode(resp.result)
+			}
+			return resp, nil
+		}
+		if interval < 4*time.Second {
+			interval *= 2
+		}
+	}
+}
+
+// checkEpoch compares the server epoch with the previous one, as described
+// in RFC 6887 section 8.5, and recreates the mappings if the server appears
+// to have lost them.
+func (c *client) checkEpoch(serverEpoch uint32) {
+	now := time.Now()
+	c.mut.Lock()
+	lost := c.haveEpoch && !epochValid(c.serverEpoch, serverEpoch, now.Sub(c.clientEpoch))
+	c.haveEpoch = true
+	c.serverEpoch = serverEpoch
+	c.clientEpoch = now
+	c.mut.Unlock()
+
+	if lost {
+		l.Debugln("Epoch of", c.ID(), "changed; recreating mappings")
+		go c.remapAll()
+	}
+}
+
+// epochValid returns whether the server epoch may follow the previous one,
+// given how much time passed for the client in between.
+func epochValid(prevEpoch, epoch uint32, elapsed time.Duration) bool {
+	if epoch+1 < prevEpoch {
+		return false
+	}
+	clientDelta := int64(elapsed / time.Second)
+	serverDelta := int64(epoch) - int64(prevEpoch)
+	return clientDelta+2 >= serverDelta-serverDelta/16 && serverDelta+2 >= clientDelta-clientDelta/16
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package pcp
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/nat"
+)
+
+// fakeServer is a minimal PCP server, answering ANNOUNCE and MAP requests
+type fakeServer struct {
+	conn *net.UDPConn
+
+	mut         sync.Mutex
+	started     time.Time
+	epochOffset uint32
+	maxLifetime uint32
+	result      uint8
+	nextPort    uint16
+	ports       map[[nonceSize]byte]uint16
+	mapRequests []mapPayload
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	t.Helper()
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeServer{
+		conn:        conn,
+		started:     time.Now(),
+		epochOffset: 1000,
+		nextPort:    40000,
+		ports:       make(map[[nonceSize]byte]uint16),
+	}
+	t.Cleanup(func() { conn.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *fakeServer) addr() *net.UDPAddr {
+	return s.conn.LocalAddr().(*net.UDPAddr)
+}
+
+// restart makes the server forget its mappings and restart its epoch
+func (s *fakeServer) restart() {
+	s.mut.Lock()
+	s.started = time.Now()
+	s.epochOffset = 0
+	s.ports = make(map[[nonceSize]byte]uint16)
+	s.mut.Unlock()
+}
+
+func (s *fakeServer) requests() []mapPayload {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return append([]mapPayload(nil), s.mapRequests...)
+}
+
+func (s *fakeServer) serve() {
+	buf := make([]byte, 1100)
+	for {
+		n, from, err := s.conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		req := buf[:n]
+		if n < headerSize || req[0] != version {
+			continue
+		}
+
+		s.mut.Lock()
+		opcode := req[1]
+		lifetime := binary.BigEndian.Uint32(req[4:])
+		if s.maxLifetime > 0 && lifetime > s.maxLifetime {
+			lifetime = s.maxLifetime
+		}
+		var payload []byte
+		if opcode == opMap {
+			p, err := parseMapPayload(req[headerSize:])
+			if err != nil {
+				s.mut.Unlock()
+				continue
+			}
+			s.mapRequests = append(s.mapRequests, p)
+			port, ok := s.ports[p.nonce]
+			if !ok {
+				port = s.nextPort
+				s.nextPort++
+				s.ports[p.nonce] = port
+			}
+			p.externalPort = port
+			p.externalIP = net.IPv4(192, 0, 2, 1)
+			payload = p.marshal()
+		}
+
+		resp := make([]byte, headerSize+len(payload))
+		resp[0] = version
+		resp[1] = opcode | opResponse
+		resp[3] = s.result
+		binary.BigEndian.PutUint32(resp[4:], lifetime)
+		binary.BigEndian.PutUint32(resp[8:], s.epochOffset+uint32(time.Since(s.started)/time.Second))
+		copy(resp[headerSize:], payload)
+		s.mut.Unlock()
+
+		s.conn.WriteToUDP(resp, from)
+	}
+}
+
+func newTestClient(s *fakeServer) *client {
+	c := newClient(s.addr())
+	c.timeout = time.Second
+	return c
+}
+
+func TestMap(t *testing.T) {
+	s := newFakeServer(t)
+	c := newTestClient(s)
+	ctx := context.Background()
+
+	if err := c.announce(ctx, time.Minute, time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	port, err := c.AddPortMapping(ctx, nat.TCP, 22000, 12345, "", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if port != 40000 {
+		t.Errorf("assigned port %d != 40000", port)
+	}
+	ip, err := c.GetExternalIPAddress(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ip.Equal(net.IPv4(192, 0, 2, 1)) {
+		t.Errorf("external address %v != 192.0.2.1", ip)
+	}
+	if !c.GetLocalIPAddress().Equal(net.IPv4(127, 0, 0, 1)) {
+		t.Errorf("local address %v != 127.0.0.1", c.GetLocalIPAddress())
+	}
+
+	// Mapping again is a renewal of the same mapping, with the same nonce.
+
+	if port, err := c.AddPortMapping(ctx, nat.TCP, 22000, 40000, "", time.Hour); err != nil || port != 40000 {
+		t.Fatal("renewal failed", port, err)
+	}
+	reqs := s.requests()
+	if len(reqs) != 2 || reqs[0].nonce != reqs[1].nonce {
+		t.Fatal("renewal should reuse the nonce")
+	}
+	if reqs[0].protocol != protocolTCP || reqs[0].internalPort != 22000 || reqs[0].externalPort != 12345 {
+		t.Errorf("unexpected request %+v", reqs[0])
+	}
+
+	// A different protocol is a different mapping.
+
+	if port, err := c.AddPortMapping(ctx, nat.UDP, 22000, 0, "", time.Hour); err != nil || port != 40001 {
+		t.Fatal("UDP mapping failed", port, err)
+	}
+}
+
+func TestError(t *testing.T) {
+	s := newFakeServer(t)
+	s.mut.Lock()
+	s.result = 8 // NO_RESOURCES
+	s.mut.Unlock()
+	c := newTestClient(s)
+
+	_, err := c.AddPortMapping(context.Background(), nat.TCP, 22000, 0, "", time.Hour)
+	var code resultCode
+	if !errors.As(err, &code) || code != 8 {
+		t.Fatalf("expected NO_RESOURCES, got %v", err)
+	}
+}
+
+func TestNoServer(t *testing.T) {
+	// Nobody answers on the port; the request times out.
+
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	c := newClient(conn.LocalAddr().(*net.UDPAddr))
+	if err := c.announce(context.Background(), time.Minute, 500*time.Millisecond); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestLeaseRenewal(t *testing.T) {
+	// The server grants two seconds, so the client should renew after
+	// about one.
+
+	s := newFakeServer(t)
+	s.mut.Lock()
+	s.maxLifetime = 2
+	s.mut.Unlock()
+	c := newTestClient(s)
+
+	if _, err := c.AddPortMapping(context.Background(), nat.TCP, 22000, 0, "", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	waitForRequests(t, s, 3)
+}
+
+func TestEpochChange(t *testing.T) {
+	s := newFakeServer(t)
+	c := newTestClient(s)
+	ctx := context.Background()
+
+	if _, err := c.AddPortMapping(ctx, nat.TCP, 22000, 0, "", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.AddPortMapping(ctx, nat.TCP, 22001, 0, "", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	// After the server restarts, the next response shows the new epoch and
+	// the client recreates both existing mappings.
+
+	s.restart()
+	if err := c.announce(ctx, time.Minute, time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := waitForRequests(t, s, 4)
+	remapped := make(map[uint16]bool)
+	for _, req := range reqs[2:] {
+		remapped[req.internalPort] = true
+	}
+	if !remapped[22000] || !remapped[22001] {
+		t.Errorf("mappings not recreated: %v", remapped)
+	}
+}
+
+func TestEpochValidity(t *testing.T) {
+	cases := []struct {
+		epoch   uint32
+		elapsed time.Duration
+		valid   bool
+	}{
+		{1000, 0, true},
+		{1001, 0, true},
+		{999, 0, true},
+		{900, 0, false},
+		{1100, 0, false},
+		{1100, 100 * time.Second, true},
+		{1000, 100 * time.Second, false},
+	}
+	for _, tc := range cases {
+		if valid := epochValid(1000, tc.epoch, tc.elapsed); valid != tc.valid {
+			t.Errorf("epoch %d after %v: valid %v != expected %v", tc.epoch, tc.elapsed, valid, tc.valid)
+		}
+	}
+}
+
+func waitForRequests(t *testing.T, s *fakeServer, n int) []mapPayload {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if reqs := s.requests(); len(reqs) >= n {
+			return reqs
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatalf("expected %d map requests, got %d", n, len(s.requests()))
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package pcp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/syncthing/syncthing/lib/nat"
+)
+
+// Wire format of RFC 6887. All requests and responses start with a 24 byte
+// header, followed by the opcode specific payload. We don't send or
+// understand any options.
+
+const (
+	version = 2
+	// The PCP server listens on the same port as NAT-PMP.
+	serverPort = 5351
+
+	opAnnounce = 0
+	opMap      = 1
+	opResponse = 0x80
+
+	headerSize     = 24
+	mapPayloadSize = 36
+	nonceSize      = 12
+
+	protocolTCP = 6
+	protocolUDP = 17
+)
+
+var errMalformedResponse = errors.New("malformed PCP response")
+
+// A resultCode is a non-success result from the PCP server
+type resultCode uint8
+
+var resultCodeNames = []string{
+	"SUCCESS",
+	"UNSUPP_VERSION",
+	"NOT_AUTHORIZED",
+	"MALFORMED_REQUEST",
+	"UNSUPP_OPCODE",
+	"UNSUPP_OPTION",
+	"MALFORMED_OPTION",
+	"NETWORK_FAILURE",
+	"NO_RESOURCES",
+	"UNSUPP_PROTOCOL",
+	"USER_EX_QUOTA",
+	"CANNOT_PROVIDE_EXTERNAL",
+	"ADDRESS_MISMATCH",
+	"EXCESSIVE_REMOTE_PEERS",
+}
+
+func (c resultCode) Error() string {
+	if int(c) < len(resultCodeNames) {
+		return fmt.Sprintf("PCP error %d (%s)", c, resultCodeNames[c])
+	}
+	return fmt.Sprintf("PCP error %d", c)
+}
+
+const resultUnsupportedVersion resultCode = 1
+
+type request struct {
+	opcode   uint8
+	lifetime uint32
+	clientIP net.IP
+	payload  []byte
+}
+
+func (r request) marshal() []byte {
+	bs := make([]byte, headerSize+len(r.payload))
+	bs[0] = version
+	bs[1] = r.opcode
+	binary.BigEndian.PutUint32(bs[4:], r.lifetime)
+	copy(bs[8:24], r.clientIP.To16())
+	copy(bs[headerSize:], r.payload)
+	return bs
+}
+
+type response struct {
+	opcode   uint8
+	result   uint8
+	lifetime uint32
+	epoch    uint32
+	payload  []byte
+}
+
+func parseResponse(bs []byte) (response, error) {
+	if len(bs) < 4 {
+		return response{}, errMalformedResponse
+	}
+	if bs[0] != version {
+		// A NAT-PMP only server answers with its own version and
+		// UNSUPP_VERSION.
+		return response{}, resultUnsupportedVersion
+	}
+	if len(bs) < headerSize || bs[1]&opResponse == 0 {
+		return response{}, errMalformedResponse
+	}
+	return response{
+		opcode:   bs[1] &^ opResponse,
+		result:   bs[3],
+		lifetime: binary.BigEndian.Uint32(bs[4:]),
+		epoch:    binary.BigEndian.Uint32(bs[8:]),
+		payload:  bs[headerSize:],
+	}, nil
+}
+
+// mapPayload is the payload of MAP requests and responses. In a request the
+// external port and address are suggestions, in a response they are what
+// the server assigned.
+type mapPayload struct {
+	nonce        [nonceSize]byte
+	protocol     uint8
+	internalPort uint16
+	externalPort uint16
+	externalIP   net.IP
+}
+
+func (p mapPayload) marshal() []byte {
+	bs := make([]byte, mapPayloadSize)
+	copy(bs, p.nonce[:])
+	bs[12] = p.protocol
+	binary.BigEndian.PutUint16(bs[16:], p.internalPort)
+	binary.BigEndian.PutUint16(bs[18:], p.externalPort)
+	ip := p.externalIP
+	if ip == nil {
+		// No preference; the all-zeroes IPv4 address in IPv4-mapped form.
+		ip = net.IPv4zero
+	}
+	copy(bs[20:], ip.To16())
+	return bs
+}
+
+func parseMapPayload(bs []byte) (mapPayload, error) {
+	if len(bs) < mapPayloadSize {
+		return mapPayload{}, errMalformedResponse
+	}
+	var p mapPayload
+	copy(p.nonce[:], bs)
+	p.protocol = bs[12]
+	p.internalPort = binary.BigEndian.Uint16(bs[16:])
+	p.externalPort = binary.BigEndian.Uint16(bs[18:])
+	p.externalIP = net.IP(append([]byte(nil), bs[20:36]...))
+	if ip4 := p.externalIP.To4(); ip4 != nil {
+		p.externalIP = ip4
+	}
+	return p, nil
+}
+
+func protocolNumber(protocol nat.Protocol) uint8 {
+	if protocol == nat.UDP {
+		return protocolUDP
+	}
+	return protocolTCP
+}
@@ -216,6 +216,12 @@ type Hello struct {
 	ClientVersion  string `protobuf:"bytes,3,opt,name=client_version,json=clientVersion,proto3" json:"clientVersion" xml:"clientVersion"`
 	NumConnections int    `protobuf:"varint,4,opt,name=num_connections,json=numConnections,proto3,casttype=int" json:"numConnections" xml:"numConnections"`
 	Timestamp      int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp" xml:"timestamp"`
+	// QUIC addresses at which the device can be reached by hole punching,
+	// sent on relayed connections only.
+	PunchAddresses []string `protobuf:"bytes,6,rep,name=punch_addresses,json=punchAddresses,proto3" json:"punchAddresses" xml:"punchAddress"`
+	// Statements that the device replaces the devices it had before its
+	// key was rotated, oldest first.
+	Successions []Succession `protobuf:"bytes,7,rep,name=successions,proto3" json:"successions" xml:"succession"`
 }
 
 func (m *Hello) Reset()         { *m = Hello{} }
@@ -251,6 +257,47 @@ func (m *Hello) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Hello proto.InternalMessageInfo
 
+// A Succession is signed by the key of a device, naming the device that
+// replaces it.
+type Succession struct {
+	OldCertificate []byte   `protobuf:"bytes,1,opt,name=old_certificate,json=oldCertificate,proto3" json:"oldCertificate" xml:"oldCertificate"`
+	NewDeviceID    DeviceID `protobuf:"bytes,2,opt,name=new_device_id,json=newDeviceId,proto3,customtype=DeviceID" json:"newDeviceID" xml:"newDeviceId"`
+	Signature      []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature" xml:"signature"`
+}
+
+func (m *Succession) Reset()         { *m = Succession{} }
+func (m *Succession) String() string { return proto.CompactTextString(m) }
+func (*Succession) ProtoMessage()    {}
+func (*Succession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311ef540e10d9705, []int{1}
+}
+func (m *Succession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Succession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Succession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Succession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Succession.Merge(m, src)
+}
+func (m *Succession) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Succession) XXX_DiscardUnknown() {
+	xxx_messageInfo_Succession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Succession proto.InternalMessageInfo
+
 type Header struct {
 	Type        MessageType        `protobuf:"varint,1,opt,name=type,proto3,enum=protocol.MessageType" json:"type" xml:"type"`
 	Compression MessageCompression `protobuf:"varint,2,opt,name=compression,proto3,enum=protocol.MessageCompression" json:"compression" xml:"compression"`
@@ -260,7 +307,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{1}
+	return fileDescriptor_311ef540e10d9705, []int{2}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,7 +345,7 @@ func (m *ClusterConfig) Reset()         { *m = ClusterConfig{} }
 func (m *ClusterConfig) String() string { return proto.CompactTextString(m) }
 func (*ClusterConfig) ProtoMessage()    {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{2}
+	return fileDescriptor_311ef540e10d9705, []int{3}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,7 +389,7 @@ func (m *Folder) Reset()         { *m = Folder{} }
 func (m *Folder) String() string { return proto.CompactTextString(m) }
 func (*Folder) ProtoMessage()    {}
 func (*Folder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{3}
+	return fileDescriptor_311ef540e10d9705, []int{4}
 }
 func (m *Folder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +435,7 @@ func (m *Device) Reset()         { *m = Device{} }
 func (m *Device) String() string { return proto.CompactTextString(m) }
 func (*Device) ProtoMessage()    {}
 func (*Device) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{4}
+	return fileDescriptor_311ef540e10d9705, []int{5}
 }
 func (m *Device) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +473,7 @@ func (m *Index) Reset()         { *m = Index{} }
 func (m *Index) String() string { return proto.CompactTextString(m) }
 func (*Index) ProtoMessage()    {}
 func (*Index) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{5}
+	return fileDescriptor_311ef540e10d9705, []int{6}
 }
 func (m *Index) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +511,7 @@ func (m *IndexUpdate) Reset()         { *m = IndexUpdate{} }
 func (m *IndexUpdate) String() string { return proto.CompactTextString(m) }
 func (*IndexUpdate) ProtoMessage()    {}
 func (*IndexUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{6}
+	return fileDescriptor_311ef540e10d9705, []int{7}
 }
 func (m *IndexUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +578,7 @@ type FileInfo struct {
 func (m *FileInfo) Reset()      { *m = FileInfo{} }
 func (*FileInfo) ProtoMessage() {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{7}
+	return fileDescriptor_311ef540e10d9705, []int{8}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,7 +617,7 @@ type BlockInfo struct {
 func (m *BlockInfo) Reset()      { *m = BlockInfo{} }
 func (*BlockInfo) ProtoMessage() {}
 func (*BlockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{8}
+	return fileDescriptor_311ef540e10d9705, []int{9}
 }
 func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +654,7 @@ func (m *Vector) Reset()         { *m = Vector{} }
 func (m *Vector) String() string { return proto.CompactTextString(m) }
 func (*Vector) ProtoMessage()    {}
 func (*Vector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{9}
+	return fileDescriptor_311ef540e10d9705, []int{10}
 }
 func (m *Vector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +692,7 @@ func (m *Counter) Reset()         { *m = Counter{} }
 func (m *Counter) String() string { return proto.CompactTextString(m) }
 func (*Counter) ProtoMessage()    {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{10}
+	return fileDescriptor_311ef540e10d9705, []int{11}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +734,7 @@ func (m *PlatformData) Reset()         { *m = PlatformData{} }
 func (m *PlatformData) String() string { return proto.CompactTextString(m) }
 func (*PlatformData) ProtoMessage()    {}
 func (*PlatformData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{11}
+	return fileDescriptor_311ef540e10d9705, []int{12}
 }
 func (m *PlatformData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +777,7 @@ func (m *UnixData) Reset()         { *m = UnixData{} }
 func (m *UnixData) String() string { return proto.CompactTextString(m) }
 func (*UnixData) ProtoMessage()    {}
 func (*UnixData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{12}
+	return fileDescriptor_311ef540e10d9705, []int{13}
 }
 func (m *UnixData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +818,7 @@ func (m *WindowsData) Reset()         { *m = WindowsData{} }
 func (m *WindowsData) String() string { return proto.CompactTextString(m) }
 func (*WindowsData) ProtoMessage()    {}
 func (*WindowsData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{13}
+	return fileDescriptor_311ef540e10d9705, []int{14}
 }
 func (m *WindowsData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -808,7 +855,7 @@ func (m *XattrData) Reset()         { *m = XattrData{} }
 func (m *XattrData) String() string { return proto.CompactTextString(m) }
 func (*XattrData) ProtoMessage()    {}
 func (*XattrData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{14}
+	return fileDescriptor_311ef540e10d9705, []int{15}
 }
 func (m *XattrData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -846,7 +893,7 @@ func (m *Xattr) Reset()         { *m = Xattr{} }
 func (m *Xattr) String() string { return proto.CompactTextString(m) }
 func (*Xattr) ProtoMessage()    {}
 func (*Xattr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{15}
+	return fileDescriptor_311ef540e10d9705, []int{16}
 }
 func (m *Xattr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +938,7 @@ func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{16}
+	return fileDescriptor_311ef540e10d9705, []int{17}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +977,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{17}
+	return fileDescriptor_311ef540e10d9705, []int{18}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1015,7 @@ func (m *DownloadProgress) Reset()         { *m = DownloadProgress{} }
 func (m *DownloadProgress) String() string { return proto.CompactTextString(m) }
 func (*DownloadProgress) ProtoMessage()    {}
 func (*DownloadProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{18}
+	return fileDescriptor_311ef540e10d9705, []int{19}
 }
 func (m *DownloadProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1009,7 +1056,7 @@ func (m *FileDownloadProgressUpdate) Reset()         { *m = FileDownloadProgress
 func (m *FileDownloadProgressUpdate) String() string { return proto.CompactTextString(m) }
 func (*FileDownloadProgressUpdate) ProtoMessage()    {}
 func (*FileDownloadProgressUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{19}
+	return fileDescriptor_311ef540e10d9705, []int{20}
 }
 func (m *FileDownloadProgressUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1045,7 +1092,7 @@ func (m *Ping) Reset()         { *m = Ping{} }
 func (m *Ping) String() string { return proto.CompactTextString(m) }
 func (*Ping) ProtoMessage()    {}
 func (*Ping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{20}
+	return fileDescriptor_311ef540e10d9705, []int{21}
 }
 func (m *Ping) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1082,7 +1129,7 @@ func (m *Close) Reset()         { *m = Close{} }
 func (m *Close) String() string { return proto.CompactTextString(m) }
 func (*Close) ProtoMessage()    {}
 func (*Close) Descriptor() ([]byte, []int) {
-	return fileDescriptor_311ef540e10d9705, []int{21}
+	return fileDescriptor_311ef540e10d9705, []int{22}
 }
 func (m *Close) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1119,6 +1166,7 @@ func init() {
 	proto.RegisterEnum("protocol.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("protocol.FileDownloadProgressUpdateType", FileDownloadProgressUpdateType_name, FileDownloadProgressUpdateType_value)
 	proto.RegisterType((*Hello)(nil), "protocol.Hello")
+	proto.RegisterType((*Succession)(nil), "protocol.Succession")
 	proto.RegisterType((*Header)(nil), "protocol.Header")
 	proto.RegisterType((*ClusterConfig)(nil), "protocol.ClusterConfig")
 	proto.RegisterType((*Folder)(nil), "protocol.Folder")
@@ -1145,211 +1193,221 @@ func init() {
 func init() { proto.RegisterFile("lib/protocol/bep.proto", fileDescriptor_311ef540e10d9705) }
 
 var fileDescriptor_311ef540e10d9705 = []byte{
-	// 3251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4b, 0x6c, 0x23, 0x47,
-	0x7a, 0x16, 0x9f, 0xa2, 0x4a, 0x8f, 0xa1, 0x6a, 0x5e, 0x34, 0x67, 0xac, 0x66, 0x6a, 0x67, 0x13,
-	0x59, 0x9b, 0x1d, 0xaf, 0xb5, 0xde, 0x8d, 0x63, 0x3b, 0x36, 0xc4, 0x87, 0x34, 0x5c, 0x6b, 0x48,
-	0xb9, 0xa8, 0x19, 0xaf, 0x07, 0x08, 0x88, 0x16, 0xbb, 0x44, 0x35, 0x86, 0xec, 0x66, 0xba, 0x9b,
-	0x7a, 0x2c, 0x72, 0x49, 0x16, 0x58, 0x2c, 0x74, 0x08, 0x82, 0x3d, 0x05, 0xc1, 0x0a, 0x59, 0xe4,
-	0x92, 0x5b, 0x80, 0x1c, 0x72, 0xd9, 0x53, 0x8e, 0x73, 0x1c, 0x2c, 0x10, 0x20, 0xc8, 0xa1, 0x01,
-	0xcf, 0x5c, 0x12, 0xe6, 0xc6, 0x63, 0x0e, 0x41, 0x50, 0x7f, 0x55, 0x57, 0x57, 0xeb, 0xe1, 0x68,
-	0xec, 0x43, 0x4e, 0xc3, 0xfa, 0xfe, 0xef, 0xff, 0xab, 0xba, 0xea, 0x7f, 0x55, 0x69, 0xd0, 0x9d,
-	0x81, 0xbd, 0xf7, 0xee, 0xc8, 0x73, 0x03, 0xb7, 0xe7, 0x0e, 0xde, 0xdd, 0x63, 0xa3, 0x87, 0x30,
-	0xc0, 0x85, 0x08, 0x2b, 0xcf, 0xb1, 0xe3, 0x40, 0x80, 0xe5, 0xef, 0x78, 0x6c, 0xe4, 0xfa, 0x82,
-	0xbe, 0x37, 0xde, 0x7f, 0xb7, 0xef, 0xf6, 0x5d, 0x18, 0xc0, 0x2f, 0x41, 0x22, 0xff, 0x93, 0x46,
-	0xb9, 0x47, 0x6c, 0x30, 0x70, 0x71, 0x0d, 0xcd, 0x5b, 0xec, 0xd0, 0xee, 0xb1, 0xae, 0x63, 0x0e,
-	0x59, 0x29, 0x55, 0x49, 0xad, 0xce, 0x55, 0xc9, 0x24, 0x34, 0x90, 0x80, 0x5b, 0xe6, 0x90, 0x4d,
-	0x43, 0xa3, 0x78, 0x3c, 0x1c, 0x7c, 0x48, 0x62, 0x88, 0x50, 0x4d, 0xce, 0x8d, 0xf4, 0x06, 0x36,
-	0x73, 0x02, 0x61, 0x24, 0x1d, 0x1b, 0x11, 0x70, 0xc2, 0x48, 0x0c, 0x11, 0xaa, 0xc9, 0x71, 0x1b,
-	0x2d, 0x49, 0x23, 0x87, 0xcc, 0xf3, 0x6d, 0xd7, 0x29, 0x65, 0xc0, 0xce, 0xea, 0x24, 0x34, 0x16,
-	0x85, 0xe4, 0xa9, 0x10, 0x4c, 0x43, 0xe3, 0xa6, 0x66, 0x4a, 0xa2, 0x84, 0x26, 0x59, 0xf8, 0x19,
-	0xba, 0xe1, 0x8c, 0x87, 0xdd, 0x9e, 0xeb, 0x38, 0xac, 0x17, 0xd8, 0xae, 0xe3, 0x97, 0xb2, 0x95,
-	0xd4, 0x6a, 0xae, 0xfa, 0xde, 0x24, 0x34, 0x96, 0x9c, 0xf1, 0xb0, 0x16, 0x4b, 0xa6, 0xa1, 0x71,
-	0x0b, 0x4c, 0x26, 0x61, 0xf2, 0xdf, 0xa1, 0x91, 0xb1, 0x9d, 0x80, 0x9e, 0xa3, 0xe3, 0x4f, 0xd0,
-	0x5c, 0x60, 0x0f, 0x99, 0x1f, 0x98, 0xc3, 0x51, 0x29, 0x57, 0x49, 0xad, 0x66, 0xaa, 0x95, 0x49,
-	0x68, 0xc4, 0xe0, 0x34, 0x34, 0x6e, 0x80, 0x41, 0x85, 0x10, 0x1a, 0x4b, 0xc9, 0x3f, 0xa5, 0x50,
-	0xfe, 0x11, 0x33, 0x2d, 0xe6, 0xe1, 0x0d, 0x94, 0x0d, 0x4e, 0x46, 0x62, 0xeb, 0x97, 0xd6, 0x6f,
-	0x3f, 0x8c, 0x0e, 0xf5, 0xe1, 0x63, 0xe6, 0xfb, 0x66, 0x9f, 0xed, 0x9e, 0x8c, 0x58, 0xf5, 0xce,
-	0x24, 0x34, 0x80, 0x36, 0x0d, 0x0d, 0x24, 0xec, 0x9e, 0x8c, 0x18, 0xa1, 0x80, 0x61, 0x0b, 0xcd,
-	0xf7, 0xdc, 0xe1, 0xc8, 0x63, 0x3e, 0xec, 0x5b, 0x1a, 0x2c, 0xdd, 0xbf, 0x60, 0xa9, 0x16, 0x73,
-	0xaa, 0x0f, 0x26, 0xa1, 0xa1, 0x2b, 0x4d, 0x43, 0x63, 0x59, 0xec, 0x69, 0x8c, 0x11, 0xaa, 0x33,
-	0xc8, 0xaf, 0x53, 0x68, 0xb1, 0x36, 0x18, 0xfb, 0x01, 0xf3, 0x6a, 0xae, 0xb3, 0x6f, 0xf7, 0xf1,
-	0x67, 0x68, 0x76, 0xdf, 0x1d, 0x58, 0xcc, 0xf3, 0x4b, 0xa9, 0x4a, 0x66, 0x75, 0x7e, 0xbd, 0x18,
-	0xcf, 0xb9, 0x09, 0x82, 0xaa, 0xf1, 0x22, 0x34, 0x66, 0x26, 0xa1, 0x11, 0x11, 0xa7, 0xa1, 0xb1,
-	0x00, 0xf3, 0x88, 0x31, 0xa1, 0x91, 0x80, 0x6f, 0xa9, 0xcf, 0x7a, 0xae, 0x63, 0x99, 0xde, 0x09,
-	0x7c, 0x42, 0x41, 0x6c, 0xa9, 0x02, 0xd5, 0x96, 0x2a, 0x84, 0xd0, 0x58, 0x4a, 0x7e, 0x9b, 0x45,
-	0x79, 0x31, 0x29, 0x7e, 0x88, 0xd2, 0xb6, 0x25, 0x7d, 0x79, 0xe5, 0x55, 0x68, 0xa4, 0x9b, 0xf5,
-	0x49, 0x68, 0xa4, 0x6d, 0x6b, 0x1a, 0x1a, 0x05, 0x30, 0x61, 0x5b, 0xe4, 0x57, 0x2f, 0x1f, 0xa4,
-	0x9b, 0x75, 0x9a, 0xb6, 0x2d, 0xfc, 0x10, 0xe5, 0x06, 0xe6, 0x1e, 0x1b, 0x48, 0xcf, 0x2d, 0x4d,
-	0x42, 0x43, 0x00, 0xd3, 0xd0, 0x98, 0x07, 0x3e, 0x8c, 0x08, 0x15, 0x28, 0xfe, 0x08, 0xcd, 0x79,
-	0xcc, 0xb4, 0xba, 0xae, 0x33, 0x38, 0x01, 0x2f, 0x2d, 0x54, 0x57, 0x26, 0xa1, 0x51, 0xe0, 0x60,
-	0xdb, 0x19, 0xf0, 0x95, 0x2e, 0x81, 0x5a, 0x04, 0x10, 0xaa, 0x64, 0xb8, 0x8b, 0xb0, 0xdd, 0x77,
-	0x5c, 0x8f, 0x75, 0x47, 0xcc, 0x1b, 0xda, 0xb0, 0xb7, 0xc2, 0x33, 0x0b, 0xd5, 0x1f, 0x4c, 0x42,
-	0x63, 0x59, 0x48, 0x77, 0x62, 0xe1, 0x34, 0x34, 0xee, 0x8a, 0x55, 0x9f, 0x97, 0x10, 0x7a, 0x91,
-	0x8d, 0x3f, 0x43, 0x8b, 0x72, 0x02, 0x8b, 0x0d, 0x58, 0xc0, 0xc0, 0x3f, 0x0b, 0xd5, 0xdf, 0x9f,
-	0x84, 0xc6, 0x82, 0x10, 0xd4, 0x01, 0x9f, 0x86, 0x06, 0xd6, 0xcc, 0x0a, 0x90, 0xd0, 0x04, 0x07,
-	0x5b, 0xe8, 0x96, 0x65, 0xfb, 0xe6, 0xde, 0x80, 0x75, 0x03, 0x36, 0x1c, 0x75, 0x6d, 0xc7, 0x62,
-	0xc7, 0xcc, 0x2f, 0xe5, 0xc1, 0xe6, 0xfa, 0x24, 0x34, 0xb0, 0x94, 0xef, 0xb2, 0xe1, 0xa8, 0x29,
-	0xa4, 0xd3, 0xd0, 0x28, 0x89, 0x84, 0x71, 0x41, 0x44, 0xe8, 0x25, 0x7c, 0xbc, 0x8e, 0xf2, 0x23,
-	0x73, 0xec, 0x33, 0xab, 0x34, 0x0b, 0x76, 0xcb, 0x93, 0xd0, 0x90, 0x88, 0x72, 0x18, 0x31, 0x24,
-	0x54, 0xe2, 0xdc, 0xf9, 0x44, 0x0a, 0xf2, 0x4b, 0xc5, 0xf3, 0xce, 0x57, 0x07, 0x41, 0xec, 0x7c,
-	0x92, 0xa8, 0x6c, 0x89, 0x31, 0xa1, 0x91, 0x80, 0xfc, 0x4b, 0x1e, 0xe5, 0x85, 0x12, 0xae, 0x2a,
-	0xe7, 0x59, 0xa8, 0xae, 0x73, 0x03, 0xff, 0x1e, 0x1a, 0x05, 0x21, 0x6b, 0xd6, 0xaf, 0x72, 0xa6,
-	0x5f, 0xbe, 0x7c, 0x90, 0xd2, 0x1c, 0x6a, 0x0d, 0x65, 0xb5, 0x4c, 0x08, 0xc1, 0xeb, 0x88, 0x1c,
-	0x28, 0x82, 0xd7, 0x81, 0xec, 0x07, 0x18, 0xfe, 0x18, 0xcd, 0x99, 0x96, 0xc5, 0x83, 0x8c, 0xf9,
-	0xa5, 0x4c, 0x25, 0xc3, 0x7d, 0x96, 0xfb, 0xbd, 0x02, 0xa7, 0xa1, 0xb1, 0x08, 0x5a, 0x12, 0x21,
-	0x34, 0x96, 0xe1, 0x3f, 0x4d, 0x86, 0x7e, 0xf6, 0x7c, 0x12, 0xf9, 0x76, 0x31, 0xcf, 0x3d, 0xbd,
-	0xc7, 0x3c, 0x99, 0xd7, 0x73, 0x22, 0xa0, 0xb8, 0xa7, 0x73, 0x50, 0x66, 0x75, 0xe1, 0xe9, 0x11,
-	0x40, 0xa8, 0x92, 0xe1, 0x2d, 0xb4, 0x30, 0x34, 0x8f, 0xbb, 0x3e, 0xfb, 0xb3, 0x31, 0x73, 0x7a,
-	0x0c, 0x7c, 0x26, 0x23, 0x56, 0x31, 0x34, 0x8f, 0x3b, 0x12, 0x56, 0xab, 0xd0, 0x30, 0x42, 0x75,
-	0x06, 0xae, 0x22, 0x64, 0x3b, 0x81, 0xe7, 0x5a, 0xe3, 0x1e, 0xf3, 0xa4, 0x8b, 0x40, 0x79, 0x89,
-	0x51, 0x55, 0x5e, 0x62, 0x88, 0x50, 0x4d, 0x8e, 0xfb, 0xa8, 0x00, 0xbe, 0xdb, 0xb5, 0xad, 0x52,
-	0xa1, 0x92, 0x5a, 0xcd, 0x56, 0xb7, 0xe5, 0xe1, 0xce, 0x82, 0x17, 0xc2, 0xd9, 0x46, 0x3f, 0xb9,
-	0xcf, 0x00, 0xbb, 0x69, 0xa9, 0xdd, 0x97, 0x63, 0x9e, 0x37, 0x22, 0xda, 0xdf, 0xc6, 0x3f, 0x69,
-	0xc4, 0xc7, 0x7f, 0x8e, 0xca, 0xfe, 0x73, 0x9b, 0x47, 0x8a, 0x98, 0x9b, 0x17, 0x8c, 0xae, 0xc7,
-	0x86, 0xee, 0xa1, 0x39, 0xf0, 0x4b, 0x73, 0xb0, 0xf8, 0x4f, 0x26, 0xa1, 0x51, 0xe2, 0xac, 0xa6,
-	0x46, 0xa2, 0x92, 0x33, 0x0d, 0x8d, 0x15, 0x91, 0xe7, 0xae, 0x20, 0x10, 0x7a, 0xa5, 0x2e, 0x3e,
-	0x46, 0x6f, 0x31, 0xa7, 0xe7, 0x9d, 0x8c, 0x60, 0xda, 0x91, 0xe9, 0xfb, 0x47, 0xae, 0x67, 0x75,
-	0x03, 0xf7, 0x39, 0x73, 0x4a, 0x08, 0x9c, 0xfa, 0xe3, 0x49, 0x68, 0xdc, 0x8d, 0x49, 0x3b, 0x92,
-	0xb3, 0xcb, 0x29, 0xd3, 0xd0, 0x78, 0x1b, 0xe6, 0xbe, 0x42, 0x4e, 0xe8, 0x55, 0x9a, 0xe4, 0x2f,
-	0x53, 0x28, 0x07, 0x9b, 0xc1, 0xa3, 0x59, 0x24, 0x75, 0x99, 0x82, 0x21, 0x9a, 0x05, 0x72, 0x21,
-	0xfd, 0x4b, 0x1c, 0x37, 0x50, 0x6e, 0xdf, 0x1e, 0x30, 0xbf, 0x94, 0x86, 0x58, 0xc6, 0x5a, 0x21,
-	0xb1, 0x07, 0xac, 0xe9, 0xec, 0xbb, 0xd5, 0x7b, 0x32, 0x9a, 0x05, 0x51, 0xc5, 0x12, 0x1f, 0x11,
-	0x2a, 0x40, 0xf2, 0xcb, 0x14, 0x9a, 0x87, 0x45, 0x3c, 0x19, 0x59, 0x66, 0xc0, 0xfe, 0x3f, 0x97,
-	0xf2, 0x8b, 0x45, 0x54, 0x88, 0x14, 0x54, 0x42, 0x48, 0x5d, 0x23, 0x21, 0xac, 0xa1, 0xac, 0x6f,
-	0xff, 0x8c, 0x41, 0x61, 0xc9, 0x08, 0x2e, 0x1f, 0x2b, 0x2e, 0x1f, 0x10, 0x0a, 0x18, 0xfe, 0x14,
-	0xa1, 0xa1, 0x6b, 0xd9, 0xfb, 0x36, 0xb3, 0xba, 0xbe, 0xde, 0x88, 0x44, 0x68, 0x47, 0x55, 0x4d,
-	0x85, 0x10, 0x1a, 0x4b, 0x79, 0xfe, 0x50, 0x06, 0xf6, 0x4e, 0x4a, 0x0b, 0x10, 0x19, 0x1f, 0x47,
-	0x91, 0xd1, 0x39, 0x70, 0xbd, 0x00, 0xc2, 0x41, 0x4d, 0x53, 0x3d, 0x51, 0xa1, 0x16, 0x43, 0x84,
-	0x47, 0x82, 0x24, 0x53, 0x8d, 0x8a, 0xb7, 0xd1, 0x6c, 0xd4, 0xcd, 0x71, 0xcf, 0x4f, 0x24, 0xe9,
-	0xa7, 0xac, 0x17, 0xb8, 0x5e, 0xb5, 0x12, 0x25, 0xe9, 0x43, 0xd5, 0xdd, 0x89, 0x80, 0x3b, 0x8c,
-	0xfa, 0xba, 0x48, 0x82, 0x3f, 0x44, 0x05, 0x95, 0x4c, 0x10, 0x7c, 0x2b, 0x24, 0x23, 0x3f, 0xce,
-	0x24, 0x4b, 0xb2, 0x41, 0x88, 0xd2, 0x88, 0x92, 0xe1, 0x9f, 0xa0, 0xfc, 0xde, 0xc0, 0xed, 0x3d,
-	0x8f, 0xaa, 0xc5, 0xcd, 0x78, 0x21, 0x55, 0x8e, 0xc3, 0xb9, 0xbe, 0x2d, 0xd7, 0x22, 0xa9, 0xaa,
-	0xfc, 0xc3, 0x90, 0x50, 0x09, 0xf3, 0x56, 0xd5, 0x3f, 0x19, 0x0e, 0x6c, 0xe7, 0x79, 0x37, 0x30,
-	0xbd, 0x3e, 0x0b, 0x4a, 0xcb, 0x71, 0xab, 0x2a, 0x25, 0xbb, 0x20, 0x50, 0xad, 0x6a, 0x02, 0x25,
-	0x34, 0xc9, 0xe2, 0x0d, 0xb4, 0x30, 0xdd, 0x3d, 0x30, 0xfd, 0x83, 0x12, 0x86, 0x38, 0x85, 0x0c,
-	0x27, 0xe0, 0x47, 0xa6, 0x7f, 0xa0, 0xb6, 0x3d, 0x86, 0x08, 0xd5, 0xe4, 0xbc, 0x81, 0x92, 0xb1,
-	0xc9, 0xac, 0xd2, 0x4d, 0x30, 0x01, 0xae, 0xa0, 0x40, 0xe5, 0x0a, 0x0a, 0x21, 0x34, 0x96, 0xe2,
-	0xaa, 0x6c, 0x44, 0x45, 0xfb, 0x78, 0xe7, 0xa2, 0xdb, 0x5f, 0xa3, 0x13, 0xdd, 0x44, 0xf3, 0xe7,
-	0xbb, 0x9a, 0x45, 0x91, 0xf1, 0x47, 0x89, 0x7e, 0x46, 0x64, 0xfc, 0x91, 0xde, 0xc9, 0xe8, 0x0c,
-	0xfc, 0x13, 0xcd, 0x2d, 0x1d, 0xbf, 0x34, 0x0f, 0x7d, 0xfb, 0x3b, 0xba, 0x1f, 0xb6, 0xfc, 0x0b,
-	0x7e, 0xd8, 0x8a, 0xfb, 0x75, 0x8d, 0x86, 0xf7, 0x91, 0xd8, 0xa5, 0x2e, 0x44, 0xd5, 0x22, 0x98,
-	0xda, 0x7a, 0x15, 0x1a, 0x0b, 0xd4, 0x3c, 0x82, 0xa3, 0xef, 0xd8, 0x3f, 0x63, 0x7c, 0xa3, 0xf6,
-	0xa2, 0x81, 0xda, 0x28, 0x85, 0x44, 0x86, 0x7f, 0xf5, 0xf2, 0x41, 0x42, 0x8d, 0xc6, 0x4a, 0xf8,
-	0x29, 0x2a, 0x8c, 0x06, 0x66, 0xb0, 0xef, 0x7a, 0xc3, 0xd2, 0x12, 0x38, 0xbb, 0xb6, 0x87, 0x3b,
-	0x52, 0x52, 0x37, 0x03, 0xb3, 0x4a, 0xa4, 0x9b, 0x29, 0xbe, 0xf2, 0xdc, 0x08, 0x20, 0x54, 0xc9,
-	0x70, 0x1d, 0xcd, 0x0f, 0xdc, 0x9e, 0x39, 0xe8, 0xee, 0x0f, 0xcc, 0xbe, 0x5f, 0xfa, 0x8f, 0x59,
-	0xd8, 0x54, 0xf0, 0x0e, 0xc0, 0x37, 0x39, 0xac, 0x36, 0x23, 0x86, 0x08, 0xd5, 0xe4, 0xf8, 0x11,
-	0x5a, 0x90, 0x61, 0x24, 0x7c, 0xec, 0x3f, 0x67, 0xc1, 0x43, 0xe0, 0x6c, 0xa4, 0x40, 0x7a, 0xd9,
-	0xb2, 0x1e, 0x7d, 0xc2, 0xcd, 0x74, 0x06, 0xfe, 0x1c, 0xdd, 0xb0, 0x1d, 0xd7, 0x62, 0xdd, 0xde,
-	0x81, 0xe9, 0xf4, 0x19, 0x3f, 0x9f, 0xc9, 0x2c, 0x44, 0x23, 0xf8, 0x3f, 0xc8, 0x6a, 0x20, 0x82,
-	0x33, 0xba, 0x29, 0xab, 0xa7, 0x86, 0x12, 0x9a, 0x64, 0xe1, 0x63, 0xa4, 0x95, 0x95, 0x6e, 0xe0,
-	0x99, 0xf6, 0x80, 0x79, 0xe2, 0xbc, 0xfe, 0x6b, 0x16, 0x0e, 0xec, 0xd3, 0x49, 0x68, 0xdc, 0x8e,
-	0x39, 0xbb, 0x82, 0x22, 0x0f, 0xeb, 0xde, 0xb9, 0x92, 0xa5, 0x49, 0x95, 0x47, 0x5c, 0xae, 0x8c,
-	0x7f, 0xcc, 0xbb, 0x48, 0xde, 0xe9, 0x5a, 0xb2, 0xa5, 0xbd, 0x2f, 0xfa, 0x45, 0x80, 0x54, 0x2a,
-	0x92, 0x63, 0x68, 0x18, 0xe1, 0x17, 0xa6, 0x68, 0xd6, 0x76, 0x0e, 0xcd, 0x81, 0x1d, 0xb5, 0xac,
-	0x1f, 0xbc, 0x0a, 0x0d, 0x44, 0xcd, 0xa3, 0xa6, 0x40, 0x45, 0x07, 0x01, 0x3f, 0xb5, 0x0e, 0x02,
-	0xc6, 0xbc, 0x83, 0xd0, 0x98, 0x34, 0xe2, 0xf1, 0xb4, 0xe2, 0xb8, 0x89, 0x5b, 0x41, 0x01, 0x4c,
-	0xc3, 0xb6, 0x3a, 0x6e, 0xf2, 0x46, 0x20, 0xb6, 0x35, 0x81, 0x12, 0x9a, 0x64, 0x7d, 0x98, 0xfd,
-	0x9b, 0xdf, 0x18, 0x33, 0xe4, 0xab, 0x14, 0x9a, 0x53, 0x29, 0x8e, 0x57, 0x17, 0x38, 0xff, 0x0c,
-	0x1c, 0x3f, 0x44, 0xf3, 0x81, 0x38, 0x77, 0x11, 0xcd, 0x07, 0x70, 0xe0, 0x80, 0xf1, 0xea, 0xe9,
-	0xee, 0xef, 0xfb, 0x2c, 0x80, 0xba, 0x95, 0x11, 0xd5, 0x53, 0x20, 0xaa, 0x7a, 0x8a, 0x21, 0xa1,
-	0x12, 0xc7, 0xef, 0xc9, 0xea, 0x95, 0x86, 0x63, 0x7b, 0xfb, 0xf2, 0xea, 0x15, 0x1d, 0x8a, 0x28,
-	0x62, 0x1f, 0xa1, 0xb9, 0x23, 0x66, 0x3e, 0x17, 0x7e, 0x29, 0x52, 0x06, 0xe4, 0x75, 0x0e, 0x4a,
-	0x9f, 0x14, 0xd1, 0x11, 0x01, 0x84, 0x2a, 0x99, 0xfc, 0xc6, 0x67, 0x28, 0x2f, 0xca, 0x09, 0xde,
-	0x41, 0x85, 0x9e, 0x3b, 0x76, 0x82, 0xf8, 0x52, 0xba, 0xac, 0x77, 0xc3, 0x20, 0xa9, 0xfe, 0x5e,
-	0x14, 0x80, 0x11, 0x55, 0x9d, 0x91, 0x04, 0x78, 0x1b, 0x2b, 0x45, 0xe4, 0xe7, 0x29, 0x34, 0x2b,
-	0x15, 0xf1, 0x23, 0x75, 0x39, 0xc8, 0x56, 0x3f, 0x38, 0x57, 0x25, 0xbf, 0xfe, 0xa2, 0xa9, 0x57,
-	0x48, 0x79, 0xe7, 0x3c, 0x34, 0x07, 0x63, 0xb1, 0x51, 0x59, 0x71, 0xe7, 0x04, 0x40, 0x15, 0x1d,
-	0x18, 0x11, 0x2a, 0x50, 0xf2, 0xf3, 0x2c, 0x5a, 0xd0, 0x93, 0x08, 0x4f, 0xd7, 0x63, 0xc7, 0x3e,
-	0x86, 0xc5, 0x24, 0xba, 0x94, 0x27, 0x8e, 0x7d, 0x0c, 0x69, 0xa6, 0xfc, 0x22, 0x34, 0x52, 0xfc,
-	0x00, 0x38, 0x4f, 0x1d, 0x00, 0x1f, 0x10, 0x0a, 0x18, 0xfe, 0x1c, 0xcd, 0x1e, 0xd9, 0x8e, 0xe5,
-	0x1e, 0xf9, 0xb0, 0x8c, 0x79, 0xfd, 0xe6, 0xf0, 0x85, 0x10, 0x80, 0xa5, 0x8a, 0xb4, 0x14, 0xb1,
-	0xd5, 0x76, 0xc9, 0x31, 0xa1, 0x91, 0x04, 0x6f, 0xa1, 0xdc, 0xc0, 0x76, 0xc6, 0xc7, 0xe0, 0x60,
-	0x89, 0x32, 0xfb, 0x53, 0x33, 0x08, 0x3c, 0x30, 0x77, 0x5f, 0x9a, 0x13, 0xcc, 0xf8, 0x92, 0xcd,
-	0x47, 0xfc, 0x92, 0xcd, 0xff, 0xc5, 0x9f, 0xa1, 0xbc, 0x65, 0x7a, 0x47, 0xb6, 0xb8, 0xd4, 0x5c,
-	0x61, 0x69, 0x45, 0x5a, 0x92, 0xd4, 0xf8, 0x82, 0x07, 0x43, 0x42, 0x25, 0x8e, 0x19, 0x9a, 0xdd,
-	0xf7, 0x18, 0xdb, 0xf3, 0x2d, 0x68, 0x92, 0xae, 0xb0, 0xf6, 0x63, 0x6e, 0x8d, 0x5f, 0x03, 0x36,
-	0x3d, 0xc6, 0xaa, 0x1d, 0xb8, 0x06, 0x48, 0x35, 0xf5, 0xc5, 0x72, 0x0c, 0xd7, 0x00, 0x49, 0xa3,
-	0x11, 0x09, 0x77, 0x51, 0xde, 0x61, 0x01, 0x9f, 0x25, 0x7f, 0xf5, 0x2c, 0xeb, 0x72, 0x96, 0x7c,
-	0x8b, 0x05, 0x62, 0x12, 0xa9, 0xa4, 0x56, 0x2f, 0x86, 0x7c, 0x0a, 0xc9, 0xa1, 0x92, 0x41, 0x7e,
-	0x91, 0x46, 0x85, 0xe8, 0x7c, 0x79, 0xf3, 0xe7, 0x1e, 0x39, 0xcc, 0xd3, 0x9f, 0xee, 0xa0, 0xe2,
-	0x03, 0x2a, 0xaf, 0x67, 0xa2, 0x90, 0x29, 0x84, 0xd0, 0x58, 0xca, 0x0d, 0xf4, 0x3d, 0x77, 0x3c,
-	0xd2, 0x9f, 0xed, 0xc0, 0x00, 0xa0, 0x09, 0x03, 0x0a, 0x21, 0x34, 0x96, 0xe2, 0x8f, 0x50, 0x66,
-	0x6c, 0x5b, 0x70, 0xd4, 0xb9, 0xea, 0x3b, 0xaf, 0x42, 0x23, 0xf3, 0x04, 0x22, 0x80, 0xa3, 0xd3,
-	0xd0, 0x98, 0x13, 0x0e, 0x67, 0x5b, 0x5a, 0xf9, 0xe4, 0x0c, 0xca, 0xe5, 0x5c, 0xb9, 0x6f, 0x5b,
-	0xf2, 0x4d, 0x0e, 0x94, 0xb7, 0x84, 0x72, 0x5f, 0x53, 0xee, 0x27, 0x95, 0xb7, 0xb8, 0x32, 0xc7,
-	0x7e, 0x9d, 0x42, 0xf3, 0x9a, 0x87, 0x7e, 0xfb, 0xbd, 0xd8, 0x46, 0x4b, 0xc2, 0x80, 0xed, 0x77,
-	0xe1, 0x03, 0xe5, 0x1b, 0x14, 0x3c, 0x9b, 0x80, 0xa4, 0xe9, 0x6f, 0x71, 0x5c, 0x3d, 0x9b, 0xe8,
-	0x20, 0xa1, 0x09, 0x0e, 0xe9, 0xa0, 0x39, 0x75, 0xe0, 0x78, 0x13, 0xe5, 0x8f, 0xf9, 0x20, 0x4a,
-	0x48, 0x37, 0xce, 0x79, 0x45, 0xdc, 0x76, 0x0a, 0x9a, 0x0a, 0x08, 0x18, 0x12, 0x2a, 0x61, 0xd2,
-	0x43, 0x39, 0xe0, 0xbf, 0xd1, 0x6d, 0x22, 0x91, 0x67, 0x16, 0xfe, 0xef, 0x3c, 0xf3, 0x17, 0x59,
-	0x34, 0x4b, 0x79, 0xd3, 0xec, 0x07, 0xf8, 0x47, 0x2a, 0xdb, 0xe5, 0xaa, 0xdf, 0xbd, 0x2a, 0xbd,
-	0xc5, 0xa7, 0x13, 0xbd, 0x7e, 0xc4, 0x97, 0xae, 0xf4, 0xb5, 0x2f, 0x5d, 0xd1, 0x27, 0x65, 0xae,
-	0xf1, 0x49, 0x71, 0x59, 0xca, 0xbe, 0x71, 0x59, 0xca, 0x5d, 0xbf, 0x2c, 0x45, 0x95, 0x32, 0x7f,
-	0x8d, 0x4a, 0xd9, 0x46, 0x4b, 0xfb, 0x9e, 0x3b, 0x84, 0x37, 0x32, 0xd7, 0x33, 0xbd, 0x13, 0xd9,
-	0x15, 0x40, 0xe9, 0xe6, 0x92, 0xdd, 0x48, 0xa0, 0x4a, 0x77, 0x02, 0x25, 0x34, 0xc9, 0x4a, 0xd6,
-	0xc4, 0xc2, 0x9b, 0xd5, 0x44, 0xfc, 0x09, 0x2a, 0x88, 0x8e, 0xd7, 0x71, 0xe1, 0xda, 0x95, 0xab,
-	0x7e, 0x87, 0xa7, 0x32, 0xc0, 0x5a, 0xae, 0x4a, 0x65, 0x72, 0xac, 0x3e, 0x3b, 0x22, 0x90, 0x7f,
-	0x4c, 0xa1, 0x02, 0x65, 0xfe, 0xc8, 0x75, 0x7c, 0xf6, 0x4d, 0x9d, 0x60, 0x0d, 0x65, 0x2d, 0x33,
-	0x30, 0xa5, 0xdb, 0xc1, 0xee, 0xf1, 0xb1, 0xda, 0x3d, 0x3e, 0x20, 0x14, 0x30, 0xfc, 0x29, 0xca,
-	0xf6, 0x5c, 0x4b, 0x1c, 0xfe, 0x92, 0x9e, 0x34, 0x1b, 0x9e, 0xe7, 0x7a, 0x35, 0xd7, 0x92, 0xd7,
-	0x0e, 0x4e, 0x52, 0x06, 0xf8, 0x80, 0x50, 0xc0, 0xc8, 0x3f, 0xa4, 0x50, 0xb1, 0xee, 0x1e, 0x39,
-	0x03, 0xd7, 0xb4, 0x76, 0x3c, 0xb7, 0xef, 0x31, 0xdf, 0xff, 0x46, 0x77, 0xff, 0x2e, 0x9a, 0x1d,
-	0xc3, 0xcb, 0x41, 0x74, 0xfb, 0x7f, 0x90, 0xbc, 0x06, 0x9d, 0x9f, 0x44, 0x3c, 0x33, 0xc4, 0x0f,
-	0x8d, 0x52, 0x59, 0xd9, 0x17, 0x63, 0x42, 0x23, 0x01, 0xf9, 0xfb, 0x0c, 0x2a, 0x5f, 0x6d, 0x08,
-	0x0f, 0xd1, 0xbc, 0x60, 0x76, 0xb5, 0xbf, 0x09, 0xac, 0x5e, 0x67, 0x0d, 0x70, 0x39, 0x83, 0x4b,
-	0xc1, 0x58, 0x8d, 0xd5, 0xa5, 0x20, 0x86, 0x08, 0xd5, 0xe4, 0x6f, 0xf4, 0x4e, 0xa9, 0x5d, 0xe5,
-	0x33, 0xdf, 0xfe, 0x2a, 0xdf, 0x41, 0x8b, 0xc2, 0x45, 0xa3, 0x07, 0xe5, 0x6c, 0x25, 0xb3, 0x9a,
-	0xab, 0x3e, 0xe4, 0xd9, 0x76, 0x4f, 0x34, 0xab, 0xd1, 0x53, 0xf2, 0x72, 0xec, 0xac, 0x02, 0x8c,
-	0xbc, 0xad, 0x38, 0x43, 0x13, 0x5c, 0xbc, 0x99, 0xb8, 0xe9, 0x89, 0x50, 0xff, 0x83, 0x6b, 0xde,
-	0xec, 0xb4, 0x9b, 0x1c, 0xc9, 0xa3, 0xec, 0x8e, 0xed, 0xf4, 0xc9, 0x47, 0x28, 0x57, 0x1b, 0xb8,
-	0x3e, 0x64, 0x1c, 0x8f, 0x99, 0xbe, 0xeb, 0xe8, 0xae, 0x24, 0x10, 0x75, 0xd4, 0x62, 0x48, 0xa8,
-	0xc4, 0xd7, 0x7e, 0x9b, 0x41, 0xf3, 0xda, 0x9f, 0x70, 0xf0, 0x9f, 0xa0, 0x7b, 0x8f, 0x1b, 0x9d,
-	0xce, 0xc6, 0x56, 0xa3, 0xbb, 0xfb, 0xe5, 0x4e, 0xa3, 0x5b, 0xdb, 0x7e, 0xd2, 0xd9, 0x6d, 0xd0,
-	0x6e, 0xad, 0xdd, 0xda, 0x6c, 0x6e, 0x15, 0x67, 0xca, 0xf7, 0x4f, 0xcf, 0x2a, 0x25, 0x4d, 0x23,
-	0xf9, 0xb7, 0x96, 0x3f, 0x44, 0x38, 0xa1, 0xde, 0x6c, 0xd5, 0x1b, 0x3f, 0x2d, 0xa6, 0xca, 0xb7,
-	0x4e, 0xcf, 0x2a, 0x45, 0x4d, 0x4b, 0x3c, 0xc1, 0xfd, 0x31, 0x7a, 0xeb, 0x22, 0xbb, 0xfb, 0x64,
-	0xa7, 0xbe, 0xb1, 0xdb, 0x28, 0xa6, 0xcb, 0xe5, 0xd3, 0xb3, 0xca, 0x9d, 0xf3, 0x4a, 0xd2, 0x05,
-	0x7f, 0x80, 0x6e, 0x25, 0x54, 0x69, 0xe3, 0xf3, 0x27, 0x8d, 0xce, 0x6e, 0x31, 0x53, 0xbe, 0x73,
-	0x7a, 0x56, 0xc1, 0x9a, 0x56, 0x54, 0x26, 0xd6, 0xd1, 0xed, 0x73, 0x1a, 0x9d, 0x9d, 0x76, 0xab,
-	0xd3, 0x28, 0x66, 0xcb, 0x77, 0x4f, 0xcf, 0x2a, 0x37, 0x13, 0x2a, 0x32, 0xab, 0xd4, 0xd0, 0x4a,
-	0x42, 0xa7, 0xde, 0xfe, 0xa2, 0xb5, 0xdd, 0xde, 0xa8, 0x77, 0x77, 0x68, 0x7b, 0x8b, 0x36, 0x3a,
-	0x9d, 0x62, 0xae, 0x6c, 0x9c, 0x9e, 0x55, 0xee, 0x69, 0xca, 0x17, 0x22, 0x7c, 0x0d, 0x2d, 0x27,
-	0x8c, 0xec, 0x34, 0x5b, 0x5b, 0xc5, 0x7c, 0xf9, 0xe6, 0xe9, 0x59, 0xe5, 0x86, 0xa6, 0xc7, 0xcf,
-	0xf2, 0xc2, 0xfe, 0xd5, 0xb6, 0xdb, 0x9d, 0x46, 0x71, 0xf6, 0xc2, 0xfe, 0xc1, 0x81, 0xaf, 0xfd,
-	0x5d, 0x0a, 0xe1, 0x8b, 0x7f, 0x35, 0xc3, 0x1f, 0xa0, 0x52, 0x64, 0xa4, 0xd6, 0x7e, 0xbc, 0xc3,
-	0xd7, 0xd9, 0x6c, 0xb7, 0xba, 0xad, 0x76, 0xab, 0x51, 0x9c, 0x49, 0xec, 0xaa, 0xa6, 0xd5, 0x72,
-	0x1d, 0x86, 0xdb, 0xe8, 0xee, 0x65, 0x9a, 0xdb, 0xcf, 0xde, 0x2f, 0xa6, 0xca, 0xeb, 0xa7, 0x67,
-	0x95, 0xdb, 0x17, 0x15, 0xb7, 0x9f, 0xbd, 0xff, 0xbb, 0xbf, 0xfa, 0xee, 0xe5, 0x82, 0x35, 0xde,
-	0x00, 0xe9, 0x4b, 0x7b, 0x0f, 0xdd, 0xd2, 0x0d, 0x3f, 0x6e, 0xec, 0x6e, 0xd4, 0x37, 0x76, 0x37,
-	0x8a, 0x33, 0xe2, 0x0c, 0x34, 0xea, 0x63, 0x16, 0x98, 0x90, 0x76, 0xbf, 0x87, 0x96, 0x13, 0x5f,
-	0xd1, 0x78, 0xda, 0xa0, 0x91, 0x47, 0xe9, 0xeb, 0x67, 0x87, 0xcc, 0xc3, 0xdf, 0x47, 0x58, 0x27,
-	0x6f, 0x6c, 0x7f, 0xb1, 0xf1, 0x65, 0xa7, 0x98, 0x2e, 0xdf, 0x3e, 0x3d, 0xab, 0x2c, 0x6b, 0xec,
-	0x8d, 0xc1, 0x91, 0x79, 0xe2, 0xaf, 0xfd, 0x73, 0x1a, 0x2d, 0xe8, 0xef, 0x46, 0xf8, 0xfb, 0xe8,
-	0xe6, 0x66, 0x73, 0x9b, 0x7b, 0xe2, 0x66, 0x5b, 0x9c, 0x00, 0x1f, 0x16, 0x67, 0xc4, 0x74, 0x3a,
-	0x95, 0xff, 0xc6, 0x7f, 0x84, 0x4a, 0xe7, 0xe8, 0xf5, 0x26, 0x6d, 0xd4, 0x76, 0xdb, 0xf4, 0xcb,
-	0x62, 0xaa, 0xfc, 0x16, 0xdf, 0x30, 0x5d, 0xa7, 0x6e, 0x7b, 0x90, 0x82, 0x4e, 0xf0, 0x27, 0xe8,
-	0xde, 0x39, 0xc5, 0xce, 0x97, 0x8f, 0xb7, 0x9b, 0xad, 0xcf, 0xc4, 0x7c, 0xe9, 0xf2, 0xdb, 0xa7,
-	0x67, 0x95, 0xbb, 0xba, 0x6e, 0x47, 0x3c, 0xc5, 0x71, 0xa8, 0x90, 0xc2, 0x8f, 0x50, 0xe5, 0x0a,
-	0xfd, 0x78, 0x01, 0x99, 0x32, 0x39, 0x3d, 0xab, 0xdc, 0xbf, 0xc4, 0x88, 0x5a, 0x47, 0x21, 0x85,
-	0x7f, 0x88, 0xee, 0x5c, 0x6e, 0x29, 0x8a, 0x8b, 0x4b, 0xf4, 0xd7, 0xfe, 0x35, 0x85, 0xe6, 0x54,
-	0xd5, 0xe3, 0x9b, 0xd6, 0xa0, 0xb4, 0xcd, 0x93, 0x44, 0xbd, 0xd1, 0x6d, 0xb5, 0xbb, 0x30, 0x8a,
-	0x36, 0x4d, 0xf1, 0x5a, 0x2e, 0xfc, 0xe4, 0x3e, 0xae, 0xd1, 0xb7, 0x1a, 0xad, 0x06, 0x6d, 0xd6,
-	0xa2, 0x13, 0x55, 0xec, 0x2d, 0xe6, 0x30, 0xcf, 0xee, 0xe1, 0xf7, 0xd1, 0xdd, 0xa4, 0xf1, 0xce,
-	0x93, 0xda, 0xa3, 0x68, 0x97, 0x60, 0x81, 0xda, 0x04, 0x9d, 0x71, 0xef, 0x00, 0x0e, 0xe6, 0x47,
-	0x09, 0xad, 0x66, 0xeb, 0xe9, 0xc6, 0x76, 0xb3, 0x2e, 0xb4, 0x32, 0xe5, 0xd2, 0xe9, 0x59, 0xe5,
-	0x96, 0xd2, 0x92, 0x0f, 0x1c, 0x5c, 0x6d, 0xed, 0x77, 0x29, 0xb4, 0xf2, 0xf5, 0xc5, 0x0b, 0x7f,
-	0x81, 0xde, 0x81, 0xfd, 0xba, 0x90, 0x0a, 0x64, 0xde, 0x12, 0x7b, 0xb8, 0xb1, 0xb3, 0xd3, 0x68,
-	0xd5, 0x8b, 0x33, 0xe5, 0xd5, 0xd3, 0xb3, 0xca, 0x83, 0xaf, 0x37, 0xb9, 0x31, 0x1a, 0x31, 0xc7,
-	0xba, 0xa6, 0xe1, 0xcd, 0x36, 0xdd, 0x6a, 0xec, 0x16, 0x53, 0xd7, 0x31, 0xbc, 0xe9, 0x7a, 0x7d,
-	0x16, 0x54, 0x1f, 0xbf, 0xf8, 0x6a, 0x65, 0xe6, 0xe5, 0x57, 0x2b, 0x33, 0x2f, 0x5e, 0xad, 0xa4,
-	0x5e, 0xbe, 0x5a, 0x49, 0xfd, 0xf5, 0xeb, 0x95, 0x99, 0xdf, 0xbc, 0x5e, 0x49, 0xbd, 0x7c, 0xbd,
-	0x32, 0xf3, 0x6f, 0xaf, 0x57, 0x66, 0x9e, 0x7d, 0xaf, 0x6f, 0x07, 0x07, 0xe3, 0xbd, 0x87, 0x3d,
-	0x77, 0xf8, 0xae, 0x7f, 0xe2, 0xf4, 0x82, 0x03, 0xdb, 0xe9, 0x6b, 0xbf, 0xf4, 0xff, 0xd9, 0xb1,
-	0x97, 0x87, 0x5f, 0x3f, 0xfc, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x35, 0x55, 0x08, 0x69, 0xf0,
-	0x21, 0x00, 0x00,
+	// 3411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x6c, 0x24, 0xc7,
+	0x75, 0xe6, 0xfc, 0x71, 0x86, 0xc5, 0x9f, 0x1d, 0xd6, 0xfe, 0x8d, 0x66, 0x57, 0xec, 0x49, 0x79,
+	0x1d, 0x53, 0x74, 0xbc, 0xb2, 0x68, 0xd9, 0x51, 0x24, 0x45, 0x02, 0xe7, 0x87, 0xdc, 0xb1, 0xb8,
+	0x33, 0x54, 0x0d, 0x77, 0x65, 0x2d, 0x10, 0x34, 0x9a, 0xd3, 0xc5, 0x61, 0x63, 0x67, 0xba, 0x27,
+	0xdd, 0x3d, 0x4b, 0xd2, 0xc8, 0x25, 0x31, 0x60, 0x38, 0x3c, 0x04, 0x81, 0x4e, 0x41, 0x60, 0x22,
+	0x46, 0x2e, 0x39, 0x25, 0x40, 0x02, 0xe4, 0xe2, 0x53, 0x8e, 0xba, 0x65, 0x61, 0x20, 0x40, 0x90,
+	0x43, 0x03, 0x5a, 0x5d, 0x12, 0xe6, 0x36, 0xc7, 0x9c, 0x82, 0x7a, 0x55, 0x5d, 0x5d, 0xcd, 0x1f,
+	0x85, 0xb2, 0x0e, 0x39, 0xed, 0xd4, 0xf7, 0xbe, 0xf7, 0xba, 0xba, 0xde, 0xab, 0xf7, 0xd3, 0x5c,
+	0x74, 0x67, 0xe8, 0xec, 0xbd, 0x39, 0xf6, 0xbd, 0xd0, 0xeb, 0x7b, 0xc3, 0x37, 0xf7, 0xd8, 0xf8,
+	0x21, 0x2c, 0x70, 0x29, 0xc6, 0xaa, 0x73, 0xec, 0x28, 0x14, 0x60, 0xf5, 0x5b, 0x3e, 0x1b, 0x7b,
+	0x81, 0xa0, 0xef, 0x4d, 0xf6, 0xdf, 0x1c, 0x78, 0x03, 0x0f, 0x16, 0xf0, 0x4b, 0x90, 0xc8, 0x3f,
+	0xe5, 0x51, 0xe1, 0x11, 0x1b, 0x0e, 0x3d, 0xdc, 0x40, 0xf3, 0x36, 0x7b, 0xe1, 0xf4, 0x99, 0xe9,
+	0x5a, 0x23, 0x56, 0xc9, 0xd4, 0x32, 0xab, 0x73, 0x75, 0x72, 0x16, 0x19, 0x48, 0xc0, 0x1d, 0x6b,
+	0xc4, 0xa6, 0x91, 0x51, 0x3e, 0x1a, 0x0d, 0xdf, 0x25, 0x09, 0x44, 0xa8, 0x26, 0xe7, 0x46, 0xfa,
+	0x43, 0x87, 0xb9, 0xa1, 0x30, 0x92, 0x4d, 0x8c, 0x08, 0x38, 0x65, 0x24, 0x81, 0x08, 0xd5, 0xe4,
+	0xb8, 0x8b, 0x96, 0xa4, 0x91, 0x17, 0xcc, 0x0f, 0x1c, 0xcf, 0xad, 0xe4, 0xc0, 0xce, 0xea, 0x59,
+	0x64, 0x2c, 0x0a, 0xc9, 0x53, 0x21, 0x98, 0x46, 0xc6, 0x4d, 0xcd, 0x94, 0x44, 0x09, 0x4d, 0xb3,
+	0xf0, 0x33, 0x74, 0xc3, 0x9d, 0x8c, 0xcc, 0xbe, 0xe7, 0xba, 0xac, 0x1f, 0x3a, 0x9e, 0x1b, 0x54,
+	0xf2, 0xb5, 0xcc, 0x6a, 0xa1, 0xfe, 0xd6, 0x59, 0x64, 0x2c, 0xb9, 0x93, 0x51, 0x23, 0x91, 0x4c,
+	0x23, 0xe3, 0x16, 0x98, 0x4c, 0xc3, 0xe4, 0x7f, 0x22, 0x23, 0xe7, 0xb8, 0x21, 0x3d, 0x47, 0xc7,
+	0x1f, 0xa0, 0xb9, 0xd0, 0x19, 0xb1, 0x20, 0xb4, 0x46, 0xe3, 0x4a, 0xa1, 0x96, 0x59, 0xcd, 0xd5,
+	0x6b, 0x67, 0x91, 0x91, 0x80, 0xd3, 0xc8, 0xb8, 0x01, 0x06, 0x15, 0x42, 0x68, 0x22, 0xc5, 0x1f,
+	0xa3, 0x1b, 0xe3, 0x89, 0xdb, 0x3f, 0x30, 0x2d, 0xdb, 0xf6, 0x59, 0x10, 0xb0, 0xa0, 0x32, 0x5b,
+	0xcb, 0xc9, 0xb7, 0x5d, 0x02, 0xd1, 0x46, 0x2c, 0x99, 0x46, 0x06, 0x06, 0x53, 0x3a, 0x4c, 0xe8,
+	0x39, 0x16, 0x36, 0xd1, 0x7c, 0x30, 0xe9, 0xf7, 0x59, 0x10, 0xc0, 0xab, 0x16, 0x6b, 0xb9, 0xd5,
+	0xf9, 0xf5, 0x5b, 0x0f, 0xe3, 0x18, 0x79, 0xd8, 0x53, 0xc2, 0xfa, 0x77, 0x3e, 0x8f, 0x8c, 0x99,
+	0xb3, 0xc8, 0xd0, 0x15, 0x94, 0x7f, 0x12, 0x8c, 0x50, 0x9d, 0x40, 0xfe, 0x3e, 0x8b, 0x50, 0x62,
+	0x04, 0xf7, 0xd0, 0x0d, 0x6f, 0x68, 0x9b, 0x7d, 0xe6, 0x87, 0xce, 0xbe, 0xd3, 0xb7, 0x42, 0x11,
+	0x3d, 0x0b, 0xf5, 0x35, 0xfe, 0x0a, 0xde, 0xd0, 0x6e, 0x24, 0x12, 0x75, 0xbc, 0x69, 0x98, 0xd0,
+	0x73, 0x3c, 0xfc, 0xe7, 0x19, 0xb4, 0xe8, 0xb2, 0x43, 0x53, 0xc6, 0xa4, 0x63, 0x43, 0x30, 0x2d,
+	0xd4, 0x19, 0xdf, 0xf1, 0x7f, 0x44, 0x46, 0xa9, 0x09, 0x82, 0x76, 0xf3, 0x55, 0x64, 0xcc, 0x77,
+	0xd8, 0x61, 0xbc, 0xe4, 0x2f, 0xe3, 0x26, 0xcb, 0x69, 0x64, 0x2c, 0x0b, 0x77, 0x2a, 0xcc, 0x26,
+	0xd3, 0x7f, 0x7d, 0xa0, 0x73, 0x7e, 0xf1, 0xf2, 0x41, 0xe6, 0xb3, 0x97, 0x0f, 0x74, 0x2b, 0x54,
+	0x93, 0xdb, 0xdc, 0xc7, 0x81, 0x33, 0x70, 0xad, 0x70, 0xe2, 0x33, 0x88, 0xc5, 0x05, 0xe1, 0x63,
+	0x05, 0x2a, 0x1f, 0x2b, 0x84, 0xd0, 0x44, 0x4a, 0xfe, 0x31, 0x83, 0x66, 0x1f, 0x31, 0xcb, 0x66,
+	0x3e, 0xde, 0x40, 0xf9, 0xf0, 0x78, 0x2c, 0x0e, 0x68, 0x69, 0xfd, 0x76, 0xe2, 0x94, 0xc7, 0x2c,
+	0x08, 0xac, 0x01, 0xdb, 0x3d, 0x1e, 0xb3, 0xfa, 0x9d, 0xb3, 0xc8, 0x00, 0xda, 0x34, 0x32, 0x90,
+	0x88, 0x9d, 0xe3, 0x31, 0x23, 0x14, 0x30, 0x6c, 0xa3, 0xf9, 0xbe, 0x37, 0x1a, 0xfb, 0xe2, 0xf4,
+	0xe1, 0x58, 0x96, 0xd6, 0xef, 0x5f, 0xb0, 0xd4, 0x48, 0x38, 0xf5, 0x07, 0xfc, 0x54, 0x34, 0x25,
+	0x75, 0x2a, 0x1a, 0x46, 0xa8, 0xce, 0x20, 0xbf, 0xcc, 0xa0, 0xc5, 0xc6, 0x70, 0x12, 0x84, 0xcc,
+	0x6f, 0x78, 0xee, 0xbe, 0x33, 0xc0, 0x1f, 0xa1, 0xe2, 0xbe, 0x37, 0xb4, 0x99, 0x1f, 0x54, 0x32,
+	0x10, 0x52, 0xe5, 0xe4, 0x99, 0x9b, 0x20, 0xa8, 0x1b, 0x32, 0x9c, 0x62, 0xe2, 0x34, 0x32, 0x16,
+	0xe0, 0x39, 0x62, 0x4d, 0x68, 0x2c, 0x80, 0x23, 0x65, 0x7d, 0xcf, 0xb5, 0x2d, 0xff, 0x18, 0x5e,
+	0xa1, 0x24, 0x8f, 0x34, 0x06, 0x93, 0x23, 0x8d, 0x11, 0x7e, 0xa4, 0xea, 0xf7, 0xaf, 0xf3, 0x68,
+	0x56, 0x3c, 0x14, 0x3f, 0x44, 0x59, 0xc7, 0x96, 0xf9, 0x6a, 0xe5, 0x55, 0x64, 0x64, 0x21, 0x08,
+	0xb2, 0x8e, 0x3d, 0x8d, 0x8c, 0x12, 0x98, 0x70, 0x6c, 0xf2, 0xd9, 0xcb, 0x07, 0xd9, 0x76, 0x93,
+	0x66, 0x1d, 0x1b, 0x3f, 0x44, 0x85, 0xa1, 0xb5, 0xc7, 0x86, 0x32, 0x3b, 0x55, 0xce, 0x22, 0x43,
+	0x00, 0xd3, 0xc8, 0x98, 0x07, 0x3e, 0xac, 0x08, 0x15, 0x28, 0x7e, 0x0f, 0xcd, 0xf9, 0xcc, 0xb2,
+	0x4d, 0xcf, 0x1d, 0x1e, 0x83, 0xf7, 0x4b, 0xf5, 0x95, 0xb3, 0xc8, 0x28, 0x71, 0xb0, 0xeb, 0x0e,
+	0xf9, 0x4e, 0x97, 0x40, 0x2d, 0x06, 0x08, 0x55, 0x32, 0x6c, 0x22, 0xec, 0x0c, 0x5c, 0xcf, 0x67,
+	0xe6, 0x98, 0xf9, 0x23, 0x27, 0x08, 0x54, 0xf6, 0x29, 0xd5, 0xbf, 0x7f, 0x16, 0x19, 0xcb, 0x42,
+	0xba, 0x93, 0x08, 0xa7, 0x91, 0x71, 0x57, 0xec, 0xfa, 0xbc, 0x84, 0xd0, 0x8b, 0x6c, 0xfc, 0x11,
+	0x5a, 0x94, 0x0f, 0xb0, 0xd9, 0x90, 0x85, 0x0c, 0x72, 0x50, 0xa9, 0xfe, 0xbb, 0x67, 0x91, 0xb1,
+	0x20, 0x04, 0x4d, 0xc0, 0x55, 0xee, 0xd0, 0x41, 0x42, 0x53, 0x1c, 0x6c, 0xa3, 0x5b, 0xb6, 0x13,
+	0x58, 0x7b, 0x43, 0x66, 0x86, 0x6c, 0x34, 0x36, 0x1d, 0xd7, 0x66, 0x47, 0x90, 0x91, 0xb8, 0xcd,
+	0xf5, 0xb3, 0xc8, 0xc0, 0x52, 0xbe, 0xcb, 0x46, 0xe3, 0xb6, 0x90, 0x4e, 0x23, 0xa3, 0x22, 0x8a,
+	0xc2, 0x05, 0x11, 0xa1, 0x97, 0xf0, 0xf1, 0x3a, 0x9a, 0x1d, 0x5b, 0x93, 0x80, 0xd9, 0x95, 0x22,
+	0xd8, 0xad, 0x9e, 0x45, 0x86, 0x44, 0x54, 0xc0, 0x88, 0x25, 0xa1, 0x12, 0xe7, 0xc1, 0x27, 0x32,
+	0x41, 0x50, 0x29, 0x9f, 0x0f, 0x3e, 0x71, 0x4f, 0x93, 0xe0, 0x93, 0x44, 0x65, 0x4b, 0xac, 0x09,
+	0x8d, 0x05, 0xe4, 0x5f, 0x66, 0xd1, 0xac, 0x50, 0xc2, 0x75, 0x15, 0x3c, 0x0b, 0xf5, 0xf5, 0x4b,
+	0x52, 0xcb, 0xa5, 0xc1, 0x24, 0x13, 0x46, 0x1c, 0x50, 0x6b, 0x28, 0xaf, 0x55, 0x3b, 0xb8, 0xbc,
+	0xae, 0x35, 0x4a, 0x2e, 0xaf, 0x0b, 0x15, 0x0e, 0x30, 0xfc, 0x3e, 0x9a, 0x4b, 0x12, 0x7d, 0x0e,
+	0x12, 0x3d, 0x0f, 0xa6, 0x04, 0x9c, 0x46, 0xc6, 0x22, 0x68, 0x59, 0x71, 0x7a, 0x4f, 0x64, 0xf8,
+	0x8f, 0xd2, 0x57, 0x3f, 0x7f, 0x3e, 0x89, 0x7c, 0xb3, 0x3b, 0xcf, 0x23, 0x9d, 0x27, 0x71, 0x51,
+	0xbb, 0x0b, 0xe2, 0x42, 0xf1, 0x48, 0xe7, 0xa0, 0xac, 0xdc, 0x22, 0xd2, 0x63, 0x80, 0x50, 0x25,
+	0xc3, 0x5b, 0x68, 0x61, 0x64, 0x1d, 0x99, 0x01, 0xfb, 0xe3, 0x09, 0x73, 0xfb, 0x0c, 0x62, 0x26,
+	0x27, 0x76, 0x31, 0xb2, 0x8e, 0x7a, 0x12, 0x56, 0xbb, 0xd0, 0x30, 0x42, 0x75, 0x06, 0xae, 0x23,
+	0xe4, 0xb8, 0xa1, 0xef, 0xd9, 0x93, 0x3e, 0xf3, 0x65, 0x88, 0x40, 0x0b, 0x91, 0xa0, 0xaa, 0x44,
+	0x25, 0x10, 0xa1, 0x9a, 0x1c, 0x0f, 0x50, 0x09, 0x62, 0x97, 0xd7, 0x8d, 0x52, 0x2d, 0xb3, 0x9a,
+	0xaf, 0x6f, 0x4b, 0xe7, 0x16, 0x21, 0x0a, 0xc1, 0xb7, 0xf1, 0x4f, 0x1e, 0x33, 0xc0, 0x6e, 0xdb,
+	0xea, 0xf4, 0xe5, 0x9a, 0xe7, 0x8d, 0x98, 0xf6, 0xd7, 0xc9, 0x4f, 0x1a, 0xf3, 0xf1, 0x9f, 0xa0,
+	0x6a, 0xf0, 0xdc, 0x19, 0x9b, 0xf1, 0xb3, 0x79, 0x53, 0x60, 0xfa, 0x6c, 0xe4, 0xbd, 0xb0, 0x86,
+	0x41, 0x65, 0x0e, 0x36, 0xff, 0xc1, 0x59, 0x64, 0x54, 0x38, 0xab, 0xad, 0x91, 0xa8, 0xe4, 0x4c,
+	0x23, 0x63, 0x45, 0xe4, 0xb9, 0x2b, 0x08, 0x84, 0x5e, 0xa9, 0x8b, 0x8f, 0xd0, 0x6b, 0xcc, 0xed,
+	0xfb, 0xc7, 0x63, 0x78, 0xec, 0xd8, 0x0a, 0x82, 0x43, 0xcf, 0xb7, 0xcd, 0xd0, 0x7b, 0xce, 0xdc,
+	0x0a, 0x82, 0xa0, 0x7e, 0xff, 0x2c, 0x32, 0xee, 0x26, 0xa4, 0x1d, 0xc9, 0xd9, 0xe5, 0x94, 0x69,
+	0x64, 0xbc, 0x0e, 0xcf, 0xbe, 0x42, 0x4e, 0xe8, 0x55, 0x9a, 0xe4, 0xcf, 0x32, 0xa8, 0x00, 0x87,
+	0xc1, 0x6f, 0xb3, 0x48, 0xea, 0x32, 0x05, 0xc3, 0x6d, 0x16, 0xc8, 0x85, 0xf4, 0x2f, 0x71, 0xdc,
+	0x42, 0x85, 0x7d, 0x67, 0xc8, 0x82, 0x4a, 0x16, 0xee, 0x32, 0xd6, 0x0a, 0x89, 0x33, 0x64, 0x6d,
+	0x77, 0xdf, 0xab, 0xdf, 0x93, 0xb7, 0x59, 0x10, 0xd5, 0x5d, 0xe2, 0x2b, 0x42, 0x05, 0x48, 0x7e,
+	0x91, 0x41, 0xf3, 0xb0, 0x89, 0x27, 0x63, 0x9b, 0xf7, 0x0c, 0xff, 0x8f, 0x5b, 0xf9, 0xf9, 0x22,
+	0x2a, 0xc5, 0x0a, 0x2a, 0x21, 0x64, 0xae, 0x91, 0x10, 0xd6, 0x50, 0x3e, 0x70, 0x7e, 0x2a, 0xda,
+	0x8a, 0x9c, 0xe0, 0xf2, 0xb5, 0xe2, 0xf2, 0x05, 0xa1, 0x80, 0xe1, 0x0f, 0x11, 0x1a, 0x79, 0xb6,
+	0xb3, 0xef, 0x30, 0xdb, 0x0c, 0xf4, 0x66, 0x33, 0x46, 0x7b, 0xaa, 0x6a, 0x2a, 0x84, 0xd0, 0x44,
+	0xca, 0xf3, 0x87, 0x32, 0xb0, 0x77, 0x5c, 0x59, 0x80, 0x9b, 0xf1, 0x7e, 0x7c, 0x33, 0x7a, 0x07,
+	0x9e, 0x1f, 0xc2, 0x75, 0x50, 0x8f, 0xa9, 0x1f, 0xab, 0xab, 0x96, 0x40, 0x84, 0xdf, 0x04, 0x49,
+	0xa6, 0x1a, 0x15, 0x6f, 0xa3, 0x62, 0xdc, 0xb1, 0xf3, 0xc8, 0x4f, 0x25, 0xe9, 0xa7, 0xac, 0x1f,
+	0x7a, 0x7e, 0xbd, 0x16, 0x27, 0xe9, 0x17, 0xaa, 0x83, 0x17, 0x17, 0xee, 0x45, 0xdc, 0xbb, 0xc7,
+	0x12, 0xfc, 0x2e, 0x2a, 0xa9, 0x64, 0x82, 0xe0, 0x5d, 0x21, 0x19, 0x05, 0x49, 0x26, 0x59, 0x92,
+	0x0d, 0x42, 0x9c, 0x46, 0x94, 0x0c, 0xff, 0x18, 0xcd, 0xee, 0x0d, 0xbd, 0xfe, 0xf3, 0xb8, 0x5a,
+	0xdc, 0x4c, 0x36, 0x52, 0xe7, 0x38, 0xf8, 0xf5, 0x75, 0xb9, 0x17, 0x49, 0x55, 0xe5, 0x1f, 0x96,
+	0x84, 0x4a, 0x98, 0x8f, 0x23, 0xc1, 0xf1, 0x68, 0xe8, 0xb8, 0xcf, 0xcd, 0xd0, 0xf2, 0x07, 0x2c,
+	0xac, 0x2c, 0x27, 0xe3, 0x88, 0x94, 0xec, 0x82, 0x40, 0x8d, 0x23, 0x29, 0x94, 0xd0, 0x34, 0x8b,
+	0x0f, 0x49, 0xc2, 0xb4, 0x79, 0x60, 0x05, 0x07, 0x15, 0x0c, 0xf7, 0x14, 0x32, 0x9c, 0x80, 0x1f,
+	0x59, 0xc1, 0x81, 0x3a, 0xf6, 0x04, 0x22, 0x54, 0x93, 0xf3, 0x06, 0x4a, 0xde, 0x4d, 0x66, 0x57,
+	0x6e, 0x26, 0x3d, 0xa9, 0x02, 0x55, 0x28, 0x28, 0x84, 0xd0, 0x44, 0x8a, 0xeb, 0xb2, 0x11, 0x15,
+	0xed, 0xe3, 0x9d, 0x8b, 0x61, 0x7f, 0x8d, 0x4e, 0x74, 0x13, 0xcd, 0x9f, 0xef, 0x6a, 0x16, 0x45,
+	0xc6, 0x1f, 0xa7, 0xfa, 0x19, 0x91, 0xf1, 0xc7, 0x7a, 0x27, 0xa3, 0x33, 0xf0, 0x8f, 0xb5, 0xb0,
+	0x74, 0x83, 0xca, 0x3c, 0xcc, 0x66, 0x6f, 0xe8, 0x71, 0xd8, 0x09, 0x2e, 0xc4, 0x61, 0x27, 0x99,
+	0xc9, 0x34, 0x1a, 0xde, 0x47, 0xe2, 0x94, 0x4c, 0xb8, 0x55, 0x8b, 0x60, 0x6a, 0xeb, 0x55, 0x64,
+	0x2c, 0x50, 0xeb, 0x10, 0x5c, 0xdf, 0x73, 0x7e, 0xca, 0xf8, 0x41, 0xed, 0xc5, 0x0b, 0x75, 0x50,
+	0x0a, 0x89, 0x0d, 0x7f, 0xf6, 0xf2, 0x41, 0x4a, 0x8d, 0x26, 0x4a, 0xf8, 0x29, 0x2a, 0x8d, 0x87,
+	0x56, 0xb8, 0xef, 0xf9, 0xa3, 0xca, 0x12, 0x04, 0xbb, 0x76, 0x86, 0x3b, 0x52, 0xd2, 0xb4, 0x42,
+	0xab, 0x4e, 0x64, 0x98, 0x29, 0xbe, 0x8a, 0xdc, 0x18, 0x20, 0x54, 0xc9, 0x70, 0x13, 0xcd, 0x0f,
+	0xbd, 0xbe, 0x35, 0x34, 0xf7, 0x87, 0xd6, 0x20, 0xa8, 0xfc, 0x67, 0x11, 0x0e, 0x15, 0xa2, 0x03,
+	0xf0, 0x4d, 0x0e, 0xab, 0xc3, 0x48, 0x20, 0x42, 0x35, 0x39, 0x7e, 0x84, 0x16, 0xe4, 0x35, 0x12,
+	0x31, 0xf6, 0x5f, 0x45, 0x88, 0x10, 0xf0, 0x8d, 0x14, 0xc8, 0x28, 0x5b, 0xd6, 0x6f, 0x9f, 0x08,
+	0x33, 0x9d, 0xc1, 0xe7, 0x53, 0xc7, 0xf5, 0x6c, 0x66, 0xf6, 0x0f, 0x2c, 0x77, 0xc0, 0xb8, 0x7f,
+	0xce, 0x8a, 0x70, 0x1b, 0x21, 0xfe, 0x41, 0xd6, 0x00, 0x51, 0x27, 0x50, 0xf1, 0x9f, 0x42, 0x09,
+	0x4d, 0xb3, 0xf0, 0x11, 0xd2, 0xca, 0x8a, 0x19, 0xfa, 0x96, 0x33, 0x64, 0xbe, 0xf0, 0xd7, 0x7f,
+	0x17, 0xc1, 0x61, 0x1f, 0x9e, 0x45, 0xc6, 0xed, 0x84, 0xb3, 0x2b, 0x28, 0xd2, 0x59, 0xf7, 0xce,
+	0x95, 0x2c, 0x4d, 0xaa, 0x22, 0xe2, 0x72, 0x65, 0xfc, 0x23, 0xde, 0x45, 0xf2, 0x4e, 0xd7, 0x96,
+	0x2d, 0xed, 0x7d, 0xd1, 0x2f, 0x02, 0xa4, 0x52, 0x91, 0x5c, 0x43, 0xc3, 0x08, 0xbf, 0x30, 0x45,
+	0x45, 0xc7, 0x7d, 0x61, 0x0d, 0x9d, 0xb8, 0x65, 0x7d, 0xe7, 0x55, 0x64, 0x20, 0x6a, 0x1d, 0xb6,
+	0x05, 0x2a, 0x3a, 0x08, 0xf8, 0xa9, 0x75, 0x10, 0xb0, 0xe6, 0x1d, 0x84, 0xc6, 0xa4, 0x31, 0x8f,
+	0xa7, 0x15, 0xd7, 0x4b, 0x4d, 0x05, 0x25, 0x30, 0x0d, 0xc7, 0xea, 0x7a, 0xe9, 0x89, 0x40, 0x1c,
+	0x6b, 0x0a, 0x25, 0x34, 0xcd, 0x7a, 0x37, 0xff, 0x57, 0xbf, 0x32, 0x66, 0xc8, 0x17, 0x19, 0x34,
+	0xa7, 0x52, 0x1c, 0xaf, 0x2e, 0xe0, 0x7f, 0x31, 0xb4, 0xc2, 0x6d, 0x3e, 0x10, 0x7e, 0x17, 0xb7,
+	0xf9, 0x00, 0x1c, 0x0e, 0x18, 0xaf, 0x9e, 0xde, 0xfe, 0x7e, 0xc0, 0x42, 0xa8, 0x5b, 0x39, 0x51,
+	0x3d, 0x05, 0xa2, 0xaa, 0xa7, 0x58, 0x12, 0x2a, 0x71, 0xfc, 0x96, 0xac, 0x5e, 0x59, 0x70, 0xdb,
+	0xeb, 0x97, 0x57, 0xaf, 0xd8, 0x29, 0x20, 0xe2, 0x4d, 0xe6, 0x21, 0xb3, 0x9e, 0x8b, 0xb8, 0x14,
+	0x29, 0x03, 0xf2, 0x3a, 0x07, 0x65, 0x4c, 0x8a, 0xdb, 0x11, 0x03, 0x84, 0x2a, 0x99, 0x7c, 0xc7,
+	0x67, 0x68, 0x56, 0x94, 0x13, 0xbc, 0x83, 0x4a, 0x7d, 0x6f, 0xe2, 0x86, 0xc9, 0x50, 0xba, 0xac,
+	0x77, 0xc3, 0x20, 0xa9, 0xff, 0x4e, 0x7c, 0x01, 0x63, 0xaa, 0xf2, 0x91, 0x04, 0x78, 0x1b, 0x2b,
+	0x45, 0xe4, 0x67, 0x19, 0x54, 0x94, 0x8a, 0xf8, 0x91, 0x1a, 0x0e, 0xf2, 0xf5, 0x77, 0xce, 0x55,
+	0xc9, 0xaf, 0x1e, 0x34, 0xf5, 0x0a, 0x29, 0x67, 0xce, 0x17, 0xd6, 0x70, 0x22, 0x0e, 0x2a, 0x2f,
+	0x66, 0x4e, 0x00, 0x54, 0xd1, 0x81, 0x15, 0xa1, 0x02, 0x25, 0x3f, 0xcb, 0xa3, 0x05, 0x3d, 0x89,
+	0xf0, 0x74, 0x3d, 0x71, 0x9d, 0x23, 0xd8, 0x4c, 0xaa, 0x4b, 0x79, 0xe2, 0x3a, 0x47, 0x90, 0x66,
+	0xaa, 0x9f, 0x47, 0x46, 0x86, 0x3b, 0x80, 0xf3, 0x94, 0x03, 0xf8, 0x82, 0x50, 0xc0, 0xf0, 0xc7,
+	0xa8, 0x78, 0xe8, 0xb8, 0xb6, 0x77, 0x18, 0xc0, 0x36, 0xe6, 0xf5, 0xc9, 0xe1, 0x13, 0x21, 0x00,
+	0x4b, 0x35, 0x69, 0x29, 0x66, 0xab, 0xe3, 0x92, 0x6b, 0x42, 0x63, 0x09, 0xde, 0x42, 0x85, 0xa1,
+	0xe3, 0x4e, 0x8e, 0x20, 0xc0, 0x52, 0x65, 0xf6, 0x27, 0x56, 0x18, 0xfa, 0x60, 0xee, 0xbe, 0x34,
+	0x27, 0x98, 0xc9, 0x90, 0xcd, 0x57, 0x7c, 0xc8, 0xe6, 0xff, 0xe2, 0x8f, 0xd0, 0xac, 0x6d, 0xf9,
+	0x87, 0x8e, 0x18, 0x6a, 0xae, 0xb0, 0xb4, 0x22, 0x2d, 0x49, 0x6a, 0x32, 0xe0, 0xc1, 0x92, 0x50,
+	0x89, 0x63, 0x86, 0x8a, 0xfb, 0x3e, 0x63, 0x7b, 0x81, 0x5d, 0x29, 0x5c, 0x6d, 0xed, 0x47, 0xdc,
+	0x1a, 0x1f, 0x03, 0x36, 0x7d, 0xc6, 0xea, 0x3d, 0x18, 0x03, 0xa4, 0x9a, 0x7a, 0x63, 0xb9, 0x86,
+	0x31, 0x40, 0xd2, 0x68, 0x4c, 0xc2, 0x26, 0x9a, 0x75, 0x59, 0xb8, 0x17, 0x88, 0x64, 0x72, 0xc5,
+	0x53, 0xd6, 0xe5, 0x53, 0x66, 0x3b, 0x2c, 0x14, 0x0f, 0x91, 0x4a, 0x6a, 0xf7, 0x62, 0xc9, 0x1f,
+	0x21, 0x39, 0x54, 0x32, 0xc8, 0xcf, 0xb3, 0xa8, 0x14, 0xfb, 0x97, 0x37, 0x7f, 0xde, 0xa1, 0xcb,
+	0x7c, 0xfd, 0xf3, 0x2c, 0x54, 0x7c, 0x40, 0xe5, 0x78, 0x26, 0x0a, 0x99, 0x42, 0x08, 0x4d, 0xa4,
+	0xdc, 0xc0, 0xc0, 0xf7, 0x26, 0x63, 0xfd, 0xd3, 0x2c, 0x18, 0x00, 0x34, 0x65, 0x40, 0x21, 0x84,
+	0x26, 0x52, 0xfc, 0x1e, 0xca, 0x4d, 0x1c, 0x1b, 0x5c, 0x5d, 0xa8, 0xbf, 0xf1, 0x2a, 0x32, 0x72,
+	0x4f, 0xe0, 0x06, 0x70, 0x74, 0x1a, 0x19, 0x73, 0x22, 0xe0, 0x1c, 0x5b, 0x2b, 0x9f, 0x9c, 0x41,
+	0xb9, 0x9c, 0x2b, 0x0f, 0x1c, 0xbb, 0x92, 0x4f, 0x94, 0xb7, 0x84, 0xf2, 0x40, 0x53, 0x1e, 0xa4,
+	0x95, 0xb7, 0xb8, 0x32, 0xc7, 0x7e, 0x99, 0x41, 0xf3, 0x5a, 0x84, 0x7e, 0xf3, 0xb3, 0xd8, 0x46,
+	0x4b, 0xc2, 0x80, 0x13, 0x98, 0xf0, 0x82, 0x95, 0x6c, 0xf2, 0xd9, 0x04, 0x24, 0xed, 0x60, 0x8b,
+	0xe3, 0xea, 0xb3, 0x89, 0x0e, 0x12, 0x9a, 0xe2, 0x90, 0x1e, 0x9a, 0x53, 0x0e, 0xc7, 0x9b, 0x68,
+	0xf6, 0x88, 0x2f, 0xe2, 0x84, 0x74, 0xe3, 0x5c, 0x54, 0x24, 0x6d, 0xa7, 0xa0, 0xa9, 0x0b, 0x01,
+	0x4b, 0x42, 0x25, 0x4c, 0xfa, 0xa8, 0x00, 0xfc, 0xaf, 0x35, 0x4d, 0xa4, 0xf2, 0xcc, 0xc2, 0xff,
+	0x9d, 0x67, 0xfe, 0x34, 0x8f, 0x8a, 0x94, 0x37, 0xcd, 0x41, 0x88, 0x7f, 0xa8, 0xb2, 0x5d, 0xa1,
+	0xfe, 0xed, 0xab, 0xd2, 0x5b, 0xe2, 0x9d, 0xf8, 0xeb, 0x47, 0x32, 0x74, 0x65, 0xaf, 0x3d, 0x74,
+	0xc5, 0xaf, 0x94, 0xbb, 0xc6, 0x2b, 0x25, 0x65, 0x29, 0xff, 0xb5, 0xcb, 0x52, 0xe1, 0xfa, 0x65,
+	0x29, 0xae, 0x94, 0xb3, 0xd7, 0xa8, 0x94, 0x5d, 0xb4, 0xb4, 0xef, 0x7b, 0x23, 0xf8, 0x46, 0xe6,
+	0xf9, 0xfc, 0x0b, 0x66, 0x31, 0x29, 0xdd, 0x5c, 0xb2, 0x1b, 0x0b, 0x54, 0xe9, 0x4e, 0xa1, 0x84,
+	0xa6, 0x59, 0xe9, 0x9a, 0x58, 0xfa, 0x7a, 0x35, 0x11, 0x7f, 0x80, 0x4a, 0xa2, 0xe3, 0x75, 0x3d,
+	0x18, 0xbb, 0x0a, 0xf5, 0x6f, 0xf1, 0x54, 0x06, 0x58, 0xc7, 0x53, 0xa9, 0x4c, 0xae, 0xd5, 0x6b,
+	0xc7, 0x04, 0xf2, 0x0f, 0x19, 0x54, 0xa2, 0x2c, 0x18, 0x7b, 0x6e, 0xc0, 0x7e, 0xdb, 0x20, 0x58,
+	0x43, 0x79, 0xdb, 0x0a, 0xad, 0x4a, 0x36, 0x39, 0x3d, 0xbe, 0x56, 0xa7, 0xc7, 0x17, 0x84, 0x02,
+	0x86, 0x3f, 0x44, 0xf9, 0xbe, 0x67, 0x0b, 0xe7, 0x2f, 0xe9, 0x49, 0xb3, 0xe5, 0xfb, 0x9e, 0xdf,
+	0xf0, 0x6c, 0x39, 0x76, 0x70, 0x92, 0x32, 0xc0, 0x17, 0x84, 0x02, 0x46, 0xfe, 0x2e, 0x83, 0xca,
+	0x4d, 0xef, 0xd0, 0x1d, 0x7a, 0x96, 0xbd, 0xe3, 0x7b, 0x03, 0xfe, 0xf9, 0xea, 0xb7, 0x9a, 0xfd,
+	0x4d, 0x54, 0x9c, 0xc0, 0x97, 0x83, 0x78, 0xfa, 0x7f, 0x90, 0x1e, 0x83, 0xce, 0x3f, 0x44, 0x7c,
+	0x66, 0x48, 0x3e, 0x34, 0x4a, 0x65, 0x65, 0x5f, 0xac, 0x09, 0x8d, 0x05, 0xe4, 0x6f, 0x73, 0xa8,
+	0x7a, 0xb5, 0x21, 0x3c, 0x42, 0xf3, 0x82, 0x69, 0x6a, 0x7f, 0x13, 0x58, 0xbd, 0xce, 0x1e, 0x60,
+	0x38, 0x83, 0xa1, 0x60, 0xa2, 0xd6, 0x6a, 0x28, 0x48, 0x20, 0x42, 0x35, 0xf9, 0xd7, 0xfa, 0x4e,
+	0xa9, 0x8d, 0xf2, 0xb9, 0x6f, 0x3e, 0xca, 0xf7, 0xd0, 0xa2, 0x08, 0xd1, 0xf8, 0x83, 0x72, 0xbe,
+	0x96, 0x5b, 0x2d, 0xd4, 0x1f, 0xf2, 0x6c, 0xbb, 0x27, 0x9a, 0xd5, 0xf8, 0x53, 0xf2, 0x72, 0x12,
+	0xac, 0x02, 0x8c, 0xa3, 0xad, 0x3c, 0x43, 0x53, 0x5c, 0xbc, 0x99, 0x9a, 0xf4, 0xc4, 0x55, 0xff,
+	0xce, 0x35, 0x27, 0x3b, 0x6d, 0x92, 0x23, 0xb3, 0x28, 0xbf, 0xe3, 0xb8, 0x03, 0xf2, 0x1e, 0x2a,
+	0x34, 0x86, 0x5e, 0x00, 0x19, 0xc7, 0x67, 0x56, 0xe0, 0xb9, 0x7a, 0x28, 0x09, 0x44, 0xb9, 0x5a,
+	0x2c, 0x09, 0x95, 0xf8, 0xda, 0xaf, 0x73, 0x68, 0x5e, 0xfb, 0x13, 0x0e, 0xfe, 0x43, 0x74, 0xef,
+	0x71, 0xab, 0xd7, 0xdb, 0xd8, 0x6a, 0x99, 0xbb, 0x9f, 0xee, 0xb4, 0xcc, 0xc6, 0xf6, 0x93, 0xde,
+	0x6e, 0x8b, 0x9a, 0x8d, 0x6e, 0x67, 0xb3, 0xbd, 0x55, 0x9e, 0xa9, 0xde, 0x3f, 0x39, 0xad, 0x55,
+	0x34, 0x8d, 0xf4, 0xdf, 0x5a, 0x7e, 0x0f, 0xe1, 0x94, 0x7a, 0xbb, 0xd3, 0x6c, 0xfd, 0xa4, 0x9c,
+	0xa9, 0xde, 0x3a, 0x39, 0xad, 0x95, 0x35, 0x2d, 0xf1, 0x09, 0xee, 0x0f, 0xd0, 0x6b, 0x17, 0xd9,
+	0xe6, 0x93, 0x9d, 0xe6, 0xc6, 0x6e, 0xab, 0x9c, 0xad, 0x56, 0x4f, 0x4e, 0x6b, 0x77, 0xce, 0x2b,
+	0xc9, 0x10, 0xfc, 0x3e, 0xba, 0x95, 0x52, 0xa5, 0xad, 0x8f, 0x9f, 0xb4, 0x7a, 0xbb, 0xe5, 0x5c,
+	0xf5, 0xce, 0xc9, 0x69, 0x0d, 0x6b, 0x5a, 0x71, 0x99, 0x58, 0x47, 0xb7, 0xcf, 0x69, 0xf4, 0x76,
+	0xba, 0x9d, 0x5e, 0xab, 0x9c, 0xaf, 0xde, 0x3d, 0x39, 0xad, 0xdd, 0x4c, 0xa9, 0xc8, 0xac, 0xd2,
+	0x40, 0x2b, 0x29, 0x9d, 0x66, 0xf7, 0x93, 0xce, 0x76, 0x77, 0xa3, 0x69, 0xee, 0xd0, 0xee, 0x16,
+	0x6d, 0xf5, 0x7a, 0xe5, 0x42, 0xd5, 0x38, 0x39, 0xad, 0xdd, 0xd3, 0x94, 0x2f, 0xdc, 0xf0, 0x35,
+	0xb4, 0x9c, 0x32, 0xb2, 0xd3, 0xee, 0x6c, 0x95, 0x67, 0xab, 0x37, 0x4f, 0x4e, 0x6b, 0x37, 0x34,
+	0x3d, 0xee, 0xcb, 0x0b, 0xe7, 0xd7, 0xd8, 0xee, 0xf6, 0x5a, 0xe5, 0xe2, 0x85, 0xf3, 0x03, 0x87,
+	0xaf, 0xfd, 0x4d, 0x06, 0xe1, 0x8b, 0x7f, 0x35, 0xc3, 0xef, 0xa0, 0x4a, 0x6c, 0xa4, 0xd1, 0x7d,
+	0xbc, 0xc3, 0xf7, 0xd9, 0xee, 0x76, 0xcc, 0x4e, 0xb7, 0xd3, 0x2a, 0xcf, 0xa4, 0x4e, 0x55, 0xd3,
+	0xea, 0x78, 0x2e, 0xff, 0x0b, 0xf6, 0xdd, 0xcb, 0x34, 0xb7, 0x9f, 0xbd, 0x5d, 0xce, 0x54, 0xd7,
+	0x4f, 0x4e, 0x6b, 0xb7, 0x2f, 0x2a, 0x6e, 0x3f, 0x7b, 0xfb, 0x37, 0x7f, 0xf1, 0xed, 0xcb, 0x05,
+	0x6b, 0xbc, 0x01, 0xd2, 0xb7, 0xf6, 0x16, 0xba, 0xa5, 0x1b, 0x7e, 0xdc, 0xda, 0xdd, 0x68, 0x6e,
+	0xec, 0x6e, 0x94, 0x67, 0x84, 0x0f, 0x34, 0xea, 0x63, 0x16, 0x5a, 0x90, 0x76, 0xbf, 0x8b, 0x96,
+	0x53, 0x6f, 0xd1, 0x7a, 0xda, 0xa2, 0x71, 0x44, 0xe9, 0xfb, 0x67, 0x2f, 0x98, 0x8f, 0xbf, 0x87,
+	0xb0, 0x4e, 0xde, 0xd8, 0xfe, 0x64, 0xe3, 0xd3, 0x5e, 0x39, 0x5b, 0xbd, 0x7d, 0x72, 0x5a, 0x5b,
+	0xd6, 0xd8, 0x1b, 0xc3, 0x43, 0xeb, 0x38, 0x58, 0xfb, 0xe7, 0x2c, 0x5a, 0xd0, 0xbf, 0x1b, 0xe1,
+	0xef, 0xa1, 0x9b, 0x9b, 0xed, 0x6d, 0x1e, 0x89, 0x9b, 0x5d, 0xe1, 0x01, 0xbe, 0x2c, 0xcf, 0x88,
+	0xc7, 0xe9, 0x54, 0xfe, 0x1b, 0xff, 0x3e, 0xaa, 0x9c, 0xa3, 0x37, 0xdb, 0xb4, 0xd5, 0xd8, 0xed,
+	0xd2, 0x4f, 0xcb, 0x99, 0xea, 0x6b, 0xfc, 0xc0, 0x74, 0x9d, 0xa6, 0xe3, 0x43, 0x0a, 0x3a, 0xc6,
+	0x1f, 0xa0, 0x7b, 0xe7, 0x14, 0x7b, 0x9f, 0x3e, 0xde, 0x6e, 0x77, 0x3e, 0x12, 0xcf, 0xcb, 0x56,
+	0x5f, 0x3f, 0x39, 0xad, 0xdd, 0xd5, 0x75, 0x7b, 0xe2, 0x53, 0x1c, 0x87, 0x4a, 0x19, 0xfc, 0x08,
+	0xd5, 0xae, 0xd0, 0x4f, 0x36, 0x90, 0xab, 0x92, 0x93, 0xd3, 0xda, 0xfd, 0x4b, 0x8c, 0xa8, 0x7d,
+	0x94, 0x32, 0xf8, 0x07, 0xe8, 0xce, 0xe5, 0x96, 0xe2, 0x7b, 0x71, 0x89, 0xfe, 0xda, 0xbf, 0x65,
+	0xd0, 0x9c, 0xaa, 0x7a, 0xfc, 0xd0, 0x5a, 0x94, 0x76, 0x79, 0x92, 0x68, 0xb6, 0xcc, 0x4e, 0xd7,
+	0x84, 0x55, 0x7c, 0x68, 0x8a, 0xd7, 0xf1, 0xe0, 0x27, 0x8f, 0x71, 0x8d, 0xbe, 0xd5, 0xea, 0xb4,
+	0x68, 0xbb, 0x11, 0x7b, 0x54, 0xb1, 0xb7, 0x98, 0xcb, 0x7c, 0xa7, 0x8f, 0xdf, 0x46, 0x77, 0xd3,
+	0xc6, 0x7b, 0x4f, 0x1a, 0x8f, 0xe2, 0x53, 0x82, 0x0d, 0x6a, 0x0f, 0xe8, 0x4d, 0xfa, 0x07, 0xe0,
+	0x98, 0x1f, 0xa6, 0xb4, 0xda, 0x9d, 0xa7, 0x1b, 0xdb, 0xed, 0xa6, 0xd0, 0xca, 0x55, 0x2b, 0x27,
+	0xa7, 0xb5, 0x5b, 0x4a, 0x4b, 0x7e, 0xe0, 0xe0, 0x6a, 0x6b, 0xbf, 0xc9, 0xa0, 0x95, 0xaf, 0x2e,
+	0x5e, 0xf8, 0x13, 0xf4, 0x06, 0x9c, 0xd7, 0x85, 0x54, 0x20, 0xf3, 0x96, 0x38, 0xc3, 0x8d, 0x9d,
+	0x9d, 0x56, 0xa7, 0x59, 0x9e, 0xa9, 0xae, 0x9e, 0x9c, 0xd6, 0x1e, 0x7c, 0xb5, 0xc9, 0x8d, 0xf1,
+	0x98, 0xb9, 0xf6, 0x35, 0x0d, 0x6f, 0x76, 0xe9, 0x56, 0x6b, 0xb7, 0x9c, 0xb9, 0x8e, 0xe1, 0x4d,
+	0x8f, 0x7f, 0xb6, 0xad, 0x3f, 0xfe, 0xfc, 0x8b, 0x95, 0x99, 0x97, 0x5f, 0xac, 0xcc, 0x7c, 0xfe,
+	0x6a, 0x25, 0xf3, 0xf2, 0xd5, 0x4a, 0xe6, 0x2f, 0xbf, 0x5c, 0x99, 0xf9, 0xd5, 0x97, 0x2b, 0x99,
+	0x97, 0x5f, 0xae, 0xcc, 0xfc, 0xfb, 0x97, 0x2b, 0x33, 0xcf, 0xbe, 0x3b, 0x70, 0xc2, 0x83, 0xc9,
+	0xde, 0xc3, 0xbe, 0x37, 0x7a, 0x33, 0x38, 0x76, 0xfb, 0xe1, 0x81, 0xe3, 0x0e, 0xb4, 0x5f, 0xfa,
+	0xff, 0xde, 0xd9, 0x9b, 0x85, 0x5f, 0x3f, 0xf8, 0xdf, 0x01, 0x00, 0xd6, 0xe9, 0x97, 0xed, 0xd4,
+	0x23, 0x00, 0x00,
 }
 
 func (m *Hello) Marshal() (dAtA []byte, err error) {
@@ -1372,6 +1430,29 @@ func (m *Hello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Successions) > 0 {
+		for iNdEx := len(m.Successions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Successions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBep(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PunchAddresses) > 0 {
+		for iNdEx := len(m.PunchAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PunchAddresses[iNdEx])
+			copy(dAtA[i:], m.PunchAddresses[iNdEx])
+			i = encodeVarintBep(dAtA, i, uint64(len(m.PunchAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintBep(dAtA, i, uint64(m.Timestamp))
 		i--
@@ -1406,6 +1487,53 @@ func (m *Hello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Succession) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Succession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Succession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.NewDeviceID.ProtoSize()
+		i -= size
+		if _, err := m.NewDeviceID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBep(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OldCertificate) > 0 {
+		i -= len(m.OldCertificate)
+		copy(dAtA[i:], m.OldCertificate)
+		i = encodeVarintBep(dAtA, i, uint64(len(m.OldCertificate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2647,6 +2775,37 @@ func (m *Hello) ProtoSize() (n int) {
 	if m.Timestamp != 0 {
 		n += 1 + sovBep(uint64(m.Timestamp))
 	}
+	if len(m.PunchAddresses) > 0 {
+		for _, s := range m.PunchAddresses {
+			l = len(s)
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
+	if len(m.Successions) > 0 {
+		for _, e := range m.Successions {
+			l = e.ProtoSize()
+			n += 1 + l + sovBep(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Succession) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldCertificate)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
+	l = m.NewDeviceID.ProtoSize()
+	n += 1 + l + sovBep(uint64(l))
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovBep(uint64(l))
+	}
 	return n
 }
 
@@ -3334,6 +3493,223 @@ func (m *Hello) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PunchAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PunchAddresses = append(m.PunchAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Successions = append(m.Successions, Succession{})
+			if err := m.Successions[len(m.Successions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Succession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Succession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Succession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldCertificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldCertificate = append(m.OldCertificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldCertificate == nil {
+				m.OldCertificate = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDeviceID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewDeviceID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBep
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBep(dAtA[iNdEx:])
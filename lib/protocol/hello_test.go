@@ -58,6 +58,48 @@ func TestVersion14Hello(t *testing.T) {
 	}
 }
 
+func TestHelloPunchAddresses(t *testing.T) {
+	// Tests that the punch addresses survive the hello exchange in both
+	// directions.
+
+	expected := Hello{
+		DeviceName:     "test device",
+		ClientName:     "syncthing",
+		ClientVersion:  "v1.27.0",
+		PunchAddresses: []string{"quic://192.0.2.42:22000", "quic://[2001:db8::1]:22000"},
+	}
+	outBuf := new(bytes.Buffer)
+	if err := writeHello(outBuf, expected); err != nil {
+		t.Fatal(err)
+	}
+
+	inBuf := new(bytes.Buffer)
+	conn := &readWriter{outBuf, inBuf}
+
+	send := Hello{
+		DeviceName:     "this device",
+		ClientName:     "syncthing",
+		ClientVersion:  "v1.27.0",
+		Timestamp:      1234567890,
+		PunchAddresses: []string{"quic://198.51.100.7:22000"},
+	}
+	res, err := ExchangeHello(conn, send)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.PunchAddresses) != 2 || res.PunchAddresses[0] != expected.PunchAddresses[0] || res.PunchAddresses[1] != expected.PunchAddresses[1] {
+		t.Errorf("incorrect PunchAddresses %v != expected %v", res.PunchAddresses, expected.PunchAddresses)
+	}
+
+	sent, err := readHello(inBuf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sent.PunchAddresses) != 1 || sent.PunchAddresses[0] != send.PunchAddresses[0] {
+		t.Errorf("sent PunchAddresses %v != expected %v", sent.PunchAddresses, send.PunchAddresses)
+	}
+}
+
 func TestOldHelloMsgs(t *testing.T) {
 	// Tests that we can correctly identify old/missing/unknown hello
 	// messages.
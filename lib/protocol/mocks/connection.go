@@ -124,6 +124,16 @@ type Connection struct {
 	priorityReturnsOnCall map[int]struct {
 		result1 int
 	}
+	ProxyStub        func() string
+	proxyMutex       sync.RWMutex
+	proxyArgsForCall []struct {
+	}
+	proxyReturns struct {
+		result1 string
+	}
+	proxyReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RemoteAddrStub        func() net.Addr
 	remoteAddrMutex       sync.RWMutex
 	remoteAddrArgsForCall []struct {
@@ -818,6 +828,59 @@ func (fake *Connection) PriorityReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *Connection) Proxy() string {
+	fake.proxyMutex.Lock()
+	ret, specificReturn := fake.proxyReturnsOnCall[len(fake.proxyArgsForCall)]
+	fake.proxyArgsForCall = append(fake.proxyArgsForCall, struct {
+	}{})
+	stub := fake.ProxyStub
+	fakeReturns := fake.proxyReturns
+	fake.recordInvocation("Proxy", []interface{}{})
+	fake.proxyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *Connection) ProxyCallCount() int {
+	fake.proxyMutex.RLock()
+	defer fake.proxyMutex.RUnlock()
+	return len(fake.proxyArgsForCall)
+}
+
+func (fake *Connection) ProxyCalls(stub func() string) {
+	fake.proxyMutex.Lock()
+	defer fake.proxyMutex.Unlock()
+	fake.ProxyStub = stub
+}
+
+func (fake *Connection) ProxyReturns(result1 string) {
+	fake.proxyMutex.Lock()
+	defer fake.proxyMutex.Unlock()
+	fake.ProxyStub = nil
+	fake.proxyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *Connection) ProxyReturnsOnCall(i int, result1 string) {
+	fake.proxyMutex.Lock()
+	defer fake.proxyMutex.Unlock()
+	fake.ProxyStub = nil
+	if fake.proxyReturnsOnCall == nil {
+		fake.proxyReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.proxyReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *Connection) RemoteAddr() net.Addr {
 	fake.remoteAddrMutex.Lock()
 	ret, specificReturn := fake.remoteAddrReturnsOnCall[len(fake.remoteAddrArgsForCall)]
@@ -1243,6 +1306,8 @@ func (fake *Connection) Invocations() map[string][][]interface{} {
 	defer fake.isLocalMutex.RUnlock()
 	fake.priorityMutex.RLock()
 	defer fake.priorityMutex.RUnlock()
+	fake.proxyMutex.RLock()
+	defer fake.proxyMutex.RUnlock()
 	fake.remoteAddrMutex.RLock()
 	defer fake.remoteAddrMutex.RUnlock()
 	fake.requestMutex.RLock()
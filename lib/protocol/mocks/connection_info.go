@@ -60,6 +60,16 @@ type ConnectionInfo struct {
 	priorityReturnsOnCall map[int]struct {
 		result1 int
 	}
+	ProxyStub        func() string
+	proxyMutex       sync.RWMutex
+	proxyArgsForCall []struct {
+	}
+	proxyReturns struct {
+		result1 string
+	}
+	proxyReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RemoteAddrStub        func() net.Addr
 	remoteAddrMutex       sync.RWMutex
 	remoteAddrArgsForCall []struct {
@@ -369,6 +379,59 @@ func (fake *ConnectionInfo) PriorityReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *ConnectionInfo) Proxy() string {
+	fake.proxyMutex.Lock()
+	ret, specificReturn := fake.proxyReturnsOnCall[len(fake.proxyArgsForCall)]
+	fake.proxyArgsForCall = append(fake.proxyArgsForCall, struct {
+	}{})
+	stub := fake.ProxyStub
+	fakeReturns := fake.proxyReturns
+	fake.recordInvocation("Proxy", []interface{}{})
+	fake.proxyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *ConnectionInfo) ProxyCallCount() int {
+	fake.proxyMutex.RLock()
+	defer fake.proxyMutex.RUnlock()
+	return len(fake.proxyArgsForCall)
+}
+
+func (fake *ConnectionInfo) ProxyCalls(stub func() string) {
+	fake.proxyMutex.Lock()
+	defer fake.proxyMutex.Unlock()
+	fake.ProxyStub = stub
+}
+
+func (fake *ConnectionInfo) ProxyReturns(result1 string) {
+	fake.proxyMutex.Lock()
+	defer fake.proxyMutex.Unlock()
+	fake.ProxyStub = nil
+	fake.proxyReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *ConnectionInfo) ProxyReturnsOnCall(i int, result1 string) {
+	fake.proxyMutex.Lock()
+	defer fake.proxyMutex.Unlock()
+	fake.ProxyStub = nil
+	if fake.proxyReturnsOnCall == nil {
+		fake.proxyReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.proxyReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *ConnectionInfo) RemoteAddr() net.Addr {
 	fake.remoteAddrMutex.Lock()
 	ret, specificReturn := fake.remoteAddrReturnsOnCall[len(fake.remoteAddrArgsForCall)]
@@ -594,6 +657,8 @@ func (fake *ConnectionInfo) Invocations() map[string][][]interface{} {
 	defer fake.isLocalMutex.RUnlock()
 	fake.priorityMutex.RLock()
 	defer fake.priorityMutex.RUnlock()
+	fake.proxyMutex.RLock()
+	defer fake.proxyMutex.RUnlock()
 	fake.remoteAddrMutex.RLock()
 	defer fake.remoteAddrMutex.RUnlock()
 	fake.stringMutex.RLock()
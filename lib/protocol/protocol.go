@@ -176,6 +176,7 @@ type ConnectionInfo interface {
 	Priority() int
 	String() string
 	Crypto() string
+	Proxy() string
 	EstablishedAt() time.Time
 	ConnectionID() string
 }
@@ -0,0 +1,114 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+)
+
+// When a device rotates its key, the old key signs a succession naming the
+// new device ID. The device sends its successions in the Hello, so that
+// peers knowing it by an old device ID can verify that it is the same device
+// and carry over its configuration.
+
+const successionContext = "syncthing device succession v1\x00"
+
+var errUnsupportedKey = errors.New("unsupported key type")
+
+// NewSuccession returns a succession from the device with the given
+// certificate to the new device ID, signed by the certificate's key.
+func NewSuccession(cert tls.Certificate, newID DeviceID) (Succession, error) {
+	if len(cert.Certificate) == 0 {
+		return Succession{}, errors.New("no certificate")
+	}
+	signer, ok := cert.PrivateKey.(crypto.Signer)
+	if !ok {
+		return Succession{}, errUnsupportedKey
+	}
+
+	msg := successionMessage(newID)
+	var sig []byte
+	var err error
+	switch signer.Public().(type) {
+	case ed25519.PublicKey:
+		sig, err = signer.Sign(rand.Reader, msg, crypto.Hash(0))
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+		digest := sha256.Sum256(msg)
+		sig, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return Succession{}, errUnsupportedKey
+	}
+	if err != nil {
+		return Succession{}, err
+	}
+
+	return Succession{
+		OldCertificate: cert.Certificate[0],
+		NewDeviceID:    newID,
+		Signature:      sig,
+	}, nil
+}
+
+// Verify checks the signature of the succession and returns the ID of the
+// device it is from.
+func (s Succession) Verify() (DeviceID, error) {
+	cert, err := x509.ParseCertificate(s.OldCertificate)
+	if err != nil {
+		return EmptyDeviceID, err
+	}
+
+	var algo x509.SignatureAlgorithm
+	switch cert.PublicKey.(type) {
+	case ed25519.PublicKey:
+		algo = x509.PureEd25519
+	case *ecdsa.PublicKey:
+		algo = x509.ECDSAWithSHA256
+	case *rsa.PublicKey:
+		algo = x509.SHA256WithRSA
+	default:
+		return EmptyDeviceID, errUnsupportedKey
+	}
+	if err := cert.CheckSignature(algo, successionMessage(s.NewDeviceID), s.Signature); err != nil {
+		return EmptyDeviceID, err
+	}
+
+	return NewDeviceID(s.OldCertificate), nil
+}
+
+// VerifySuccessions verifies that the successions, oldest first, form an
+// unbroken chain ending in the given device. It returns the IDs of the
+// devices it succeeds, oldest first.
+func VerifySuccessions(id DeviceID, successions []Succession) ([]DeviceID, error) {
+	ids := make([]DeviceID, 0, len(successions))
+	for i, s := range successions {
+		oldID, err := s.Verify()
+		if err != nil {
+			return nil, fmt.Errorf("succession %d: %w", i, err)
+		}
+		if i > 0 && successions[i-1].NewDeviceID != oldID {
+			return nil, fmt.Errorf("succession %d: not from %s", i, successions[i-1].NewDeviceID)
+		}
+		ids = append(ids, oldID)
+	}
+	if len(successions) > 0 && successions[len(successions)-1].NewDeviceID != id {
+		return nil, fmt.Errorf("successions do not end in %s", id)
+	}
+	return ids, nil
+}
+
+func successionMessage(newID DeviceID) []byte {
+	return append([]byte(successionContext), newID[:]...)
+}
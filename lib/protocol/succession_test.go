@@ -0,0 +1,74 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package protocol
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+func TestSuccessions(t *testing.T) {
+	certs := make([]tls.Certificate, 3)
+	ids := make([]DeviceID, len(certs))
+	for i := range certs {
+		cert, err := tlsutil.NewCertificateInMemory("syncthing", 365)
+		if err != nil {
+			t.Fatal(err)
+		}
+		certs[i] = cert
+		ids[i] = NewDeviceID(cert.Certificate[0])
+	}
+
+	var chain []Succession
+	for i := 0; i < len(certs)-1; i++ {
+		s, err := NewSuccession(certs[i], ids[i+1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain = append(chain, s)
+	}
+
+	old, err := VerifySuccessions(ids[2], chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(old) != 2 || old[0] != ids[0] || old[1] != ids[1] {
+		t.Errorf("unexpected predecessors %v", old)
+	}
+
+	if _, err := VerifySuccessions(ids[1], chain); err == nil {
+		t.Error("chain should not end in the middle device")
+	}
+	if _, err := VerifySuccessions(ids[2], chain[1:2]); err != nil {
+		t.Error("partial chain should verify:", err)
+	}
+	if _, err := VerifySuccessions(ids[2], []Succession{chain[1], chain[0]}); err == nil {
+		t.Error("out of order chain should not verify")
+	}
+
+	forged := chain[1]
+	forged.NewDeviceID = ids[0]
+	if _, err := forged.Verify(); err == nil {
+		t.Error("succession with changed device ID should not verify")
+	}
+
+	// Survives the trip through the Hello
+	hello := Hello{DeviceName: "test", Successions: chain}
+	bs, err := hello.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Hello
+	if err := got.Unmarshal(bs); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifySuccessions(ids[2], got.Successions); err != nil {
+		t.Error(err)
+	}
+}
@@ -21,16 +21,30 @@ type RelayClient interface {
 	String() string
 	Invitations() <-chan protocol.SessionInvitation
 	URI() *url.URL
+	Relays() []RelayStatus
+	Changed() <-chan struct{}
 }
 
-func NewClient(uri *url.URL, certs []tls.Certificate, timeout time.Duration) (RelayClient, error) {
+// RelayStatus is the state of one relay the client is joined, or trying to
+// join, to.
+type RelayStatus struct {
+	URI       *url.URL
+	Connected bool
+	Since     time.Time // when the client joined, or started connecting if not yet connected
+}
+
+// NewClient returns a client for the given relay, or relay pool when the
+// scheme is dynamic+http(s). A pool client stays joined to up to the given
+// number of relays from the pool at the same time.
+func NewClient(uri *url.URL, certs []tls.Certificate, timeout time.Duration, connections int) (RelayClient, error) {
 	invitations := make(chan protocol.SessionInvitation)
+	changed := make(chan struct{}, 1)
 
 	switch uri.Scheme {
 	case "relay":
-		return newStaticClient(uri, certs, invitations, timeout), nil
+		return newStaticClient(uri, certs, invitations, changed, timeout), nil
 	case "dynamic+http", "dynamic+https":
-		return newDynamicClient(uri, certs, invitations, timeout), nil
+		return newDynamicClient(uri, certs, invitations, changed, timeout, connections), nil
 	default:
 		return nil, fmt.Errorf("unsupported scheme: %s", uri.Scheme)
 	}
@@ -39,15 +53,30 @@ func NewClient(uri *url.URL, certs []tls.Certificate, timeout time.Duration) (Re
 type commonClient struct {
 	svcutil.ServiceWithError
 	invitations chan protocol.SessionInvitation
+	changed     chan struct{}
 }
 
-func newCommonClient(invitations chan protocol.SessionInvitation, serve func(context.Context) error, creator string) commonClient {
+func newCommonClient(invitations chan protocol.SessionInvitation, changed chan struct{}, serve func(context.Context) error, creator string) commonClient {
 	return commonClient{
 		ServiceWithError: svcutil.AsService(serve, creator),
 		invitations:      invitations,
+		changed:          changed,
 	}
 }
 
 func (c *commonClient) Invitations() <-chan protocol.SessionInvitation {
 	return c.invitations
 }
+
+// Changed returns a channel that receives a value when the set of relays
+// the client is joined to may have changed.
+func (c *commonClient) Changed() <-chan struct{} {
+	return c.changed
+}
+
+func (c *commonClient) notifyChanged() {
+	select {
+	case c.changed <- struct{}{}:
+	default:
+	}
+}
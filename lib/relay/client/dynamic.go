@@ -19,28 +19,94 @@ import (
 	"github.com/syncthing/syncthing/lib/relay/protocol"
 )
 
+var errNotJoined = errors.New("not joined to any relay yet")
+
 type dynamicClient struct {
 	commonClient
 
-	pooladdr *url.URL
-	certs    []tls.Certificate
-	timeout  time.Duration
+	pooladdr    *url.URL
+	certs       []tls.Certificate
+	timeout     time.Duration
+	connections int
 
-	mut    sync.RWMutex // Protects client.
-	client *staticClient
+	mut     sync.RWMutex // Protects clients.
+	clients []*staticClient
 }
 
-func newDynamicClient(uri *url.URL, certs []tls.Certificate, invitations chan protocol.SessionInvitation, timeout time.Duration) *dynamicClient {
+func newDynamicClient(uri *url.URL, certs []tls.Certificate, invitations chan protocol.SessionInvitation, changed chan struct{}, timeout time.Duration, connections int) *dynamicClient {
+	if connections < 1 {
+		connections = 1
+	}
 	c := &dynamicClient{
-		pooladdr: uri,
-		certs:    certs,
-		timeout:  timeout,
+		pooladdr:    uri,
+		certs:       certs,
+		timeout:     timeout,
+		connections: connections,
 	}
-	c.commonClient = newCommonClient(invitations, c.serve, fmt.Sprintf("dynamicClient@%p", c))
+	c.commonClient = newCommonClient(invitations, changed, c.serve, fmt.Sprintf("dynamicClient@%p", c))
 	return c
 }
 
 func (c *dynamicClient) serve(ctx context.Context) error {
+	addrs, err := c.lookupRelays(ctx)
+	if err != nil {
+		return err
+	}
+	candidates := relayAddressesOrder(ctx, addrs)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// We stay joined to up to c.connections relays at a time, taken in
+	// order of latency. When one of them fails we move on to the next
+	// candidate right away, while the others keep serving.
+	done := make(chan *staticClient)
+	active := 0
+	startNext := func() {
+		for len(candidates) > 0 {
+			addr := candidates[0]
+			candidates = candidates[1:]
+			ruri, err := url.Parse(addr)
+			if err != nil {
+				l.Debugln(c, "skipping relay", addr, err)
+				continue
+			}
+			client := newStaticClient(ruri, c.certs, c.invitations, c.changed, c.timeout)
+			c.mut.Lock()
+			c.clients = append(c.clients, client)
+			c.mut.Unlock()
+			active++
+			go func() {
+				err := client.Serve(ctx)
+				l.Debugf("Disconnected from %s://%s: %v", client.URI().Scheme, client.URI().Host, err)
+				done <- client
+			}()
+			return
+		}
+	}
+
+	for i := 0; i < c.connections; i++ {
+		startNext()
+	}
+	for active > 0 {
+		client := <-done
+		active--
+		c.removeClient(client)
+		if ctx.Err() == nil {
+			startNext()
+		}
+	}
+
+	if ctx.Err() != nil {
+		l.Debugln(c, "stopping")
+		return nil
+	}
+	l.Debugln(c, "could not find a connectable relay")
+	return errors.New("could not find a connectable relay")
+}
+
+// lookupRelays returns the addresses of the relays in the pool
+func (c *dynamicClient) lookupRelays(ctx context.Context) ([]string, error) {
 	uri := *c.pooladdr
 
 	// Trim off the `dynamic+` prefix
@@ -51,12 +117,12 @@ func (c *dynamicClient) serve(ctx context.Context) error {
 	req, err := http.NewRequestWithContext(ctx, "GET", uri.String(), nil)
 	if err != nil {
 		l.Debugln(c, "failed to lookup dynamic relays", err)
-		return err
+		return nil, err
 	}
 	data, err := http.DefaultClient.Do(req)
 	if err != nil {
 		l.Debugln(c, "failed to lookup dynamic relays", err)
-		return err
+		return nil, err
 	}
 
 	var ann dynamicAnnouncement
@@ -64,7 +130,7 @@ func (c *dynamicClient) serve(ctx context.Context) error {
 	data.Body.Close()
 	if err != nil {
 		l.Debugln(c, "failed to lookup dynamic relays", err)
-		return err
+		return nil, err
 	}
 
 	var addrs []string
@@ -77,55 +143,60 @@ func (c *dynamicClient) serve(ctx context.Context) error {
 		l.Debugln(c, "found", ruri)
 		addrs = append(addrs, ruri.String())
 	}
+	return addrs, nil
+}
 
-	for _, addr := range relayAddressesOrder(ctx, addrs) {
-		select {
-		case <-ctx.Done():
-			l.Debugln(c, "stopping")
-			return nil
-		default:
-			ruri, err := url.Parse(addr)
-			if err != nil {
-				l.Debugln(c, "skipping relay", addr, err)
-				continue
-			}
-			client := newStaticClient(ruri, c.certs, c.invitations, c.timeout)
-			c.mut.Lock()
-			c.client = client
-			c.mut.Unlock()
-
-			err = c.client.Serve(ctx)
-			l.Debugf("Disconnected from %s://%s: %v", c.client.URI().Scheme, c.client.URI().Host, err)
-
-			c.mut.Lock()
-			c.client = nil
-			c.mut.Unlock()
+func (c *dynamicClient) removeClient(client *staticClient) {
+	c.mut.Lock()
+	for i, cl := range c.clients {
+		if cl == client {
+			c.clients = append(c.clients[:i], c.clients[i+1:]...)
+			break
 		}
 	}
-	l.Debugln(c, "could not find a connectable relay")
-	return errors.New("could not find a connectable relay")
+	c.mut.Unlock()
+	c.notifyChanged()
 }
 
+// Error returns nil while the client is joined to at least one relay, and
+// otherwise the reason it isn't.
 func (c *dynamicClient) Error() error {
 	c.mut.RLock()
 	defer c.mut.RUnlock()
-	if c.client == nil {
-		return c.commonClient.Error()
+	for _, client := range c.clients {
+		if client.status().Connected {
+			return nil
+		}
 	}
-	return c.client.Error()
+	if err := c.commonClient.Error(); err != nil {
+		return err
+	}
+	return errNotJoined
 }
 
 func (c *dynamicClient) String() string {
 	return fmt.Sprintf("DynamicClient:%p:%s@%s", c, c.URI(), c.pooladdr)
 }
 
+// URI returns the address of the first relay the client is joined to, or
+// nil if there is none.
 func (c *dynamicClient) URI() *url.URL {
+	for _, status := range c.Relays() {
+		if status.Connected {
+			return status.URI
+		}
+	}
+	return nil
+}
+
+func (c *dynamicClient) Relays() []RelayStatus {
 	c.mut.RLock()
 	defer c.mut.RUnlock()
-	if c.client == nil {
-		return nil
+	res := make([]RelayStatus, 0, len(c.clients))
+	for _, client := range c.clients {
+		res = append(res, client.status())
 	}
-	return c.client.URI()
+	return res
 }
 
 // This is the announcement received from the relay server;
@@ -0,0 +1,232 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/relay/protocol"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+// fakeRelay accepts joins and then keeps the connections open until closed.
+type fakeRelay struct {
+	listener net.Listener
+	mut      sync.Mutex
+	conns    []net.Conn
+}
+
+func newFakeRelay(t *testing.T) *fakeRelay {
+	t.Helper()
+	cert, err := tlsutil.NewCertificateInMemory("relay", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{protocol.ProtocolName},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &fakeRelay{listener: listener}
+	t.Cleanup(r.close)
+	go r.serve()
+	return r
+}
+
+func (r *fakeRelay) serve() {
+	for {
+		conn, err := r.listener.Accept()
+		if err != nil {
+			return
+		}
+		r.mut.Lock()
+		r.conns = append(r.conns, conn)
+		r.mut.Unlock()
+		go func() {
+			if _, err := protocol.ReadMessage(conn); err != nil {
+				return
+			}
+			if err := protocol.WriteMessage(conn, protocol.ResponseSuccess); err != nil {
+				return
+			}
+			for {
+				if _, err := protocol.ReadMessage(conn); err != nil {
+					return
+				}
+			}
+		}()
+	}
+}
+
+func (r *fakeRelay) uri() string {
+	return "relay://" + r.listener.Addr().String()
+}
+
+func (r *fakeRelay) close() {
+	r.listener.Close()
+	r.mut.Lock()
+	for _, conn := range r.conns {
+		conn.Close()
+	}
+	r.mut.Unlock()
+}
+
+// newPool serves a relay pool announcement for the given addresses.
+func newPool(t *testing.T, addrs ...string) *url.URL {
+	t.Helper()
+	var ann dynamicAnnouncement
+	for _, addr := range addrs {
+		ann.Relays = append(ann.Relays, struct{ URL string }{addr})
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(ann)
+	}))
+	t.Cleanup(srv.Close)
+	uri, err := url.Parse("dynamic+" + srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return uri
+}
+
+func startClient(t *testing.T, uri *url.URL, connections int) RelayClient {
+	t.Helper()
+	cert, err := tlsutil.NewCertificateInMemory("syncthing", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewClient(uri, []tls.Certificate{cert}, 10*time.Second, connections)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go c.Serve(ctx)
+	t.Cleanup(cancel)
+	return c
+}
+
+// waitConnected waits until the client is joined to exactly n relays, none
+// of them excluded, and returns them.
+func waitConnected(t *testing.T, c RelayClient, n int, exclude ...string) map[string]bool {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		connected := make(map[string]bool)
+		for _, status := range c.Relays() {
+			if status.Connected {
+				connected[status.URI.String()] = true
+			}
+		}
+		ok := len(connected) == n
+		for _, addr := range exclude {
+			ok = ok && !connected[addr]
+		}
+		if ok {
+			return connected
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("joined to %v, expected %d relays", connected, n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestDynamicClientConnections(t *testing.T) {
+	relays := map[string]*fakeRelay{}
+	var addrs []string
+	for i := 0; i < 3; i++ {
+		r := newFakeRelay(t)
+		relays[r.uri()] = r
+		addrs = append(addrs, r.uri())
+	}
+
+	c := startClient(t, newPool(t, addrs...), 2)
+	connected := waitConnected(t, c, 2)
+	if err := c.Error(); err != nil {
+		t.Errorf("unexpected error while joined: %v", err)
+	}
+	if uri := c.URI(); uri == nil || !connected[uri.String()] {
+		t.Errorf("URI %v is not one of the joined relays", uri)
+	}
+
+	// When one of the relays goes away the client moves on to the one it
+	// wasn't joined to yet, and stays joined to the other.
+	var gone string
+	for addr := range connected {
+		gone = addr
+		break
+	}
+	relays[gone].close()
+	reconnected := waitConnected(t, c, 2, gone)
+	for addr := range connected {
+		if addr != gone && !reconnected[addr] {
+			t.Errorf("lost the connection to %s", addr)
+		}
+	}
+}
+
+func TestDynamicClientSingleConnection(t *testing.T) {
+	c := startClient(t, newPool(t, newFakeRelay(t).uri(), newFakeRelay(t).uri()), 1)
+	waitConnected(t, c, 1)
+	// Give the client a chance to (wrongly) join a second relay.
+	time.Sleep(100 * time.Millisecond)
+	if n := len(c.Relays()); n != 1 {
+		t.Errorf("got %d relays, expected one", n)
+	}
+}
+
+func TestDynamicClientErrorWhileConnecting(t *testing.T) {
+	// A relay that accepts connections but never completes the handshake.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			accepted <- conn
+		}
+	}()
+
+	c := startClient(t, newPool(t, "relay://"+listener.Addr().String()), 2)
+	select {
+	case conn := <-accepted:
+		defer conn.Close()
+	case <-time.After(10 * time.Second):
+		t.Fatal("the client didn't connect")
+	}
+	if err := c.Error(); err == nil {
+		t.Error("expected an error while not joined to any relay")
+	}
+	if n := len(c.Relays()); n != 1 || c.Relays()[0].Connected {
+		t.Errorf("unexpected relays %v", c.Relays())
+	}
+}
+
+func TestDynamicClientNoRelays(t *testing.T) {
+	c := startClient(t, newPool(t), 2)
+	deadline := time.Now().Add(10 * time.Second)
+	for c.Error() == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("expected an error without relays")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
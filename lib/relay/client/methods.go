@@ -26,14 +26,17 @@ func (e *incorrectResponseCodeErr) Error() string {
 	return fmt.Sprintf("incorrect response code %d: %s", e.code, e.msg)
 }
 
-func GetInvitationFromRelay(ctx context.Context, uri *url.URL, id syncthingprotocol.DeviceID, certs []tls.Certificate, timeout time.Duration) (protocol.SessionInvitation, error) {
+// GetInvitationFromRelay requests an invitation to a session with the given
+// device from the relay. The relay is dialed through the given proxy, as
+// for dialer.DialContextProxy.
+func GetInvitationFromRelay(ctx context.Context, uri *url.URL, id syncthingprotocol.DeviceID, certs []tls.Certificate, timeout time.Duration, proxyURL string) (protocol.SessionInvitation, error) {
 	if uri.Scheme != "relay" {
 		return protocol.SessionInvitation{}, fmt.Errorf("unsupported relay scheme: %v", uri.Scheme)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	rconn, err := dialer.DialContext(ctx, "tcp", uri.Host)
+	rconn, err := dialer.DialContextProxy(ctx, proxyURL, "tcp", uri.Host)
 	if err != nil {
 		return protocol.SessionInvitation{}, err
 	}
@@ -75,12 +78,14 @@ func GetInvitationFromRelay(ctx context.Context, uri *url.URL, id syncthingproto
 	}
 }
 
-func JoinSession(ctx context.Context, invitation protocol.SessionInvitation) (net.Conn, error) {
+// JoinSession joins the session of the invitation. The relay is dialed
+// through the given proxy, as for dialer.DialContextProxy.
+func JoinSession(ctx context.Context, invitation protocol.SessionInvitation, proxyURL string) (net.Conn, error) {
 	addr := net.JoinHostPort(net.IP(invitation.Address).String(), strconv.Itoa(int(invitation.Port)))
 
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
-	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	conn, err := dialer.DialContextProxy(ctx, proxyURL, "tcp", addr)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +120,7 @@ func JoinSession(ctx context.Context, invitation protocol.SessionInvitation) (ne
 
 func TestRelay(ctx context.Context, uri *url.URL, certs []tls.Certificate, sleep, timeout time.Duration, times int) error {
 	id := syncthingprotocol.NewDeviceID(certs[0].Certificate[0])
-	c, err := NewClient(uri, certs, timeout)
+	c, err := NewClient(uri, certs, timeout, 1)
 	if err != nil {
 		return fmt.Errorf("creating client: %w", err)
 	}
@@ -133,7 +138,7 @@ func TestRelay(ctx context.Context, uri *url.URL, certs []tls.Certificate, sleep
 	defer cancel()
 
 	for i := 0; i < times; i++ {
-		_, err = GetInvitationFromRelay(ctx, uri, id, certs, timeout)
+		_, err = GetInvitationFromRelay(ctx, uri, id, certs, timeout, "")
 		if err == nil {
 			return nil
 		}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/syncthing/syncthing/lib/dialer"
@@ -29,9 +30,13 @@ type staticClient struct {
 
 	conn  *tls.Conn
 	token string
+
+	mut       sync.Mutex // Protects connected and since.
+	connected bool
+	since     time.Time
 }
 
-func newStaticClient(uri *url.URL, certs []tls.Certificate, invitations chan protocol.SessionInvitation, timeout time.Duration) *staticClient {
+func newStaticClient(uri *url.URL, certs []tls.Certificate, invitations chan protocol.SessionInvitation, changed chan struct{}, timeout time.Duration) *staticClient {
 	c := &staticClient{
 		uri: uri,
 
@@ -42,11 +47,13 @@ func newStaticClient(uri *url.URL, certs []tls.Certificate, invitations chan pro
 
 		token: uri.Query().Get("token"),
 	}
-	c.commonClient = newCommonClient(invitations, c.serve, c.String())
+	c.commonClient = newCommonClient(invitations, changed, c.serve, c.String())
 	return c
 }
 
 func (c *staticClient) serve(ctx context.Context) error {
+	c.setConnected(false)
+
 	if err := c.connect(ctx); err != nil {
 		l.Debugf("Could not connect to relay %s: %s", c.uri, err)
 		return err
@@ -66,6 +73,8 @@ func (c *staticClient) serve(ctx context.Context) error {
 	}
 
 	l.Infof("Joined relay %s://%s", c.uri.Scheme, c.uri.Host)
+	c.setConnected(true)
+	defer c.setConnected(false)
 
 	messages := make(chan interface{})
 	errorsc := make(chan error, 1)
@@ -132,6 +141,31 @@ func (c *staticClient) URI() *url.URL {
 	return c.uri
 }
 
+func (c *staticClient) Relays() []RelayStatus {
+	return []RelayStatus{c.status()}
+}
+
+func (c *staticClient) status() RelayStatus {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return RelayStatus{
+		URI:       c.uri,
+		Connected: c.connected,
+		Since:     c.since,
+	}
+}
+
+func (c *staticClient) setConnected(connected bool) {
+	c.mut.Lock()
+	changed := connected != c.connected
+	c.connected = connected
+	c.since = time.Now()
+	c.mut.Unlock()
+	if changed {
+		c.notifyChanged()
+	}
+}
+
 func (c *staticClient) connect(ctx context.Context) error {
 	if c.uri.Scheme != "relay" {
 		return fmt.Errorf("unsupported relay scheme: %v", c.uri.Scheme)
@@ -18,6 +18,8 @@ var (
 	ResponseNotFound          = Response{1, "not found"}
 	ResponseAlreadyConnected  = Response{2, "already connected"}
 	ResponseWrongToken        = Response{3, "wrong token"}
+	ResponseNotAllowed        = Response{4, "not allowed"}
+	ResponseQuotaExceeded     = Response{5, "quota exceeded"}
 	ResponseUnexpectedMessage = Response{100, "unexpected message"}
 )
 
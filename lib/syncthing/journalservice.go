@@ -0,0 +1,141 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package syncthing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/events"
+)
+
+const (
+	// Progress and summary events are frequent and only interesting at
+	// the time; the saved config may contain secrets.
+	journalEvents = events.AllEvents &^ (events.DownloadProgress | events.RemoteDownloadProgress | events.FolderSummary | events.FolderCompletion | events.FolderScanProgress | events.ConfigSaved)
+
+	journalFlushInterval = time.Second
+	journalMaxBatch      = 1000
+	journalPruneInterval = time.Hour
+)
+
+// The journalService writes events to the event journal in the database,
+// while enabled in the options; it only subscribes to events then. Events
+// older than the retention period are pruned regularly, also when the
+// journal is disabled.
+type journalService struct {
+	cfg      config.Wrapper
+	ll       *db.Lowlevel
+	evLogger events.Logger
+	changed  chan struct{}
+}
+
+func newJournalService(cfg config.Wrapper, ll *db.Lowlevel, evLogger events.Logger) *journalService {
+	return &journalService{
+		cfg:      cfg,
+		ll:       ll,
+		evLogger: evLogger,
+		changed:  make(chan struct{}, 1),
+	}
+}
+
+func (s *journalService) Serve(ctx context.Context) error {
+	s.cfg.Subscribe(s)
+	defer s.cfg.Unsubscribe(s)
+
+	flushTimer := time.NewTimer(journalFlushInterval)
+	defer flushTimer.Stop()
+	pruneTimer := time.NewTimer(0)
+	defer pruneTimer.Stop()
+
+	var batch []events.Event
+	flush := func() {
+		if err := s.ll.AppendEvents(batch); err != nil {
+			l.Warnln("Writing event journal:", err)
+		}
+		batch = batch[:0]
+	}
+	defer flush()
+
+	// Receiving from the nil channel while disabled blocks forever.
+	var sub events.Subscription
+	var evChan <-chan events.Event
+	subscribe := func() {
+		enabled := s.cfg.Options().EventJournalEnabled
+		if enabled && sub == nil {
+			sub = s.evLogger.Subscribe(journalEvents)
+			evChan = sub.C()
+		} else if !enabled && sub != nil {
+			sub.Unsubscribe()
+			sub, evChan = nil, nil
+			flush()
+		}
+	}
+	subscribe()
+	defer func() {
+		if sub != nil {
+			sub.Unsubscribe()
+		}
+	}()
+
+	for {
+		select {
+		case ev, ok := <-evChan:
+			if !ok {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			batch = append(batch, ev)
+			if len(batch) >= journalMaxBatch {
+				flush()
+			}
+		case <-s.changed:
+			subscribe()
+		case <-flushTimer.C:
+			flush()
+			flushTimer.Reset(journalFlushInterval)
+		case <-pruneTimer.C:
+			s.prune()
+			pruneTimer.Reset(journalPruneInterval)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *journalService) CommitConfiguration(from, to config.Configuration) bool {
+	if from.Options.EventJournalEnabled != to.Options.EventJournalEnabled {
+		select {
+		case s.changed <- struct{}{}:
+		default:
+		}
+	}
+	return true
+}
+
+func (s *journalService) prune() {
+	days := s.cfg.Options().EventJournalRetentionDays
+	if days <= 0 {
+		// Kept forever
+		return
+	}
+	n, err := s.ll.PruneEventJournal(time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		l.Warnln("Pruning event journal:", err)
+		return
+	}
+	if n > 0 {
+		l.Debugf("Pruned %d events from the event journal", n)
+	}
+}
+
+func (s *journalService) String() string {
+	return fmt.Sprintf("journalService@%p", s)
+}
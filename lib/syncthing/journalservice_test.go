@@ -0,0 +1,140 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package syncthing
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+func TestJournalService(t *testing.T) {
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	go evLogger.Serve(ctx)
+	defer cancel()
+
+	cfg := config.Wrap(tempCfgFilename(t), config.Configuration{
+		Options: config.OptionsConfiguration{
+			EventJournalEnabled:       true,
+			EventJournalRetentionDays: 1,
+		},
+	}, protocol.LocalDeviceID, events.NoopLogger)
+	defer os.Remove(cfg.ConfigPath())
+
+	ll, err := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ll.Close()
+
+	// Past the retention period, to be pruned when the service starts
+	old := events.Event{GlobalID: 1, Time: time.Now().AddDate(0, 0, -2), Type: events.StateChanged}
+	if err := ll.AppendEvents([]events.Event{old}); err != nil {
+		t.Fatal(err)
+	}
+
+	journalCtx, journalCancel := context.WithCancel(context.Background())
+	service := newJournalService(cfg, ll, evLogger)
+	done := make(chan struct{})
+	go func() {
+		service.Serve(journalCtx)
+		close(done)
+	}()
+
+	// Subscription needs to happen in service.Serve
+	time.Sleep(10 * time.Millisecond)
+
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "default"})
+	// Not journalled
+	evLogger.Log(events.ConfigSaved, "the config")
+
+	// Stopping flushes the pending events
+	time.Sleep(10 * time.Millisecond)
+	journalCancel()
+	<-done
+
+	evs, err := ll.JournalledEvents(db.EventJournalQuery{Filter: events.Filter{Mask: events.AllEvents}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evs) != 1 {
+		t.Fatalf("expected one event, got %v", evs)
+	}
+	if evs[0].Type != events.StateChanged || evs[0].Time.Before(time.Now().Add(-time.Minute)) {
+		t.Errorf("unexpected event %v", evs[0])
+	}
+}
+
+func TestJournalServiceDisabled(t *testing.T) {
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	go evLogger.Serve(ctx)
+	defer cancel()
+
+	cfg := config.Wrap(tempCfgFilename(t), config.Configuration{}, protocol.LocalDeviceID, events.NoopLogger)
+	defer os.Remove(cfg.ConfigPath())
+	go cfg.Serve(ctx)
+
+	ll, err := db.NewLowlevel(backend.OpenMemory(), events.NoopLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ll.Close()
+
+	journalCtx, journalCancel := context.WithCancel(context.Background())
+	service := newJournalService(cfg, ll, evLogger)
+	done := make(chan struct{})
+	go func() {
+		service.Serve(journalCtx)
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	// Not journalled while disabled
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "disabled"})
+
+	setEnabled := func(enabled bool) {
+		t.Helper()
+		waiter, err := cfg.Modify(func(cfg *config.Configuration) {
+			cfg.Options.EventJournalEnabled = enabled
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		waiter.Wait()
+		// Subscription happens asynchronously in service.Serve
+		time.Sleep(10 * time.Millisecond)
+	}
+	setEnabled(true)
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "enabled"})
+	time.Sleep(10 * time.Millisecond)
+	setEnabled(false)
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "disabled"})
+
+	time.Sleep(10 * time.Millisecond)
+	journalCancel()
+	<-done
+
+	evs, err := ll.JournalledEvents(db.EventJournalQuery{Filter: events.Filter{Mask: events.AllEvents}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evs) != 1 {
+		t.Fatalf("expected one event, got %v", evs)
+	}
+	if data, _ := evs[0].Data.(map[string]interface{}); data["folder"] != "enabled" {
+		t.Errorf("unexpected event %v", evs[0])
+	}
+}
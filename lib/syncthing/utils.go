@@ -8,6 +8,8 @@ package syncthing
 
 import (
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -18,6 +20,7 @@ import (
 	"github.com/syncthing/syncthing/lib/events"
 	"github.com/syncthing/syncthing/lib/fs"
 	"github.com/syncthing/syncthing/lib/locations"
+	"github.com/syncthing/syncthing/lib/osutil"
 	"github.com/syncthing/syncthing/lib/protocol"
 	"github.com/syncthing/syncthing/lib/tlsutil"
 )
@@ -58,6 +61,143 @@ func GenerateCertificate(certFile, keyFile string) (tls.Certificate, error) {
 	return tlsutil.NewCertificate(certFile, keyFile, tlsDefaultCommonName, deviceCertLifetimeDays)
 }
 
+// RotateCertificate replaces the device certificate and key by new ones,
+// keeping the common name, and adds the succession from the old device ID
+// to the new one, signed by the old key, to the successions file.
+func RotateCertificate(certFile, keyFile, successionsFile string) (tls.Certificate, error) {
+	oldCert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	commonName := tlsDefaultCommonName
+	if parsed, err := x509.ParseCertificate(oldCert.Certificate[0]); err == nil && parsed.Subject.CommonName != "" {
+		commonName = parsed.Subject.CommonName
+	}
+
+	successions, err := LoadSuccessions(successionsFile)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	// The new certificate only replaces the old one once the succession is
+	// safely stored. The certificate is moved into place before the key,
+	// and FinishCertificateRotation completes or undoes a rotation that is
+	// interrupted anywhere along the way.
+	newCertFile, newKeyFile := certFile+".new", keyFile+".new"
+	l.Infof("Generating ECDSA key and certificate for %s...", commonName)
+	cert, err := tlsutil.NewCertificate(newCertFile, newKeyFile, commonName, deviceCertLifetimeDays)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	succession, err := protocol.NewSuccession(oldCert, protocol.NewDeviceID(cert.Certificate[0]))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	if err := SaveSuccessions(successionsFile, append(successions, succession)); err != nil {
+		return tls.Certificate{}, err
+	}
+	if err := os.Rename(newCertFile, certFile); err != nil {
+		return tls.Certificate{}, err
+	}
+	if err := os.Rename(newKeyFile, keyFile); err != nil {
+		return tls.Certificate{}, err
+	}
+	return cert, nil
+}
+
+// FinishCertificateRotation makes sure the certificate and key match after
+// an interrupted RotateCertificate. A rotation that got as far as storing
+// the succession is completed, and any other is undone.
+func FinishCertificateRotation(certFile, keyFile, successionsFile string) error {
+	newCertFile, newKeyFile := certFile+".new", keyFile+".new"
+	if _, err := os.Stat(newKeyFile); fs.IsNotExist(err) {
+		// Nothing to finish, but there may be a certificate left over
+		// from generating the new one.
+		if err := os.Remove(newCertFile); err != nil && !fs.IsNotExist(err) {
+			return err
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// The new certificate is either still next to the new key, or
+	// already moved into place.
+	pendingCertFile := newCertFile
+	if _, err := os.Stat(newCertFile); fs.IsNotExist(err) {
+		pendingCertFile = certFile
+	}
+	cert, err := tls.LoadX509KeyPair(pendingCertFile, newKeyFile)
+	if err != nil {
+		if pendingCertFile == certFile {
+			return fmt.Errorf("%s doesn't match the new key in %s: %w", certFile, newKeyFile, err)
+		}
+		// Interrupted while generating the new certificate.
+		return removeNewCertificate(newCertFile, newKeyFile)
+	}
+	successions, err := LoadSuccessions(successionsFile)
+	if err != nil {
+		return err
+	}
+	newID := protocol.NewDeviceID(cert.Certificate[0])
+	if len(successions) == 0 || successions[len(successions)-1].NewDeviceID != newID {
+		// Interrupted before storing the succession.
+		l.Infoln("Undoing interrupted certificate rotation")
+		return removeNewCertificate(newCertFile, newKeyFile)
+	}
+
+	l.Infoln("Completing interrupted certificate rotation to device ID", newID)
+	if pendingCertFile == newCertFile {
+		if err := os.Rename(newCertFile, certFile); err != nil {
+			return err
+		}
+	}
+	return os.Rename(newKeyFile, keyFile)
+}
+
+func removeNewCertificate(newCertFile, newKeyFile string) error {
+	for _, file := range []string{newCertFile, newKeyFile} {
+		if err := os.Remove(file); err != nil && !fs.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadSuccessions returns the successions from earlier device keys to the
+// current one, oldest first, or none if the key was never rotated.
+func LoadSuccessions(path string) ([]protocol.Succession, error) {
+	bs, err := os.ReadFile(path)
+	if fs.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var successions []protocol.Succession
+	if err := json.Unmarshal(bs, &successions); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return successions, nil
+}
+
+// SaveSuccessions writes the successions to the given file, replacing it
+// atomically.
+func SaveSuccessions(path string, successions []protocol.Succession) error {
+	bs, err := json.MarshalIndent(successions, "", "  ")
+	if err != nil {
+		return err
+	}
+	fd, err := osutil.CreateAtomic(path)
+	if err != nil {
+		return err
+	}
+	if _, err := fd.Write(bs); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
+
 func DefaultConfig(path string, myID protocol.DeviceID, evLogger events.Logger, noDefaultFolder, skipPortProbing bool) (config.Wrapper, error) {
 	newCfg := config.New(myID)
 
@@ -86,6 +226,7 @@ func DefaultConfig(path string, myID protocol.DeviceID, evLogger events.Logger,
 // creates a default one, without the default folder if noDefaultFolder is true.
 // Otherwise it checks the version, and archives and upgrades the config if
 // necessary or returns an error, if the version isn't compatible.
+// The configuration history is kept from then on.
 func LoadConfigAtStartup(path string, cert tls.Certificate, evLogger events.Logger, allowNewerConfig, noDefaultFolder, skipPortProbing bool) (config.Wrapper, error) {
 	myID := protocol.NewDeviceID(cert.Certificate[0])
 	cfg, originalVersion, err := config.Load(path, myID, evLogger)
@@ -118,6 +259,8 @@ func LoadConfigAtStartup(path string, cert tls.Certificate, evLogger events.Logg
 		}
 	}
 
+	cfg.SetHistory(config.NewHistory(locations.Get(locations.ConfigHistory), myID))
+
 	return cfg, nil
 }
 
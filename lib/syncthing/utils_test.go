@@ -0,0 +1,190 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package syncthing
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	"github.com/syncthing/syncthing/lib/tlsutil"
+)
+
+type certFiles struct {
+	cert, key, successions string
+}
+
+func newCertFiles(t *testing.T) (certFiles, protocol.DeviceID) {
+	t.Helper()
+	dir := t.TempDir()
+	files := certFiles{
+		cert:        filepath.Join(dir, "cert.pem"),
+		key:         filepath.Join(dir, "key.pem"),
+		successions: filepath.Join(dir, "successions.json"),
+	}
+	cert, err := GenerateCertificate(files.cert, files.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files, protocol.NewDeviceID(cert.Certificate[0])
+}
+
+// loadedID returns the device ID of the certificate and key on disk,
+// failing if they don't match.
+func (f certFiles) loadedID(t *testing.T) protocol.DeviceID {
+	t.Helper()
+	cert, err := tls.LoadX509KeyPair(f.cert, f.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return protocol.NewDeviceID(cert.Certificate[0])
+}
+
+func TestRotateCertificate(t *testing.T) {
+	files, firstID := newCertFiles(t)
+
+	cert, err := RotateCertificate(files.cert, files.key, files.successions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondID := protocol.NewDeviceID(cert.Certificate[0])
+	if secondID == firstID {
+		t.Fatal("the device ID didn't change")
+	}
+	if id := files.loadedID(t); id != secondID {
+		t.Errorf("loaded %s, expected %s", id, secondID)
+	}
+
+	cert, err = RotateCertificate(files.cert, files.key, files.successions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thirdID := protocol.NewDeviceID(cert.Certificate[0])
+
+	successions, err := LoadSuccessions(files.successions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	predecessors, err := protocol.VerifySuccessions(thirdID, successions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(predecessors) != 2 || predecessors[0] != firstID || predecessors[1] != secondID {
+		t.Errorf("got predecessors %v, expected %s and %s", predecessors, firstID, secondID)
+	}
+	for _, file := range []string{files.cert + ".new", files.key + ".new"} {
+		if _, err := os.Stat(file); err == nil {
+			t.Errorf("%s left behind", file)
+		}
+	}
+}
+
+func TestLoadSuccessions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "successions.json")
+	if successions, err := LoadSuccessions(path); err != nil || successions != nil {
+		t.Errorf("got %v, %v for a missing file", successions, err)
+	}
+	if err := os.WriteFile(path, []byte("not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSuccessions(path); err == nil {
+		t.Error("expected an error for an invalid file")
+	}
+}
+
+// interruptedRotation performs a rotation up to the given step: 1 has
+// generated the new certificate, 2 has stored the succession, 3 has moved
+// the certificate into place. It returns the new device ID.
+func interruptedRotation(t *testing.T, files certFiles, steps int) protocol.DeviceID {
+	t.Helper()
+	oldCert, err := tls.LoadX509KeyPair(files.cert, files.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := tlsutil.NewCertificate(files.cert+".new", files.key+".new", tlsDefaultCommonName, deviceCertLifetimeDays)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newID := protocol.NewDeviceID(cert.Certificate[0])
+	if steps >= 2 {
+		succession, err := protocol.NewSuccession(oldCert, newID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := SaveSuccessions(files.successions, []protocol.Succession{succession}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if steps >= 3 {
+		if err := os.Rename(files.cert+".new", files.cert); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return newID
+}
+
+func TestFinishCertificateRotation(t *testing.T) {
+	t.Run("nothing to do", func(t *testing.T) {
+		files, id := newCertFiles(t)
+		if err := FinishCertificateRotation(files.cert, files.key, files.successions); err != nil {
+			t.Fatal(err)
+		}
+		if loaded := files.loadedID(t); loaded != id {
+			t.Errorf("loaded %s, expected %s", loaded, id)
+		}
+	})
+
+	t.Run("certificate left over", func(t *testing.T) {
+		files, id := newCertFiles(t)
+		interruptedRotation(t, files, 1)
+		if err := os.Remove(files.key + ".new"); err != nil {
+			t.Fatal(err)
+		}
+		if err := FinishCertificateRotation(files.cert, files.key, files.successions); err != nil {
+			t.Fatal(err)
+		}
+		if loaded := files.loadedID(t); loaded != id {
+			t.Errorf("loaded %s, expected %s", loaded, id)
+		}
+		if _, err := os.Stat(files.cert + ".new"); err == nil {
+			t.Error("new certificate left behind")
+		}
+	})
+
+	for steps, undone := range map[int]bool{1: true, 2: false, 3: false} {
+		files, oldID := newCertFiles(t)
+		newID := interruptedRotation(t, files, steps)
+		if err := FinishCertificateRotation(files.cert, files.key, files.successions); err != nil {
+			t.Fatal(err)
+		}
+		expected := newID
+		if undone {
+			expected = oldID
+		}
+		if loaded := files.loadedID(t); loaded != expected {
+			t.Errorf("after %d steps: loaded %s, expected %s", steps, loaded, expected)
+		}
+		for _, file := range []string{files.cert + ".new", files.key + ".new"} {
+			if _, err := os.Stat(file); err == nil {
+				t.Errorf("after %d steps: %s left behind", steps, file)
+			}
+		}
+	}
+
+	t.Run("mismatched key", func(t *testing.T) {
+		files, _ := newCertFiles(t)
+		interruptedRotation(t, files, 3)
+		if _, err := tlsutil.NewCertificate(files.cert+".other", files.key+".new", tlsDefaultCommonName, deviceCertLifetimeDays); err != nil {
+			t.Fatal(err)
+		}
+		if err := FinishCertificateRotation(files.cert, files.key, files.successions); err == nil {
+			t.Error("expected an error when the key doesn't match the certificate in place")
+		}
+	})
+}
@@ -0,0 +1,433 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+// Package webhook sends events to the configured webhooks.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thejerf/suture/v4"
+
+	"github.com/syncthing/syncthing/lib/build"
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/dialer"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/sync"
+)
+
+// Each event a webhook is interested in is queued for it, and each queued
+// delivery is stored in the database under its own key, so that events
+// survive a restart while the receiving end is unavailable. Events are
+// delivered one at a time and in order; a failed delivery is retried after
+// the retry interval, doubled for every further failure, until the maximum
+// number of attempts is reached.
+
+const (
+	maxQueued   = 1000
+	sendTimeout = 30 * time.Second
+	kvPrefix    = "webhook/"
+
+	SignatureHeader = "X-Syncthing-Signature"
+	EventHeader     = "X-Syncthing-Event"
+	WebhookHeader   = "X-Syncthing-Webhook"
+)
+
+type Service interface {
+	suture.Service
+	config.Committer
+	Status() []Status
+}
+
+// Status is the delivery status of a webhook.
+type Status struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Paused      bool      `json:"paused"`
+	Queued      int       `json:"queued"`
+	Delivered   int       `json:"delivered"`
+	Failed      int       `json:"failed"`  // given up on after the maximum number of attempts
+	Dropped     int       `json:"dropped"` // not queued, as the queue was full
+	LastAttempt time.Time `json:"lastAttempt"`
+	LastSuccess time.Time `json:"lastSuccess"`
+	NextAttempt time.Time `json:"nextAttempt"`
+	LastError   *string   `json:"lastError"`
+}
+
+type delivery struct {
+	Type     events.EventType `json:"type"`
+	GlobalID int              `json:"globalID"`
+	Payload  json.RawMessage  `json:"payload"`
+	Attempts int              `json:"attempts"`
+	seq      int64            // position in the queue, part of the database key
+}
+
+type hook struct {
+	cfg    config.WebhookConfiguration
+	filter events.Filter
+	queue  []delivery
+	next   int64 // sequence number of the next queued delivery
+	status Status
+	busy   bool // a delivery is in progress
+}
+
+type result struct {
+	id  string
+	seq int64
+	err error
+}
+
+type service struct {
+	cfg      config.Wrapper
+	evLogger events.Logger
+	kv       *db.NamespacedKV
+	client   *http.Client
+	changed  chan struct{}
+	results  chan result
+
+	mut   sync.Mutex
+	hooks map[string]*hook
+}
+
+func New(cfg config.Wrapper, evLogger events.Logger, kv *db.NamespacedKV) Service {
+	return &service{
+		cfg:      cfg,
+		evLogger: evLogger,
+		kv:       kv,
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext: dialer.DialContext,
+				Proxy:       http.ProxyFromEnvironment,
+			},
+			Timeout: sendTimeout,
+		},
+		changed: make(chan struct{}, 1),
+		results: make(chan result),
+		mut:     sync.NewMutex(),
+		hooks:   make(map[string]*hook),
+	}
+}
+
+func (s *service) Serve(ctx context.Context) error {
+	sub := s.evLogger.Subscribe(events.AllEvents)
+	defer sub.Unsubscribe()
+
+	s.cfg.Subscribe(s)
+	defer s.cfg.Unsubscribe(s)
+	s.applyConfig(s.cfg.Webhooks())
+
+	timer := time.NewTimer(time.Hour)
+	defer timer.Stop()
+
+	for {
+		wait := s.dispatch(ctx)
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(wait)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-sub.C():
+			if !ok {
+				return nil
+			}
+			s.enqueue(ev)
+		case <-s.changed:
+			s.applyConfig(s.cfg.Webhooks())
+		case res := <-s.results:
+			s.handleResult(res)
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *service) CommitConfiguration(from, to config.Configuration) bool {
+	if !reflect.DeepEqual(from.Webhooks, to.Webhooks) {
+		select {
+		case s.changed <- struct{}{}:
+		default:
+		}
+	}
+	return true
+}
+
+func (*service) String() string {
+	return "webhook.Service"
+}
+
+// Status returns the delivery status of the configured webhooks.
+func (s *service) Status() []Status {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	statuses := make([]Status, 0, len(s.hooks))
+	for _, cfg := range s.cfg.Webhooks() {
+		if h, ok := s.hooks[cfg.ID]; ok {
+			status := h.status
+			status.Queued = len(h.queue)
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+func (s *service) applyConfig(cfgs []config.WebhookConfiguration) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	hooks := make(map[string]*hook, len(cfgs))
+	for _, cfg := range cfgs {
+		filter, err := cfg.Filter()
+		if err != nil {
+			// Can't happen, as the configuration was verified.
+			l.Warnf("Webhook %s: %v", cfg.ID, err)
+			continue
+		}
+		h, ok := s.hooks[cfg.ID]
+		if !ok {
+			h = &hook{status: Status{ID: cfg.ID}}
+			h.queue, h.next = s.loadQueue(cfg.ID)
+		}
+		h.cfg = cfg
+		h.filter = filter
+		h.status.URL = cfg.URL
+		h.status.Paused = cfg.Paused
+		hooks[cfg.ID] = h
+	}
+	for id := range s.hooks {
+		if _, ok := hooks[id]; !ok {
+			s.deleteQueue(id)
+		}
+	}
+	s.hooks = hooks
+}
+
+func (s *service) enqueue(ev events.Event) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	var payload []byte
+	for id, h := range s.hooks {
+		if !h.filter.Matches(ev) {
+			continue
+		}
+		if len(h.queue) >= maxQueued {
+			h.status.Dropped++
+			continue
+		}
+		if payload == nil {
+			var err error
+			payload, err = json.Marshal(ev)
+			if err != nil {
+				l.Debugln("Webhook event:", err)
+				return
+			}
+		}
+		d := delivery{Type: ev.Type, GlobalID: ev.GlobalID, Payload: payload, seq: h.next}
+		h.next++
+		h.queue = append(h.queue, d)
+		s.saveDelivery(id, d)
+	}
+}
+
+// dispatch starts the deliveries that are due, and returns how long until
+// the next one is.
+func (s *service) dispatch(ctx context.Context) time.Duration {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	now := time.Now()
+	wait := time.Hour
+	for id, h := range s.hooks {
+		if h.busy || h.cfg.Paused || len(h.queue) == 0 {
+			continue
+		}
+		if due := h.status.NextAttempt.Sub(now); due > 0 {
+			if due < wait {
+				wait = due
+			}
+			continue
+		}
+		h.busy = true
+		go s.deliver(ctx, id, h.cfg, h.queue[0])
+	}
+	return wait
+}
+
+func (s *service) deliver(ctx context.Context, id string, cfg config.WebhookConfiguration, d delivery) {
+	err := s.send(ctx, cfg, d)
+	select {
+	case s.results <- result{id: id, seq: d.seq, err: err}:
+	case <-ctx.Done():
+	}
+}
+
+func (s *service) send(ctx context.Context, cfg config.WebhookConfiguration, d delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "syncthing/"+build.Version)
+	req.Header.Set(EventHeader, d.Type.String())
+	req.Header.Set(WebhookHeader, cfg.ID)
+	if cfg.Secret != "" {
+		req.Header.Set(SignatureHeader, Signature(cfg.Secret, d.Payload))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s: %s", cfg.URL, resp.Status)
+	}
+	return nil
+}
+
+func (s *service) handleResult(res result) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	h, ok := s.hooks[res.id]
+	if !ok {
+		// Removed in the meantime
+		return
+	}
+	h.busy = false
+	if len(h.queue) == 0 || h.queue[0].seq != res.seq {
+		return
+	}
+
+	now := time.Now()
+	h.status.LastAttempt = now
+	if res.err == nil {
+		s.deleteDelivery(res.id, h.queue[0])
+		h.queue = h.queue[1:]
+		h.status.Delivered++
+		h.status.LastSuccess = now
+		h.status.NextAttempt = time.Time{}
+		h.status.LastError = nil
+	} else {
+		h.status.LastError = events.Error(res.err)
+		h.queue[0].Attempts++
+		if h.queue[0].Attempts >= h.cfg.MaxAttempts {
+			l.Infof("Webhook %s: giving up on event %d after %d attempts: %v", res.id, h.queue[0].GlobalID, h.queue[0].Attempts, res.err)
+			s.deleteDelivery(res.id, h.queue[0])
+			h.queue = h.queue[1:]
+			h.status.Failed++
+			h.status.NextAttempt = time.Time{}
+		} else {
+			l.Debugf("Webhook %s: delivering event %d: %v", res.id, h.queue[0].GlobalID, res.err)
+			h.status.NextAttempt = now.Add(h.cfg.RetryInterval(h.queue[0].Attempts))
+			s.saveDelivery(res.id, h.queue[0])
+		}
+	}
+}
+
+// queuePrefix is the database key prefix of the deliveries queued for a
+// webhook. Each delivery is stored at the prefix followed by its sequence
+// number, as 16 hex digits so that the keys sort in queue order.
+func queuePrefix(id string) string {
+	return kvPrefix + id + "/"
+}
+
+func deliveryKey(id string, seq int64) string {
+	return fmt.Sprintf("%s%016x", queuePrefix(id), seq)
+}
+
+// queueKeys calls fn with the sequence number, key and value of each
+// delivery stored for the webhook, in queue order.
+func (s *service) queueKeys(id string, fn func(seq int64, key string, val []byte)) error {
+	prefix := queuePrefix(id)
+	return s.kv.IterateBytes(prefix, func(key string, val []byte) bool {
+		// Skip the deliveries of other webhooks whose ID starts with this
+		// one's, followed by a slash.
+		seq, err := strconv.ParseInt(strings.TrimPrefix(key, prefix), 16, 64)
+		if err == nil && len(key) == len(prefix)+16 {
+			fn(seq, key, val)
+		}
+		return true
+	})
+}
+
+// loadQueue returns the stored deliveries of the webhook and the sequence
+// number of the next one.
+func (s *service) loadQueue(id string) ([]delivery, int64) {
+	var queue []delivery
+	var next int64
+	err := s.queueKeys(id, func(seq int64, _ string, val []byte) {
+		next = seq + 1
+		var d delivery
+		if err := json.Unmarshal(val, &d); err != nil {
+			l.Debugf("Webhook %s: loading delivery %d: %v", id, seq, err)
+			return
+		}
+		d.seq = seq
+		queue = append(queue, d)
+	})
+	if err != nil {
+		l.Debugf("Webhook %s: loading queue: %v", id, err)
+	}
+	return queue, next
+}
+
+func (s *service) saveDelivery(id string, d delivery) {
+	bs, err := json.Marshal(d)
+	if err == nil {
+		err = s.kv.PutBytes(deliveryKey(id, d.seq), bs)
+	}
+	if err != nil {
+		l.Warnf("Webhook %s: saving delivery: %v", id, err)
+	}
+}
+
+func (s *service) deleteDelivery(id string, d delivery) {
+	if err := s.kv.Delete(deliveryKey(id, d.seq)); err != nil {
+		l.Warnf("Webhook %s: deleting delivery: %v", id, err)
+	}
+}
+
+func (s *service) deleteQueue(id string) {
+	var keys []string
+	err := s.queueKeys(id, func(_ int64, key string, _ []byte) {
+		keys = append(keys, key)
+	})
+	for _, key := range keys {
+		if err == nil {
+			err = s.kv.Delete(key)
+		}
+	}
+	if err != nil {
+		l.Debugf("Webhook %s: deleting queue: %v", id, err)
+	}
+}
+
+// Signature returns the value of the signature header for the payload:
+// "sha256=" followed by the hex encoded HMAC-SHA256 of the payload, keyed
+// with the secret.
+func Signature(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,257 @@
+// Copyright (C) 2023 The Syncthing Authors.
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this file,
+// You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/config"
+	"github.com/syncthing/syncthing/lib/db"
+	"github.com/syncthing/syncthing/lib/db/backend"
+	"github.com/syncthing/syncthing/lib/events"
+	"github.com/syncthing/syncthing/lib/protocol"
+)
+
+type received struct {
+	event     events.Event
+	signature string
+	status    int
+}
+
+func newReceiver(t *testing.T, secret string, statuses ...int) (*httptest.Server, chan received) {
+	t.Helper()
+	recv := make(chan received, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var ev events.Event
+		if err := json.Unmarshal(body, &ev); err != nil {
+			t.Error(err)
+		}
+		status := http.StatusOK
+		if len(statuses) > 0 {
+			status, statuses = statuses[0], statuses[1:]
+		}
+		if secret != "" && r.Header.Get(SignatureHeader) != Signature(secret, body) {
+			status = http.StatusUnauthorized
+		}
+		w.WriteHeader(status)
+		recv <- received{event: ev, signature: r.Header.Get(SignatureHeader), status: status}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, recv
+}
+
+func startService(t *testing.T, evLogger events.Logger, kv *db.NamespacedKV, hooks ...config.WebhookConfiguration) (*service, context.CancelFunc) {
+	t.Helper()
+	cfg := config.New(protocol.LocalDeviceID)
+	cfg.Webhooks = hooks
+	w := config.Wrap("", cfg, protocol.LocalDeviceID, events.NoopLogger)
+
+	svc := New(w, evLogger, kv).(*service)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		svc.Serve(ctx)
+		close(done)
+	}()
+	// Subscribed to events once the hooks are set up
+	for len(svc.Status()) != len(hooks) {
+		time.Sleep(time.Millisecond)
+	}
+	return svc, func() {
+		cancel()
+		<-done
+	}
+}
+
+func expectReceived(t *testing.T, recv chan received, folder string, status int) {
+	t.Helper()
+	select {
+	case r := <-recv:
+		data, _ := r.event.Data.(map[string]interface{})
+		if data["folder"] != folder {
+			t.Errorf("received event for folder %v, expected %v", data["folder"], folder)
+		}
+		if r.status != status {
+			t.Errorf("request got status %d, expected %d", r.status, status)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for webhook request")
+	}
+}
+
+func TestDeliveryWithRetry(t *testing.T) {
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go evLogger.Serve(ctx)
+
+	srv, recv := newReceiver(t, "secret", http.StatusInternalServerError)
+	svc, stop := startService(t, evLogger, db.NewMiscDataNamespace(backend.OpenMemory()), config.WebhookConfiguration{
+		ID:             "test",
+		URL:            srv.URL,
+		Events:         []string{"StateChanged"},
+		Folders:        []string{"a"},
+		Secret:         "secret",
+		MaxAttempts:    3,
+		RetryIntervalS: 1,
+	})
+	defer stop()
+
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "b"})
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "a"})
+
+	expectReceived(t, recv, "a", http.StatusInternalServerError)
+	expectReceived(t, recv, "a", http.StatusOK)
+
+	var status Status
+	for i := 0; i < 1000; i++ {
+		status = svc.Status()[0]
+		if status.Delivered > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if status.Delivered != 1 || status.Queued != 0 || status.LastError != nil {
+		t.Errorf("unexpected status %+v", status)
+	}
+	select {
+	case r := <-recv:
+		t.Errorf("unexpected request %v", r)
+	default:
+	}
+}
+
+func TestQueuePersisted(t *testing.T) {
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go evLogger.Serve(ctx)
+
+	srv, recv := newReceiver(t, "")
+	kv := db.NewMiscDataNamespace(backend.OpenMemory())
+	hook := config.WebhookConfiguration{
+		ID:             "test",
+		URL:            srv.URL,
+		Paused:         true,
+		Events:         []string{"StateChanged"},
+		MaxAttempts:    3,
+		RetryIntervalS: 1,
+	}
+
+	svc, stop := startService(t, evLogger, kv, hook)
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "a"})
+	for svc.Status()[0].Queued == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	stop()
+
+	hook.Paused = false
+	_, stop = startService(t, evLogger, kv, hook)
+	defer stop()
+	expectReceived(t, recv, "a", http.StatusOK)
+}
+
+func TestQueueStoredPerDelivery(t *testing.T) {
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go evLogger.Serve(ctx)
+
+	srv, recv := newReceiver(t, "")
+	kv := db.NewMiscDataNamespace(backend.OpenMemory())
+	hook := config.WebhookConfiguration{
+		ID:             "test",
+		URL:            srv.URL,
+		Paused:         true,
+		Events:         []string{"StateChanged"},
+		MaxAttempts:    3,
+		RetryIntervalS: 1,
+	}
+	// A webhook whose ID extends the other's, to check that their queues
+	// are kept apart.
+	other := hook
+	other.ID = "test/other"
+
+	storedKeys := func() []string {
+		var keys []string
+		kv.IterateBytes(kvPrefix, func(key string, _ []byte) bool {
+			keys = append(keys, key)
+			return true
+		})
+		return keys
+	}
+
+	svc, stop := startService(t, evLogger, kv, hook, other)
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "a"})
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "b"})
+	for svc.Status()[0].Queued != 2 || svc.Status()[1].Queued != 2 {
+		time.Sleep(time.Millisecond)
+	}
+	stop()
+	if keys := storedKeys(); len(keys) != 4 {
+		t.Fatalf("stored keys %v, expected one per delivery", keys)
+	}
+
+	// Delivered events are deleted one at a time, leaving the queue of the
+	// other, still paused, webhook alone.
+	hook.Paused = false
+	svc, stop = startService(t, evLogger, kv, hook, other)
+	defer stop()
+	expectReceived(t, recv, "a", http.StatusOK)
+	expectReceived(t, recv, "b", http.StatusOK)
+	for svc.Status()[0].Queued != 0 {
+		time.Sleep(time.Millisecond)
+	}
+	if keys := storedKeys(); len(keys) != 2 || svc.Status()[1].Queued != 2 {
+		t.Errorf("stored keys %v after delivery, expected those of the other webhook", keys)
+	}
+}
+
+func TestResultMatchedBySequence(t *testing.T) {
+	evLogger := events.NewLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go evLogger.Serve(ctx)
+
+	kv := db.NewMiscDataNamespace(backend.OpenMemory())
+	svc, stop := startService(t, evLogger, kv, config.WebhookConfiguration{
+		ID:             "test",
+		URL:            "http://127.0.0.1:0",
+		Paused:         true,
+		Events:         []string{"StateChanged"},
+		MaxAttempts:    3,
+		RetryIntervalS: 1,
+	})
+	defer stop()
+
+	evLogger.Log(events.StateChanged, map[string]string{"folder": "a"})
+	for svc.Status()[0].Queued != 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// A result for another delivery with the same global event ID, as
+	// after a restart, leaves the queued one alone.
+	svc.mut.Lock()
+	head := svc.hooks["test"].queue[0]
+	svc.mut.Unlock()
+	svc.handleResult(result{id: "test", seq: head.seq + 1})
+	if status := svc.Status()[0]; status.Queued != 1 || status.Delivered != 0 {
+		t.Errorf("unexpected status %+v after a result for another delivery", status)
+	}
+
+	svc.handleResult(result{id: "test", seq: head.seq})
+	if status := svc.Status()[0]; status.Queued != 0 || status.Delivered != 1 {
+		t.Errorf("unexpected status %+v after the result for the delivery", status)
+	}
+}